@@ -92,10 +92,13 @@ var (
 	generateGetters         = flag.Bool("generate_getters", false, "If set to true, getter methdos that retrieve or create an element are generated for YANG container (Go struct pointer) or list (Go map) fields within the generated code.")
 	generateDelete          = flag.Bool("generate_delete", false, "If set to true, delete methods are generated for YANG lists (Go maps) within the Go code.")
 	generateLeafGetters     = flag.Bool("generate_leaf_getters", false, "If set to true, getters for YANG leaves are generated within the Go code. Caution should be exercised when using leaf getters, since values that are explicitly set to the Go default/zero value are not distinguishable from those that are unset when retrieved via the GetXXX method.")
+	generateLeafSetters     = flag.Bool("generate_leaf_setters", false, "If set to true, setters for YANG leaves, which validate the value being set against the YANG schema, are generated within the Go code. Setters are only generated when the JSON schema is generated.")
 	generateSimpleUnions    = flag.Bool("generate_simple_unions", false, "If set to true, then generated typedefs will be used to represent union subtypes within Go code instead of wrapper struct types.")
 	includeModelData        = flag.Bool("include_model_data", false, "If set to true, a slice of gNMI ModelData messages are included in the generated Go code containing the details of the input schemas from which the code was generated.")
 	generatePopulateDefault = flag.Bool("generate_populate_defaults", false, "If set to true, a PopulateDefault method will be generated for all GoStructs which recursively populates default values.")
 	generateValidateFnName  = flag.String("validate_fn_name", "Validate", "The Name of the proxy function for the Validate functionality.")
+	sensitiveExtensions     = flag.String("sensitive_extensions", "", "Comma separated set of YANG extensions, e.g., oc-ext:sensitive, that mark a node as containing sensitive data. Fields storing such nodes are tagged such that their values can be redacted when rendered.")
+	generateMarshalMethods  = flag.Bool("generate_marshal_methods", false, "If set to true, methods that render GoStructs to RFC7951 JSON and gNMI Notifications, and unmarshal RFC7951 JSON into them, without reflection are generated. These are used automatically by ygot and ytypes.")
 
 	// Flags used for PathStruct generation only.
 	schemaStructPath        = flag.String("schema_struct_path", "", "The Go import path for the schema structs package. This should be specified if and only if schema structs are not being generated at the same time as path structs.")
@@ -107,6 +110,8 @@ var (
 	trimPathPackagePrefix   = flag.String("trim_path_package_prefix", "", "Module prefix to trim from generated path struct package names (e.g. 'openconfig-'), when split_pathstructs_by_module=true.")
 	baseImportPath          = flag.String("base_import_path", "", "Base import path used to concatenate with module package relative paths for path struct imports when split_pathstructs_by_module=true.")
 	packageSuffix           = flag.String("path_struct_package_suffix", "path", "Suffix to append to generated Go package names, when split_pathstructs_by_module=true.")
+	keylessListPaths        = flag.Bool("generate_keyless_list_paths", false, "Whether to generate methods for constructing paths to entries of keyless lists, which are identified by their index.")
+	generateAccessors       = flag.Bool("generate_path_accessors", false, "Whether to generate Get and Set methods on the path structs which access the corresponding node within a tree of schema structs rooted at the fakeroot.")
 )
 
 // writeGoCodeSingleFile takes a gogen.GeneratedCode struct and writes the Go code
@@ -309,6 +314,12 @@ func main() {
 		}
 	}
 
+	// Determine the set of extensions that mark YANG nodes as sensitive.
+	var sensitiveExts []string
+	if len(*sensitiveExtensions) > 0 {
+		sensitiveExts = strings.Split(*sensitiveExtensions, ",")
+	}
+
 	if *generateGoStructs {
 		generateGoStructsSingleFile := *ocStructsOutputFile != ""
 		generateGoStructsMultipleFiles := *outputDir != ""
@@ -360,12 +371,15 @@ func main() {
 				GenerateDeleteMethod:                *generateDelete,
 				GenerateAppendMethod:                *generateAppend,
 				GenerateLeafGetters:                 *generateLeafGetters,
+				GenerateLeafSetters:                 *generateLeafSetters,
 				GeneratePopulateDefault:             *generatePopulateDefault,
 				ValidateFunctionName:                *generateValidateFnName,
 				GenerateSimpleUnions:                *generateSimpleUnions,
 				IncludeModelData:                    *includeModelData,
 				AppendEnumSuffixForSimpleUnionEnums: *appendEnumSuffixForSimpleUnionEnums,
 				IgnoreShadowSchemaPaths:             *ignoreShadowSchemaPaths,
+				SensitiveExtensions:                 sensitiveExts,
+				GenerateMarshalMethods:              *generateMarshalMethods,
 			},
 		)
 
@@ -450,6 +464,9 @@ func main() {
 		SplitByModule:           *splitByModule,
 		BaseImportPath:          *baseImportPath,
 		PackageSuffix:           *packageSuffix,
+
+		GenerateKeylessListPaths: *keylessListPaths,
+		GenerateAccessors:        *generateAccessors,
 	}
 
 	pathCode, _, errs := pcg.GeneratePathCode(generateModules, includePaths)
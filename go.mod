@@ -13,4 +13,5 @@ require (
 	github.com/pmezard/go-difflib v1.0.0
 	google.golang.org/grpc v1.37.0
 	google.golang.org/protobuf v1.26.0
+	gopkg.in/yaml.v3 v3.0.1
 )
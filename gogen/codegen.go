@@ -90,6 +90,13 @@ type GoOpts struct {
 	// whether a field has been explicitly set to the zero value (i.e., an integer
 	// field is set to 0), or whether the field was actually unset.
 	GenerateLeafGetters bool
+	// GenerateLeafSetters specifies whether Set* methods should be created
+	// for leaf fields of a struct. Each method validates the value supplied
+	// to it against the restrictions of the leaf in the YANG schema before
+	// setting the field, such that a data tree can be modified without the
+	// cost of validating the entire tree. It is used only when the JSON
+	// schema is generated.
+	GenerateLeafSetters bool
 	// GeneratePopulateDefault specifies whether a PopulateDefaults method
 	// should be generated for every GoStruct that recursively populates
 	// default values within the subtree.
@@ -116,6 +123,20 @@ type GoOpts struct {
 	// compression is enabled, that the shadowed paths are to be ignored
 	// while while unmarshalling.
 	IgnoreShadowSchemaPaths bool
+	// SensitiveExtensions is the set of YANG extensions, e.g.,
+	// "oc-ext:sensitive", that mark a schema node as containing sensitive
+	// data. Fields corresponding to such nodes are tagged with ygotSensitive
+	// such that their values can be redacted when the GoStruct is rendered.
+	// An extension that is not qualified with a prefix matches the extension
+	// with any prefix.
+	SensitiveExtensions []string
+	// GenerateMarshalMethods specifies whether MarshalRFC7951 and
+	// ToNotifications methods, and UnmarshalRFC7951 methods when the JSON
+	// schema is generated, should be created for each generated struct.
+	// These methods are used by ygot and ytypes in place of reflecting over
+	// the struct when rendering it to RFC7951 JSON or gNMI Notifications,
+	// and when unmarshalling JSON into it, producing identical results.
+	GenerateMarshalMethods bool
 }
 
 // GeneratedCode contains generated code snippets that can be processed by the calling
@@ -205,7 +226,9 @@ func (cg *CodeGenerator) Generate(yangFiles, includePaths []string) (*GeneratedC
 	}
 
 	var codegenErr util.Errors
-	ir, err := ygen.GenerateIR(yangFiles, includePaths, NewGoLangMapper(cg.GoOptions.GenerateSimpleUnions), opts)
+	langMapper := NewGoLangMapper(cg.GoOptions.GenerateSimpleUnions)
+	langMapper.sensitiveExtensions = cg.GoOptions.SensitiveExtensions
+	ir, err := ygen.GenerateIR(yangFiles, includePaths, langMapper, opts)
 	if err != nil {
 		return nil, util.AppendErr(codegenErr, err)
 	}
@@ -194,6 +194,25 @@ func TestSimpleStructs(t *testing.T) {
 			},
 		},
 		wantStructsCodeFile: filepath.Join(TestRoot, "testdata/structs/openconfig-withlist.formatted-txt"),
+	}, {
+		name:    "OpenConfig schema test - list with marshalling methods",
+		inFiles: []string{filepath.Join(datapath, "openconfig-withlist.yang")},
+		inConfig: CodeGenerator{
+			IROptions: ygen.IROptions{
+				TransformationOptions: ygen.TransformationOpts{
+					CompressBehaviour:                    genutil.PreferIntendedConfig,
+					ShortenEnumLeafNames:                 true,
+					UseDefiningModuleForTypedefEnumNames: true,
+					EnumerationsUseUnderscores:           true,
+				},
+			},
+			GoOptions: GoOpts{
+				GenerateSimpleUnions:   true,
+				AddAnnotationFields:    true,
+				GenerateMarshalMethods: true,
+			},
+		},
+		wantStructsCodeFile: filepath.Join(TestRoot, "testdata/structs/openconfig-withlist.marshal-methods.formatted-txt"),
 	}, {
 		name:    "OpenConfig schema test - list and associated method (rename, new) - using operational state",
 		inFiles: []string{filepath.Join(datapath, "openconfig-withlist.yang")},
@@ -351,6 +370,46 @@ func TestSimpleStructs(t *testing.T) {
 		},
 		wantStructsCodeFile: filepath.Join(TestRoot, "testdata/schema/openconfig-options-compress.formatted-txt"),
 		wantSchemaFile:      filepath.Join(TestRoot, "testdata/schema/openconfig-options-compress-schema.json"),
+	}, {
+		name:    "schema test with compression and marshalling methods",
+		inFiles: []string{filepath.Join(TestRoot, "testdata/schema/openconfig-options.yang")},
+		inConfig: CodeGenerator{
+			IROptions: ygen.IROptions{
+				TransformationOptions: ygen.TransformationOpts{
+					CompressBehaviour:                    genutil.PreferIntendedConfig,
+					ShortenEnumLeafNames:                 true,
+					UseDefiningModuleForTypedefEnumNames: true,
+					EnumerationsUseUnderscores:           true,
+				},
+			},
+			GoOptions: GoOpts{
+				GenerateJSONSchema:     true,
+				GenerateSimpleUnions:   true,
+				GenerateMarshalMethods: true,
+			},
+		},
+		wantStructsCodeFile: filepath.Join(TestRoot, "testdata/schema/openconfig-options-compress.marshal-methods.formatted-txt"),
+		wantSchemaFile:      filepath.Join(TestRoot, "testdata/schema/openconfig-options-compress-schema.json"),
+	}, {
+		name:    "schema test with compression and leaf setters",
+		inFiles: []string{filepath.Join(TestRoot, "testdata/schema/openconfig-options.yang")},
+		inConfig: CodeGenerator{
+			IROptions: ygen.IROptions{
+				TransformationOptions: ygen.TransformationOpts{
+					CompressBehaviour:                    genutil.PreferIntendedConfig,
+					ShortenEnumLeafNames:                 true,
+					UseDefiningModuleForTypedefEnumNames: true,
+					EnumerationsUseUnderscores:           true,
+				},
+			},
+			GoOptions: GoOpts{
+				GenerateJSONSchema:   true,
+				GenerateSimpleUnions: true,
+				GenerateLeafSetters:  true,
+			},
+		},
+		wantStructsCodeFile: filepath.Join(TestRoot, "testdata/schema/openconfig-options-compress.leaf-setters.formatted-txt"),
+		wantSchemaFile:      filepath.Join(TestRoot, "testdata/schema/openconfig-options-compress-schema.json"),
 	}, {
 		name:    "schema test without compression",
 		inFiles: []string{filepath.Join(TestRoot, "testdata/schema/openconfig-options.yang")},
@@ -152,6 +152,10 @@ type GoLangMapper struct {
 	// NOTE: This flag will be removed as part of ygot's v1 release.
 	simpleUnions bool
 
+	// sensitiveExtensions is the set of YANG extensions that mark a schema
+	// node as containing sensitive data.
+	sensitiveExtensions []string
+
 	// UnimplementedLangMapperExt ensures GoLangMapper implements the
 	// LangMapperExt interface for forwards compatibility.
 	ygen.UnimplementedLangMapperExt
@@ -172,6 +176,16 @@ func NewGoLangMapper(simpleUnions bool) *GoLangMapper {
 	}
 }
 
+// PopulateFieldFlags populates extra information for a field of a generated
+// GoStruct. The field is flagged as sensitive if its schema has one of the
+// sensitive extensions specified for the GoLangMapper.
+func (s *GoLangMapper) PopulateFieldFlags(_ ygen.NodeDetails, field *yang.Entry) map[string]string {
+	if !util.HasExtension(field, s.sensitiveExtensions) {
+		return nil
+	}
+	return map[string]string{sensitiveFieldFlagKey: "true"}
+}
+
 // resolveTypeArgs is a structure used as an input argument to the yangTypeToGoType
 // function which allows extra context to be handed on. This provides the ability
 // to use not only the YangType but also the yang.Entry that the type was part of
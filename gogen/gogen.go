@@ -47,6 +47,9 @@ const (
 	// annotationFieldType defines the type that should be used for the
 	// annotation/metadata fields within each struct when they are generated.
 	annotationFieldType string = "[]ygot.Annotation"
+	// sensitiveFieldFlagKey is the key of the field flag that indicates
+	// that a field stores sensitive data.
+	sensitiveFieldFlagKey string = "ygot:sensitive"
 )
 
 // The methods in this file take the structs that have been generated by
@@ -183,6 +186,11 @@ type goStructField struct {
 	// in templates to determine whether GetXXX methods should be created using
 	// the base template.
 	IsYANGList bool
+	// MarshalKind describes how the field is handled by the generated
+	// marshalling methods. It is one of "leaf", "enum", "union", "empty",
+	// "binary", "leaflist", "container", "list", "keylesslist" or
+	// "annotation".
+	MarshalKind string
 }
 
 // goUnionInterface contains a definition of an interface that should
@@ -279,6 +287,10 @@ type generatedLeafGetter struct {
 	IsPtr bool
 	// Receiver is the name of the receiver for the getter method.
 	Receiver string
+	// IsListKey stores whether the field is a key of the list represented by
+	// the receiver, for which no setter is generated since changing it would
+	// not change the key of the list entry within its parent's map.
+	IsListKey bool
 }
 
 // generatedDefaultMethod is used to represent parameters required to generate
@@ -781,6 +793,24 @@ func (t *{{ .Receiver }}) Get{{ .Name }}() {{ .Type }} {
 	}
 	return {{ if .IsPtr -}} * {{- end -}} t.{{ .Name }}
 }
+`)
+
+	// goLeafSetterTemplate defines a template for a function that, for a
+	// particular leaf, generates a setter method that validates the value
+	// being set.
+	goLeafSetterTemplate = mustMakeTemplate("setLeaf", `
+// Set{{ .Name }} sets the leaf {{ .Name }} of the {{ .Receiver }} struct to v if
+// it is valid according to the YANG schema of the leaf. Only v is validated,
+// such that the value of a leafref is checked against the type of the leaf
+// that it refers to, but it is not resolved. If v is invalid, an error is
+// returned and the leaf is not modified.
+func (t *{{ .Receiver }}) Set{{ .Name }}(v {{ .Type }}) error {
+	if err := ytypes.ValidateField(SchemaTree["{{ .Receiver }}"], t, "{{ .Name }}", {{ if .IsPtr -}} & {{- end -}} v); err != nil {
+		return err
+	}
+	t.{{ .Name }} = {{ if .IsPtr -}} & {{- end -}} v
+	return nil
+}
 `)
 
 	// goDefaultMethodTemplate is a template for generating a PopulateDefaults method
@@ -816,6 +846,136 @@ func (t *{{ .Receiver }}) PopulateDefaults() {
 	}
 	{{- end }}
 }
+`)
+
+	// goMarshalMethodsTemplate is a template for generating the methods that
+	// allow a GoStruct to be rendered to RFC7951 JSON and gNMI Notifications,
+	// and unmarshalled from RFC7951 JSON, without reflecting over its fields.
+	// A variable storing the pre-parsed struct tags of each field is also
+	// output.
+	goMarshalMethodsTemplate = mustMakeTemplate("marshalMethods", `
+// Λ{{ .StructName }}_Fields stores the descriptions of the fields of {{ .StructName }},
+// which are used by its generated marshalling methods.
+var Λ{{ .StructName }}_Fields = struct {
+{{- range $field := .Fields }}
+	{{ $field.Name }} *ygot.StructField
+{{- end }}
+}{
+{{- range $field := .Fields }}
+	{{ $field.Name }}: ygot.NewStructField("{{ $field.Name }}", `+"`"+`{{ $field.Tags }}`+"`"+`),
+{{- end }}
+}
+
+// MarshalRFC7951 implements the ygot.RFC7951Marshaler interface, writing each
+// field of {{ .StructName }} to w.
+func (t *{{ .StructName }}) MarshalRFC7951(w *ygot.RFC7951Writer) {
+{{- range $field := .Fields }}
+	{{- $f := printf "Λ%s_Fields.%s" $.StructName $field.Name }}
+	{{- if eq $field.MarshalKind "enum" }}
+	w.Enum({{ $f }}, t.{{ $field.Name }})
+	{{- else if eq $field.MarshalKind "empty" }}
+	w.Empty({{ $f }}, bool(t.{{ $field.Name }}))
+	{{- else }}
+	if t.{{ $field.Name }} != nil {
+		{{- if eq $field.MarshalKind "leaf" }}
+		w.Leaf({{ $f }}, *t.{{ $field.Name }})
+		{{- else if eq $field.MarshalKind "union" }}
+		w.Union({{ $f }}, t.{{ $field.Name }})
+		{{- else if eq $field.MarshalKind "binary" }}
+		w.Binary({{ $f }}, t.{{ $field.Name }})
+		{{- else if eq $field.MarshalKind "leaflist" }}
+		w.LeafList({{ $f }}, t.{{ $field.Name }})
+		{{- else if eq $field.MarshalKind "annotation" }}
+		w.Annotations({{ $f }}, t.{{ $field.Name }})
+		{{- else if eq $field.MarshalKind "container" }}
+		w.Container({{ $f }}, t.{{ $field.Name }})
+		{{- else if eq $field.MarshalKind "keylesslist" }}
+		w.KeylessList({{ $f }}, t.{{ $field.Name }})
+		{{- else if eq $field.MarshalKind "list" }}
+		l := make([]ygot.ListEntry, 0, len(t.{{ $field.Name }}))
+		for k, v := range t.{{ $field.Name }} {
+			l = append(l, ygot.ListEntry{Key: k, Value: v})
+		}
+		w.List({{ $f }}, l)
+		{{- end }}
+	}
+	{{- end }}
+{{- end }}
+}
+
+// ToNotifications implements the ygot.NotificationsMarshaler interface,
+// writing each populated field of {{ .StructName }} to w.
+func (t *{{ .StructName }}) ToNotifications(w *ygot.NotificationWriter) {
+{{- range $field := .Fields }}
+	{{- $f := printf "Λ%s_Fields.%s" $.StructName $field.Name }}
+	{{- if eq $field.MarshalKind "enum" }}
+	w.Enum({{ $f }}, t.{{ $field.Name }})
+	{{- else if ne $field.MarshalKind "empty" }}
+	if t.{{ $field.Name }} != nil {
+		{{- if eq $field.MarshalKind "container" }}
+		w.Container({{ $f }}, t.{{ $field.Name }})
+		{{- else if eq $field.MarshalKind "keylesslist" }}
+		w.KeylessList({{ $f }}, t.{{ $field.Name }})
+		{{- else if eq $field.MarshalKind "list" }}
+		l := make([]ygot.ListEntry, 0, len(t.{{ $field.Name }}))
+		for k, v := range t.{{ $field.Name }} {
+			l = append(l, ygot.ListEntry{Key: k, Value: v})
+		}
+		w.List({{ $f }}, l)
+		{{- else }}
+		w.Leaf({{ $f }}, t.{{ $field.Name }})
+		{{- end }}
+	}
+	{{- end }}
+{{- end }}
+}
+{{- if .GenerateUnmarshal }}
+
+// UnmarshalRFC7951 implements the ytypes.RFC7951Unmarshaler interface,
+// unmarshalling each field of {{ .StructName }} using u.
+func (t *{{ .StructName }}) UnmarshalRFC7951(u *ytypes.StructUnmarshaler) error {
+{{- range $field := .Fields }}
+	{{- $f := printf "Λ%s_Fields.%s" $.StructName $field.Name }}
+	{{- if eq $field.MarshalKind "annotation" }}
+	if err := u.Annotation({{ $f }}); err != nil {
+		return err
+	}
+	{{- else }}
+	if s, v, err := u.Field({{ $f }}); err != nil {
+		return err
+	} else if v != nil {
+		{{- if eq $field.MarshalKind "leaf" }}
+		if t.{{ $field.Name }} == nil {
+			t.{{ $field.Name }} = new({{ $field.Type }})
+		}
+		{{- else if eq $field.MarshalKind "container" }}
+		if t.{{ $field.Name }} == nil {
+			t.{{ $field.Name }} = &{{ slice $field.Type 1 }}{}
+		}
+		{{- else if eq $field.MarshalKind "list" }}
+		if t.{{ $field.Name }} == nil {
+			t.{{ $field.Name }} = make({{ $field.Type }})
+		}
+		{{- else if or (eq $field.MarshalKind "leaflist") (eq $field.MarshalKind "binary") (eq $field.MarshalKind "keylesslist") }}
+		if t.{{ $field.Name }} == nil {
+			t.{{ $field.Name }} = {{ $field.Type }}{}
+		}
+		{{- end }}
+		{{- if or (eq $field.MarshalKind "container") (eq $field.MarshalKind "list") }}
+		if err := u.Unmarshal(s, t.{{ $field.Name }}, v); err != nil {
+		{{- else if eq $field.MarshalKind "keylesslist" }}
+		if err := u.Unmarshal(s, &t.{{ $field.Name }}, v); err != nil {
+		{{- else }}
+		if err := u.Unmarshal(s, t, v); err != nil {
+		{{- end }}
+			return err
+		}
+	}
+	{{- end }}
+{{- end }}
+	return nil
+}
+{{- end }}
 `)
 
 	// goDeleteListTemplate defines a template for a function that, for a
@@ -1346,9 +1506,10 @@ func writeGoStruct(targetStruct *ygen.ParsedDirectory, goStructElements map[stri
 	if goOpts.AddAnnotationFields {
 		// Add the top-level struct metadata field.
 		structDef.Fields = append(structDef.Fields, &goStructField{
-			Name: fmt.Sprintf("%sMetadata", annotationPrefix),
-			Type: annotationFieldType,
-			Tags: `path:"@" ygotAnnotation:"true"`,
+			Name:        fmt.Sprintf("%sMetadata", annotationPrefix),
+			Type:        annotationFieldType,
+			Tags:        `path:"@" ygotAnnotation:"true"`,
+			MarshalKind: "annotation",
 		})
 	}
 
@@ -1376,9 +1537,13 @@ func writeGoStruct(targetStruct *ygen.ParsedDirectory, goStructElements map[stri
 			}
 
 			fieldDef = &goStructField{
-				Name:       fieldName,
-				Type:       fieldType,
-				IsYANGList: true,
+				Name:        fieldName,
+				Type:        fieldType,
+				IsYANGList:  true,
+				MarshalKind: "list",
+			}
+			if strings.HasPrefix(fieldType, "[]") {
+				fieldDef.MarshalKind = "keylesslist"
 			}
 			associatedDefaultMethod.ChildListNames = append(associatedDefaultMethod.ChildListNames, fieldName)
 
@@ -1406,6 +1571,7 @@ func writeGoStruct(targetStruct *ygen.ParsedDirectory, goStructElements map[stri
 				Name:            fieldName,
 				Type:            fmt.Sprintf("*%s", dir.Name),
 				IsYANGContainer: true,
+				MarshalKind:     "container",
 			}
 			associatedDefaultMethod.ChildContainerNames = append(associatedDefaultMethod.ChildContainerNames, fieldName)
 		case ygen.LeafNode, ygen.LeafListNode:
@@ -1486,19 +1652,22 @@ func writeGoStruct(targetStruct *ygen.ParsedDirectory, goStructElements map[stri
 			// If we are generating leaf getters, then append the relevant information
 			// to the associatedLeafGetters slice to be generated along with other
 			// associated methods.
+			_, isListKey := targetStruct.ListKeys[fName]
 			associatedLeafGetters = append(associatedLeafGetters, &generatedLeafGetter{
-				Name:     fieldName,
-				Type:     fType,
-				Zero:     zeroValue,
-				IsPtr:    scalarField,
-				Receiver: targetStruct.Name,
-				Default:  field.LangType.DefaultValue,
+				Name:      fieldName,
+				Type:      fType,
+				Zero:      zeroValue,
+				IsPtr:     scalarField,
+				Receiver:  targetStruct.Name,
+				Default:   field.LangType.DefaultValue,
+				IsListKey: isListKey,
 			})
 
 			fieldDef = &goStructField{
 				Name:          fieldName,
 				Type:          fType,
 				IsScalarField: scalarField,
+				MarshalKind:   leafMarshalKind(field),
 			}
 		default:
 			errs = append(errs, fmt.Errorf("unknown entity type for mapping to Go: %s, Kind: %v", field.YANGDetails.Path, field.Type))
@@ -1562,6 +1731,10 @@ func writeGoStruct(targetStruct *ygen.ParsedDirectory, goStructElements map[stri
 			}
 		}
 
+		if field.Flags[sensitiveFieldFlagKey] == "true" {
+			tagBuf.WriteString(` ygotSensitive:"true"`)
+		}
+
 		fieldDef.Tags = tagBuf.String()
 
 		// Append the generated field definition to the set of fields of the struct.
@@ -1571,9 +1744,10 @@ func writeGoStruct(targetStruct *ygen.ParsedDirectory, goStructElements map[stri
 			// Append the definition of the field annotation to the set of fields in the
 			// struct.
 			structDef.Fields = append(structDef.Fields, &goStructField{
-				Name: fmt.Sprintf("%s%s", annotationPrefix, fieldDef.Name),
-				Type: annotationFieldType,
-				Tags: metadataTagBuf.String(),
+				Name:        fmt.Sprintf("%s%s", annotationPrefix, fieldDef.Name),
+				Type:        annotationFieldType,
+				Tags:        metadataTagBuf.String(),
+				MarshalKind: "annotation",
 			})
 		}
 	}
@@ -1690,12 +1864,24 @@ func writeGoStruct(targetStruct *ygen.ParsedDirectory, goStructElements map[stri
 		if err := generateEnumTypeMapAccessor(&methodBuf, structDef); err != nil {
 			errs = append(errs, err)
 		}
+
+		if goOpts.GenerateLeafSetters {
+			if err := generateLeafSetters(&methodBuf, associatedLeafGetters); err != nil {
+				errs = append(errs, err)
+			}
+		}
 	}
 
 	if err := generateBelongingModuleFunction(&methodBuf, structDef); err != nil {
 		errs = append(errs, err)
 	}
 
+	if goOpts.GenerateMarshalMethods {
+		if err := generateMarshalMethods(&methodBuf, structDef, goOpts.GenerateJSONSchema); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
 	return GoStructCodeSnippet{
 		StructName: structDef.StructName,
 		StructDef:  structBuf.String(),
@@ -1705,6 +1891,27 @@ func writeGoStruct(targetStruct *ygen.ParsedDirectory, goStructElements map[stri
 	}, errs
 }
 
+// leafMarshalKind returns the kind of the generated struct field for the
+// leaf or leaf-list field, which determines how it is handled by the
+// generated marshalling methods.
+func leafMarshalKind(field *ygen.NodeDetails) string {
+	switch {
+	case field.Type == ygen.LeafListNode:
+		return "leaflist"
+	case IsScalarField(field):
+		return "leaf"
+	case len(field.LangType.UnionTypes) >= 2, field.LangType.NativeType == "interface{}":
+		return "union"
+	case field.LangType.IsEnumeratedValue:
+		return "enum"
+	case field.LangType.NativeType == ygot.BinaryTypeName:
+		return "binary"
+	case field.LangType.NativeType == ygot.EmptyTypeName:
+		return "empty"
+	}
+	return "leaf"
+}
+
 // mappedPathTag returns a generated Go Struct tag containing the stringified
 // input paths separated by '|'. If prefix is supplied, it is prepended to the
 // last element in each path.
@@ -1834,6 +2041,21 @@ func generateLeafGetters(buf *bytes.Buffer, leaves []*generatedLeafGetter) error
 	return errs.Err()
 }
 
+// generateLeafSetters generates SetXXX methods for the leaf fields described by
+// the supplied slice of generatedLeafGetter structs. List keys are skipped.
+func generateLeafSetters(buf *bytes.Buffer, leaves []*generatedLeafGetter) error {
+	var errs errlist.List
+	for _, l := range leaves {
+		if l.IsListKey {
+			continue
+		}
+		if err := goLeafSetterTemplate.Execute(buf, l); err != nil {
+			errs.Add(err)
+		}
+	}
+	return errs.Err()
+}
+
 // generateGetOrCreateList generates a getter function similar to that created
 // by the generateGetOrCreateStruct function for maps within the generated Go
 // code (which represent YANG lists). It handles both simple and composite key
@@ -2105,6 +2327,20 @@ func generateEnumTypeMapAccessor(b *bytes.Buffer, s generatedGoStruct) error {
 	return goEnumTypeMapAccessTemplate.Execute(b, s)
 }
 
+// generateMarshalMethods generates the methods that render and unmarshal
+// the struct s without reflection, and appends them to the supplied buffer.
+// The UnmarshalRFC7951 method is generated only if generateUnmarshal is set,
+// since it requires the schema to be generated.
+func generateMarshalMethods(b *bytes.Buffer, s generatedGoStruct, generateUnmarshal bool) error {
+	return goMarshalMethodsTemplate.Execute(b, struct {
+		generatedGoStruct
+		GenerateUnmarshal bool
+	}{
+		generatedGoStruct: s,
+		GenerateUnmarshal: generateUnmarshal,
+	})
+}
+
 // generateBelongingModuleFunction generates a function which returns the
 // belonging module as a string.
 func generateBelongingModuleFunction(b io.Writer, s generatedGoStruct) error {
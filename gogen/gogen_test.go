@@ -478,6 +478,68 @@ func (t *InputStruct) ΛValidate(opts ...ygot.ValidationOption) error {
 // that are included in the generated code.
 func (t *InputStruct) ΛEnumTypeMap() map[string][]reflect.Type { return ΛEnumTypes }
 
+// ΛBelongingModule returns the name of the module that defines the namespace
+// of InputStruct.
+func (*InputStruct) ΛBelongingModule() string {
+	return "exmod"
+}
+`,
+		},
+	}, {
+		name: "struct with sensitive leaf",
+		inStructToMap: &ygen.ParsedDirectory{
+			Name: "InputStruct",
+			Type: ygen.Container,
+			Fields: map[string]*ygen.NodeDetails{
+				"password": {
+					Name: "Password",
+					YANGDetails: ygen.YANGNodeDetails{
+						Name:              "password",
+						Defaults:          nil,
+						RootElementModule: "exmod",
+						Path:              "/root-module/input-struct/password",
+						LeafrefTargetPath: "",
+					},
+					Type:                    ygen.LeafNode,
+					LangType:                &ygen.MappedType{NativeType: "string"},
+					MappedPaths:             [][]string{{"password"}},
+					MappedPathModules:       [][]string{{"exmod"}},
+					ShadowMappedPaths:       nil,
+					ShadowMappedPathModules: nil,
+					Flags:                   map[string]string{sensitiveFieldFlagKey: "true"},
+				},
+			},
+			Path:            "/root-module/input-struct",
+			BelongingModule: "exmod",
+		},
+		inGoOpts: GoOpts{
+			GenerateJSONSchema: true,
+		},
+		want: wantGoStructOut{
+			structs: `
+// InputStruct represents the /root-module/input-struct YANG schema element.
+type InputStruct struct {
+	Password	*string	` + "`" + `path:"password" module:"exmod" ygotSensitive:"true"` + "`" + `
+}
+
+// IsYANGGoStruct ensures that InputStruct implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*InputStruct) IsYANGGoStruct() {}
+`,
+			methods: `
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *InputStruct) ΛValidate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["InputStruct"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *InputStruct) ΛEnumTypeMap() map[string][]reflect.Type { return ΛEnumTypes }
+
 // ΛBelongingModule returns the name of the module that defines the namespace
 // of InputStruct.
 func (*InputStruct) ΛBelongingModule() string {
@@ -0,0 +1,314 @@
+// Copyright 2021 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package marshalmethods tests that the marshalling methods generated for
+// GoStructs produce the same output as the reflection-based implementation in
+// the ygot and ytypes packages.
+package marshalmethods
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/openconfig/ygot/testutil"
+	"github.com/openconfig/ygot/ygot"
+	"github.com/openconfig/ygot/ytypes"
+
+	gpb "github.com/openconfig/gnmi/proto/gnmi"
+	"github.com/openconfig/ygot/integration_tests/marshalmethods/withmethods"
+	"github.com/openconfig/ygot/integration_tests/marshalmethods/withoutmethods"
+)
+
+// The generated types must implement the interfaces used by ygot and ytypes
+// for generated methods, otherwise the tests below compare the
+// reflection-based implementation against itself.
+var (
+	_ ygot.RFC7951Marshaler       = (*withmethods.Top)(nil)
+	_ ygot.NotificationsMarshaler = (*withmethods.Top)(nil)
+	_ ytypes.RFC7951Unmarshaler   = (*withmethods.Top)(nil)
+)
+
+const fullJSON = `{
+  "marshaltest:top": {
+    "config": {
+      "str": "hello",
+      "i8": -8,
+      "i64": "-64",
+      "u64": "18446744073709551615",
+      "dec": "3.14",
+      "flag": false,
+      "bin": "AQID",
+      "emp": [null],
+      "state": "UP",
+      "ident": "marshaltest:ONE",
+      "ext-ident": "marshaltest-types:EXT_ONE",
+      "un": "DOWN",
+      "strs": ["a", "b"],
+      "nums": ["1", "2"],
+      "idents": ["marshaltest:ONE", "marshaltest:TWO"],
+      "uns": ["x", 42],
+      "marshaltest-augment:augmented": "config-aug"
+    },
+    "state": {
+      "str": "hello-state",
+      "i8": 8,
+      "counter": "100",
+      "flag": true,
+      "state": "DOWN",
+      "marshaltest-augment:augmented": "state-aug"
+    },
+    "present": {},
+    "single-keys": {
+      "single-key": [
+        {"name": "one", "config": {"name": "one", "value": 1}, "state": {"name": "one", "value": 11}},
+        {"name": "two", "config": {"name": "two", "value": 2}}
+      ]
+    },
+    "multi-keys": {
+      "multi-key": [
+        {"name": "a", "id": "UP", "config": {"name": "a", "id": "UP", "value": "a-up"}},
+        {"name": "a", "id": "DOWN", "config": {"name": "a", "id": "DOWN", "value": "a-down"}}
+      ]
+    },
+    "keylesses": {
+      "keyless": [
+        {"value": "first", "num": "1"},
+        {"value": "second", "num": "2"}
+      ]
+    }
+  }
+}`
+
+// note is a ygot.Annotation used to check that annotations are marshalled.
+type note struct {
+	Note string `json:"note"`
+}
+
+func (n *note) MarshalJSON() ([]byte, error) {
+	return json.Marshal(*n)
+}
+
+func (n *note) UnmarshalJSON(b []byte) error {
+	return fmt.Errorf("unimplemented")
+}
+
+// unmarshal unmarshals data into the Device struct of both the generated
+// packages, returning the two structs. It returns an error if the packages
+// return different errors.
+func unmarshal(data string, opts ...ytypes.UnmarshalOpt) (*withmethods.Device, *withoutmethods.Device, error) {
+	with, without := &withmethods.Device{}, &withoutmethods.Device{}
+	withErr := withmethods.Unmarshal([]byte(data), with, opts...)
+	withoutErr := withoutmethods.Unmarshal([]byte(data), without, opts...)
+	if fmt.Sprint(withErr) != fmt.Sprint(withoutErr) {
+		return nil, nil, fmt.Errorf("got different errors, with methods: %v, without methods: %v", withErr, withoutErr)
+	}
+	return with, without, withErr
+}
+
+// populated returns the Device structs of both the generated packages,
+// populated with fullJSON and annotations, which cannot be unmarshalled.
+func populated(t *testing.T) (*withmethods.Device, *withoutmethods.Device) {
+	t.Helper()
+	with, without, err := unmarshal(fullJSON)
+	if err != nil {
+		t.Fatalf("cannot unmarshal input JSON: %v", err)
+	}
+	with.Top.ΛStr = []ygot.Annotation{&note{Note: "str"}}
+	without.Top.ΛStr = []ygot.Annotation{&note{Note: "str"}}
+	with.Top.ΛKeyless = []ygot.Annotation{&note{Note: "keyless"}}
+	without.Top.ΛKeyless = []ygot.Annotation{&note{Note: "keyless"}}
+	return with, without
+}
+
+func TestUnmarshal(t *testing.T) {
+	tests := []struct {
+		desc    string
+		inJSON  string
+		inOpts  []ytypes.UnmarshalOpt
+		wantErr bool
+	}{{
+		desc:   "all fields",
+		inJSON: fullJSON,
+	}, {
+		desc:   "all fields, preferring shadow paths",
+		inJSON: fullJSON,
+		inOpts: []ytypes.UnmarshalOpt{&ytypes.PreferShadowPath{}},
+	}, {
+		desc:   "empty tree",
+		inJSON: `{}`,
+	}, {
+		desc:    "unknown field",
+		inJSON:  `{"marshaltest:top": {"config": {"unknown": "value"}}}`,
+		wantErr: true,
+	}, {
+		desc:   "unknown field, ignored",
+		inJSON: `{"marshaltest:top": {"config": {"str": "x", "unknown": "value"}}}`,
+		inOpts: []ytypes.UnmarshalOpt{&ytypes.IgnoreExtraFields{}},
+	}, {
+		desc:    "invalid leaf value",
+		inJSON:  `{"marshaltest:top": {"config": {"i8": "not-a-number"}}}`,
+		wantErr: true,
+	}, {
+		desc:    "invalid list key",
+		inJSON:  `{"marshaltest:top": {"multi-keys": {"multi-key": [{"name": "a", "id": "SIDEWAYS"}]}}}`,
+		wantErr: true,
+	}}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			with, without, err := unmarshal(tt.inJSON, tt.inOpts...)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("unmarshal: got error %v, want error: %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+
+			gotWith, err := ygot.EmitJSON(with, &ygot.EmitJSONConfig{Format: ygot.RFC7951, SkipValidation: true})
+			if err != nil {
+				t.Fatalf("EmitJSON(with methods): got unexpected error: %v", err)
+			}
+			gotWithout, err := ygot.EmitJSON(without, &ygot.EmitJSONConfig{Format: ygot.RFC7951, SkipValidation: true})
+			if err != nil {
+				t.Fatalf("EmitJSON(without methods): got unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(gotWithout, gotWith); diff != "" {
+				t.Errorf("unmarshalled structs differ, (-without methods, +with methods):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestConstructIETFJSON(t *testing.T) {
+	with, without := populated(t)
+
+	tests := []struct {
+		desc     string
+		inConfig *ygot.RFC7951JSONConfig
+	}{{
+		desc: "no config",
+	}, {
+		desc:     "append module names",
+		inConfig: &ygot.RFC7951JSONConfig{AppendModuleName: true},
+	}, {
+		desc:     "prepend module names to identityrefs",
+		inConfig: &ygot.RFC7951JSONConfig{PrependModuleNameIdentityref: true},
+	}, {
+		desc:     "prefer shadow paths",
+		inConfig: &ygot.RFC7951JSONConfig{AppendModuleName: true, PreferShadowPath: true},
+	}, {
+		desc: "rewrite module names",
+		inConfig: &ygot.RFC7951JSONConfig{
+			AppendModuleName:   true,
+			RewriteModuleNames: map[string]string{"marshaltest-augment": "marshaltest"},
+		},
+	}}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			gotWith, err := ygot.ConstructIETFJSON(with, tt.inConfig)
+			if err != nil {
+				t.Fatalf("ConstructIETFJSON(with methods): got unexpected error: %v", err)
+			}
+			gotWithout, err := ygot.ConstructIETFJSON(without, tt.inConfig)
+			if err != nil {
+				t.Fatalf("ConstructIETFJSON(without methods): got unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(gotWithout, gotWith); diff != "" {
+				t.Errorf("ConstructIETFJSON: outputs differ, (-without methods, +with methods):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestConstructInternalJSON(t *testing.T) {
+	with, without := populated(t)
+
+	// Internal JSON is always produced by the reflection-based
+	// implementation, so the outputs must be identical.
+	gotWith, err := ygot.ConstructInternalJSON(with)
+	if err != nil {
+		t.Fatalf("ConstructInternalJSON(with methods): got unexpected error: %v", err)
+	}
+	gotWithout, err := ygot.ConstructInternalJSON(without)
+	if err != nil {
+		t.Fatalf("ConstructInternalJSON(without methods): got unexpected error: %v", err)
+	}
+	if diff := cmp.Diff(gotWithout, gotWith); diff != "" {
+		t.Errorf("ConstructInternalJSON: outputs differ, (-without methods, +with methods):\n%s", diff)
+	}
+}
+
+func TestTogNMINotifications(t *testing.T) {
+	tests := []struct {
+		desc     string
+		inConfig ygot.GNMINotificationsConfig
+		// noMultiKey specifies that the multi-keyed list should be
+		// removed, since it cannot be output with string slice paths.
+		noMultiKey bool
+		wantErr    bool
+	}{{
+		desc:       "string slice paths, keyless lists unsupported",
+		inConfig:   ygot.GNMINotificationsConfig{},
+		noMultiKey: true,
+		wantErr:    true,
+	}, {
+		desc:       "string slice paths, keyless lists as JSON",
+		inConfig:   ygot.GNMINotificationsConfig{KeylessListMode: ygot.KeylessListAsJSONIETF},
+		noMultiKey: true,
+	}, {
+		desc:     "PathElem paths, keyless lists as JSON",
+		inConfig: ygot.GNMINotificationsConfig{UsePathElem: true, KeylessListMode: ygot.KeylessListAsJSONIETF},
+	}, {
+		desc:     "PathElem paths, keyless lists with index keys",
+		inConfig: ygot.GNMINotificationsConfig{UsePathElem: true, KeylessListMode: ygot.KeylessListIndexKeys},
+	}, {
+		desc: "PathElem paths with prefix",
+		inConfig: ygot.GNMINotificationsConfig{
+			UsePathElem:     true,
+			PathElemPrefix:  []*gpb.PathElem{{Name: "device"}},
+			KeylessListMode: ygot.KeylessListIndexKeys,
+		},
+	}, {
+		desc: "list entry prefixes",
+		inConfig: ygot.GNMINotificationsConfig{
+			UsePathElem:       true,
+			ListEntryPrefixes: true,
+			KeylessListMode:   ygot.KeylessListIndexKeys,
+		},
+	}}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			with, without := populated(t)
+			if tt.noMultiKey {
+				with.Top.MultiKey, without.Top.MultiKey = nil, nil
+			}
+
+			gotWith, withErr := ygot.TogNMINotifications(with, 42, tt.inConfig)
+			gotWithout, withoutErr := ygot.TogNMINotifications(without, 42, tt.inConfig)
+			if fmt.Sprint(withErr) != fmt.Sprint(withoutErr) {
+				t.Fatalf("TogNMINotifications: got different errors, with methods: %v, without methods: %v", withErr, withoutErr)
+			}
+			if (withErr != nil) != tt.wantErr {
+				t.Fatalf("TogNMINotifications: got error %v, want error: %v", withErr, tt.wantErr)
+			}
+			if !testutil.NotificationSetEqual(gotWith, gotWithout) {
+				t.Errorf("TogNMINotifications: outputs differ, with methods: %v, without methods: %v", gotWith, gotWithout)
+			}
+		})
+	}
+}
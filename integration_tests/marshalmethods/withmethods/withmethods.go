@@ -0,0 +1,1924 @@
+// Copyright 2021 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+/*
+Package withmethods is a generated package which contains definitions
+of structs which represent a YANG schema. The generated schema can be
+compressed by a series of transformations (compression was true
+in this case).
+
+This package was generated by /root/module/genutil/names.go
+using the following YANG input files:
+  - ../yang/marshaltest.yang
+  - ../yang/marshaltest-types.yang
+  - ../yang/marshaltest-augment.yang
+
+Imported modules were sourced from:
+  - ../yang/...
+*/
+package withmethods
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"github.com/openconfig/goyang/pkg/yang"
+	"github.com/openconfig/ygot/ygot"
+	"github.com/openconfig/ygot/ytypes"
+)
+
+// Binary is a type that is used for fields that have a YANG type of
+// binary. It is used such that binary fields can be distinguished from
+// leaf-lists of uint8s (which are mapped to []uint8, equivalent to
+// []byte in reflection).
+type Binary []byte
+
+// YANGEmpty is a type that is used for fields that have a YANG type of
+// empty. It is used such that empty fields can be distinguished from boolean fields
+// in the generated code.
+type YANGEmpty bool
+
+// UnionInt8 is an int8 type assignable to unions of which it is a subtype.
+type UnionInt8 int8
+
+// UnionInt16 is an int16 type assignable to unions of which it is a subtype.
+type UnionInt16 int16
+
+// UnionInt32 is an int32 type assignable to unions of which it is a subtype.
+type UnionInt32 int32
+
+// UnionInt64 is an int64 type assignable to unions of which it is a subtype.
+type UnionInt64 int64
+
+// UnionUint8 is a uint8 type assignable to unions of which it is a subtype.
+type UnionUint8 uint8
+
+// UnionUint16 is a uint16 type assignable to unions of which it is a subtype.
+type UnionUint16 uint16
+
+// UnionUint32 is a uint32 type assignable to unions of which it is a subtype.
+type UnionUint32 uint32
+
+// UnionUint64 is a uint64 type assignable to unions of which it is a subtype.
+type UnionUint64 uint64
+
+// UnionFloat64 is a float64 type assignable to unions of which it is a subtype.
+type UnionFloat64 float64
+
+// UnionString is a string type assignable to unions of which it is a subtype.
+type UnionString string
+
+// UnionBool is a bool type assignable to unions of which it is a subtype.
+type UnionBool bool
+
+// UnionUnsupported is an interface{} wrapper type for unsupported types. It is
+// assignable to unions of which it is a subtype.
+type UnionUnsupported struct {
+	Value interface{}
+}
+
+var (
+	SchemaTree map[string]*yang.Entry
+	ΛEnumTypes map[string][]reflect.Type
+)
+
+func init() {
+	var err error
+	initΛEnumTypes()
+	if SchemaTree, err = UnzipSchema(); err != nil {
+		panic("schema error: " + err.Error())
+	}
+}
+
+// Schema returns the details of the generated schema.
+func Schema() (*ytypes.Schema, error) {
+	uzp, err := UnzipSchema()
+	if err != nil {
+		return nil, fmt.Errorf("cannot unzip schema, %v", err)
+	}
+
+	return &ytypes.Schema{
+		Root:       &Device{},
+		SchemaTree: uzp,
+		Unmarshal:  Unmarshal,
+	}, nil
+}
+
+// UnzipSchema unzips the zipped schema and returns a map of yang.Entry nodes,
+// keyed by the name of the struct that the yang.Entry describes the schema for.
+func UnzipSchema() (map[string]*yang.Entry, error) {
+	var schemaTree map[string]*yang.Entry
+	var err error
+	if schemaTree, err = ygot.GzipToSchema(ySchema); err != nil {
+		return nil, fmt.Errorf("could not unzip the schema; %v", err)
+	}
+	return schemaTree, nil
+}
+
+// Unmarshal unmarshals data, which must be RFC7951 JSON format, into
+// destStruct, which must be non-nil and the correct GoStruct type. It returns
+// an error if the destStruct is not found in the schema or the data cannot be
+// unmarshaled. The supplied options (opts) are used to control the behaviour
+// of the unmarshal function - for example, determining whether errors are
+// thrown for unknown fields in the input JSON.
+func Unmarshal(data []byte, destStruct ygot.GoStruct, opts ...ytypes.UnmarshalOpt) error {
+	tn := reflect.TypeOf(destStruct).Elem().Name()
+	schema, ok := SchemaTree[tn]
+	if !ok {
+		return fmt.Errorf("could not find schema for type %s", tn)
+	}
+	var jsonTree interface{}
+	if err := json.Unmarshal([]byte(data), &jsonTree); err != nil {
+		return err
+	}
+	return ytypes.Unmarshal(schema, destStruct, jsonTree, opts...)
+}
+
+// Device represents the /device YANG schema element.
+type Device struct {
+	ΛMetadata []ygot.Annotation `path:"@" ygotAnnotation:"true"`
+	Top       *Top              `path:"top" module:"marshaltest"`
+	ΛTop      []ygot.Annotation `path:"@top" ygotAnnotation:"true"`
+}
+
+// IsYANGGoStruct ensures that Device implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*Device) IsYANGGoStruct() {}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *Device) ΛValidate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["Device"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *Device) Validate(opts ...ygot.ValidationOption) error {
+	return t.ΛValidate(opts...)
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *Device) ΛEnumTypeMap() map[string][]reflect.Type { return ΛEnumTypes }
+
+// ΛBelongingModule returns the name of the module that defines the namespace
+// of Device.
+func (*Device) ΛBelongingModule() string {
+	return ""
+}
+
+// ΛDevice_Fields stores the descriptions of the fields of Device,
+// which are used by its generated marshalling methods.
+var ΛDevice_Fields = struct {
+	ΛMetadata *ygot.StructField
+	Top       *ygot.StructField
+	ΛTop      *ygot.StructField
+}{
+	ΛMetadata: ygot.NewStructField("ΛMetadata", `path:"@" ygotAnnotation:"true"`),
+	Top:       ygot.NewStructField("Top", `path:"top" module:"marshaltest"`),
+	ΛTop:      ygot.NewStructField("ΛTop", `path:"@top" ygotAnnotation:"true"`),
+}
+
+// MarshalRFC7951 implements the ygot.RFC7951Marshaler interface, writing each
+// field of Device to w.
+func (t *Device) MarshalRFC7951(w *ygot.RFC7951Writer) {
+	if t.ΛMetadata != nil {
+		w.Annotations(ΛDevice_Fields.ΛMetadata, t.ΛMetadata)
+	}
+	if t.Top != nil {
+		w.Container(ΛDevice_Fields.Top, t.Top)
+	}
+	if t.ΛTop != nil {
+		w.Annotations(ΛDevice_Fields.ΛTop, t.ΛTop)
+	}
+}
+
+// ToNotifications implements the ygot.NotificationsMarshaler interface,
+// writing each populated field of Device to w.
+func (t *Device) ToNotifications(w *ygot.NotificationWriter) {
+	if t.ΛMetadata != nil {
+		w.Leaf(ΛDevice_Fields.ΛMetadata, t.ΛMetadata)
+	}
+	if t.Top != nil {
+		w.Container(ΛDevice_Fields.Top, t.Top)
+	}
+	if t.ΛTop != nil {
+		w.Leaf(ΛDevice_Fields.ΛTop, t.ΛTop)
+	}
+}
+
+// UnmarshalRFC7951 implements the ytypes.RFC7951Unmarshaler interface,
+// unmarshalling each field of Device using u.
+func (t *Device) UnmarshalRFC7951(u *ytypes.StructUnmarshaler) error {
+	if err := u.Annotation(ΛDevice_Fields.ΛMetadata); err != nil {
+		return err
+	}
+	if s, v, err := u.Field(ΛDevice_Fields.Top); err != nil {
+		return err
+	} else if v != nil {
+		if t.Top == nil {
+			t.Top = &Top{}
+		}
+		if err := u.Unmarshal(s, t.Top, v); err != nil {
+			return err
+		}
+	}
+	if err := u.Annotation(ΛDevice_Fields.ΛTop); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Top represents the /marshaltest/top YANG schema element.
+type Top struct {
+	ΛMetadata  []ygot.Annotation                  `path:"@" ygotAnnotation:"true"`
+	Augmented  *string                            `path:"config/augmented" module:"marshaltest/marshaltest-augment" shadow-path:"state/augmented" shadow-module:"marshaltest/marshaltest-augment"`
+	ΛAugmented []ygot.Annotation                  `path:"config/@augmented" ygotAnnotation:"true"`
+	Bin        Binary                             `path:"config/bin" module:"marshaltest/marshaltest" shadow-path:"state/bin" shadow-module:"marshaltest/marshaltest"`
+	ΛBin       []ygot.Annotation                  `path:"config/@bin" ygotAnnotation:"true"`
+	Counter    *uint64                            `path:"state/counter" module:"marshaltest/marshaltest"`
+	ΛCounter   []ygot.Annotation                  `path:"state/@counter" ygotAnnotation:"true"`
+	Dec        *float64                           `path:"config/dec" module:"marshaltest/marshaltest" shadow-path:"state/dec" shadow-module:"marshaltest/marshaltest"`
+	ΛDec       []ygot.Annotation                  `path:"config/@dec" ygotAnnotation:"true"`
+	Emp        YANGEmpty                          `path:"config/emp" module:"marshaltest/marshaltest" shadow-path:"state/emp" shadow-module:"marshaltest/marshaltest"`
+	ΛEmp       []ygot.Annotation                  `path:"config/@emp" ygotAnnotation:"true"`
+	ExtIdent   E_MarshaltestTypes_EXT_BASE        `path:"config/ext-ident" module:"marshaltest/marshaltest" shadow-path:"state/ext-ident" shadow-module:"marshaltest/marshaltest"`
+	ΛExtIdent  []ygot.Annotation                  `path:"config/@ext-ident" ygotAnnotation:"true"`
+	Flag       *bool                              `path:"config/flag" module:"marshaltest/marshaltest" shadow-path:"state/flag" shadow-module:"marshaltest/marshaltest"`
+	ΛFlag      []ygot.Annotation                  `path:"config/@flag" ygotAnnotation:"true"`
+	I64        *int64                             `path:"config/i64" module:"marshaltest/marshaltest" shadow-path:"state/i64" shadow-module:"marshaltest/marshaltest"`
+	ΛI64       []ygot.Annotation                  `path:"config/@i64" ygotAnnotation:"true"`
+	I8         *int8                              `path:"config/i8" module:"marshaltest/marshaltest" shadow-path:"state/i8" shadow-module:"marshaltest/marshaltest"`
+	ΛI8        []ygot.Annotation                  `path:"config/@i8" ygotAnnotation:"true"`
+	Ident      E_Marshaltest_BASE                 `path:"config/ident" module:"marshaltest/marshaltest" shadow-path:"state/ident" shadow-module:"marshaltest/marshaltest"`
+	ΛIdent     []ygot.Annotation                  `path:"config/@ident" ygotAnnotation:"true"`
+	Idents     []E_Marshaltest_BASE               `path:"config/idents" module:"marshaltest/marshaltest" shadow-path:"state/idents" shadow-module:"marshaltest/marshaltest"`
+	ΛIdents    []ygot.Annotation                  `path:"config/@idents" ygotAnnotation:"true"`
+	Keyless    []*Top_Keyless                     `path:"keylesses/keyless" module:"marshaltest/marshaltest"`
+	ΛKeyless   []ygot.Annotation                  `path:"keylesses/@keyless" ygotAnnotation:"true"`
+	MultiKey   map[Top_MultiKey_Key]*Top_MultiKey `path:"multi-keys/multi-key" module:"marshaltest/marshaltest"`
+	ΛMultiKey  []ygot.Annotation                  `path:"multi-keys/@multi-key" ygotAnnotation:"true"`
+	Nums       []uint64                           `path:"config/nums" module:"marshaltest/marshaltest" shadow-path:"state/nums" shadow-module:"marshaltest/marshaltest"`
+	ΛNums      []ygot.Annotation                  `path:"config/@nums" ygotAnnotation:"true"`
+	Present    *Top_Present                       `path:"present" module:"marshaltest" yangPresence:"true"`
+	ΛPresent   []ygot.Annotation                  `path:"@present" ygotAnnotation:"true"`
+	SingleKey  map[string]*Top_SingleKey          `path:"single-keys/single-key" module:"marshaltest/marshaltest"`
+	ΛSingleKey []ygot.Annotation                  `path:"single-keys/@single-key" ygotAnnotation:"true"`
+	State      E_Marshaltest_StateEnum            `path:"config/state" module:"marshaltest/marshaltest" shadow-path:"state/state" shadow-module:"marshaltest/marshaltest"`
+	ΛState     []ygot.Annotation                  `path:"config/@state" ygotAnnotation:"true"`
+	Str        *string                            `path:"config/str" module:"marshaltest/marshaltest" shadow-path:"state/str" shadow-module:"marshaltest/marshaltest"`
+	ΛStr       []ygot.Annotation                  `path:"config/@str" ygotAnnotation:"true"`
+	Strs       []string                           `path:"config/strs" module:"marshaltest/marshaltest" shadow-path:"state/strs" shadow-module:"marshaltest/marshaltest"`
+	ΛStrs      []ygot.Annotation                  `path:"config/@strs" ygotAnnotation:"true"`
+	U64        *uint64                            `path:"config/u64" module:"marshaltest/marshaltest" shadow-path:"state/u64" shadow-module:"marshaltest/marshaltest"`
+	ΛU64       []ygot.Annotation                  `path:"config/@u64" ygotAnnotation:"true"`
+	Un         Top_Un_Union                       `path:"config/un" module:"marshaltest/marshaltest" shadow-path:"state/un" shadow-module:"marshaltest/marshaltest"`
+	ΛUn        []ygot.Annotation                  `path:"config/@un" ygotAnnotation:"true"`
+	Uns        []Top_Uns_Union                    `path:"config/uns" module:"marshaltest/marshaltest" shadow-path:"state/uns" shadow-module:"marshaltest/marshaltest"`
+	ΛUns       []ygot.Annotation                  `path:"config/@uns" ygotAnnotation:"true"`
+}
+
+// IsYANGGoStruct ensures that Top implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*Top) IsYANGGoStruct() {}
+
+// Top_MultiKey_Key represents the key for list MultiKey of element /marshaltest/top.
+type Top_MultiKey_Key struct {
+	Name string                  `path:"name"`
+	Id   E_Marshaltest_StateEnum `path:"id"`
+}
+
+// NewMultiKey creates a new entry in the MultiKey list of the
+// Top struct. The keys of the list are populated from the input
+// arguments.
+func (t *Top) NewMultiKey(Name string, Id E_Marshaltest_StateEnum) (*Top_MultiKey, error) {
+
+	// Initialise the list within the receiver struct if it has not already been
+	// created.
+	if t.MultiKey == nil {
+		t.MultiKey = make(map[Top_MultiKey_Key]*Top_MultiKey)
+	}
+
+	key := Top_MultiKey_Key{
+		Name: Name,
+		Id:   Id,
+	}
+
+	// Ensure that this key has not already been used in the
+	// list. Keyed YANG lists do not allow duplicate keys to
+	// be created.
+	if _, ok := t.MultiKey[key]; ok {
+		return nil, fmt.Errorf("duplicate key %v for list MultiKey", key)
+	}
+
+	t.MultiKey[key] = &Top_MultiKey{
+		Name: &Name,
+		Id:   Id,
+	}
+
+	return t.MultiKey[key], nil
+}
+
+// NewSingleKey creates a new entry in the SingleKey list of the
+// Top struct. The keys of the list are populated from the input
+// arguments.
+func (t *Top) NewSingleKey(Name string) (*Top_SingleKey, error) {
+
+	// Initialise the list within the receiver struct if it has not already been
+	// created.
+	if t.SingleKey == nil {
+		t.SingleKey = make(map[string]*Top_SingleKey)
+	}
+
+	key := Name
+
+	// Ensure that this key has not already been used in the
+	// list. Keyed YANG lists do not allow duplicate keys to
+	// be created.
+	if _, ok := t.SingleKey[key]; ok {
+		return nil, fmt.Errorf("duplicate key %v for list SingleKey", key)
+	}
+
+	t.SingleKey[key] = &Top_SingleKey{
+		Name: &Name,
+	}
+
+	return t.SingleKey[key], nil
+}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *Top) ΛValidate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["Top"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *Top) Validate(opts ...ygot.ValidationOption) error {
+	return t.ΛValidate(opts...)
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *Top) ΛEnumTypeMap() map[string][]reflect.Type { return ΛEnumTypes }
+
+// ΛBelongingModule returns the name of the module that defines the namespace
+// of Top.
+func (*Top) ΛBelongingModule() string {
+	return "marshaltest"
+}
+
+// ΛTop_Fields stores the descriptions of the fields of Top,
+// which are used by its generated marshalling methods.
+var ΛTop_Fields = struct {
+	ΛMetadata  *ygot.StructField
+	Augmented  *ygot.StructField
+	ΛAugmented *ygot.StructField
+	Bin        *ygot.StructField
+	ΛBin       *ygot.StructField
+	Counter    *ygot.StructField
+	ΛCounter   *ygot.StructField
+	Dec        *ygot.StructField
+	ΛDec       *ygot.StructField
+	Emp        *ygot.StructField
+	ΛEmp       *ygot.StructField
+	ExtIdent   *ygot.StructField
+	ΛExtIdent  *ygot.StructField
+	Flag       *ygot.StructField
+	ΛFlag      *ygot.StructField
+	I64        *ygot.StructField
+	ΛI64       *ygot.StructField
+	I8         *ygot.StructField
+	ΛI8        *ygot.StructField
+	Ident      *ygot.StructField
+	ΛIdent     *ygot.StructField
+	Idents     *ygot.StructField
+	ΛIdents    *ygot.StructField
+	Keyless    *ygot.StructField
+	ΛKeyless   *ygot.StructField
+	MultiKey   *ygot.StructField
+	ΛMultiKey  *ygot.StructField
+	Nums       *ygot.StructField
+	ΛNums      *ygot.StructField
+	Present    *ygot.StructField
+	ΛPresent   *ygot.StructField
+	SingleKey  *ygot.StructField
+	ΛSingleKey *ygot.StructField
+	State      *ygot.StructField
+	ΛState     *ygot.StructField
+	Str        *ygot.StructField
+	ΛStr       *ygot.StructField
+	Strs       *ygot.StructField
+	ΛStrs      *ygot.StructField
+	U64        *ygot.StructField
+	ΛU64       *ygot.StructField
+	Un         *ygot.StructField
+	ΛUn        *ygot.StructField
+	Uns        *ygot.StructField
+	ΛUns       *ygot.StructField
+}{
+	ΛMetadata:  ygot.NewStructField("ΛMetadata", `path:"@" ygotAnnotation:"true"`),
+	Augmented:  ygot.NewStructField("Augmented", `path:"config/augmented" module:"marshaltest/marshaltest-augment" shadow-path:"state/augmented" shadow-module:"marshaltest/marshaltest-augment"`),
+	ΛAugmented: ygot.NewStructField("ΛAugmented", `path:"config/@augmented" ygotAnnotation:"true"`),
+	Bin:        ygot.NewStructField("Bin", `path:"config/bin" module:"marshaltest/marshaltest" shadow-path:"state/bin" shadow-module:"marshaltest/marshaltest"`),
+	ΛBin:       ygot.NewStructField("ΛBin", `path:"config/@bin" ygotAnnotation:"true"`),
+	Counter:    ygot.NewStructField("Counter", `path:"state/counter" module:"marshaltest/marshaltest"`),
+	ΛCounter:   ygot.NewStructField("ΛCounter", `path:"state/@counter" ygotAnnotation:"true"`),
+	Dec:        ygot.NewStructField("Dec", `path:"config/dec" module:"marshaltest/marshaltest" shadow-path:"state/dec" shadow-module:"marshaltest/marshaltest"`),
+	ΛDec:       ygot.NewStructField("ΛDec", `path:"config/@dec" ygotAnnotation:"true"`),
+	Emp:        ygot.NewStructField("Emp", `path:"config/emp" module:"marshaltest/marshaltest" shadow-path:"state/emp" shadow-module:"marshaltest/marshaltest"`),
+	ΛEmp:       ygot.NewStructField("ΛEmp", `path:"config/@emp" ygotAnnotation:"true"`),
+	ExtIdent:   ygot.NewStructField("ExtIdent", `path:"config/ext-ident" module:"marshaltest/marshaltest" shadow-path:"state/ext-ident" shadow-module:"marshaltest/marshaltest"`),
+	ΛExtIdent:  ygot.NewStructField("ΛExtIdent", `path:"config/@ext-ident" ygotAnnotation:"true"`),
+	Flag:       ygot.NewStructField("Flag", `path:"config/flag" module:"marshaltest/marshaltest" shadow-path:"state/flag" shadow-module:"marshaltest/marshaltest"`),
+	ΛFlag:      ygot.NewStructField("ΛFlag", `path:"config/@flag" ygotAnnotation:"true"`),
+	I64:        ygot.NewStructField("I64", `path:"config/i64" module:"marshaltest/marshaltest" shadow-path:"state/i64" shadow-module:"marshaltest/marshaltest"`),
+	ΛI64:       ygot.NewStructField("ΛI64", `path:"config/@i64" ygotAnnotation:"true"`),
+	I8:         ygot.NewStructField("I8", `path:"config/i8" module:"marshaltest/marshaltest" shadow-path:"state/i8" shadow-module:"marshaltest/marshaltest"`),
+	ΛI8:        ygot.NewStructField("ΛI8", `path:"config/@i8" ygotAnnotation:"true"`),
+	Ident:      ygot.NewStructField("Ident", `path:"config/ident" module:"marshaltest/marshaltest" shadow-path:"state/ident" shadow-module:"marshaltest/marshaltest"`),
+	ΛIdent:     ygot.NewStructField("ΛIdent", `path:"config/@ident" ygotAnnotation:"true"`),
+	Idents:     ygot.NewStructField("Idents", `path:"config/idents" module:"marshaltest/marshaltest" shadow-path:"state/idents" shadow-module:"marshaltest/marshaltest"`),
+	ΛIdents:    ygot.NewStructField("ΛIdents", `path:"config/@idents" ygotAnnotation:"true"`),
+	Keyless:    ygot.NewStructField("Keyless", `path:"keylesses/keyless" module:"marshaltest/marshaltest"`),
+	ΛKeyless:   ygot.NewStructField("ΛKeyless", `path:"keylesses/@keyless" ygotAnnotation:"true"`),
+	MultiKey:   ygot.NewStructField("MultiKey", `path:"multi-keys/multi-key" module:"marshaltest/marshaltest"`),
+	ΛMultiKey:  ygot.NewStructField("ΛMultiKey", `path:"multi-keys/@multi-key" ygotAnnotation:"true"`),
+	Nums:       ygot.NewStructField("Nums", `path:"config/nums" module:"marshaltest/marshaltest" shadow-path:"state/nums" shadow-module:"marshaltest/marshaltest"`),
+	ΛNums:      ygot.NewStructField("ΛNums", `path:"config/@nums" ygotAnnotation:"true"`),
+	Present:    ygot.NewStructField("Present", `path:"present" module:"marshaltest" yangPresence:"true"`),
+	ΛPresent:   ygot.NewStructField("ΛPresent", `path:"@present" ygotAnnotation:"true"`),
+	SingleKey:  ygot.NewStructField("SingleKey", `path:"single-keys/single-key" module:"marshaltest/marshaltest"`),
+	ΛSingleKey: ygot.NewStructField("ΛSingleKey", `path:"single-keys/@single-key" ygotAnnotation:"true"`),
+	State:      ygot.NewStructField("State", `path:"config/state" module:"marshaltest/marshaltest" shadow-path:"state/state" shadow-module:"marshaltest/marshaltest"`),
+	ΛState:     ygot.NewStructField("ΛState", `path:"config/@state" ygotAnnotation:"true"`),
+	Str:        ygot.NewStructField("Str", `path:"config/str" module:"marshaltest/marshaltest" shadow-path:"state/str" shadow-module:"marshaltest/marshaltest"`),
+	ΛStr:       ygot.NewStructField("ΛStr", `path:"config/@str" ygotAnnotation:"true"`),
+	Strs:       ygot.NewStructField("Strs", `path:"config/strs" module:"marshaltest/marshaltest" shadow-path:"state/strs" shadow-module:"marshaltest/marshaltest"`),
+	ΛStrs:      ygot.NewStructField("ΛStrs", `path:"config/@strs" ygotAnnotation:"true"`),
+	U64:        ygot.NewStructField("U64", `path:"config/u64" module:"marshaltest/marshaltest" shadow-path:"state/u64" shadow-module:"marshaltest/marshaltest"`),
+	ΛU64:       ygot.NewStructField("ΛU64", `path:"config/@u64" ygotAnnotation:"true"`),
+	Un:         ygot.NewStructField("Un", `path:"config/un" module:"marshaltest/marshaltest" shadow-path:"state/un" shadow-module:"marshaltest/marshaltest"`),
+	ΛUn:        ygot.NewStructField("ΛUn", `path:"config/@un" ygotAnnotation:"true"`),
+	Uns:        ygot.NewStructField("Uns", `path:"config/uns" module:"marshaltest/marshaltest" shadow-path:"state/uns" shadow-module:"marshaltest/marshaltest"`),
+	ΛUns:       ygot.NewStructField("ΛUns", `path:"config/@uns" ygotAnnotation:"true"`),
+}
+
+// MarshalRFC7951 implements the ygot.RFC7951Marshaler interface, writing each
+// field of Top to w.
+func (t *Top) MarshalRFC7951(w *ygot.RFC7951Writer) {
+	if t.ΛMetadata != nil {
+		w.Annotations(ΛTop_Fields.ΛMetadata, t.ΛMetadata)
+	}
+	if t.Augmented != nil {
+		w.Leaf(ΛTop_Fields.Augmented, *t.Augmented)
+	}
+	if t.ΛAugmented != nil {
+		w.Annotations(ΛTop_Fields.ΛAugmented, t.ΛAugmented)
+	}
+	if t.Bin != nil {
+		w.Binary(ΛTop_Fields.Bin, t.Bin)
+	}
+	if t.ΛBin != nil {
+		w.Annotations(ΛTop_Fields.ΛBin, t.ΛBin)
+	}
+	if t.Counter != nil {
+		w.Leaf(ΛTop_Fields.Counter, *t.Counter)
+	}
+	if t.ΛCounter != nil {
+		w.Annotations(ΛTop_Fields.ΛCounter, t.ΛCounter)
+	}
+	if t.Dec != nil {
+		w.Leaf(ΛTop_Fields.Dec, *t.Dec)
+	}
+	if t.ΛDec != nil {
+		w.Annotations(ΛTop_Fields.ΛDec, t.ΛDec)
+	}
+	w.Empty(ΛTop_Fields.Emp, bool(t.Emp))
+	if t.ΛEmp != nil {
+		w.Annotations(ΛTop_Fields.ΛEmp, t.ΛEmp)
+	}
+	w.Enum(ΛTop_Fields.ExtIdent, t.ExtIdent)
+	if t.ΛExtIdent != nil {
+		w.Annotations(ΛTop_Fields.ΛExtIdent, t.ΛExtIdent)
+	}
+	if t.Flag != nil {
+		w.Leaf(ΛTop_Fields.Flag, *t.Flag)
+	}
+	if t.ΛFlag != nil {
+		w.Annotations(ΛTop_Fields.ΛFlag, t.ΛFlag)
+	}
+	if t.I64 != nil {
+		w.Leaf(ΛTop_Fields.I64, *t.I64)
+	}
+	if t.ΛI64 != nil {
+		w.Annotations(ΛTop_Fields.ΛI64, t.ΛI64)
+	}
+	if t.I8 != nil {
+		w.Leaf(ΛTop_Fields.I8, *t.I8)
+	}
+	if t.ΛI8 != nil {
+		w.Annotations(ΛTop_Fields.ΛI8, t.ΛI8)
+	}
+	w.Enum(ΛTop_Fields.Ident, t.Ident)
+	if t.ΛIdent != nil {
+		w.Annotations(ΛTop_Fields.ΛIdent, t.ΛIdent)
+	}
+	if t.Idents != nil {
+		w.LeafList(ΛTop_Fields.Idents, t.Idents)
+	}
+	if t.ΛIdents != nil {
+		w.Annotations(ΛTop_Fields.ΛIdents, t.ΛIdents)
+	}
+	if t.Keyless != nil {
+		w.KeylessList(ΛTop_Fields.Keyless, t.Keyless)
+	}
+	if t.ΛKeyless != nil {
+		w.Annotations(ΛTop_Fields.ΛKeyless, t.ΛKeyless)
+	}
+	if t.MultiKey != nil {
+		l := make([]ygot.ListEntry, 0, len(t.MultiKey))
+		for k, v := range t.MultiKey {
+			l = append(l, ygot.ListEntry{Key: k, Value: v})
+		}
+		w.List(ΛTop_Fields.MultiKey, l)
+	}
+	if t.ΛMultiKey != nil {
+		w.Annotations(ΛTop_Fields.ΛMultiKey, t.ΛMultiKey)
+	}
+	if t.Nums != nil {
+		w.LeafList(ΛTop_Fields.Nums, t.Nums)
+	}
+	if t.ΛNums != nil {
+		w.Annotations(ΛTop_Fields.ΛNums, t.ΛNums)
+	}
+	if t.Present != nil {
+		w.Container(ΛTop_Fields.Present, t.Present)
+	}
+	if t.ΛPresent != nil {
+		w.Annotations(ΛTop_Fields.ΛPresent, t.ΛPresent)
+	}
+	if t.SingleKey != nil {
+		l := make([]ygot.ListEntry, 0, len(t.SingleKey))
+		for k, v := range t.SingleKey {
+			l = append(l, ygot.ListEntry{Key: k, Value: v})
+		}
+		w.List(ΛTop_Fields.SingleKey, l)
+	}
+	if t.ΛSingleKey != nil {
+		w.Annotations(ΛTop_Fields.ΛSingleKey, t.ΛSingleKey)
+	}
+	w.Enum(ΛTop_Fields.State, t.State)
+	if t.ΛState != nil {
+		w.Annotations(ΛTop_Fields.ΛState, t.ΛState)
+	}
+	if t.Str != nil {
+		w.Leaf(ΛTop_Fields.Str, *t.Str)
+	}
+	if t.ΛStr != nil {
+		w.Annotations(ΛTop_Fields.ΛStr, t.ΛStr)
+	}
+	if t.Strs != nil {
+		w.LeafList(ΛTop_Fields.Strs, t.Strs)
+	}
+	if t.ΛStrs != nil {
+		w.Annotations(ΛTop_Fields.ΛStrs, t.ΛStrs)
+	}
+	if t.U64 != nil {
+		w.Leaf(ΛTop_Fields.U64, *t.U64)
+	}
+	if t.ΛU64 != nil {
+		w.Annotations(ΛTop_Fields.ΛU64, t.ΛU64)
+	}
+	if t.Un != nil {
+		w.Union(ΛTop_Fields.Un, t.Un)
+	}
+	if t.ΛUn != nil {
+		w.Annotations(ΛTop_Fields.ΛUn, t.ΛUn)
+	}
+	if t.Uns != nil {
+		w.LeafList(ΛTop_Fields.Uns, t.Uns)
+	}
+	if t.ΛUns != nil {
+		w.Annotations(ΛTop_Fields.ΛUns, t.ΛUns)
+	}
+}
+
+// ToNotifications implements the ygot.NotificationsMarshaler interface,
+// writing each populated field of Top to w.
+func (t *Top) ToNotifications(w *ygot.NotificationWriter) {
+	if t.ΛMetadata != nil {
+		w.Leaf(ΛTop_Fields.ΛMetadata, t.ΛMetadata)
+	}
+	if t.Augmented != nil {
+		w.Leaf(ΛTop_Fields.Augmented, t.Augmented)
+	}
+	if t.ΛAugmented != nil {
+		w.Leaf(ΛTop_Fields.ΛAugmented, t.ΛAugmented)
+	}
+	if t.Bin != nil {
+		w.Leaf(ΛTop_Fields.Bin, t.Bin)
+	}
+	if t.ΛBin != nil {
+		w.Leaf(ΛTop_Fields.ΛBin, t.ΛBin)
+	}
+	if t.Counter != nil {
+		w.Leaf(ΛTop_Fields.Counter, t.Counter)
+	}
+	if t.ΛCounter != nil {
+		w.Leaf(ΛTop_Fields.ΛCounter, t.ΛCounter)
+	}
+	if t.Dec != nil {
+		w.Leaf(ΛTop_Fields.Dec, t.Dec)
+	}
+	if t.ΛDec != nil {
+		w.Leaf(ΛTop_Fields.ΛDec, t.ΛDec)
+	}
+	if t.ΛEmp != nil {
+		w.Leaf(ΛTop_Fields.ΛEmp, t.ΛEmp)
+	}
+	w.Enum(ΛTop_Fields.ExtIdent, t.ExtIdent)
+	if t.ΛExtIdent != nil {
+		w.Leaf(ΛTop_Fields.ΛExtIdent, t.ΛExtIdent)
+	}
+	if t.Flag != nil {
+		w.Leaf(ΛTop_Fields.Flag, t.Flag)
+	}
+	if t.ΛFlag != nil {
+		w.Leaf(ΛTop_Fields.ΛFlag, t.ΛFlag)
+	}
+	if t.I64 != nil {
+		w.Leaf(ΛTop_Fields.I64, t.I64)
+	}
+	if t.ΛI64 != nil {
+		w.Leaf(ΛTop_Fields.ΛI64, t.ΛI64)
+	}
+	if t.I8 != nil {
+		w.Leaf(ΛTop_Fields.I8, t.I8)
+	}
+	if t.ΛI8 != nil {
+		w.Leaf(ΛTop_Fields.ΛI8, t.ΛI8)
+	}
+	w.Enum(ΛTop_Fields.Ident, t.Ident)
+	if t.ΛIdent != nil {
+		w.Leaf(ΛTop_Fields.ΛIdent, t.ΛIdent)
+	}
+	if t.Idents != nil {
+		w.Leaf(ΛTop_Fields.Idents, t.Idents)
+	}
+	if t.ΛIdents != nil {
+		w.Leaf(ΛTop_Fields.ΛIdents, t.ΛIdents)
+	}
+	if t.Keyless != nil {
+		w.KeylessList(ΛTop_Fields.Keyless, t.Keyless)
+	}
+	if t.ΛKeyless != nil {
+		w.Leaf(ΛTop_Fields.ΛKeyless, t.ΛKeyless)
+	}
+	if t.MultiKey != nil {
+		l := make([]ygot.ListEntry, 0, len(t.MultiKey))
+		for k, v := range t.MultiKey {
+			l = append(l, ygot.ListEntry{Key: k, Value: v})
+		}
+		w.List(ΛTop_Fields.MultiKey, l)
+	}
+	if t.ΛMultiKey != nil {
+		w.Leaf(ΛTop_Fields.ΛMultiKey, t.ΛMultiKey)
+	}
+	if t.Nums != nil {
+		w.Leaf(ΛTop_Fields.Nums, t.Nums)
+	}
+	if t.ΛNums != nil {
+		w.Leaf(ΛTop_Fields.ΛNums, t.ΛNums)
+	}
+	if t.Present != nil {
+		w.Container(ΛTop_Fields.Present, t.Present)
+	}
+	if t.ΛPresent != nil {
+		w.Leaf(ΛTop_Fields.ΛPresent, t.ΛPresent)
+	}
+	if t.SingleKey != nil {
+		l := make([]ygot.ListEntry, 0, len(t.SingleKey))
+		for k, v := range t.SingleKey {
+			l = append(l, ygot.ListEntry{Key: k, Value: v})
+		}
+		w.List(ΛTop_Fields.SingleKey, l)
+	}
+	if t.ΛSingleKey != nil {
+		w.Leaf(ΛTop_Fields.ΛSingleKey, t.ΛSingleKey)
+	}
+	w.Enum(ΛTop_Fields.State, t.State)
+	if t.ΛState != nil {
+		w.Leaf(ΛTop_Fields.ΛState, t.ΛState)
+	}
+	if t.Str != nil {
+		w.Leaf(ΛTop_Fields.Str, t.Str)
+	}
+	if t.ΛStr != nil {
+		w.Leaf(ΛTop_Fields.ΛStr, t.ΛStr)
+	}
+	if t.Strs != nil {
+		w.Leaf(ΛTop_Fields.Strs, t.Strs)
+	}
+	if t.ΛStrs != nil {
+		w.Leaf(ΛTop_Fields.ΛStrs, t.ΛStrs)
+	}
+	if t.U64 != nil {
+		w.Leaf(ΛTop_Fields.U64, t.U64)
+	}
+	if t.ΛU64 != nil {
+		w.Leaf(ΛTop_Fields.ΛU64, t.ΛU64)
+	}
+	if t.Un != nil {
+		w.Leaf(ΛTop_Fields.Un, t.Un)
+	}
+	if t.ΛUn != nil {
+		w.Leaf(ΛTop_Fields.ΛUn, t.ΛUn)
+	}
+	if t.Uns != nil {
+		w.Leaf(ΛTop_Fields.Uns, t.Uns)
+	}
+	if t.ΛUns != nil {
+		w.Leaf(ΛTop_Fields.ΛUns, t.ΛUns)
+	}
+}
+
+// UnmarshalRFC7951 implements the ytypes.RFC7951Unmarshaler interface,
+// unmarshalling each field of Top using u.
+func (t *Top) UnmarshalRFC7951(u *ytypes.StructUnmarshaler) error {
+	if err := u.Annotation(ΛTop_Fields.ΛMetadata); err != nil {
+		return err
+	}
+	if s, v, err := u.Field(ΛTop_Fields.Augmented); err != nil {
+		return err
+	} else if v != nil {
+		if t.Augmented == nil {
+			t.Augmented = new(string)
+		}
+		if err := u.Unmarshal(s, t, v); err != nil {
+			return err
+		}
+	}
+	if err := u.Annotation(ΛTop_Fields.ΛAugmented); err != nil {
+		return err
+	}
+	if s, v, err := u.Field(ΛTop_Fields.Bin); err != nil {
+		return err
+	} else if v != nil {
+		if t.Bin == nil {
+			t.Bin = Binary{}
+		}
+		if err := u.Unmarshal(s, t, v); err != nil {
+			return err
+		}
+	}
+	if err := u.Annotation(ΛTop_Fields.ΛBin); err != nil {
+		return err
+	}
+	if s, v, err := u.Field(ΛTop_Fields.Counter); err != nil {
+		return err
+	} else if v != nil {
+		if t.Counter == nil {
+			t.Counter = new(uint64)
+		}
+		if err := u.Unmarshal(s, t, v); err != nil {
+			return err
+		}
+	}
+	if err := u.Annotation(ΛTop_Fields.ΛCounter); err != nil {
+		return err
+	}
+	if s, v, err := u.Field(ΛTop_Fields.Dec); err != nil {
+		return err
+	} else if v != nil {
+		if t.Dec == nil {
+			t.Dec = new(float64)
+		}
+		if err := u.Unmarshal(s, t, v); err != nil {
+			return err
+		}
+	}
+	if err := u.Annotation(ΛTop_Fields.ΛDec); err != nil {
+		return err
+	}
+	if s, v, err := u.Field(ΛTop_Fields.Emp); err != nil {
+		return err
+	} else if v != nil {
+		if err := u.Unmarshal(s, t, v); err != nil {
+			return err
+		}
+	}
+	if err := u.Annotation(ΛTop_Fields.ΛEmp); err != nil {
+		return err
+	}
+	if s, v, err := u.Field(ΛTop_Fields.ExtIdent); err != nil {
+		return err
+	} else if v != nil {
+		if err := u.Unmarshal(s, t, v); err != nil {
+			return err
+		}
+	}
+	if err := u.Annotation(ΛTop_Fields.ΛExtIdent); err != nil {
+		return err
+	}
+	if s, v, err := u.Field(ΛTop_Fields.Flag); err != nil {
+		return err
+	} else if v != nil {
+		if t.Flag == nil {
+			t.Flag = new(bool)
+		}
+		if err := u.Unmarshal(s, t, v); err != nil {
+			return err
+		}
+	}
+	if err := u.Annotation(ΛTop_Fields.ΛFlag); err != nil {
+		return err
+	}
+	if s, v, err := u.Field(ΛTop_Fields.I64); err != nil {
+		return err
+	} else if v != nil {
+		if t.I64 == nil {
+			t.I64 = new(int64)
+		}
+		if err := u.Unmarshal(s, t, v); err != nil {
+			return err
+		}
+	}
+	if err := u.Annotation(ΛTop_Fields.ΛI64); err != nil {
+		return err
+	}
+	if s, v, err := u.Field(ΛTop_Fields.I8); err != nil {
+		return err
+	} else if v != nil {
+		if t.I8 == nil {
+			t.I8 = new(int8)
+		}
+		if err := u.Unmarshal(s, t, v); err != nil {
+			return err
+		}
+	}
+	if err := u.Annotation(ΛTop_Fields.ΛI8); err != nil {
+		return err
+	}
+	if s, v, err := u.Field(ΛTop_Fields.Ident); err != nil {
+		return err
+	} else if v != nil {
+		if err := u.Unmarshal(s, t, v); err != nil {
+			return err
+		}
+	}
+	if err := u.Annotation(ΛTop_Fields.ΛIdent); err != nil {
+		return err
+	}
+	if s, v, err := u.Field(ΛTop_Fields.Idents); err != nil {
+		return err
+	} else if v != nil {
+		if t.Idents == nil {
+			t.Idents = []E_Marshaltest_BASE{}
+		}
+		if err := u.Unmarshal(s, t, v); err != nil {
+			return err
+		}
+	}
+	if err := u.Annotation(ΛTop_Fields.ΛIdents); err != nil {
+		return err
+	}
+	if s, v, err := u.Field(ΛTop_Fields.Keyless); err != nil {
+		return err
+	} else if v != nil {
+		if t.Keyless == nil {
+			t.Keyless = []*Top_Keyless{}
+		}
+		if err := u.Unmarshal(s, &t.Keyless, v); err != nil {
+			return err
+		}
+	}
+	if err := u.Annotation(ΛTop_Fields.ΛKeyless); err != nil {
+		return err
+	}
+	if s, v, err := u.Field(ΛTop_Fields.MultiKey); err != nil {
+		return err
+	} else if v != nil {
+		if t.MultiKey == nil {
+			t.MultiKey = make(map[Top_MultiKey_Key]*Top_MultiKey)
+		}
+		if err := u.Unmarshal(s, t.MultiKey, v); err != nil {
+			return err
+		}
+	}
+	if err := u.Annotation(ΛTop_Fields.ΛMultiKey); err != nil {
+		return err
+	}
+	if s, v, err := u.Field(ΛTop_Fields.Nums); err != nil {
+		return err
+	} else if v != nil {
+		if t.Nums == nil {
+			t.Nums = []uint64{}
+		}
+		if err := u.Unmarshal(s, t, v); err != nil {
+			return err
+		}
+	}
+	if err := u.Annotation(ΛTop_Fields.ΛNums); err != nil {
+		return err
+	}
+	if s, v, err := u.Field(ΛTop_Fields.Present); err != nil {
+		return err
+	} else if v != nil {
+		if t.Present == nil {
+			t.Present = &Top_Present{}
+		}
+		if err := u.Unmarshal(s, t.Present, v); err != nil {
+			return err
+		}
+	}
+	if err := u.Annotation(ΛTop_Fields.ΛPresent); err != nil {
+		return err
+	}
+	if s, v, err := u.Field(ΛTop_Fields.SingleKey); err != nil {
+		return err
+	} else if v != nil {
+		if t.SingleKey == nil {
+			t.SingleKey = make(map[string]*Top_SingleKey)
+		}
+		if err := u.Unmarshal(s, t.SingleKey, v); err != nil {
+			return err
+		}
+	}
+	if err := u.Annotation(ΛTop_Fields.ΛSingleKey); err != nil {
+		return err
+	}
+	if s, v, err := u.Field(ΛTop_Fields.State); err != nil {
+		return err
+	} else if v != nil {
+		if err := u.Unmarshal(s, t, v); err != nil {
+			return err
+		}
+	}
+	if err := u.Annotation(ΛTop_Fields.ΛState); err != nil {
+		return err
+	}
+	if s, v, err := u.Field(ΛTop_Fields.Str); err != nil {
+		return err
+	} else if v != nil {
+		if t.Str == nil {
+			t.Str = new(string)
+		}
+		if err := u.Unmarshal(s, t, v); err != nil {
+			return err
+		}
+	}
+	if err := u.Annotation(ΛTop_Fields.ΛStr); err != nil {
+		return err
+	}
+	if s, v, err := u.Field(ΛTop_Fields.Strs); err != nil {
+		return err
+	} else if v != nil {
+		if t.Strs == nil {
+			t.Strs = []string{}
+		}
+		if err := u.Unmarshal(s, t, v); err != nil {
+			return err
+		}
+	}
+	if err := u.Annotation(ΛTop_Fields.ΛStrs); err != nil {
+		return err
+	}
+	if s, v, err := u.Field(ΛTop_Fields.U64); err != nil {
+		return err
+	} else if v != nil {
+		if t.U64 == nil {
+			t.U64 = new(uint64)
+		}
+		if err := u.Unmarshal(s, t, v); err != nil {
+			return err
+		}
+	}
+	if err := u.Annotation(ΛTop_Fields.ΛU64); err != nil {
+		return err
+	}
+	if s, v, err := u.Field(ΛTop_Fields.Un); err != nil {
+		return err
+	} else if v != nil {
+		if err := u.Unmarshal(s, t, v); err != nil {
+			return err
+		}
+	}
+	if err := u.Annotation(ΛTop_Fields.ΛUn); err != nil {
+		return err
+	}
+	if s, v, err := u.Field(ΛTop_Fields.Uns); err != nil {
+		return err
+	} else if v != nil {
+		if t.Uns == nil {
+			t.Uns = []Top_Uns_Union{}
+		}
+		if err := u.Unmarshal(s, t, v); err != nil {
+			return err
+		}
+	}
+	if err := u.Annotation(ΛTop_Fields.ΛUns); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Top_Un_Union is an interface that is implemented by valid types for the union
+// for the leaf /marshaltest/top/config/un within the YANG schema.
+// Union type can be one of [E_Marshaltest_StateEnum, UnionInt64, UnionString].
+type Top_Un_Union interface {
+	// Union type can be one of [E_Marshaltest_StateEnum, UnionInt64, UnionString]
+	Documentation_for_Top_Un_Union()
+}
+
+// Documentation_for_Top_Un_Union ensures that E_Marshaltest_StateEnum
+// implements the Top_Un_Union interface.
+func (E_Marshaltest_StateEnum) Documentation_for_Top_Un_Union() {}
+
+// Documentation_for_Top_Un_Union ensures that UnionInt64
+// implements the Top_Un_Union interface.
+func (UnionInt64) Documentation_for_Top_Un_Union() {}
+
+// Documentation_for_Top_Un_Union ensures that UnionString
+// implements the Top_Un_Union interface.
+func (UnionString) Documentation_for_Top_Un_Union() {}
+
+// To_Top_Un_Union takes an input interface{} and attempts to convert it to a struct
+// which implements the Top_Un_Union union. It returns an error if the interface{} supplied
+// cannot be converted to a type within the union.
+func (t *Top) To_Top_Un_Union(i interface{}) (Top_Un_Union, error) {
+	if v, ok := i.(Top_Un_Union); ok {
+		return v, nil
+	}
+	switch v := i.(type) {
+	case int64:
+		return UnionInt64(v), nil
+	case string:
+		return UnionString(v), nil
+	}
+	return nil, fmt.Errorf("cannot convert %v to Top_Un_Union, unknown union type, got: %T, want any of [E_Marshaltest_StateEnum, int64, string]", i, i)
+}
+
+// Top_Uns_Union is an interface that is implemented by valid types for the union
+// for the leaf /marshaltest/top/config/uns within the YANG schema.
+// Union type can be one of [UnionInt32, UnionString].
+type Top_Uns_Union interface {
+	// Union type can be one of [UnionInt32, UnionString]
+	Documentation_for_Top_Uns_Union()
+}
+
+// Documentation_for_Top_Uns_Union ensures that UnionInt32
+// implements the Top_Uns_Union interface.
+func (UnionInt32) Documentation_for_Top_Uns_Union() {}
+
+// Documentation_for_Top_Uns_Union ensures that UnionString
+// implements the Top_Uns_Union interface.
+func (UnionString) Documentation_for_Top_Uns_Union() {}
+
+// To_Top_Uns_Union takes an input interface{} and attempts to convert it to a struct
+// which implements the Top_Uns_Union union. It returns an error if the interface{} supplied
+// cannot be converted to a type within the union.
+func (t *Top) To_Top_Uns_Union(i interface{}) (Top_Uns_Union, error) {
+	if v, ok := i.(Top_Uns_Union); ok {
+		return v, nil
+	}
+	switch v := i.(type) {
+	case int32:
+		return UnionInt32(v), nil
+	case string:
+		return UnionString(v), nil
+	}
+	return nil, fmt.Errorf("cannot convert %v to Top_Uns_Union, unknown union type, got: %T, want any of [int32, string]", i, i)
+}
+
+// Top_Keyless represents the /marshaltest/top/keylesses/keyless YANG schema element.
+type Top_Keyless struct {
+	ΛMetadata []ygot.Annotation `path:"@" ygotAnnotation:"true"`
+	Num       *int64            `path:"num" module:"marshaltest"`
+	ΛNum      []ygot.Annotation `path:"@num" ygotAnnotation:"true"`
+	Value     *string           `path:"value" module:"marshaltest"`
+	ΛValue    []ygot.Annotation `path:"@value" ygotAnnotation:"true"`
+}
+
+// IsYANGGoStruct ensures that Top_Keyless implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*Top_Keyless) IsYANGGoStruct() {}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *Top_Keyless) ΛValidate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["Top_Keyless"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *Top_Keyless) Validate(opts ...ygot.ValidationOption) error {
+	return t.ΛValidate(opts...)
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *Top_Keyless) ΛEnumTypeMap() map[string][]reflect.Type { return ΛEnumTypes }
+
+// ΛBelongingModule returns the name of the module that defines the namespace
+// of Top_Keyless.
+func (*Top_Keyless) ΛBelongingModule() string {
+	return "marshaltest"
+}
+
+// ΛTop_Keyless_Fields stores the descriptions of the fields of Top_Keyless,
+// which are used by its generated marshalling methods.
+var ΛTop_Keyless_Fields = struct {
+	ΛMetadata *ygot.StructField
+	Num       *ygot.StructField
+	ΛNum      *ygot.StructField
+	Value     *ygot.StructField
+	ΛValue    *ygot.StructField
+}{
+	ΛMetadata: ygot.NewStructField("ΛMetadata", `path:"@" ygotAnnotation:"true"`),
+	Num:       ygot.NewStructField("Num", `path:"num" module:"marshaltest"`),
+	ΛNum:      ygot.NewStructField("ΛNum", `path:"@num" ygotAnnotation:"true"`),
+	Value:     ygot.NewStructField("Value", `path:"value" module:"marshaltest"`),
+	ΛValue:    ygot.NewStructField("ΛValue", `path:"@value" ygotAnnotation:"true"`),
+}
+
+// MarshalRFC7951 implements the ygot.RFC7951Marshaler interface, writing each
+// field of Top_Keyless to w.
+func (t *Top_Keyless) MarshalRFC7951(w *ygot.RFC7951Writer) {
+	if t.ΛMetadata != nil {
+		w.Annotations(ΛTop_Keyless_Fields.ΛMetadata, t.ΛMetadata)
+	}
+	if t.Num != nil {
+		w.Leaf(ΛTop_Keyless_Fields.Num, *t.Num)
+	}
+	if t.ΛNum != nil {
+		w.Annotations(ΛTop_Keyless_Fields.ΛNum, t.ΛNum)
+	}
+	if t.Value != nil {
+		w.Leaf(ΛTop_Keyless_Fields.Value, *t.Value)
+	}
+	if t.ΛValue != nil {
+		w.Annotations(ΛTop_Keyless_Fields.ΛValue, t.ΛValue)
+	}
+}
+
+// ToNotifications implements the ygot.NotificationsMarshaler interface,
+// writing each populated field of Top_Keyless to w.
+func (t *Top_Keyless) ToNotifications(w *ygot.NotificationWriter) {
+	if t.ΛMetadata != nil {
+		w.Leaf(ΛTop_Keyless_Fields.ΛMetadata, t.ΛMetadata)
+	}
+	if t.Num != nil {
+		w.Leaf(ΛTop_Keyless_Fields.Num, t.Num)
+	}
+	if t.ΛNum != nil {
+		w.Leaf(ΛTop_Keyless_Fields.ΛNum, t.ΛNum)
+	}
+	if t.Value != nil {
+		w.Leaf(ΛTop_Keyless_Fields.Value, t.Value)
+	}
+	if t.ΛValue != nil {
+		w.Leaf(ΛTop_Keyless_Fields.ΛValue, t.ΛValue)
+	}
+}
+
+// UnmarshalRFC7951 implements the ytypes.RFC7951Unmarshaler interface,
+// unmarshalling each field of Top_Keyless using u.
+func (t *Top_Keyless) UnmarshalRFC7951(u *ytypes.StructUnmarshaler) error {
+	if err := u.Annotation(ΛTop_Keyless_Fields.ΛMetadata); err != nil {
+		return err
+	}
+	if s, v, err := u.Field(ΛTop_Keyless_Fields.Num); err != nil {
+		return err
+	} else if v != nil {
+		if t.Num == nil {
+			t.Num = new(int64)
+		}
+		if err := u.Unmarshal(s, t, v); err != nil {
+			return err
+		}
+	}
+	if err := u.Annotation(ΛTop_Keyless_Fields.ΛNum); err != nil {
+		return err
+	}
+	if s, v, err := u.Field(ΛTop_Keyless_Fields.Value); err != nil {
+		return err
+	} else if v != nil {
+		if t.Value == nil {
+			t.Value = new(string)
+		}
+		if err := u.Unmarshal(s, t, v); err != nil {
+			return err
+		}
+	}
+	if err := u.Annotation(ΛTop_Keyless_Fields.ΛValue); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Top_MultiKey represents the /marshaltest/top/multi-keys/multi-key YANG schema element.
+type Top_MultiKey struct {
+	ΛMetadata []ygot.Annotation       `path:"@" ygotAnnotation:"true"`
+	Id        E_Marshaltest_StateEnum `path:"config/id|id" module:"marshaltest/marshaltest|marshaltest" shadow-path:"state/id|id" shadow-module:"marshaltest/marshaltest|marshaltest"`
+	ΛId       []ygot.Annotation       `path:"config/@id|@id" ygotAnnotation:"true"`
+	Name      *string                 `path:"config/name|name" module:"marshaltest/marshaltest|marshaltest" shadow-path:"state/name|name" shadow-module:"marshaltest/marshaltest|marshaltest"`
+	ΛName     []ygot.Annotation       `path:"config/@name|@name" ygotAnnotation:"true"`
+	Value     *string                 `path:"config/value" module:"marshaltest/marshaltest" shadow-path:"state/value" shadow-module:"marshaltest/marshaltest"`
+	ΛValue    []ygot.Annotation       `path:"config/@value" ygotAnnotation:"true"`
+}
+
+// IsYANGGoStruct ensures that Top_MultiKey implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*Top_MultiKey) IsYANGGoStruct() {}
+
+// ΛListKeyMap returns the keys of the Top_MultiKey struct, which is a YANG list entry.
+func (t *Top_MultiKey) ΛListKeyMap() (map[string]interface{}, error) {
+
+	if t.Name == nil {
+		return nil, fmt.Errorf("nil value for key Name")
+	}
+
+	return map[string]interface{}{
+		"id":   t.Id,
+		"name": *t.Name,
+	}, nil
+}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *Top_MultiKey) ΛValidate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["Top_MultiKey"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *Top_MultiKey) Validate(opts ...ygot.ValidationOption) error {
+	return t.ΛValidate(opts...)
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *Top_MultiKey) ΛEnumTypeMap() map[string][]reflect.Type { return ΛEnumTypes }
+
+// ΛBelongingModule returns the name of the module that defines the namespace
+// of Top_MultiKey.
+func (*Top_MultiKey) ΛBelongingModule() string {
+	return "marshaltest"
+}
+
+// ΛTop_MultiKey_Fields stores the descriptions of the fields of Top_MultiKey,
+// which are used by its generated marshalling methods.
+var ΛTop_MultiKey_Fields = struct {
+	ΛMetadata *ygot.StructField
+	Id        *ygot.StructField
+	ΛId       *ygot.StructField
+	Name      *ygot.StructField
+	ΛName     *ygot.StructField
+	Value     *ygot.StructField
+	ΛValue    *ygot.StructField
+}{
+	ΛMetadata: ygot.NewStructField("ΛMetadata", `path:"@" ygotAnnotation:"true"`),
+	Id:        ygot.NewStructField("Id", `path:"config/id|id" module:"marshaltest/marshaltest|marshaltest" shadow-path:"state/id|id" shadow-module:"marshaltest/marshaltest|marshaltest"`),
+	ΛId:       ygot.NewStructField("ΛId", `path:"config/@id|@id" ygotAnnotation:"true"`),
+	Name:      ygot.NewStructField("Name", `path:"config/name|name" module:"marshaltest/marshaltest|marshaltest" shadow-path:"state/name|name" shadow-module:"marshaltest/marshaltest|marshaltest"`),
+	ΛName:     ygot.NewStructField("ΛName", `path:"config/@name|@name" ygotAnnotation:"true"`),
+	Value:     ygot.NewStructField("Value", `path:"config/value" module:"marshaltest/marshaltest" shadow-path:"state/value" shadow-module:"marshaltest/marshaltest"`),
+	ΛValue:    ygot.NewStructField("ΛValue", `path:"config/@value" ygotAnnotation:"true"`),
+}
+
+// MarshalRFC7951 implements the ygot.RFC7951Marshaler interface, writing each
+// field of Top_MultiKey to w.
+func (t *Top_MultiKey) MarshalRFC7951(w *ygot.RFC7951Writer) {
+	if t.ΛMetadata != nil {
+		w.Annotations(ΛTop_MultiKey_Fields.ΛMetadata, t.ΛMetadata)
+	}
+	w.Enum(ΛTop_MultiKey_Fields.Id, t.Id)
+	if t.ΛId != nil {
+		w.Annotations(ΛTop_MultiKey_Fields.ΛId, t.ΛId)
+	}
+	if t.Name != nil {
+		w.Leaf(ΛTop_MultiKey_Fields.Name, *t.Name)
+	}
+	if t.ΛName != nil {
+		w.Annotations(ΛTop_MultiKey_Fields.ΛName, t.ΛName)
+	}
+	if t.Value != nil {
+		w.Leaf(ΛTop_MultiKey_Fields.Value, *t.Value)
+	}
+	if t.ΛValue != nil {
+		w.Annotations(ΛTop_MultiKey_Fields.ΛValue, t.ΛValue)
+	}
+}
+
+// ToNotifications implements the ygot.NotificationsMarshaler interface,
+// writing each populated field of Top_MultiKey to w.
+func (t *Top_MultiKey) ToNotifications(w *ygot.NotificationWriter) {
+	if t.ΛMetadata != nil {
+		w.Leaf(ΛTop_MultiKey_Fields.ΛMetadata, t.ΛMetadata)
+	}
+	w.Enum(ΛTop_MultiKey_Fields.Id, t.Id)
+	if t.ΛId != nil {
+		w.Leaf(ΛTop_MultiKey_Fields.ΛId, t.ΛId)
+	}
+	if t.Name != nil {
+		w.Leaf(ΛTop_MultiKey_Fields.Name, t.Name)
+	}
+	if t.ΛName != nil {
+		w.Leaf(ΛTop_MultiKey_Fields.ΛName, t.ΛName)
+	}
+	if t.Value != nil {
+		w.Leaf(ΛTop_MultiKey_Fields.Value, t.Value)
+	}
+	if t.ΛValue != nil {
+		w.Leaf(ΛTop_MultiKey_Fields.ΛValue, t.ΛValue)
+	}
+}
+
+// UnmarshalRFC7951 implements the ytypes.RFC7951Unmarshaler interface,
+// unmarshalling each field of Top_MultiKey using u.
+func (t *Top_MultiKey) UnmarshalRFC7951(u *ytypes.StructUnmarshaler) error {
+	if err := u.Annotation(ΛTop_MultiKey_Fields.ΛMetadata); err != nil {
+		return err
+	}
+	if s, v, err := u.Field(ΛTop_MultiKey_Fields.Id); err != nil {
+		return err
+	} else if v != nil {
+		if err := u.Unmarshal(s, t, v); err != nil {
+			return err
+		}
+	}
+	if err := u.Annotation(ΛTop_MultiKey_Fields.ΛId); err != nil {
+		return err
+	}
+	if s, v, err := u.Field(ΛTop_MultiKey_Fields.Name); err != nil {
+		return err
+	} else if v != nil {
+		if t.Name == nil {
+			t.Name = new(string)
+		}
+		if err := u.Unmarshal(s, t, v); err != nil {
+			return err
+		}
+	}
+	if err := u.Annotation(ΛTop_MultiKey_Fields.ΛName); err != nil {
+		return err
+	}
+	if s, v, err := u.Field(ΛTop_MultiKey_Fields.Value); err != nil {
+		return err
+	} else if v != nil {
+		if t.Value == nil {
+			t.Value = new(string)
+		}
+		if err := u.Unmarshal(s, t, v); err != nil {
+			return err
+		}
+	}
+	if err := u.Annotation(ΛTop_MultiKey_Fields.ΛValue); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Top_Present represents the /marshaltest/top/present YANG schema element.
+type Top_Present struct {
+	ΛMetadata []ygot.Annotation `path:"@" ygotAnnotation:"true"`
+}
+
+// IsYANGGoStruct ensures that Top_Present implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*Top_Present) IsYANGGoStruct() {}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *Top_Present) ΛValidate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["Top_Present"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *Top_Present) Validate(opts ...ygot.ValidationOption) error {
+	return t.ΛValidate(opts...)
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *Top_Present) ΛEnumTypeMap() map[string][]reflect.Type { return ΛEnumTypes }
+
+// ΛBelongingModule returns the name of the module that defines the namespace
+// of Top_Present.
+func (*Top_Present) ΛBelongingModule() string {
+	return "marshaltest"
+}
+
+// ΛTop_Present_Fields stores the descriptions of the fields of Top_Present,
+// which are used by its generated marshalling methods.
+var ΛTop_Present_Fields = struct {
+	ΛMetadata *ygot.StructField
+}{
+	ΛMetadata: ygot.NewStructField("ΛMetadata", `path:"@" ygotAnnotation:"true"`),
+}
+
+// MarshalRFC7951 implements the ygot.RFC7951Marshaler interface, writing each
+// field of Top_Present to w.
+func (t *Top_Present) MarshalRFC7951(w *ygot.RFC7951Writer) {
+	if t.ΛMetadata != nil {
+		w.Annotations(ΛTop_Present_Fields.ΛMetadata, t.ΛMetadata)
+	}
+}
+
+// ToNotifications implements the ygot.NotificationsMarshaler interface,
+// writing each populated field of Top_Present to w.
+func (t *Top_Present) ToNotifications(w *ygot.NotificationWriter) {
+	if t.ΛMetadata != nil {
+		w.Leaf(ΛTop_Present_Fields.ΛMetadata, t.ΛMetadata)
+	}
+}
+
+// UnmarshalRFC7951 implements the ytypes.RFC7951Unmarshaler interface,
+// unmarshalling each field of Top_Present using u.
+func (t *Top_Present) UnmarshalRFC7951(u *ytypes.StructUnmarshaler) error {
+	if err := u.Annotation(ΛTop_Present_Fields.ΛMetadata); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Top_SingleKey represents the /marshaltest/top/single-keys/single-key YANG schema element.
+type Top_SingleKey struct {
+	ΛMetadata []ygot.Annotation `path:"@" ygotAnnotation:"true"`
+	Name      *string           `path:"config/name|name" module:"marshaltest/marshaltest|marshaltest" shadow-path:"state/name|name" shadow-module:"marshaltest/marshaltest|marshaltest"`
+	ΛName     []ygot.Annotation `path:"config/@name|@name" ygotAnnotation:"true"`
+	Value     *int32            `path:"config/value" module:"marshaltest/marshaltest" shadow-path:"state/value" shadow-module:"marshaltest/marshaltest"`
+	ΛValue    []ygot.Annotation `path:"config/@value" ygotAnnotation:"true"`
+}
+
+// IsYANGGoStruct ensures that Top_SingleKey implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*Top_SingleKey) IsYANGGoStruct() {}
+
+// ΛListKeyMap returns the keys of the Top_SingleKey struct, which is a YANG list entry.
+func (t *Top_SingleKey) ΛListKeyMap() (map[string]interface{}, error) {
+	if t.Name == nil {
+		return nil, fmt.Errorf("nil value for key Name")
+	}
+
+	return map[string]interface{}{
+		"name": *t.Name,
+	}, nil
+}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *Top_SingleKey) ΛValidate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["Top_SingleKey"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *Top_SingleKey) Validate(opts ...ygot.ValidationOption) error {
+	return t.ΛValidate(opts...)
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *Top_SingleKey) ΛEnumTypeMap() map[string][]reflect.Type { return ΛEnumTypes }
+
+// ΛBelongingModule returns the name of the module that defines the namespace
+// of Top_SingleKey.
+func (*Top_SingleKey) ΛBelongingModule() string {
+	return "marshaltest"
+}
+
+// ΛTop_SingleKey_Fields stores the descriptions of the fields of Top_SingleKey,
+// which are used by its generated marshalling methods.
+var ΛTop_SingleKey_Fields = struct {
+	ΛMetadata *ygot.StructField
+	Name      *ygot.StructField
+	ΛName     *ygot.StructField
+	Value     *ygot.StructField
+	ΛValue    *ygot.StructField
+}{
+	ΛMetadata: ygot.NewStructField("ΛMetadata", `path:"@" ygotAnnotation:"true"`),
+	Name:      ygot.NewStructField("Name", `path:"config/name|name" module:"marshaltest/marshaltest|marshaltest" shadow-path:"state/name|name" shadow-module:"marshaltest/marshaltest|marshaltest"`),
+	ΛName:     ygot.NewStructField("ΛName", `path:"config/@name|@name" ygotAnnotation:"true"`),
+	Value:     ygot.NewStructField("Value", `path:"config/value" module:"marshaltest/marshaltest" shadow-path:"state/value" shadow-module:"marshaltest/marshaltest"`),
+	ΛValue:    ygot.NewStructField("ΛValue", `path:"config/@value" ygotAnnotation:"true"`),
+}
+
+// MarshalRFC7951 implements the ygot.RFC7951Marshaler interface, writing each
+// field of Top_SingleKey to w.
+func (t *Top_SingleKey) MarshalRFC7951(w *ygot.RFC7951Writer) {
+	if t.ΛMetadata != nil {
+		w.Annotations(ΛTop_SingleKey_Fields.ΛMetadata, t.ΛMetadata)
+	}
+	if t.Name != nil {
+		w.Leaf(ΛTop_SingleKey_Fields.Name, *t.Name)
+	}
+	if t.ΛName != nil {
+		w.Annotations(ΛTop_SingleKey_Fields.ΛName, t.ΛName)
+	}
+	if t.Value != nil {
+		w.Leaf(ΛTop_SingleKey_Fields.Value, *t.Value)
+	}
+	if t.ΛValue != nil {
+		w.Annotations(ΛTop_SingleKey_Fields.ΛValue, t.ΛValue)
+	}
+}
+
+// ToNotifications implements the ygot.NotificationsMarshaler interface,
+// writing each populated field of Top_SingleKey to w.
+func (t *Top_SingleKey) ToNotifications(w *ygot.NotificationWriter) {
+	if t.ΛMetadata != nil {
+		w.Leaf(ΛTop_SingleKey_Fields.ΛMetadata, t.ΛMetadata)
+	}
+	if t.Name != nil {
+		w.Leaf(ΛTop_SingleKey_Fields.Name, t.Name)
+	}
+	if t.ΛName != nil {
+		w.Leaf(ΛTop_SingleKey_Fields.ΛName, t.ΛName)
+	}
+	if t.Value != nil {
+		w.Leaf(ΛTop_SingleKey_Fields.Value, t.Value)
+	}
+	if t.ΛValue != nil {
+		w.Leaf(ΛTop_SingleKey_Fields.ΛValue, t.ΛValue)
+	}
+}
+
+// UnmarshalRFC7951 implements the ytypes.RFC7951Unmarshaler interface,
+// unmarshalling each field of Top_SingleKey using u.
+func (t *Top_SingleKey) UnmarshalRFC7951(u *ytypes.StructUnmarshaler) error {
+	if err := u.Annotation(ΛTop_SingleKey_Fields.ΛMetadata); err != nil {
+		return err
+	}
+	if s, v, err := u.Field(ΛTop_SingleKey_Fields.Name); err != nil {
+		return err
+	} else if v != nil {
+		if t.Name == nil {
+			t.Name = new(string)
+		}
+		if err := u.Unmarshal(s, t, v); err != nil {
+			return err
+		}
+	}
+	if err := u.Annotation(ΛTop_SingleKey_Fields.ΛName); err != nil {
+		return err
+	}
+	if s, v, err := u.Field(ΛTop_SingleKey_Fields.Value); err != nil {
+		return err
+	} else if v != nil {
+		if t.Value == nil {
+			t.Value = new(int32)
+		}
+		if err := u.Unmarshal(s, t, v); err != nil {
+			return err
+		}
+	}
+	if err := u.Annotation(ΛTop_SingleKey_Fields.ΛValue); err != nil {
+		return err
+	}
+	return nil
+}
+
+// E_MarshaltestTypes_EXT_BASE is a derived int64 type which is used to represent
+// the enumerated node MarshaltestTypes_EXT_BASE. An additional value named
+// MarshaltestTypes_EXT_BASE_UNSET is added to the enumeration which is used as
+// the nil value, indicating that the enumeration was not explicitly set by
+// the program importing the generated structures.
+type E_MarshaltestTypes_EXT_BASE int64
+
+// IsYANGGoEnum ensures that MarshaltestTypes_EXT_BASE implements the yang.GoEnum
+// interface. This ensures that MarshaltestTypes_EXT_BASE can be identified as a
+// mapped type for a YANG enumeration.
+func (E_MarshaltestTypes_EXT_BASE) IsYANGGoEnum() {}
+
+// ΛMap returns the value lookup map associated with  MarshaltestTypes_EXT_BASE.
+func (E_MarshaltestTypes_EXT_BASE) ΛMap() map[string]map[int64]ygot.EnumDefinition { return ΛEnum }
+
+// String returns a logging-friendly string for E_MarshaltestTypes_EXT_BASE.
+func (e E_MarshaltestTypes_EXT_BASE) String() string {
+	return ygot.EnumLogString(e, int64(e), "E_MarshaltestTypes_EXT_BASE")
+}
+
+const (
+	// MarshaltestTypes_EXT_BASE_UNSET corresponds to the value UNSET of MarshaltestTypes_EXT_BASE
+	MarshaltestTypes_EXT_BASE_UNSET E_MarshaltestTypes_EXT_BASE = 0
+	// MarshaltestTypes_EXT_BASE_EXT_ONE corresponds to the value EXT_ONE of MarshaltestTypes_EXT_BASE
+	MarshaltestTypes_EXT_BASE_EXT_ONE E_MarshaltestTypes_EXT_BASE = 1
+)
+
+// E_Marshaltest_BASE is a derived int64 type which is used to represent
+// the enumerated node Marshaltest_BASE. An additional value named
+// Marshaltest_BASE_UNSET is added to the enumeration which is used as
+// the nil value, indicating that the enumeration was not explicitly set by
+// the program importing the generated structures.
+type E_Marshaltest_BASE int64
+
+// IsYANGGoEnum ensures that Marshaltest_BASE implements the yang.GoEnum
+// interface. This ensures that Marshaltest_BASE can be identified as a
+// mapped type for a YANG enumeration.
+func (E_Marshaltest_BASE) IsYANGGoEnum() {}
+
+// ΛMap returns the value lookup map associated with  Marshaltest_BASE.
+func (E_Marshaltest_BASE) ΛMap() map[string]map[int64]ygot.EnumDefinition { return ΛEnum }
+
+// String returns a logging-friendly string for E_Marshaltest_BASE.
+func (e E_Marshaltest_BASE) String() string {
+	return ygot.EnumLogString(e, int64(e), "E_Marshaltest_BASE")
+}
+
+const (
+	// Marshaltest_BASE_UNSET corresponds to the value UNSET of Marshaltest_BASE
+	Marshaltest_BASE_UNSET E_Marshaltest_BASE = 0
+	// Marshaltest_BASE_ONE corresponds to the value ONE of Marshaltest_BASE
+	Marshaltest_BASE_ONE E_Marshaltest_BASE = 1
+	// Marshaltest_BASE_TWO corresponds to the value TWO of Marshaltest_BASE
+	Marshaltest_BASE_TWO E_Marshaltest_BASE = 2
+)
+
+// E_Marshaltest_StateEnum is a derived int64 type which is used to represent
+// the enumerated node Marshaltest_StateEnum. An additional value named
+// Marshaltest_StateEnum_UNSET is added to the enumeration which is used as
+// the nil value, indicating that the enumeration was not explicitly set by
+// the program importing the generated structures.
+type E_Marshaltest_StateEnum int64
+
+// IsYANGGoEnum ensures that Marshaltest_StateEnum implements the yang.GoEnum
+// interface. This ensures that Marshaltest_StateEnum can be identified as a
+// mapped type for a YANG enumeration.
+func (E_Marshaltest_StateEnum) IsYANGGoEnum() {}
+
+// ΛMap returns the value lookup map associated with  Marshaltest_StateEnum.
+func (E_Marshaltest_StateEnum) ΛMap() map[string]map[int64]ygot.EnumDefinition { return ΛEnum }
+
+// String returns a logging-friendly string for E_Marshaltest_StateEnum.
+func (e E_Marshaltest_StateEnum) String() string {
+	return ygot.EnumLogString(e, int64(e), "E_Marshaltest_StateEnum")
+}
+
+const (
+	// Marshaltest_StateEnum_UNSET corresponds to the value UNSET of Marshaltest_StateEnum
+	Marshaltest_StateEnum_UNSET E_Marshaltest_StateEnum = 0
+	// Marshaltest_StateEnum_UP corresponds to the value UP of Marshaltest_StateEnum
+	Marshaltest_StateEnum_UP E_Marshaltest_StateEnum = 1
+	// Marshaltest_StateEnum_DOWN corresponds to the value DOWN of Marshaltest_StateEnum
+	Marshaltest_StateEnum_DOWN E_Marshaltest_StateEnum = 2
+)
+
+// ΛEnum is a map, keyed by the name of the type defined for each enum in the
+// generated Go code, which provides a mapping between the constant int64 value
+// of each value of the enumeration, and the string that is used to represent it
+// in the YANG schema. The map is named ΛEnum in order to avoid clash with any
+// valid YANG identifier.
+var ΛEnum = map[string]map[int64]ygot.EnumDefinition{
+	"E_MarshaltestTypes_EXT_BASE": {
+		1: {Name: "EXT_ONE", DefiningModule: "marshaltest-types"},
+	},
+	"E_Marshaltest_BASE": {
+		1: {Name: "ONE", DefiningModule: "marshaltest"},
+		2: {Name: "TWO", DefiningModule: "marshaltest"},
+	},
+	"E_Marshaltest_StateEnum": {
+		1: {Name: "UP"},
+		2: {Name: "DOWN"},
+	},
+}
+
+var (
+	// ySchema is a byte slice contain a gzip compressed representation of the
+	// YANG schema from which the Go code was generated. When uncompressed the
+	// contents of the byte slice is a JSON document containing an object, keyed
+	// on the name of the generated struct, and containing the JSON marshalled
+	// contents of a goyang yang.Entry struct, which defines the schema for the
+	// fields within the struct.
+	ySchema = []byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5d, 0x51, 0x6f, 0xe2, 0x48,
+		0x12, 0x7e, 0xe7, 0x57, 0xb4, 0xfc, 0x4c, 0x36, 0x81, 0x90, 0x40, 0x78, 0x4b, 0x76, 0xb3, 0xba,
+		0xd5, 0xdc, 0x4c, 0x46, 0x9b, 0xe8, 0x76, 0xa5, 0x13, 0x1a, 0x39, 0xd0, 0x61, 0xac, 0x01, 0x1b,
+		0xd9, 0xed, 0xb9, 0xa0, 0x15, 0xff, 0xfd, 0x64, 0xb0, 0x81, 0x60, 0xc0, 0x55, 0xdd, 0x36, 0xd8,
+		0xf0, 0xf9, 0x65, 0x67, 0x43, 0x37, 0xb4, 0xbb, 0xbf, 0xfa, 0xaa, 0xfa, 0x73, 0xb9, 0xfa, 0x9f,
+		0x9a, 0x10, 0x42, 0x58, 0x5f, 0xec, 0xb1, 0xb4, 0xba, 0xc2, 0x1a, 0xc8, 0x9f, 0x4e, 0x5f, 0x5a,
+		0xf5, 0xc5, 0x5f, 0x3f, 0x39, 0xee, 0xc0, 0xea, 0x8a, 0x46, 0xfc, 0xbf, 0xbf, 0x7a, 0xee, 0x9b,
+		0x33, 0xb4, 0xba, 0xe2, 0x2a, 0xfe, 0xc3, 0x6f, 0x8e, 0x6f, 0x75, 0xc5, 0xe2, 0x2b, 0x84, 0x10,
+		0xc2, 0x52, 0xde, 0xe4, 0xc3, 0x1f, 0x3e, 0x7c, 0x77, 0xf4, 0x61, 0xfd, 0xe3, 0x47, 0x1f, 0x7f,
+		0x60, 0xf9, 0xe7, 0xcd, 0x1f, 0x5a, 0x7e, 0xf0, 0xd5, 0x97, 0x6f, 0xce, 0x7b, 0xea, 0x27, 0x3e,
+		0xfc, 0xcc, 0x58, 0x59, 0xf5, 0xf4, 0xa7, 0xcf, 0x5e, 0xe8, 0xf7, 0xe5, 0xd6, 0x9e, 0x8b, 0x91,
+		0xc8, 0xe9, 0xff, 0x3c, 0x3f, 0x1a, 0x8c, 0x35, 0x59, 0xfc, 0x48, 0x7d, 0x7b, 0xc3, 0x7f, 0xd9,
+		0xc1, 0xbd, 0x3f, 0x0c, 0xc7, 0xd2, 0x55, 0x56, 0x57, 0x28, 0x3f, 0x94, 0x3b, 0x1a, 0xae, 0xb5,
+		0x8a, 0xc6, 0x94, 0x6a, 0x34, 0xfb, 0xf0, 0x97, 0xd9, 0xc6, 0x9d, 0x6e, 0x4e, 0xed, 0xf2, 0x83,
+		0x7e, 0x32, 0x37, 0x3b, 0x6e, 0x24, 0x99, 0x86, 0xb8, 0xdd, 0x8e, 0xc1, 0x6d, 0x9f, 0xf8, 0xcc,
+		0x05, 0xa0, 0x2c, 0x04, 0x6d, 0x41, 0xa8, 0x0b, 0xc3, 0x5e, 0x20, 0xf6, 0x42, 0x91, 0x17, 0x6c,
+		0xfb, 0xc2, 0xed, 0x58, 0xc0, 0xcc, 0x85, 0x4c, 0x2e, 0xcb, 0x0e, 0x87, 0xd1, 0xaf, 0xca, 0x41,
+		0xf6, 0x1c, 0x24, 0x33, 0xba, 0xea, 0x92, 0x71, 0x4b, 0xf1, 0x32, 0x5f, 0x65, 0x34, 0xcb, 0x5a,
+		0x6e, 0xce, 0xb2, 0x6f, 0x59, 0x7e, 0xdb, 0xaa, 0x67, 0x37, 0x27, 0xe2, 0x40, 0x1b, 0x0f, 0xda,
+		0xb8, 0xd8, 0x81, 0x0f, 0xdb, 0xca, 0xec, 0x35, 0xdb, 0xdb, 0x62, 0x96, 0x31, 0xd7, 0x2f, 0xd3,
+		0x89, 0xe4, 0xcd, 0x74, 0xa0, 0x7c, 0xc7, 0x1d, 0x52, 0x26, 0x3b, 0x31, 0xff, 0x8e, 0xd1, 0x08,
+		0xef, 0x5d, 0xd7, 0x53, 0xb6, 0x72, 0x3c, 0x97, 0x36, 0xce, 0xe9, 0xd0, 0x53, 0x17, 0x5e, 0xff,
+		0xa2, 0xef, 0x8d, 0x27, 0xbe, 0x0c, 0x02, 0x39, 0xb8, 0x18, 0x49, 0xfb, 0x2d, 0xea, 0x9c, 0x31,
+		0x55, 0x35, 0x8d, 0x21, 0x5a, 0xaf, 0x8e, 0x4b, 0x37, 0xa9, 0xa8, 0x71, 0x25, 0x8c, 0xe9, 0x14,
+		0x6d, 0xa9, 0x7c, 0xa6, 0xf4, 0xea, 0xb8, 0xb6, 0x3f, 0x65, 0x98, 0xd2, 0xdd, 0x29, 0x5b, 0xd2,
+		0x40, 0xf6, 0xe9, 0x96, 0x14, 0x35, 0x86, 0x25, 0xc1, 0x92, 0x56, 0x70, 0x70, 0xc6, 0xf6, 0xe8,
+		0xb6, 0xc5, 0xf1, 0x4b, 0x4d, 0x42, 0xdb, 0xdf, 0x7d, 0xbb, 0x1f, 0x59, 0xcc, 0x6f, 0xce, 0xd0,
+		0x51, 0x81, 0xd5, 0x15, 0x94, 0x4e, 0x7f, 0xda, 0xee, 0x30, 0x1a, 0xd6, 0x7f, 0x33, 0x9b, 0x0a,
+		0x21, 0x88, 0x8b, 0x2e, 0x84, 0x10, 0xd6, 0x67, 0xc7, 0xb5, 0xba, 0x8c, 0x0e, 0x42, 0x08, 0x61,
+		0xfd, 0xc7, 0x1e, 0x85, 0xd1, 0x68, 0xee, 0x9a, 0xcd, 0xeb, 0xeb, 0x76, 0xf3, 0xea, 0xfa, 0xb6,
+		0x73, 0xd3, 0x6a, 0xb7, 0x6f, 0x3a, 0x57, 0x9d, 0x3a, 0xef, 0x9b, 0x74, 0x26, 0xe3, 0xe3, 0x72,
+		0xc9, 0xa1, 0xad, 0x9c, 0x9f, 0x32, 0x06, 0x1f, 0xb9, 0xf3, 0xac, 0xce, 0x98, 0x23, 0xfb, 0x3d,
+		0xcf, 0x39, 0x6a, 0x1f, 0x71, 0x8e, 0xde, 0xec, 0x51, 0xc0, 0x98, 0xa4, 0x5a, 0x3e, 0xad, 0x7a,
+		0xa7, 0xec, 0x64, 0xe4, 0x78, 0x42, 0x77, 0x32, 0x51, 0x63, 0x38, 0x19, 0x38, 0x99, 0x15, 0x1c,
+		0x14, 0x27, 0x5a, 0x6b, 0x5c, 0x9f, 0xb4, 0x25, 0xbd, 0xab, 0x0b, 0x67, 0xb0, 0x58, 0x2e, 0xaa,
+		0x3d, 0x2d, 0xbb, 0xc0, 0xaa, 0x60, 0x55, 0xf1, 0x3c, 0xcf, 0x01, 0xe1, 0xa8, 0xa9, 0x2f, 0xdf,
+		0x38, 0xb6, 0x75, 0x43, 0x68, 0xfb, 0x47, 0xfc, 0xd5, 0x0f, 0x76, 0xc0, 0x58, 0xa2, 0x64, 0x60,
+		0x8f, 0x7f, 0xbf, 0x7c, 0x7b, 0xb8, 0x7f, 0x7e, 0xa4, 0xae, 0xd0, 0x3c, 0x86, 0x08, 0xc8, 0x61,
+		0x9f, 0x60, 0x85, 0x7e, 0xa9, 0x91, 0x3d, 0x7d, 0x79, 0xb4, 0x72, 0x0e, 0x0d, 0x7a, 0x05, 0x63,
+		0xa3, 0xe4, 0x84, 0xf6, 0x36, 0xb2, 0x87, 0x74, 0x2e, 0x9b, 0xb7, 0x06, 0x8d, 0x81, 0xc6, 0x12,
+		0x2d, 0xc7, 0xf3, 0x46, 0xd2, 0x76, 0x39, 0x14, 0xd6, 0x38, 0x65, 0x6b, 0x72, 0x6e, 0x5b, 0x74,
+		0x63, 0x72, 0x32, 0x37, 0xee, 0xb0, 0xa5, 0x33, 0xb2, 0x25, 0xc7, 0x55, 0x2c, 0x25, 0xa7, 0x05,
+		0x4d, 0x66, 0xa5, 0x37, 0x5c, 0x41, 0x93, 0x29, 0x72, 0x8e, 0xa0, 0xc9, 0x68, 0xdd, 0xc8, 0x5e,
+		0x57, 0xd1, 0x61, 0x78, 0x8a, 0x0e, 0x1c, 0x05, 0x1c, 0xc5, 0x9a, 0xa3, 0xe8, 0x70, 0x22, 0xae,
+		0xca, 0xfa, 0x89, 0x46, 0x13, 0x7e, 0x21, 0x3d, 0x27, 0xf0, 0x03, 0xa7, 0xe5, 0x07, 0x78, 0x6a,
+		0x22, 0x94, 0x44, 0x78, 0x03, 0x51, 0x09, 0x25, 0xb1, 0x9c, 0x2a, 0x22, 0x4f, 0x41, 0xac, 0x17,
+		0x3d, 0x9c, 0x97, 0xbf, 0x9e, 0x20, 0x68, 0xe6, 0xcf, 0xa7, 0x01, 0x93, 0x50, 0x03, 0x30, 0x2a,
+		0x18, 0x15, 0x8c, 0x0a, 0x46, 0x3d, 0x0a, 0xa3, 0xfe, 0xdb, 0x09, 0xd4, 0xbd, 0x52, 0x3e, 0x0d,
+		0xa6, 0x9f, 0x1d, 0xf7, 0x71, 0x24, 0xc7, 0x31, 0xcb, 0x11, 0x82, 0xf9, 0x68, 0xc3, 0xb1, 0xd6,
+		0xa3, 0xd1, 0x69, 0xb5, 0x6e, 0xdb, 0xad, 0xd6, 0x55, 0xfb, 0xba, 0x7d, 0x75, 0x77, 0x73, 0xd3,
+		0xb8, 0x25, 0x41, 0xf7, 0xc9, 0x1f, 0x48, 0x5f, 0x0e, 0x1e, 0xa6, 0x56, 0x57, 0xb8, 0xe1, 0x68,
+		0x74, 0xca, 0x3e, 0xc4, 0x0d, 0xc7, 0x0c, 0x0f, 0x32, 0x6f, 0x0d, 0xff, 0x01, 0xff, 0x11, 0xcf,
+		0x73, 0xc8, 0x55, 0xf2, 0x3b, 0x95, 0x55, 0x68, 0xae, 0xaa, 0xa3, 0x45, 0x1c, 0x4c, 0xa0, 0xd1,
+		0xa2, 0xd7, 0xb2, 0xcc, 0x52, 0x29, 0x14, 0x1b, 0xf8, 0xc3, 0x92, 0xf9, 0xc3, 0x40, 0xd9, 0x4a,
+		0xd2, 0x1d, 0xe2, 0xa2, 0x39, 0x3c, 0x22, 0x3c, 0xe2, 0x3a, 0x20, 0x2e, 0xa4, 0x1b, 0x8e, 0x39,
+		0x1b, 0x2a, 0xca, 0x03, 0xee, 0xc7, 0xe8, 0x3b, 0xb3, 0x51, 0x5f, 0x75, 0xf3, 0xf3, 0x39, 0xc6,
+		0xe7, 0xc3, 0xf4, 0x60, 0x7a, 0x78, 0x71, 0x75, 0x97, 0x29, 0x05, 0x2c, 0x5b, 0xc2, 0xce, 0x0e,
+		0xc6, 0x74, 0x34, 0x63, 0x42, 0x20, 0x5c, 0x32, 0xfa, 0x08, 0x39, 0xf9, 0x9d, 0x21, 0xf2, 0x3b,
+		0x41, 0x1e, 0x90, 0x85, 0xca, 0x2d, 0x78, 0x40, 0x16, 0x22, 0x5c, 0x48, 0xe4, 0xc9, 0x1e, 0xa2,
+		0x15, 0x32, 0x4a, 0xa2, 0x84, 0xa8, 0x88, 0x02, 0xcf, 0xb0, 0x86, 0x86, 0x08, 0xd3, 0x8c, 0xa8,
+		0xf2, 0x8e, 0xd0, 0x36, 0x1e, 0x46, 0xee, 0x8e, 0x81, 0x1d, 0x0b, 0x33, 0x63, 0x62, 0x26, 0x33,
+		0x6b, 0x8c, 0x9c, 0xea, 0x87, 0x37, 0x07, 0xde, 0x62, 0x74, 0xe1, 0xf9, 0x65, 0xfe, 0xcd, 0x18,
+		0xf9, 0xe9, 0x94, 0x73, 0x32, 0x7e, 0x21, 0x23, 0x37, 0x1f, 0xb5, 0xcd, 0x57, 0xb1, 0x12, 0x71,
+		0x99, 0xf0, 0x31, 0x76, 0xf0, 0x84, 0xb9, 0x6c, 0x97, 0x68, 0x2e, 0x79, 0x7e, 0x9f, 0x46, 0x88,
+		0xfa, 0xad, 0x7b, 0x47, 0x66, 0x02, 0x96, 0x2e, 0x9d, 0xe2, 0x31, 0x0e, 0x1f, 0xd0, 0x74, 0x6a,
+		0xfa, 0x0c, 0x9e, 0x78, 0x24, 0x15, 0x70, 0x42, 0x29, 0x48, 0x74, 0x88, 0xa5, 0x10, 0x4b, 0x1d,
+		0x87, 0x41, 0x1d, 0x57, 0x5d, 0x37, 0x35, 0x06, 0x7e, 0x7d, 0xb2, 0xb1, 0x54, 0xb3, 0xd1, 0x6a,
+		0xb7, 0x3a, 0xd7, 0xb7, 0x2d, 0x84, 0x50, 0xc6, 0x53, 0x88, 0xc8, 0x49, 0xcf, 0xff, 0xd3, 0xbf,
+		0x0f, 0x89, 0x3a, 0xd5, 0x8b, 0x9c, 0x58, 0x35, 0xb2, 0xef, 0xd7, 0x4a, 0x60, 0xef, 0x26, 0x50,
+		0x62, 0xac, 0x75, 0x39, 0x56, 0x5d, 0xe5, 0x4d, 0xa2, 0xff, 0xec, 0xad, 0x81, 0xbe, 0xc9, 0xf6,
+		0x0d, 0x14, 0xc9, 0xce, 0x3d, 0xf4, 0x2a, 0xbc, 0x48, 0x76, 0x56, 0x75, 0xf5, 0xe4, 0x62, 0x54,
+		0x59, 0x4f, 0xad, 0x0d, 0xb5, 0xda, 0xfa, 0x26, 0xa0, 0x88, 0x7c, 0x4e, 0x06, 0x96, 0x0e, 0xc0,
+		0x34, 0x81, 0xa6, 0x0b, 0x38, 0x63, 0xe0, 0x19, 0x03, 0x50, 0x1f, 0x88, 0x34, 0x40, 0x12, 0x81,
+		0xb9, 0x19, 0x95, 0x97, 0x31, 0xde, 0x36, 0x35, 0x4d, 0x26, 0xf9, 0xf7, 0xea, 0x35, 0x13, 0x6f,
+		0x65, 0x05, 0xfd, 0xef, 0x72, 0x6c, 0x4f, 0x6c, 0xf5, 0x7d, 0xc1, 0xf2, 0xb6, 0x1f, 0x7c, 0xb7,
+		0x47, 0x4a, 0x06, 0xea, 0x32, 0xa2, 0xfb, 0x98, 0xeb, 0x6b, 0xb4, 0x01, 0x6d, 0x59, 0x3e, 0xeb,
+		0x87, 0x9c, 0x8e, 0x22, 0xef, 0x17, 0x64, 0x1f, 0xae, 0xb1, 0x6a, 0x6a, 0x78, 0xbe, 0x46, 0x13,
+		0xe7, 0x6b, 0x10, 0xe1, 0xa3, 0x7d, 0xbe, 0x46, 0xbc, 0x56, 0x74, 0xb1, 0x26, 0xe9, 0x50, 0x89,
+		0xb0, 0x01, 0x82, 0x0d, 0x9b, 0x9b, 0xe9, 0x41, 0x43, 0xac, 0x87, 0xf2, 0xc2, 0x05, 0xba, 0x50,
+		0x5b, 0xf2, 0x40, 0xe1, 0x1c, 0xe3, 0x84, 0xea, 0x85, 0x09, 0x78, 0x50, 0x28, 0x84, 0x10, 0x78,
+		0x50, 0x58, 0x8c, 0xca, 0x85, 0x07, 0x85, 0x87, 0x97, 0xbb, 0x08, 0x8b, 0x6d, 0xfd, 0x8c, 0xd7,
+		0x87, 0xe9, 0x9b, 0x16, 0xdd, 0xe0, 0x9d, 0x84, 0x80, 0x77, 0xc2, 0x26, 0x16, 0xe2, 0xb1, 0xfe,
+		0x76, 0x9c, 0xba, 0x2d, 0x5f, 0xee, 0x94, 0x2f, 0x69, 0xdb, 0x2a, 0x11, 0xbf, 0x00, 0x13, 0xf6,
+		0x95, 0x9b, 0x14, 0xcb, 0xf0, 0x26, 0xdf, 0x3e, 0xc5, 0x9d, 0x0f, 0x23, 0x47, 0xe7, 0xa3, 0x48,
+		0x2c, 0x6f, 0xdd, 0x44, 0x94, 0x18, 0x87, 0x23, 0xe5, 0x5c, 0xfc, 0x90, 0x53, 0x82, 0x2a, 0xb1,
+		0xd6, 0x16, 0xc7, 0x7e, 0x96, 0x5d, 0x96, 0x58, 0x2e, 0x16, 0x5d, 0x98, 0x58, 0x75, 0x81, 0x34,
+		0x71, 0xee, 0xd2, 0x44, 0xc6, 0x31, 0xc0, 0x3b, 0x97, 0x85, 0xf4, 0x48, 0x8c, 0x09, 0x24, 0x36,
+		0xa0, 0x74, 0x80, 0xa5, 0x07, 0x30, 0x5d, 0xa0, 0x19, 0x03, 0xce, 0x18, 0x78, 0xda, 0x00, 0xa4,
+		0x01, 0x91, 0x08, 0x48, 0x36, 0x30, 0x93, 0xcb, 0x72, 0x06, 0xfc, 0xc9, 0x5e, 0x15, 0x37, 0xe3,
+		0xce, 0x32, 0x6f, 0xaf, 0xa2, 0x0d, 0x58, 0x13, 0xe0, 0x9a, 0x01, 0xd8, 0x14, 0xc8, 0xb9, 0x01,
+		0x3a, 0x37, 0x60, 0x1b, 0x03, 0x9c, 0x07, 0x74, 0x26, 0xe0, 0xf5, 0xf7, 0x3e, 0xf9, 0x24, 0xf0,
+		0xa6, 0x28, 0xb8, 0xa5, 0xd1, 0x97, 0x97, 0xd0, 0xab, 0x3b, 0x43, 0xcc, 0x1d, 0x43, 0x7e, 0xe9,
+		0x27, 0xfa, 0x40, 0x60, 0xdc, 0xa2, 0x15, 0x6f, 0x43, 0x34, 0xa9, 0x6c, 0xde, 0x1b, 0x64, 0x06,
+		0x32, 0x3b, 0x19, 0x32, 0x63, 0x0a, 0x3a, 0x9a, 0xc2, 0x0e, 0xc8, 0x68, 0xdb, 0xc5, 0xd4, 0x7d,
+		0x53, 0xab, 0xc7, 0xd1, 0x7f, 0x41, 0x47, 0xa0, 0x23, 0xd0, 0xd1, 0x19, 0xd2, 0x51, 0xae, 0xfb,
+		0x46, 0xcd, 0x09, 0xc8, 0xd2, 0x56, 0x57, 0x52, 0xe7, 0xea, 0x9f, 0x7b, 0x53, 0xc0, 0xf8, 0x77,
+		0x49, 0xb8, 0x43, 0xce, 0x26, 0x97, 0xbf, 0xb9, 0xc5, 0x03, 0x38, 0xa8, 0x2f, 0xba, 0x44, 0xb9,
+		0x5c, 0xa7, 0x88, 0x2d, 0x68, 0x35, 0xe2, 0x53, 0xcc, 0xc8, 0x78, 0xd2, 0x6f, 0x7d, 0x8d, 0x0d,
+		0xf5, 0x97, 0x5f, 0x62, 0x2b, 0xbc, 0x74, 0x06, 0x87, 0x34, 0x44, 0xd6, 0x26, 0x4d, 0x67, 0x73,
+		0x06, 0x63, 0x84, 0x31, 0x56, 0xd7, 0x18, 0xe7, 0x40, 0x3f, 0xa0, 0x39, 0xd2, 0x6a, 0xf1, 0xa6,
+		0xe6, 0x87, 0x52, 0x93, 0x37, 0x35, 0x33, 0x5c, 0x83, 0x6c, 0xc2, 0x20, 0x85, 0xc0, 0xb3, 0x09,
+		0x3c, 0x9b, 0xc8, 0x0d, 0xc0, 0xa6, 0x40, 0xce, 0x0d, 0xd0, 0xb9, 0x01, 0xdb, 0x18, 0xe0, 0x3c,
+		0xa0, 0x33, 0x01, 0xaf, 0xef, 0x89, 0xaa, 0xff, 0x6c, 0x02, 0x12, 0x3f, 0x38, 0x01, 0x9c, 0x70,
+		0x5a, 0x9a, 0x1a, 0x94, 0x72, 0x58, 0x35, 0xac, 0xfa, 0x7c, 0xad, 0xba, 0xb2, 0x82, 0xf3, 0x62,
+		0xbf, 0x5a, 0x8a, 0x44, 0xf5, 0x4f, 0x72, 0x9a, 0xc4, 0x22, 0x22, 0x73, 0x8b, 0x82, 0xb4, 0xf6,
+		0x22, 0xe0, 0xa0, 0x97, 0xd9, 0xfe, 0x39, 0xea, 0x1e, 0xad, 0x5e, 0x95, 0x52, 0xdb, 0x57, 0xb7,
+		0x6f, 0x92, 0xdb, 0x1e, 0x3d, 0xd1, 0xda, 0x77, 0xae, 0xf8, 0x92, 0xbb, 0x92, 0x86, 0xc8, 0x6a,
+		0x3f, 0x72, 0x56, 0xbb, 0x7c, 0x57, 0xbe, 0x7d, 0x11, 0xba, 0x81, 0xb2, 0x5f, 0x47, 0xfb, 0xef,
+		0x33, 0x5e, 0xb4, 0x7e, 0xf6, 0x7b, 0xa3, 0x8c, 0xa4, 0x72, 0x5b, 0x24, 0xdf, 0x2a, 0xfa, 0x9e,
+		0xab, 0x6c, 0xc7, 0x95, 0x7e, 0xf1, 0x69, 0xe6, 0x8b, 0xdb, 0x38, 0x64, 0xa2, 0xf9, 0xd6, 0xfb,
+		0x2c, 0xac, 0x5e, 0x47, 0xef, 0x18, 0x14, 0xb2, 0xdf, 0xa6, 0x77, 0x90, 0xe5, 0xd7, 0xb8, 0x93,
+		0x01, 0xe9, 0x04, 0x8e, 0x3b, 0x1c, 0x49, 0xe2, 0x1b, 0x35, 0xeb, 0x8d, 0x41, 0x3e, 0x65, 0x7f,
+		0xa5, 0x66, 0xb5, 0x5a, 0x8c, 0xd3, 0x93, 0x56, 0x7d, 0xf0, 0x52, 0x0d, 0x5e, 0xaa, 0xc1, 0x4b,
+		0x35, 0x78, 0x70, 0xa5, 0x0f, 0x44, 0x22, 0x20, 0xd9, 0xc0, 0x4c, 0x2e, 0x08, 0xd5, 0xf9, 0x83,
+		0xd8, 0x14, 0xcc, 0xb9, 0x81, 0x3a, 0x37, 0x70, 0x1b, 0x83, 0x9c, 0x07, 0x76, 0x26, 0xe8, 0xab,
+		0x2c, 0x69, 0x21, 0x17, 0x7d, 0xed, 0x82, 0xc2, 0x0e, 0x3a, 0x02, 0x1d, 0x19, 0x94, 0x99, 0x37,
+		0x28, 0x37, 0x6f, 0x58, 0x99, 0x2b, 0xb9, 0xf4, 0xa0, 0x25, 0x4c, 0x2b, 0x75, 0xa5, 0xaa, 0x4c,
+		0x99, 0x96, 0xa3, 0xcf, 0xbd, 0xc8, 0xd4, 0xb6, 0x62, 0x53, 0x5a, 0x85, 0xbb, 0x34, 0xd1, 0x98,
+		0x5b, 0x21, 0xaf, 0xdd, 0x53, 0xdd, 0x2e, 0xf1, 0x54, 0xeb, 0xd5, 0xf5, 0xd2, 0xe3, 0x09, 0xfd,
+		0x5e, 0x3d, 0x04, 0x0f, 0x3a, 0x2d, 0x8f, 0xfc, 0x20, 0x6f, 0x4d, 0xd2, 0x5b, 0xfb, 0xf7, 0x11,
+		0xde, 0x1d, 0x41, 0xca, 0x3a, 0x84, 0x86, 0x43, 0xd9, 0x12, 0x52, 0xd6, 0xb3, 0x69, 0x03, 0x29,
+		0xeb, 0x30, 0x48, 0x21, 0xa0, 0xfc, 0x6d, 0x80, 0x15, 0x5b, 0xed, 0xc2, 0x41, 0x9d, 0x1b, 0xb8,
+		0x8d, 0x41, 0xce, 0x03, 0x3b, 0x13, 0xf4, 0xfa, 0xde, 0xe8, 0xf8, 0xca, 0x1f, 0x04, 0x34, 0x58,
+		0x35, 0xac, 0x5a, 0x08, 0x08, 0x68, 0x1f, 0x2e, 0x08, 0x68, 0x0c, 0x55, 0x07, 0x02, 0x9a, 0x10,
+		0x10, 0xd0, 0xf4, 0x05, 0xb4, 0xb3, 0xd0, 0xa1, 0xca, 0x99, 0x52, 0x8e, 0x7c, 0x72, 0x43, 0x54,
+		0xe8, 0xa1, 0x41, 0x2f, 0xa3, 0xfc, 0x79, 0xde, 0xbf, 0x6a, 0x29, 0xe5, 0x6b, 0x33, 0x60, 0x94,
+		0xde, 0xb9, 0x57, 0xc6, 0x21, 0xc9, 0x36, 0x38, 0xbc, 0x6d, 0xdb, 0x2a, 0x1f, 0x27, 0xa5, 0x93,
+		0x7e, 0x6c, 0x27, 0xfb, 0xb8, 0xce, 0x92, 0x9c, 0xb8, 0x8f, 0x73, 0x5f, 0x75, 0xe8, 0x97, 0x7f,
+		0xe6, 0x3e, 0x59, 0x32, 0x20, 0x4a, 0x04, 0x7b, 0xe8, 0x73, 0x8f, 0x41, 0xbf, 0x12, 0xa2, 0xfe,
+		0xe5, 0x90, 0xa3, 0xc6, 0x95, 0xc0, 0x30, 0x92, 0x92, 0x0f, 0x81, 0xe0, 0x57, 0xc7, 0xb5, 0xfd,
+		0x29, 0x03, 0xc1, 0x77, 0x05, 0x00, 0xb8, 0xef, 0x85, 0xae, 0x92, 0x3e, 0x1d, 0xc4, 0x49, 0x07,
+		0x00, 0x19, 0x40, 0x8e, 0xe7, 0x39, 0xa4, 0x9e, 0x59, 0x98, 0xa0, 0x82, 0xa0, 0x6a, 0x30, 0x85,
+		0x9c, 0x7f, 0x6a, 0x85, 0x0a, 0x35, 0x4b, 0xb5, 0x80, 0x2b, 0x97, 0x9a, 0x8a, 0x02, 0xfa, 0x22,
+		0x00, 0x47, 0xb8, 0xd6, 0x11, 0x54, 0x96, 0x53, 0xa2, 0xb7, 0x7b, 0x2b, 0xcb, 0x2c, 0xe5, 0xb4,
+		0x33, 0xef, 0x15, 0x40, 0xcd, 0x03, 0xd9, 0xa7, 0xd3, 0x72, 0xd4, 0x18, 0x94, 0x0c, 0x4a, 0x5e,
+		0xc1, 0xc1, 0x19, 0xdb, 0x23, 0x16, 0x2b, 0x37, 0x08, 0x69, 0x09, 0x69, 0x53, 0x6d, 0x56, 0x96,
+		0xcb, 0x8d, 0xcf, 0x95, 0xd5, 0x9a, 0x8c, 0x5d, 0xbc, 0xc5, 0x52, 0xd3, 0x0f, 0x46, 0xee, 0xc6,
+		0xe7, 0xc5, 0xe6, 0x3a, 0x47, 0xa7, 0xc3, 0xed, 0x72, 0x3c, 0xa1, 0x73, 0x7b, 0xd4, 0x18, 0xdc,
+		0x0e, 0x6e, 0x5f, 0xc1, 0x41, 0x71, 0xb6, 0x8d, 0x8d, 0xeb, 0x22, 0x00, 0xfc, 0xae, 0x2e, 0x9c,
+		0xc1, 0xbe, 0x6a, 0x1f, 0xe9, 0x71, 0x2f, 0xbb, 0x00, 0xcc, 0x00, 0xf3, 0xb2, 0xfc, 0xaa, 0x74,
+		0x95, 0xa3, 0xa6, 0xb4, 0x9c, 0xd4, 0x25, 0xa4, 0x29, 0xcf, 0x87, 0xfe, 0x88, 0xbf, 0xfa, 0xc1,
+		0x0e, 0x34, 0x12, 0x41, 0x1f, 0xff, 0x7e, 0xf9, 0xf6, 0x70, 0xff, 0xfc, 0x48, 0x5d, 0xa1, 0xb9,
+		0xc7, 0x0c, 0x58, 0x99, 0x07, 0x9a, 0x79, 0x42, 0xd1, 0xc8, 0x9e, 0xbe, 0x3c, 0xe6, 0x9d, 0xea,
+		0xd8, 0x2b, 0xac, 0x1e, 0xc9, 0x3e, 0x1e, 0x79, 0x1b, 0xd9, 0x43, 0x3a, 0x85, 0xcc, 0x5b, 0x83,
+		0x3d, 0xc0, 0x1e, 0x89, 0x84, 0xea, 0x79, 0x23, 0x69, 0xbb, 0x1c, 0xe6, 0x68, 0x14, 0x00, 0x62,
+		0xe7, 0xb6, 0x45, 0xc7, 0xb0, 0x93, 0xb9, 0x29, 0x03, 0x84, 0xcf, 0x08, 0xc2, 0x5c, 0xed, 0xb4,
+		0x85, 0xfd, 0x76, 0x2e, 0x3a, 0xe1, 0xd9, 0xec, 0xb7, 0xa1, 0xa5, 0x0a, 0x61, 0x39, 0x1d, 0x06,
+		0x41, 0x77, 0xc0, 0xcf, 0xe0, 0xe7, 0x35, 0x7e, 0xee, 0x70, 0xe2, 0x8b, 0xca, 0xd2, 0x73, 0xa3,
+		0x09, 0x3a, 0x4e, 0xcf, 0x09, 0xe8, 0x37, 0x17, 0xfa, 0xe5, 0x29, 0x45, 0x50, 0x89, 0x40, 0xc2,
+		0xa2, 0x12, 0x2a, 0x51, 0x39, 0x15, 0x22, 0x9e, 0x3a, 0x54, 0x2f, 0x7a, 0x38, 0x2f, 0x7f, 0x3d,
+		0x9d, 0x86, 0x58, 0x35, 0x87, 0x61, 0xc0, 0xe4, 0xb1, 0x00, 0x44, 0x06, 0x22, 0x03, 0x91, 0x81,
+		0xc8, 0x8a, 0x21, 0xb2, 0xf3, 0x7b, 0x93, 0x47, 0x8b, 0xba, 0xdd, 0x70, 0xcc, 0x20, 0xee, 0x79,
+		0x6b, 0xd0, 0x36, 0x68, 0x1b, 0x19, 0xae, 0x25, 0xde, 0xf0, 0x22, 0xc3, 0x95, 0x70, 0x1d, 0x48,
+		0x16, 0x80, 0x1b, 0xa2, 0xcc, 0x05, 0xb1, 0x2a, 0x15, 0xab, 0x1a, 0x15, 0x1c, 0xd1, 0x19, 0x39,
+		0x22, 0xd6, 0xf9, 0xae, 0x9c, 0xf3, 0x5c, 0x89, 0xe7, 0xb7, 0xea, 0xa2, 0xde, 0xe7, 0x60, 0x1e,
+		0x2f, 0x17, 0x01, 0xf1, 0x25, 0x7b, 0xcf, 0x33, 0x50, 0x7e, 0xc0, 0x82, 0x30, 0xb6, 0x0f, 0xc0,
+		0x70, 0x71, 0x18, 0x46, 0xb4, 0x45, 0xb2, 0xda, 0x90, 0x93, 0x97, 0x15, 0x22, 0x2f, 0x0b, 0x36,
+		0x8b, 0x2d, 0x7f, 0xb9, 0x37, 0xb3, 0xd8, 0xf2, 0x13, 0xae, 0x32, 0x67, 0x02, 0x84, 0x8c, 0x7a,
+		0x19, 0x21, 0xca, 0x65, 0x80, 0x90, 0xd7, 0xd0, 0x10, 0x95, 0xa4, 0x62, 0xc4, 0x50, 0x77, 0x84,
+		0xb6, 0xf1, 0x30, 0x72, 0xe7, 0x63, 0xed, 0xc2, 0xb6, 0xcc, 0x82, 0xb6, 0xb3, 0x7a, 0x51, 0x23,
+		0xa7, 0xba, 0xbf, 0xcd, 0x81, 0xb7, 0x18, 0x5d, 0xf4, 0x8a, 0x75, 0x6a, 0x54, 0x24, 0x35, 0x29,
+		0xce, 0x99, 0x5f, 0xfe, 0x72, 0x6e, 0xae, 0x61, 0x9b, 0x8b, 0xd0, 0xaa, 0xc6, 0xa9, 0x51, 0x85,
+		0xd3, 0xa8, 0xfa, 0x66, 0x7e, 0x79, 0xce, 0x85, 0xce, 0xa5, 0x5e, 0xb9, 0xcd, 0xa2, 0x8e, 0x61,
+		0xe9, 0x1d, 0x99, 0x09, 0x58, 0x9a, 0x63, 0x8a, 0xc7, 0x38, 0x7c, 0x40, 0xd3, 0x20, 0xe9, 0x33,
+		0x58, 0x4c, 0x00, 0x13, 0x70, 0x22, 0x18, 0xe8, 0x40, 0x08, 0x61, 0x10, 0xc2, 0x1c, 0x87, 0xb8,
+		0xb8, 0xf5, 0xc7, 0x35, 0xea, 0x8e, 0x57, 0x2c, 0x84, 0x31, 0xad, 0x2b, 0x8e, 0xc8, 0xc5, 0xb8,
+		0x5e, 0x38, 0x02, 0x16, 0xf2, 0xf7, 0x21, 0xe5, 0x80, 0x3a, 0x63, 0xcc, 0x0a, 0xcf, 0x6b, 0x45,
+		0x78, 0x77, 0xf3, 0x16, 0x31, 0xc4, 0xb9, 0x1c, 0xab, 0x6e, 0x54, 0xef, 0x79, 0xac, 0xba, 0x9c,
+		0x6c, 0x85, 0x06, 0xaa, 0xf4, 0xe6, 0x1e, 0xf0, 0x14, 0x5e, 0xa5, 0x97, 0x7a, 0xca, 0x15, 0xa3,
+		0xcc, 0x73, 0x6a, 0x6d, 0xa8, 0xe5, 0x9e, 0x37, 0x01, 0x55, 0xd2, 0x53, 0x11, 0xed, 0x73, 0x3c,
+		0x85, 0xcd, 0xae, 0xde, 0xb9, 0x88, 0x85, 0x47, 0xb9, 0xc5, 0xbd, 0xb0, 0xb2, 0xf5, 0x93, 0x5e,
+		0xc1, 0xd5, 0xfd, 0x77, 0x1f, 0x6b, 0x31, 0xab, 0xed, 0xff, 0xcb, 0xc6, 0x5a, 0x66, 0x8d, 0x28,
+		0x63, 0x24, 0x56, 0xbd, 0x96, 0x7d, 0x84, 0x82, 0x55, 0xdb, 0x3e, 0x9c, 0x59, 0x6d, 0x6d, 0x40,
+		0xbb, 0x06, 0x62, 0x39, 0xc1, 0xaf, 0xcb, 0x33, 0x77, 0x9f, 0xe7, 0x83, 0x49, 0xd9, 0x88, 0xe5,
+		0x04, 0xbf, 0xdb, 0x3f, 0xe4, 0x9f, 0x9e, 0x97, 0xb6, 0x9f, 0xcd, 0x1b, 0xb0, 0xea, 0xb5, 0x1d,
+		0x23, 0xfd, 0x4d, 0xfe, 0x74, 0xfa, 0xf1, 0xac, 0xce, 0x6a, 0xb3, 0xff, 0x03, 0x00, 0x00, 0xff,
+		0xff, 0x03, 0x00, 0x98, 0x83, 0x51, 0xea, 0xcb, 0x33, 0x01, 0x00,
+	}
+)
+
+// ΛEnumTypes is a map, keyed by a YANG schema path, of the enumerated types that
+// correspond with the leaf. The type is represented as a reflect.Type. The naming
+// of the map ensures that there are no clashes with valid YANG identifiers.
+func initΛEnumTypes() {
+	ΛEnumTypes = map[string][]reflect.Type{
+		"/top/config/ext-ident": {
+			reflect.TypeOf((E_MarshaltestTypes_EXT_BASE)(0)),
+		},
+		"/top/config/ident": {
+			reflect.TypeOf((E_Marshaltest_BASE)(0)),
+		},
+		"/top/config/idents": {
+			reflect.TypeOf((E_Marshaltest_BASE)(0)),
+		},
+		"/top/config/state": {
+			reflect.TypeOf((E_Marshaltest_StateEnum)(0)),
+		},
+		"/top/config/un": {
+			reflect.TypeOf((E_Marshaltest_StateEnum)(0)),
+		},
+		"/top/multi-keys/multi-key/config/id": {
+			reflect.TypeOf((E_Marshaltest_StateEnum)(0)),
+		},
+	}
+}
@@ -0,0 +1,33 @@
+#!/bin/bash
+
+# Copyright 2021 Google Inc.
+#
+# Licensed under the Apache License, Version 2.0 (the "License");
+# you may not use this file except in compliance with the License.
+# You may obtain a copy of the License at
+#
+#      http://www.apache.org/licenses/LICENSE-2.0
+#
+# Unless required by applicable law or agreed to in writing, software
+# distributed under the License is distributed on an "AS IS" BASIS,
+# WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+# See the License for the specific language governing permissions and
+# limitations under the License.
+
+go run ../../../generator/generator.go -path="../yang" -output_file=withoutmethods.go \
+  -package_name=withoutmethods -generate_fakeroot -fakeroot_name=device \
+  -compress_paths \
+  -ignore_shadow_schema_paths \
+  -shorten_enum_leaf_names \
+  -typedef_enum_with_defmod \
+  -generate_simple_unions \
+  -annotations \
+  -yangpresence \
+  ../yang/marshaltest.yang \
+  ../yang/marshaltest-types.yang \
+  ../yang/marshaltest-augment.yang
+gofmt -w -s withoutmethods.go
+
+# Prepend the license header of the repository to the generated code.
+{ sed -n '1,13p' generate.go; echo; cat withoutmethods.go; } > withoutmethods.go.tmp
+mv withoutmethods.go.tmp withoutmethods.go
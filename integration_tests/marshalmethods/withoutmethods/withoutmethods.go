@@ -0,0 +1,879 @@
+// Copyright 2021 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+/*
+Package withoutmethods is a generated package which contains definitions
+of structs which represent a YANG schema. The generated schema can be
+compressed by a series of transformations (compression was true
+in this case).
+
+This package was generated by /root/module/genutil/names.go
+using the following YANG input files:
+  - ../yang/marshaltest.yang
+  - ../yang/marshaltest-types.yang
+  - ../yang/marshaltest-augment.yang
+
+Imported modules were sourced from:
+  - ../yang/...
+*/
+package withoutmethods
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"github.com/openconfig/goyang/pkg/yang"
+	"github.com/openconfig/ygot/ygot"
+	"github.com/openconfig/ygot/ytypes"
+)
+
+// Binary is a type that is used for fields that have a YANG type of
+// binary. It is used such that binary fields can be distinguished from
+// leaf-lists of uint8s (which are mapped to []uint8, equivalent to
+// []byte in reflection).
+type Binary []byte
+
+// YANGEmpty is a type that is used for fields that have a YANG type of
+// empty. It is used such that empty fields can be distinguished from boolean fields
+// in the generated code.
+type YANGEmpty bool
+
+// UnionInt8 is an int8 type assignable to unions of which it is a subtype.
+type UnionInt8 int8
+
+// UnionInt16 is an int16 type assignable to unions of which it is a subtype.
+type UnionInt16 int16
+
+// UnionInt32 is an int32 type assignable to unions of which it is a subtype.
+type UnionInt32 int32
+
+// UnionInt64 is an int64 type assignable to unions of which it is a subtype.
+type UnionInt64 int64
+
+// UnionUint8 is a uint8 type assignable to unions of which it is a subtype.
+type UnionUint8 uint8
+
+// UnionUint16 is a uint16 type assignable to unions of which it is a subtype.
+type UnionUint16 uint16
+
+// UnionUint32 is a uint32 type assignable to unions of which it is a subtype.
+type UnionUint32 uint32
+
+// UnionUint64 is a uint64 type assignable to unions of which it is a subtype.
+type UnionUint64 uint64
+
+// UnionFloat64 is a float64 type assignable to unions of which it is a subtype.
+type UnionFloat64 float64
+
+// UnionString is a string type assignable to unions of which it is a subtype.
+type UnionString string
+
+// UnionBool is a bool type assignable to unions of which it is a subtype.
+type UnionBool bool
+
+// UnionUnsupported is an interface{} wrapper type for unsupported types. It is
+// assignable to unions of which it is a subtype.
+type UnionUnsupported struct {
+	Value interface{}
+}
+
+var (
+	SchemaTree map[string]*yang.Entry
+	ΛEnumTypes map[string][]reflect.Type
+)
+
+func init() {
+	var err error
+	initΛEnumTypes()
+	if SchemaTree, err = UnzipSchema(); err != nil {
+		panic("schema error: " + err.Error())
+	}
+}
+
+// Schema returns the details of the generated schema.
+func Schema() (*ytypes.Schema, error) {
+	uzp, err := UnzipSchema()
+	if err != nil {
+		return nil, fmt.Errorf("cannot unzip schema, %v", err)
+	}
+
+	return &ytypes.Schema{
+		Root:       &Device{},
+		SchemaTree: uzp,
+		Unmarshal:  Unmarshal,
+	}, nil
+}
+
+// UnzipSchema unzips the zipped schema and returns a map of yang.Entry nodes,
+// keyed by the name of the struct that the yang.Entry describes the schema for.
+func UnzipSchema() (map[string]*yang.Entry, error) {
+	var schemaTree map[string]*yang.Entry
+	var err error
+	if schemaTree, err = ygot.GzipToSchema(ySchema); err != nil {
+		return nil, fmt.Errorf("could not unzip the schema; %v", err)
+	}
+	return schemaTree, nil
+}
+
+// Unmarshal unmarshals data, which must be RFC7951 JSON format, into
+// destStruct, which must be non-nil and the correct GoStruct type. It returns
+// an error if the destStruct is not found in the schema or the data cannot be
+// unmarshaled. The supplied options (opts) are used to control the behaviour
+// of the unmarshal function - for example, determining whether errors are
+// thrown for unknown fields in the input JSON.
+func Unmarshal(data []byte, destStruct ygot.GoStruct, opts ...ytypes.UnmarshalOpt) error {
+	tn := reflect.TypeOf(destStruct).Elem().Name()
+	schema, ok := SchemaTree[tn]
+	if !ok {
+		return fmt.Errorf("could not find schema for type %s", tn)
+	}
+	var jsonTree interface{}
+	if err := json.Unmarshal([]byte(data), &jsonTree); err != nil {
+		return err
+	}
+	return ytypes.Unmarshal(schema, destStruct, jsonTree, opts...)
+}
+
+// Device represents the /device YANG schema element.
+type Device struct {
+	ΛMetadata []ygot.Annotation `path:"@" ygotAnnotation:"true"`
+	Top       *Top              `path:"top" module:"marshaltest"`
+	ΛTop      []ygot.Annotation `path:"@top" ygotAnnotation:"true"`
+}
+
+// IsYANGGoStruct ensures that Device implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*Device) IsYANGGoStruct() {}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *Device) ΛValidate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["Device"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *Device) Validate(opts ...ygot.ValidationOption) error {
+	return t.ΛValidate(opts...)
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *Device) ΛEnumTypeMap() map[string][]reflect.Type { return ΛEnumTypes }
+
+// ΛBelongingModule returns the name of the module that defines the namespace
+// of Device.
+func (*Device) ΛBelongingModule() string {
+	return ""
+}
+
+// Top represents the /marshaltest/top YANG schema element.
+type Top struct {
+	ΛMetadata  []ygot.Annotation                  `path:"@" ygotAnnotation:"true"`
+	Augmented  *string                            `path:"config/augmented" module:"marshaltest/marshaltest-augment" shadow-path:"state/augmented" shadow-module:"marshaltest/marshaltest-augment"`
+	ΛAugmented []ygot.Annotation                  `path:"config/@augmented" ygotAnnotation:"true"`
+	Bin        Binary                             `path:"config/bin" module:"marshaltest/marshaltest" shadow-path:"state/bin" shadow-module:"marshaltest/marshaltest"`
+	ΛBin       []ygot.Annotation                  `path:"config/@bin" ygotAnnotation:"true"`
+	Counter    *uint64                            `path:"state/counter" module:"marshaltest/marshaltest"`
+	ΛCounter   []ygot.Annotation                  `path:"state/@counter" ygotAnnotation:"true"`
+	Dec        *float64                           `path:"config/dec" module:"marshaltest/marshaltest" shadow-path:"state/dec" shadow-module:"marshaltest/marshaltest"`
+	ΛDec       []ygot.Annotation                  `path:"config/@dec" ygotAnnotation:"true"`
+	Emp        YANGEmpty                          `path:"config/emp" module:"marshaltest/marshaltest" shadow-path:"state/emp" shadow-module:"marshaltest/marshaltest"`
+	ΛEmp       []ygot.Annotation                  `path:"config/@emp" ygotAnnotation:"true"`
+	ExtIdent   E_MarshaltestTypes_EXT_BASE        `path:"config/ext-ident" module:"marshaltest/marshaltest" shadow-path:"state/ext-ident" shadow-module:"marshaltest/marshaltest"`
+	ΛExtIdent  []ygot.Annotation                  `path:"config/@ext-ident" ygotAnnotation:"true"`
+	Flag       *bool                              `path:"config/flag" module:"marshaltest/marshaltest" shadow-path:"state/flag" shadow-module:"marshaltest/marshaltest"`
+	ΛFlag      []ygot.Annotation                  `path:"config/@flag" ygotAnnotation:"true"`
+	I64        *int64                             `path:"config/i64" module:"marshaltest/marshaltest" shadow-path:"state/i64" shadow-module:"marshaltest/marshaltest"`
+	ΛI64       []ygot.Annotation                  `path:"config/@i64" ygotAnnotation:"true"`
+	I8         *int8                              `path:"config/i8" module:"marshaltest/marshaltest" shadow-path:"state/i8" shadow-module:"marshaltest/marshaltest"`
+	ΛI8        []ygot.Annotation                  `path:"config/@i8" ygotAnnotation:"true"`
+	Ident      E_Marshaltest_BASE                 `path:"config/ident" module:"marshaltest/marshaltest" shadow-path:"state/ident" shadow-module:"marshaltest/marshaltest"`
+	ΛIdent     []ygot.Annotation                  `path:"config/@ident" ygotAnnotation:"true"`
+	Idents     []E_Marshaltest_BASE               `path:"config/idents" module:"marshaltest/marshaltest" shadow-path:"state/idents" shadow-module:"marshaltest/marshaltest"`
+	ΛIdents    []ygot.Annotation                  `path:"config/@idents" ygotAnnotation:"true"`
+	Keyless    []*Top_Keyless                     `path:"keylesses/keyless" module:"marshaltest/marshaltest"`
+	ΛKeyless   []ygot.Annotation                  `path:"keylesses/@keyless" ygotAnnotation:"true"`
+	MultiKey   map[Top_MultiKey_Key]*Top_MultiKey `path:"multi-keys/multi-key" module:"marshaltest/marshaltest"`
+	ΛMultiKey  []ygot.Annotation                  `path:"multi-keys/@multi-key" ygotAnnotation:"true"`
+	Nums       []uint64                           `path:"config/nums" module:"marshaltest/marshaltest" shadow-path:"state/nums" shadow-module:"marshaltest/marshaltest"`
+	ΛNums      []ygot.Annotation                  `path:"config/@nums" ygotAnnotation:"true"`
+	Present    *Top_Present                       `path:"present" module:"marshaltest" yangPresence:"true"`
+	ΛPresent   []ygot.Annotation                  `path:"@present" ygotAnnotation:"true"`
+	SingleKey  map[string]*Top_SingleKey          `path:"single-keys/single-key" module:"marshaltest/marshaltest"`
+	ΛSingleKey []ygot.Annotation                  `path:"single-keys/@single-key" ygotAnnotation:"true"`
+	State      E_Marshaltest_StateEnum            `path:"config/state" module:"marshaltest/marshaltest" shadow-path:"state/state" shadow-module:"marshaltest/marshaltest"`
+	ΛState     []ygot.Annotation                  `path:"config/@state" ygotAnnotation:"true"`
+	Str        *string                            `path:"config/str" module:"marshaltest/marshaltest" shadow-path:"state/str" shadow-module:"marshaltest/marshaltest"`
+	ΛStr       []ygot.Annotation                  `path:"config/@str" ygotAnnotation:"true"`
+	Strs       []string                           `path:"config/strs" module:"marshaltest/marshaltest" shadow-path:"state/strs" shadow-module:"marshaltest/marshaltest"`
+	ΛStrs      []ygot.Annotation                  `path:"config/@strs" ygotAnnotation:"true"`
+	U64        *uint64                            `path:"config/u64" module:"marshaltest/marshaltest" shadow-path:"state/u64" shadow-module:"marshaltest/marshaltest"`
+	ΛU64       []ygot.Annotation                  `path:"config/@u64" ygotAnnotation:"true"`
+	Un         Top_Un_Union                       `path:"config/un" module:"marshaltest/marshaltest" shadow-path:"state/un" shadow-module:"marshaltest/marshaltest"`
+	ΛUn        []ygot.Annotation                  `path:"config/@un" ygotAnnotation:"true"`
+	Uns        []Top_Uns_Union                    `path:"config/uns" module:"marshaltest/marshaltest" shadow-path:"state/uns" shadow-module:"marshaltest/marshaltest"`
+	ΛUns       []ygot.Annotation                  `path:"config/@uns" ygotAnnotation:"true"`
+}
+
+// IsYANGGoStruct ensures that Top implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*Top) IsYANGGoStruct() {}
+
+// Top_MultiKey_Key represents the key for list MultiKey of element /marshaltest/top.
+type Top_MultiKey_Key struct {
+	Name string                  `path:"name"`
+	Id   E_Marshaltest_StateEnum `path:"id"`
+}
+
+// NewMultiKey creates a new entry in the MultiKey list of the
+// Top struct. The keys of the list are populated from the input
+// arguments.
+func (t *Top) NewMultiKey(Name string, Id E_Marshaltest_StateEnum) (*Top_MultiKey, error) {
+
+	// Initialise the list within the receiver struct if it has not already been
+	// created.
+	if t.MultiKey == nil {
+		t.MultiKey = make(map[Top_MultiKey_Key]*Top_MultiKey)
+	}
+
+	key := Top_MultiKey_Key{
+		Name: Name,
+		Id:   Id,
+	}
+
+	// Ensure that this key has not already been used in the
+	// list. Keyed YANG lists do not allow duplicate keys to
+	// be created.
+	if _, ok := t.MultiKey[key]; ok {
+		return nil, fmt.Errorf("duplicate key %v for list MultiKey", key)
+	}
+
+	t.MultiKey[key] = &Top_MultiKey{
+		Name: &Name,
+		Id:   Id,
+	}
+
+	return t.MultiKey[key], nil
+}
+
+// NewSingleKey creates a new entry in the SingleKey list of the
+// Top struct. The keys of the list are populated from the input
+// arguments.
+func (t *Top) NewSingleKey(Name string) (*Top_SingleKey, error) {
+
+	// Initialise the list within the receiver struct if it has not already been
+	// created.
+	if t.SingleKey == nil {
+		t.SingleKey = make(map[string]*Top_SingleKey)
+	}
+
+	key := Name
+
+	// Ensure that this key has not already been used in the
+	// list. Keyed YANG lists do not allow duplicate keys to
+	// be created.
+	if _, ok := t.SingleKey[key]; ok {
+		return nil, fmt.Errorf("duplicate key %v for list SingleKey", key)
+	}
+
+	t.SingleKey[key] = &Top_SingleKey{
+		Name: &Name,
+	}
+
+	return t.SingleKey[key], nil
+}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *Top) ΛValidate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["Top"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *Top) Validate(opts ...ygot.ValidationOption) error {
+	return t.ΛValidate(opts...)
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *Top) ΛEnumTypeMap() map[string][]reflect.Type { return ΛEnumTypes }
+
+// ΛBelongingModule returns the name of the module that defines the namespace
+// of Top.
+func (*Top) ΛBelongingModule() string {
+	return "marshaltest"
+}
+
+// Top_Un_Union is an interface that is implemented by valid types for the union
+// for the leaf /marshaltest/top/config/un within the YANG schema.
+// Union type can be one of [E_Marshaltest_StateEnum, UnionInt64, UnionString].
+type Top_Un_Union interface {
+	// Union type can be one of [E_Marshaltest_StateEnum, UnionInt64, UnionString]
+	Documentation_for_Top_Un_Union()
+}
+
+// Documentation_for_Top_Un_Union ensures that E_Marshaltest_StateEnum
+// implements the Top_Un_Union interface.
+func (E_Marshaltest_StateEnum) Documentation_for_Top_Un_Union() {}
+
+// Documentation_for_Top_Un_Union ensures that UnionInt64
+// implements the Top_Un_Union interface.
+func (UnionInt64) Documentation_for_Top_Un_Union() {}
+
+// Documentation_for_Top_Un_Union ensures that UnionString
+// implements the Top_Un_Union interface.
+func (UnionString) Documentation_for_Top_Un_Union() {}
+
+// To_Top_Un_Union takes an input interface{} and attempts to convert it to a struct
+// which implements the Top_Un_Union union. It returns an error if the interface{} supplied
+// cannot be converted to a type within the union.
+func (t *Top) To_Top_Un_Union(i interface{}) (Top_Un_Union, error) {
+	if v, ok := i.(Top_Un_Union); ok {
+		return v, nil
+	}
+	switch v := i.(type) {
+	case int64:
+		return UnionInt64(v), nil
+	case string:
+		return UnionString(v), nil
+	}
+	return nil, fmt.Errorf("cannot convert %v to Top_Un_Union, unknown union type, got: %T, want any of [E_Marshaltest_StateEnum, int64, string]", i, i)
+}
+
+// Top_Uns_Union is an interface that is implemented by valid types for the union
+// for the leaf /marshaltest/top/config/uns within the YANG schema.
+// Union type can be one of [UnionInt32, UnionString].
+type Top_Uns_Union interface {
+	// Union type can be one of [UnionInt32, UnionString]
+	Documentation_for_Top_Uns_Union()
+}
+
+// Documentation_for_Top_Uns_Union ensures that UnionInt32
+// implements the Top_Uns_Union interface.
+func (UnionInt32) Documentation_for_Top_Uns_Union() {}
+
+// Documentation_for_Top_Uns_Union ensures that UnionString
+// implements the Top_Uns_Union interface.
+func (UnionString) Documentation_for_Top_Uns_Union() {}
+
+// To_Top_Uns_Union takes an input interface{} and attempts to convert it to a struct
+// which implements the Top_Uns_Union union. It returns an error if the interface{} supplied
+// cannot be converted to a type within the union.
+func (t *Top) To_Top_Uns_Union(i interface{}) (Top_Uns_Union, error) {
+	if v, ok := i.(Top_Uns_Union); ok {
+		return v, nil
+	}
+	switch v := i.(type) {
+	case int32:
+		return UnionInt32(v), nil
+	case string:
+		return UnionString(v), nil
+	}
+	return nil, fmt.Errorf("cannot convert %v to Top_Uns_Union, unknown union type, got: %T, want any of [int32, string]", i, i)
+}
+
+// Top_Keyless represents the /marshaltest/top/keylesses/keyless YANG schema element.
+type Top_Keyless struct {
+	ΛMetadata []ygot.Annotation `path:"@" ygotAnnotation:"true"`
+	Num       *int64            `path:"num" module:"marshaltest"`
+	ΛNum      []ygot.Annotation `path:"@num" ygotAnnotation:"true"`
+	Value     *string           `path:"value" module:"marshaltest"`
+	ΛValue    []ygot.Annotation `path:"@value" ygotAnnotation:"true"`
+}
+
+// IsYANGGoStruct ensures that Top_Keyless implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*Top_Keyless) IsYANGGoStruct() {}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *Top_Keyless) ΛValidate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["Top_Keyless"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *Top_Keyless) Validate(opts ...ygot.ValidationOption) error {
+	return t.ΛValidate(opts...)
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *Top_Keyless) ΛEnumTypeMap() map[string][]reflect.Type { return ΛEnumTypes }
+
+// ΛBelongingModule returns the name of the module that defines the namespace
+// of Top_Keyless.
+func (*Top_Keyless) ΛBelongingModule() string {
+	return "marshaltest"
+}
+
+// Top_MultiKey represents the /marshaltest/top/multi-keys/multi-key YANG schema element.
+type Top_MultiKey struct {
+	ΛMetadata []ygot.Annotation       `path:"@" ygotAnnotation:"true"`
+	Id        E_Marshaltest_StateEnum `path:"config/id|id" module:"marshaltest/marshaltest|marshaltest" shadow-path:"state/id|id" shadow-module:"marshaltest/marshaltest|marshaltest"`
+	ΛId       []ygot.Annotation       `path:"config/@id|@id" ygotAnnotation:"true"`
+	Name      *string                 `path:"config/name|name" module:"marshaltest/marshaltest|marshaltest" shadow-path:"state/name|name" shadow-module:"marshaltest/marshaltest|marshaltest"`
+	ΛName     []ygot.Annotation       `path:"config/@name|@name" ygotAnnotation:"true"`
+	Value     *string                 `path:"config/value" module:"marshaltest/marshaltest" shadow-path:"state/value" shadow-module:"marshaltest/marshaltest"`
+	ΛValue    []ygot.Annotation       `path:"config/@value" ygotAnnotation:"true"`
+}
+
+// IsYANGGoStruct ensures that Top_MultiKey implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*Top_MultiKey) IsYANGGoStruct() {}
+
+// ΛListKeyMap returns the keys of the Top_MultiKey struct, which is a YANG list entry.
+func (t *Top_MultiKey) ΛListKeyMap() (map[string]interface{}, error) {
+
+	if t.Name == nil {
+		return nil, fmt.Errorf("nil value for key Name")
+	}
+
+	return map[string]interface{}{
+		"id":   t.Id,
+		"name": *t.Name,
+	}, nil
+}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *Top_MultiKey) ΛValidate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["Top_MultiKey"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *Top_MultiKey) Validate(opts ...ygot.ValidationOption) error {
+	return t.ΛValidate(opts...)
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *Top_MultiKey) ΛEnumTypeMap() map[string][]reflect.Type { return ΛEnumTypes }
+
+// ΛBelongingModule returns the name of the module that defines the namespace
+// of Top_MultiKey.
+func (*Top_MultiKey) ΛBelongingModule() string {
+	return "marshaltest"
+}
+
+// Top_Present represents the /marshaltest/top/present YANG schema element.
+type Top_Present struct {
+	ΛMetadata []ygot.Annotation `path:"@" ygotAnnotation:"true"`
+}
+
+// IsYANGGoStruct ensures that Top_Present implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*Top_Present) IsYANGGoStruct() {}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *Top_Present) ΛValidate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["Top_Present"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *Top_Present) Validate(opts ...ygot.ValidationOption) error {
+	return t.ΛValidate(opts...)
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *Top_Present) ΛEnumTypeMap() map[string][]reflect.Type { return ΛEnumTypes }
+
+// ΛBelongingModule returns the name of the module that defines the namespace
+// of Top_Present.
+func (*Top_Present) ΛBelongingModule() string {
+	return "marshaltest"
+}
+
+// Top_SingleKey represents the /marshaltest/top/single-keys/single-key YANG schema element.
+type Top_SingleKey struct {
+	ΛMetadata []ygot.Annotation `path:"@" ygotAnnotation:"true"`
+	Name      *string           `path:"config/name|name" module:"marshaltest/marshaltest|marshaltest" shadow-path:"state/name|name" shadow-module:"marshaltest/marshaltest|marshaltest"`
+	ΛName     []ygot.Annotation `path:"config/@name|@name" ygotAnnotation:"true"`
+	Value     *int32            `path:"config/value" module:"marshaltest/marshaltest" shadow-path:"state/value" shadow-module:"marshaltest/marshaltest"`
+	ΛValue    []ygot.Annotation `path:"config/@value" ygotAnnotation:"true"`
+}
+
+// IsYANGGoStruct ensures that Top_SingleKey implements the yang.GoStruct
+// interface. This allows functions that need to handle this struct to
+// identify it as being generated by ygen.
+func (*Top_SingleKey) IsYANGGoStruct() {}
+
+// ΛListKeyMap returns the keys of the Top_SingleKey struct, which is a YANG list entry.
+func (t *Top_SingleKey) ΛListKeyMap() (map[string]interface{}, error) {
+	if t.Name == nil {
+		return nil, fmt.Errorf("nil value for key Name")
+	}
+
+	return map[string]interface{}{
+		"name": *t.Name,
+	}, nil
+}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *Top_SingleKey) ΛValidate(opts ...ygot.ValidationOption) error {
+	if err := ytypes.Validate(SchemaTree["Top_SingleKey"], t, opts...); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate validates s against the YANG schema corresponding to its type.
+func (t *Top_SingleKey) Validate(opts ...ygot.ValidationOption) error {
+	return t.ΛValidate(opts...)
+}
+
+// ΛEnumTypeMap returns a map, keyed by YANG schema path, of the enumerated types
+// that are included in the generated code.
+func (t *Top_SingleKey) ΛEnumTypeMap() map[string][]reflect.Type { return ΛEnumTypes }
+
+// ΛBelongingModule returns the name of the module that defines the namespace
+// of Top_SingleKey.
+func (*Top_SingleKey) ΛBelongingModule() string {
+	return "marshaltest"
+}
+
+// E_MarshaltestTypes_EXT_BASE is a derived int64 type which is used to represent
+// the enumerated node MarshaltestTypes_EXT_BASE. An additional value named
+// MarshaltestTypes_EXT_BASE_UNSET is added to the enumeration which is used as
+// the nil value, indicating that the enumeration was not explicitly set by
+// the program importing the generated structures.
+type E_MarshaltestTypes_EXT_BASE int64
+
+// IsYANGGoEnum ensures that MarshaltestTypes_EXT_BASE implements the yang.GoEnum
+// interface. This ensures that MarshaltestTypes_EXT_BASE can be identified as a
+// mapped type for a YANG enumeration.
+func (E_MarshaltestTypes_EXT_BASE) IsYANGGoEnum() {}
+
+// ΛMap returns the value lookup map associated with  MarshaltestTypes_EXT_BASE.
+func (E_MarshaltestTypes_EXT_BASE) ΛMap() map[string]map[int64]ygot.EnumDefinition { return ΛEnum }
+
+// String returns a logging-friendly string for E_MarshaltestTypes_EXT_BASE.
+func (e E_MarshaltestTypes_EXT_BASE) String() string {
+	return ygot.EnumLogString(e, int64(e), "E_MarshaltestTypes_EXT_BASE")
+}
+
+const (
+	// MarshaltestTypes_EXT_BASE_UNSET corresponds to the value UNSET of MarshaltestTypes_EXT_BASE
+	MarshaltestTypes_EXT_BASE_UNSET E_MarshaltestTypes_EXT_BASE = 0
+	// MarshaltestTypes_EXT_BASE_EXT_ONE corresponds to the value EXT_ONE of MarshaltestTypes_EXT_BASE
+	MarshaltestTypes_EXT_BASE_EXT_ONE E_MarshaltestTypes_EXT_BASE = 1
+)
+
+// E_Marshaltest_BASE is a derived int64 type which is used to represent
+// the enumerated node Marshaltest_BASE. An additional value named
+// Marshaltest_BASE_UNSET is added to the enumeration which is used as
+// the nil value, indicating that the enumeration was not explicitly set by
+// the program importing the generated structures.
+type E_Marshaltest_BASE int64
+
+// IsYANGGoEnum ensures that Marshaltest_BASE implements the yang.GoEnum
+// interface. This ensures that Marshaltest_BASE can be identified as a
+// mapped type for a YANG enumeration.
+func (E_Marshaltest_BASE) IsYANGGoEnum() {}
+
+// ΛMap returns the value lookup map associated with  Marshaltest_BASE.
+func (E_Marshaltest_BASE) ΛMap() map[string]map[int64]ygot.EnumDefinition { return ΛEnum }
+
+// String returns a logging-friendly string for E_Marshaltest_BASE.
+func (e E_Marshaltest_BASE) String() string {
+	return ygot.EnumLogString(e, int64(e), "E_Marshaltest_BASE")
+}
+
+const (
+	// Marshaltest_BASE_UNSET corresponds to the value UNSET of Marshaltest_BASE
+	Marshaltest_BASE_UNSET E_Marshaltest_BASE = 0
+	// Marshaltest_BASE_ONE corresponds to the value ONE of Marshaltest_BASE
+	Marshaltest_BASE_ONE E_Marshaltest_BASE = 1
+	// Marshaltest_BASE_TWO corresponds to the value TWO of Marshaltest_BASE
+	Marshaltest_BASE_TWO E_Marshaltest_BASE = 2
+)
+
+// E_Marshaltest_StateEnum is a derived int64 type which is used to represent
+// the enumerated node Marshaltest_StateEnum. An additional value named
+// Marshaltest_StateEnum_UNSET is added to the enumeration which is used as
+// the nil value, indicating that the enumeration was not explicitly set by
+// the program importing the generated structures.
+type E_Marshaltest_StateEnum int64
+
+// IsYANGGoEnum ensures that Marshaltest_StateEnum implements the yang.GoEnum
+// interface. This ensures that Marshaltest_StateEnum can be identified as a
+// mapped type for a YANG enumeration.
+func (E_Marshaltest_StateEnum) IsYANGGoEnum() {}
+
+// ΛMap returns the value lookup map associated with  Marshaltest_StateEnum.
+func (E_Marshaltest_StateEnum) ΛMap() map[string]map[int64]ygot.EnumDefinition { return ΛEnum }
+
+// String returns a logging-friendly string for E_Marshaltest_StateEnum.
+func (e E_Marshaltest_StateEnum) String() string {
+	return ygot.EnumLogString(e, int64(e), "E_Marshaltest_StateEnum")
+}
+
+const (
+	// Marshaltest_StateEnum_UNSET corresponds to the value UNSET of Marshaltest_StateEnum
+	Marshaltest_StateEnum_UNSET E_Marshaltest_StateEnum = 0
+	// Marshaltest_StateEnum_UP corresponds to the value UP of Marshaltest_StateEnum
+	Marshaltest_StateEnum_UP E_Marshaltest_StateEnum = 1
+	// Marshaltest_StateEnum_DOWN corresponds to the value DOWN of Marshaltest_StateEnum
+	Marshaltest_StateEnum_DOWN E_Marshaltest_StateEnum = 2
+)
+
+// ΛEnum is a map, keyed by the name of the type defined for each enum in the
+// generated Go code, which provides a mapping between the constant int64 value
+// of each value of the enumeration, and the string that is used to represent it
+// in the YANG schema. The map is named ΛEnum in order to avoid clash with any
+// valid YANG identifier.
+var ΛEnum = map[string]map[int64]ygot.EnumDefinition{
+	"E_MarshaltestTypes_EXT_BASE": {
+		1: {Name: "EXT_ONE", DefiningModule: "marshaltest-types"},
+	},
+	"E_Marshaltest_BASE": {
+		1: {Name: "ONE", DefiningModule: "marshaltest"},
+		2: {Name: "TWO", DefiningModule: "marshaltest"},
+	},
+	"E_Marshaltest_StateEnum": {
+		1: {Name: "UP"},
+		2: {Name: "DOWN"},
+	},
+}
+
+var (
+	// ySchema is a byte slice contain a gzip compressed representation of the
+	// YANG schema from which the Go code was generated. When uncompressed the
+	// contents of the byte slice is a JSON document containing an object, keyed
+	// on the name of the generated struct, and containing the JSON marshalled
+	// contents of a goyang yang.Entry struct, which defines the schema for the
+	// fields within the struct.
+	ySchema = []byte{
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5d, 0x51, 0x6f, 0xe2, 0x48,
+		0x12, 0x7e, 0xe7, 0x57, 0xb4, 0xfc, 0x4c, 0x36, 0x81, 0x90, 0x40, 0x78, 0x4b, 0x76, 0xb3, 0xba,
+		0xd5, 0xdc, 0x4c, 0x46, 0x9b, 0xe8, 0x76, 0xa5, 0x13, 0x1a, 0x39, 0xd0, 0x61, 0xac, 0x01, 0x1b,
+		0xd9, 0xed, 0xb9, 0xa0, 0x15, 0xff, 0xfd, 0x64, 0xb0, 0x81, 0x60, 0xc0, 0x55, 0xdd, 0x36, 0xd8,
+		0xf0, 0xf9, 0x65, 0x67, 0x43, 0x37, 0xb4, 0xbb, 0xbf, 0xfa, 0xaa, 0xfa, 0x73, 0xb9, 0xfa, 0x9f,
+		0x9a, 0x10, 0x42, 0x58, 0x5f, 0xec, 0xb1, 0xb4, 0xba, 0xc2, 0x1a, 0xc8, 0x9f, 0x4e, 0x5f, 0x5a,
+		0xf5, 0xc5, 0x5f, 0x3f, 0x39, 0xee, 0xc0, 0xea, 0x8a, 0x46, 0xfc, 0xbf, 0xbf, 0x7a, 0xee, 0x9b,
+		0x33, 0xb4, 0xba, 0xe2, 0x2a, 0xfe, 0xc3, 0x6f, 0x8e, 0x6f, 0x75, 0xc5, 0xe2, 0x2b, 0x84, 0x10,
+		0xc2, 0x52, 0xde, 0xe4, 0xc3, 0x1f, 0x3e, 0x7c, 0x77, 0xf4, 0x61, 0xfd, 0xe3, 0x47, 0x1f, 0x7f,
+		0x60, 0xf9, 0xe7, 0xcd, 0x1f, 0x5a, 0x7e, 0xf0, 0xd5, 0x97, 0x6f, 0xce, 0x7b, 0xea, 0x27, 0x3e,
+		0xfc, 0xcc, 0x58, 0x59, 0xf5, 0xf4, 0xa7, 0xcf, 0x5e, 0xe8, 0xf7, 0xe5, 0xd6, 0x9e, 0x8b, 0x91,
+		0xc8, 0xe9, 0xff, 0x3c, 0x3f, 0x1a, 0x8c, 0x35, 0x59, 0xfc, 0x48, 0x7d, 0x7b, 0xc3, 0x7f, 0xd9,
+		0xc1, 0xbd, 0x3f, 0x0c, 0xc7, 0xd2, 0x55, 0x56, 0x57, 0x28, 0x3f, 0x94, 0x3b, 0x1a, 0xae, 0xb5,
+		0x8a, 0xc6, 0x94, 0x6a, 0x34, 0xfb, 0xf0, 0x97, 0xd9, 0xc6, 0x9d, 0x6e, 0x4e, 0xed, 0xf2, 0x83,
+		0x7e, 0x32, 0x37, 0x3b, 0x6e, 0x24, 0x99, 0x86, 0xb8, 0xdd, 0x8e, 0xc1, 0x6d, 0x9f, 0xf8, 0xcc,
+		0x05, 0xa0, 0x2c, 0x04, 0x6d, 0x41, 0xa8, 0x0b, 0xc3, 0x5e, 0x20, 0xf6, 0x42, 0x91, 0x17, 0x6c,
+		0xfb, 0xc2, 0xed, 0x58, 0xc0, 0xcc, 0x85, 0x4c, 0x2e, 0xcb, 0x0e, 0x87, 0xd1, 0xaf, 0xca, 0x41,
+		0xf6, 0x1c, 0x24, 0x33, 0xba, 0xea, 0x92, 0x71, 0x4b, 0xf1, 0x32, 0x5f, 0x65, 0x34, 0xcb, 0x5a,
+		0x6e, 0xce, 0xb2, 0x6f, 0x59, 0x7e, 0xdb, 0xaa, 0x67, 0x37, 0x27, 0xe2, 0x40, 0x1b, 0x0f, 0xda,
+		0xb8, 0xd8, 0x81, 0x0f, 0xdb, 0xca, 0xec, 0x35, 0xdb, 0xdb, 0x62, 0x96, 0x31, 0xd7, 0x2f, 0xd3,
+		0x89, 0xe4, 0xcd, 0x74, 0xa0, 0x7c, 0xc7, 0x1d, 0x52, 0x26, 0x3b, 0x31, 0xff, 0x8e, 0xd1, 0x08,
+		0xef, 0x5d, 0xd7, 0x53, 0xb6, 0x72, 0x3c, 0x97, 0x36, 0xce, 0xe9, 0xd0, 0x53, 0x17, 0x5e, 0xff,
+		0xa2, 0xef, 0x8d, 0x27, 0xbe, 0x0c, 0x02, 0x39, 0xb8, 0x18, 0x49, 0xfb, 0x2d, 0xea, 0x9c, 0x31,
+		0x55, 0x35, 0x8d, 0x21, 0x5a, 0xaf, 0x8e, 0x4b, 0x37, 0xa9, 0xa8, 0x71, 0x25, 0x8c, 0xe9, 0x14,
+		0x6d, 0xa9, 0x7c, 0xa6, 0xf4, 0xea, 0xb8, 0xb6, 0x3f, 0x65, 0x98, 0xd2, 0xdd, 0x29, 0x5b, 0xd2,
+		0x40, 0xf6, 0xe9, 0x96, 0x14, 0x35, 0x86, 0x25, 0xc1, 0x92, 0x56, 0x70, 0x70, 0xc6, 0xf6, 0xe8,
+		0xb6, 0xc5, 0xf1, 0x4b, 0x4d, 0x42, 0xdb, 0xdf, 0x7d, 0xbb, 0x1f, 0x59, 0xcc, 0x6f, 0xce, 0xd0,
+		0x51, 0x81, 0xd5, 0x15, 0x94, 0x4e, 0x7f, 0xda, 0xee, 0x30, 0x1a, 0xd6, 0x7f, 0x33, 0x9b, 0x0a,
+		0x21, 0x88, 0x8b, 0x2e, 0x84, 0x10, 0xd6, 0x67, 0xc7, 0xb5, 0xba, 0x8c, 0x0e, 0x42, 0x08, 0x61,
+		0xfd, 0xc7, 0x1e, 0x85, 0xd1, 0x68, 0xee, 0x9a, 0xcd, 0xeb, 0xeb, 0x76, 0xf3, 0xea, 0xfa, 0xb6,
+		0x73, 0xd3, 0x6a, 0xb7, 0x6f, 0x3a, 0x57, 0x9d, 0x3a, 0xef, 0x9b, 0x74, 0x26, 0xe3, 0xe3, 0x72,
+		0xc9, 0xa1, 0xad, 0x9c, 0x9f, 0x32, 0x06, 0x1f, 0xb9, 0xf3, 0xac, 0xce, 0x98, 0x23, 0xfb, 0x3d,
+		0xcf, 0x39, 0x6a, 0x1f, 0x71, 0x8e, 0xde, 0xec, 0x51, 0xc0, 0x98, 0xa4, 0x5a, 0x3e, 0xad, 0x7a,
+		0xa7, 0xec, 0x64, 0xe4, 0x78, 0x42, 0x77, 0x32, 0x51, 0x63, 0x38, 0x19, 0x38, 0x99, 0x15, 0x1c,
+		0x14, 0x27, 0x5a, 0x6b, 0x5c, 0x9f, 0xb4, 0x25, 0xbd, 0xab, 0x0b, 0x67, 0xb0, 0x58, 0x2e, 0xaa,
+		0x3d, 0x2d, 0xbb, 0xc0, 0xaa, 0x60, 0x55, 0xf1, 0x3c, 0xcf, 0x01, 0xe1, 0xa8, 0xa9, 0x2f, 0xdf,
+		0x38, 0xb6, 0x75, 0x43, 0x68, 0xfb, 0x47, 0xfc, 0xd5, 0x0f, 0x76, 0xc0, 0x58, 0xa2, 0x64, 0x60,
+		0x8f, 0x7f, 0xbf, 0x7c, 0x7b, 0xb8, 0x7f, 0x7e, 0xa4, 0xae, 0xd0, 0x3c, 0x86, 0x08, 0xc8, 0x61,
+		0x9f, 0x60, 0x85, 0x7e, 0xa9, 0x91, 0x3d, 0x7d, 0x79, 0xb4, 0x72, 0x0e, 0x0d, 0x7a, 0x05, 0x63,
+		0xa3, 0xe4, 0x84, 0xf6, 0x36, 0xb2, 0x87, 0x74, 0x2e, 0x9b, 0xb7, 0x06, 0x8d, 0x81, 0xc6, 0x12,
+		0x2d, 0xc7, 0xf3, 0x46, 0xd2, 0x76, 0x39, 0x14, 0xd6, 0x38, 0x65, 0x6b, 0x72, 0x6e, 0x5b, 0x74,
+		0x63, 0x72, 0x32, 0x37, 0xee, 0xb0, 0xa5, 0x33, 0xb2, 0x25, 0xc7, 0x55, 0x2c, 0x25, 0xa7, 0x05,
+		0x4d, 0x66, 0xa5, 0x37, 0x5c, 0x41, 0x93, 0x29, 0x72, 0x8e, 0xa0, 0xc9, 0x68, 0xdd, 0xc8, 0x5e,
+		0x57, 0xd1, 0x61, 0x78, 0x8a, 0x0e, 0x1c, 0x05, 0x1c, 0xc5, 0x9a, 0xa3, 0xe8, 0x70, 0x22, 0xae,
+		0xca, 0xfa, 0x89, 0x46, 0x13, 0x7e, 0x21, 0x3d, 0x27, 0xf0, 0x03, 0xa7, 0xe5, 0x07, 0x78, 0x6a,
+		0x22, 0x94, 0x44, 0x78, 0x03, 0x51, 0x09, 0x25, 0xb1, 0x9c, 0x2a, 0xe2, 0xcb, 0x5f, 0x4f, 0x56,
+		0x11, 0xac, 0xae, 0x39, 0x1c, 0x08, 0x9a, 0x85, 0xf0, 0x69, 0xc0, 0x24, 0xd4, 0x00, 0x8c, 0x0a,
+		0x46, 0x05, 0xa3, 0x82, 0x51, 0x8f, 0xc2, 0xa8, 0xff, 0x76, 0x02, 0x75, 0xaf, 0x94, 0x4f, 0x83,
+		0xe9, 0x67, 0xc7, 0x7d, 0x1c, 0xc9, 0x71, 0xcc, 0x72, 0x84, 0x60, 0x3e, 0xda, 0x70, 0xac, 0xf5,
+		0x68, 0x74, 0x5a, 0xad, 0xdb, 0x76, 0xab, 0x75, 0xd5, 0xbe, 0x6e, 0x5f, 0xdd, 0xdd, 0xdc, 0x34,
+		0x6e, 0x49, 0xd0, 0x7d, 0xf2, 0x07, 0xd2, 0x97, 0x83, 0x87, 0xa9, 0xd5, 0x15, 0x6e, 0x38, 0x1a,
+		0x9d, 0xb2, 0x0f, 0x71, 0xc3, 0x31, 0xc3, 0x83, 0xcc, 0x5b, 0xc3, 0x7f, 0xc0, 0x7f, 0xc4, 0xf3,
+		0x1c, 0x72, 0x95, 0xfc, 0x4e, 0x65, 0x15, 0x9a, 0xab, 0xea, 0x68, 0x11, 0x07, 0x13, 0x68, 0xb4,
+		0xe8, 0xb5, 0x2c, 0xb3, 0x54, 0x0a, 0xc5, 0x06, 0xfe, 0xb0, 0x64, 0xfe, 0x30, 0x50, 0xb6, 0x92,
+		0x74, 0x87, 0xb8, 0x68, 0x0e, 0x8f, 0x08, 0x8f, 0xb8, 0x0e, 0x88, 0x0b, 0xe9, 0x86, 0x63, 0xce,
+		0x86, 0x8a, 0xf2, 0x80, 0xfb, 0x31, 0xfa, 0xce, 0x6c, 0xd4, 0x57, 0xdd, 0xfc, 0x7c, 0x8e, 0xf1,
+		0xf9, 0x30, 0x3d, 0x98, 0x1e, 0x5e, 0x5c, 0xdd, 0x65, 0x4a, 0x01, 0xcb, 0x96, 0xb0, 0xb3, 0x83,
+		0x31, 0x1d, 0xcd, 0x98, 0x10, 0x08, 0x97, 0x8c, 0x3e, 0x42, 0x4e, 0x7e, 0x67, 0x88, 0xfc, 0x4e,
+		0x90, 0x07, 0x64, 0xa1, 0x72, 0x0b, 0x1e, 0x90, 0x85, 0x08, 0x17, 0x12, 0x79, 0xb2, 0x87, 0x68,
+		0x85, 0x8c, 0x92, 0x28, 0x21, 0x2a, 0xa2, 0xc0, 0x33, 0xac, 0xa1, 0x21, 0xc2, 0x34, 0x23, 0xaa,
+		0xbc, 0x23, 0xb4, 0x8d, 0x87, 0x91, 0xbb, 0x63, 0x60, 0xc7, 0xc2, 0xcc, 0x98, 0x98, 0xc9, 0xcc,
+		0x1a, 0x23, 0xa7, 0xfa, 0xe1, 0xcd, 0x81, 0xb7, 0x18, 0x5d, 0x78, 0x7e, 0x99, 0x7f, 0x33, 0x46,
+		0x7e, 0x3a, 0xe5, 0x9c, 0x8c, 0x5f, 0xc8, 0xc8, 0xcd, 0x47, 0x6d, 0xf3, 0x55, 0xac, 0x44, 0x5c,
+		0x26, 0x7c, 0x8c, 0x1d, 0x3c, 0x61, 0x2e, 0xdb, 0x25, 0x9a, 0x4b, 0x9e, 0xdf, 0xa7, 0x11, 0xa2,
+		0x7e, 0xeb, 0xde, 0x91, 0x99, 0x80, 0xa5, 0x4b, 0xa7, 0x78, 0x8c, 0xc3, 0x07, 0x34, 0x9d, 0x9a,
+		0x3e, 0x83, 0x27, 0x1e, 0x49, 0x05, 0x9c, 0x50, 0x0a, 0x12, 0x1d, 0x62, 0x29, 0xc4, 0x52, 0xc7,
+		0x61, 0x50, 0xc7, 0x55, 0xd7, 0x4d, 0x8d, 0x81, 0x5f, 0x9f, 0x6c, 0x2c, 0xd5, 0x6c, 0xb4, 0xda,
+		0xad, 0xce, 0xf5, 0x6d, 0x0b, 0x21, 0x94, 0xf1, 0x14, 0x22, 0x72, 0xd2, 0xf3, 0xff, 0xf4, 0xef,
+		0x43, 0xa2, 0x4e, 0xf5, 0x22, 0x27, 0x56, 0x8d, 0xec, 0xfb, 0xb5, 0x12, 0xd8, 0xbb, 0x09, 0x94,
+		0x18, 0x6b, 0x5d, 0x8e, 0x55, 0x57, 0x79, 0x93, 0xe8, 0x3f, 0x7b, 0x6b, 0xa0, 0x6f, 0xb2, 0x7d,
+		0x03, 0x45, 0xb2, 0x73, 0x0f, 0xbd, 0x0a, 0x2f, 0x92, 0x9d, 0x55, 0x5d, 0x3d, 0xb9, 0x18, 0x55,
+		0xd6, 0x53, 0x6b, 0x43, 0xad, 0xb6, 0xbe, 0x09, 0x28, 0x22, 0x9f, 0x93, 0x81, 0xa5, 0x03, 0x30,
+		0x4d, 0xa0, 0xe9, 0x02, 0xce, 0x18, 0x78, 0xc6, 0x00, 0xd4, 0x07, 0x22, 0x0d, 0x90, 0x44, 0x60,
+		0x6e, 0x46, 0xe5, 0x65, 0x8c, 0xb7, 0x4d, 0x4d, 0x93, 0x49, 0xfe, 0xbd, 0x7a, 0xcd, 0xc4, 0x5b,
+		0x59, 0x41, 0xff, 0xbb, 0x1c, 0xdb, 0x13, 0x5b, 0x7d, 0x5f, 0xb0, 0xbc, 0xed, 0x07, 0xdf, 0xed,
+		0x91, 0x92, 0x81, 0xba, 0x8c, 0xe8, 0x3e, 0xe6, 0xfa, 0x1a, 0x6d, 0x40, 0x5b, 0x96, 0xcf, 0xfa,
+		0x21, 0xa7, 0xa3, 0xc8, 0xfb, 0x05, 0xd9, 0x87, 0x6b, 0xac, 0x9a, 0x1a, 0x9e, 0xaf, 0xd1, 0xc4,
+		0xf9, 0x1a, 0x44, 0xf8, 0x68, 0x9f, 0xaf, 0x11, 0xaf, 0x15, 0x5d, 0xac, 0x49, 0x3a, 0x54, 0x22,
+		0x6c, 0x80, 0x60, 0xc3, 0xe6, 0x66, 0x7a, 0xd0, 0x10, 0xeb, 0xa1, 0xbc, 0x70, 0x81, 0x2e, 0xd4,
+		0x96, 0x3c, 0x50, 0x38, 0xc7, 0x38, 0xa1, 0x7a, 0x61, 0x02, 0x1e, 0x14, 0x0a, 0x21, 0x04, 0x1e,
+		0x14, 0x16, 0xa3, 0x72, 0xe1, 0x41, 0xe1, 0xe1, 0xe5, 0x2e, 0xc2, 0x62, 0x5b, 0x3f, 0xe3, 0xf5,
+		0x61, 0xfa, 0xa6, 0x45, 0x37, 0x78, 0x27, 0x21, 0xe0, 0x9d, 0xb0, 0x89, 0x85, 0x78, 0xac, 0xbf,
+		0x1d, 0xa7, 0x6e, 0xcb, 0x97, 0x3b, 0xe5, 0x4b, 0xda, 0xb6, 0x4a, 0xc4, 0x2f, 0xc0, 0x84, 0x7d,
+		0xe5, 0x26, 0xb5, 0x3b, 0xbc, 0xc9, 0xb7, 0x4f, 0x71, 0xe7, 0xc3, 0xc8, 0xd1, 0xf9, 0x28, 0x12,
+		0xcb, 0x5b, 0x37, 0x11, 0x25, 0xc6, 0xe1, 0x48, 0x39, 0x17, 0x3f, 0xe4, 0x94, 0xa0, 0x4a, 0xac,
+		0xb5, 0xc5, 0xb1, 0x9f, 0x65, 0x97, 0x25, 0x96, 0x8b, 0x45, 0x17, 0x26, 0x56, 0x5d, 0x20, 0x4d,
+		0x9c, 0xbb, 0x34, 0x91, 0x71, 0x0c, 0xf0, 0xce, 0x65, 0x21, 0x3d, 0x12, 0x63, 0x02, 0x89, 0x0d,
+		0x28, 0x1d, 0x60, 0xe9, 0x01, 0x4c, 0x17, 0x68, 0xc6, 0x80, 0x33, 0x06, 0x9e, 0x36, 0x00, 0x69,
+		0x40, 0x24, 0x02, 0x92, 0x0d, 0xcc, 0xe4, 0xb2, 0x9c, 0x01, 0x7f, 0xb2, 0x57, 0xc5, 0xcd, 0xb8,
+		0xb3, 0xcc, 0xdb, 0xab, 0x68, 0x03, 0xd6, 0x04, 0xb8, 0x66, 0x00, 0x36, 0x05, 0x72, 0x6e, 0x80,
+		0xce, 0x0d, 0xd8, 0xc6, 0x00, 0xe7, 0x01, 0x9d, 0x09, 0x78, 0xfd, 0xbd, 0x4f, 0x3e, 0x09, 0xbc,
+		0x29, 0x0a, 0x6e, 0x69, 0xf4, 0xe5, 0x25, 0xf4, 0xea, 0xce, 0x10, 0x73, 0xc7, 0x90, 0x5f, 0xfa,
+		0x89, 0x3e, 0x10, 0x18, 0xb7, 0x68, 0xc5, 0xdb, 0x10, 0x4d, 0x2a, 0x9b, 0xf7, 0x06, 0x99, 0x81,
+		0xcc, 0x4e, 0x86, 0xcc, 0x98, 0x82, 0x8e, 0xa6, 0xb0, 0x03, 0x32, 0xda, 0x76, 0x31, 0x75, 0xdf,
+		0xd4, 0xea, 0x71, 0xf4, 0x5f, 0xd0, 0x11, 0xe8, 0x08, 0x74, 0x74, 0x86, 0x74, 0x94, 0xeb, 0xbe,
+		0x51, 0x73, 0x02, 0xb2, 0xb4, 0xd5, 0x95, 0xd4, 0xb9, 0xfa, 0xe7, 0xde, 0x14, 0x30, 0xfe, 0x5d,
+		0x12, 0xee, 0x90, 0xb3, 0xc9, 0xe5, 0x6f, 0x6e, 0xf1, 0x00, 0x0e, 0xea, 0x8b, 0x2e, 0x51, 0x2e,
+		0xd7, 0x29, 0x62, 0x0b, 0x5a, 0x8d, 0xf8, 0x14, 0x33, 0x32, 0x9e, 0xf4, 0x5b, 0x5f, 0x63, 0x43,
+		0xfd, 0xe5, 0x97, 0xd8, 0x0a, 0x2f, 0x9d, 0xc1, 0x21, 0x0d, 0x91, 0xb5, 0x49, 0xd3, 0xd9, 0x9c,
+		0xc1, 0x18, 0x61, 0x8c, 0xd5, 0x35, 0xc6, 0x39, 0xd0, 0x0f, 0x68, 0x8e, 0xb4, 0x5a, 0xbc, 0xa9,
+		0xf9, 0xa1, 0xd4, 0xe4, 0x4d, 0xcd, 0x0c, 0xd7, 0x20, 0x9b, 0x30, 0x48, 0x21, 0xf0, 0x6c, 0x02,
+		0xcf, 0x26, 0x72, 0x03, 0xb0, 0x29, 0x90, 0x73, 0x03, 0x74, 0x6e, 0xc0, 0x36, 0x06, 0x38, 0x0f,
+		0xe8, 0x4c, 0xc0, 0xeb, 0x7b, 0xa2, 0xea, 0x3f, 0x9b, 0x80, 0xc4, 0x0f, 0x4e, 0x00, 0x27, 0x9c,
+		0x96, 0xa6, 0x06, 0xa5, 0x1c, 0x56, 0x0d, 0xab, 0x3e, 0x5f, 0xab, 0xae, 0xac, 0xe0, 0xbc, 0xd8,
+		0xaf, 0x96, 0x22, 0x51, 0xfd, 0x93, 0x9c, 0x26, 0xb1, 0x88, 0xc8, 0xdc, 0xa2, 0x20, 0xad, 0xbd,
+		0x08, 0x38, 0xe8, 0x65, 0xb6, 0x7f, 0x8e, 0xba, 0x47, 0xab, 0x57, 0xa5, 0xd4, 0xf6, 0xd5, 0xed,
+		0x9b, 0xe4, 0xb6, 0x47, 0x4f, 0xb4, 0xf6, 0x9d, 0x2b, 0xbe, 0xe4, 0xae, 0xa4, 0x21, 0xb2, 0xda,
+		0x8f, 0x9c, 0xd5, 0x2e, 0xdf, 0x95, 0x6f, 0x5f, 0x84, 0x6e, 0xa0, 0xec, 0xd7, 0xd1, 0xfe, 0xfb,
+		0x8c, 0x17, 0xad, 0x9f, 0xfd, 0xde, 0x28, 0x23, 0xa9, 0xdc, 0x16, 0xc9, 0xb7, 0x8a, 0xbe, 0xe7,
+		0x2a, 0xdb, 0x71, 0xa5, 0x5f, 0x7c, 0x9a, 0xf9, 0xe2, 0x36, 0x0e, 0x99, 0x68, 0xbe, 0xf5, 0x3e,
+		0x0b, 0xab, 0xd7, 0xd1, 0x3b, 0x06, 0x85, 0xec, 0xb7, 0xe9, 0x1d, 0x64, 0xf9, 0x35, 0xee, 0x64,
+		0x40, 0x3a, 0x81, 0xe3, 0x0e, 0x47, 0x92, 0xf8, 0x46, 0xcd, 0x7a, 0x63, 0x90, 0x4f, 0xd9, 0x5f,
+		0xa9, 0x59, 0xad, 0x16, 0xe3, 0xf4, 0xa4, 0x55, 0x1f, 0xbc, 0x54, 0x83, 0x97, 0x6a, 0xf0, 0x52,
+		0x0d, 0x1e, 0x5c, 0xe9, 0x03, 0x91, 0x08, 0x48, 0x36, 0x30, 0x93, 0x0b, 0x42, 0x75, 0xfe, 0x20,
+		0x36, 0x05, 0x73, 0x6e, 0xa0, 0xce, 0x0d, 0xdc, 0xc6, 0x20, 0xe7, 0x81, 0x9d, 0x09, 0xfa, 0x2a,
+		0x4b, 0x5a, 0xc8, 0x45, 0x5f, 0xbb, 0xa0, 0xb0, 0x83, 0x8e, 0x40, 0x47, 0x06, 0x65, 0xe6, 0x0d,
+		0xca, 0xcd, 0x1b, 0x56, 0xe6, 0x4a, 0x2e, 0x3d, 0x68, 0x09, 0xd3, 0x4a, 0x5d, 0xa9, 0x2a, 0x53,
+		0xa6, 0xe5, 0xe8, 0x73, 0x2f, 0x32, 0xb5, 0xad, 0xd8, 0x94, 0x56, 0xe1, 0x2e, 0x4d, 0x34, 0xe6,
+		0x56, 0xc8, 0x6b, 0xf7, 0x54, 0xb7, 0x4b, 0x3c, 0xd5, 0x7a, 0x75, 0xbd, 0xf4, 0x78, 0x42, 0xbf,
+		0x57, 0x0f, 0xc1, 0x83, 0x4e, 0xcb, 0x23, 0x3f, 0xc8, 0x5b, 0x93, 0xf4, 0xd6, 0xfe, 0x7d, 0x84,
+		0x77, 0x47, 0x90, 0xb2, 0x0e, 0xa1, 0xe1, 0x50, 0xb6, 0x84, 0x94, 0xf5, 0x6c, 0xda, 0x40, 0xca,
+		0x3a, 0x0c, 0x52, 0x08, 0x28, 0x7f, 0x1b, 0x60, 0xc5, 0x56, 0xbb, 0x70, 0x50, 0xe7, 0x06, 0x6e,
+		0x63, 0x90, 0xf3, 0xc0, 0xce, 0x04, 0xbd, 0xbe, 0x37, 0x3a, 0xbe, 0xf2, 0x07, 0x01, 0x0d, 0x56,
+		0x0d, 0xab, 0x16, 0x02, 0x02, 0xda, 0x87, 0x0b, 0x02, 0x1a, 0x43, 0xd5, 0x81, 0x80, 0x26, 0x04,
+		0x04, 0x34, 0x7d, 0x01, 0xed, 0x2c, 0x74, 0xa8, 0x72, 0xa6, 0x94, 0x23, 0x9f, 0xdc, 0x10, 0x15,
+		0x7a, 0x68, 0xd0, 0xcb, 0x28, 0x7f, 0x9e, 0xf7, 0xaf, 0x5a, 0x4a, 0xf9, 0xda, 0x0c, 0x18, 0xa5,
+		0x77, 0xee, 0x95, 0x71, 0x48, 0xb2, 0x0d, 0x0e, 0x6f, 0xdb, 0xb6, 0xca, 0xc7, 0x49, 0xe9, 0xa4,
+		0x1f, 0xdb, 0xc9, 0x3e, 0xae, 0xb3, 0x24, 0x27, 0xee, 0xe3, 0xdc, 0x57, 0x1d, 0xfa, 0xe5, 0x9f,
+		0xb9, 0x4f, 0x96, 0x0c, 0x88, 0x12, 0xc1, 0x1e, 0xfa, 0xdc, 0x63, 0xd0, 0xaf, 0x84, 0xa8, 0x7f,
+		0x39, 0xe4, 0xa8, 0x71, 0x25, 0x30, 0x8c, 0xa4, 0xe4, 0x43, 0x20, 0xf8, 0xd5, 0x71, 0x6d, 0x7f,
+		0xca, 0x40, 0xf0, 0x5d, 0x01, 0x00, 0xee, 0x7b, 0xa1, 0xab, 0xa4, 0x4f, 0x07, 0x71, 0xd2, 0x01,
+		0x40, 0x06, 0x90, 0xe3, 0x79, 0x0e, 0xa9, 0x67, 0x16, 0x26, 0xa8, 0x20, 0xa8, 0x1a, 0x4c, 0x21,
+		0xe7, 0x9f, 0x5a, 0xa1, 0x42, 0xcd, 0x52, 0x2d, 0xe0, 0xca, 0xa5, 0xa6, 0xa2, 0x80, 0xbe, 0x08,
+		0xc0, 0x11, 0xae, 0x75, 0x04, 0x95, 0xe5, 0x94, 0xe8, 0xed, 0xde, 0xca, 0x32, 0x4b, 0x39, 0xed,
+		0xcc, 0x7b, 0x05, 0x50, 0xf3, 0x40, 0xf6, 0xe9, 0xb4, 0x1c, 0x35, 0x06, 0x25, 0x83, 0x92, 0x57,
+		0x70, 0x70, 0xc6, 0xf6, 0x88, 0xc5, 0xca, 0x0d, 0x42, 0x5a, 0x42, 0xda, 0x54, 0x9b, 0x95, 0xe5,
+		0x72, 0xe3, 0x73, 0x65, 0xb5, 0x26, 0x63, 0x17, 0x6f, 0xb1, 0xd4, 0xf4, 0x83, 0x91, 0xbb, 0xf1,
+		0x79, 0xb1, 0xb9, 0xce, 0xd1, 0xe9, 0x70, 0xbb, 0x1c, 0x4f, 0xe8, 0xdc, 0x1e, 0x35, 0x06, 0xb7,
+		0x83, 0xdb, 0x57, 0x70, 0x50, 0x9c, 0x6d, 0x63, 0xe3, 0xba, 0x08, 0x00, 0xbf, 0xab, 0x0b, 0x67,
+		0xb0, 0xaf, 0xda, 0x47, 0x7a, 0xdc, 0xcb, 0x2e, 0x00, 0x33, 0xc0, 0xbc, 0x2c, 0xbf, 0x2a, 0x5d,
+		0xe5, 0xa8, 0x29, 0x2d, 0x27, 0x75, 0x09, 0x69, 0xca, 0xf3, 0xa1, 0x3f, 0xe2, 0xaf, 0x7e, 0xb0,
+		0x03, 0x8d, 0x44, 0xd0, 0xc7, 0xbf, 0x5f, 0xbe, 0x3d, 0xdc, 0x3f, 0x3f, 0x52, 0x57, 0x68, 0xee,
+		0x31, 0x03, 0x56, 0xe6, 0x81, 0x66, 0x9e, 0x50, 0x34, 0xb2, 0xa7, 0x2f, 0x8f, 0x79, 0xa7, 0x3a,
+		0xf6, 0x0a, 0xab, 0x47, 0xb2, 0x8f, 0x47, 0xde, 0x46, 0xf6, 0x90, 0x4e, 0x21, 0xf3, 0xd6, 0x60,
+		0x0f, 0xb0, 0x47, 0x22, 0xa1, 0x7a, 0xde, 0x48, 0xda, 0x2e, 0x87, 0x39, 0x1a, 0x05, 0x80, 0xd8,
+		0xb9, 0x6d, 0xd1, 0x31, 0xec, 0x64, 0x6e, 0xca, 0x00, 0xe1, 0x33, 0x82, 0x30, 0x57, 0x3b, 0x6d,
+		0x61, 0xbf, 0x9d, 0x8b, 0x4e, 0x78, 0x36, 0xfb, 0x6d, 0x68, 0xa9, 0x42, 0x58, 0x4e, 0x87, 0x41,
+		0xd0, 0x1d, 0xf0, 0x33, 0xf8, 0x79, 0x8d, 0x9f, 0x3b, 0x9c, 0xf8, 0xa2, 0xb2, 0xf4, 0xdc, 0x68,
+		0x82, 0x8e, 0xd3, 0x73, 0x02, 0xfa, 0xcd, 0x85, 0x7e, 0x79, 0x4a, 0x11, 0x54, 0x22, 0x90, 0xb0,
+		0xa8, 0x84, 0x4a, 0x54, 0x4e, 0x85, 0xe8, 0xe5, 0xaf, 0x27, 0xab, 0x08, 0x32, 0xd5, 0x1c, 0xce,
+		0xc9, 0x88, 0x55, 0x73, 0x18, 0x06, 0x4c, 0x1e, 0x0b, 0x40, 0x64, 0x20, 0x32, 0x10, 0x19, 0x88,
+		0xac, 0x18, 0x22, 0x3b, 0xbf, 0x37, 0x79, 0xb4, 0xa8, 0xdb, 0x0d, 0xc7, 0x0c, 0xe2, 0x9e, 0xb7,
+		0x06, 0x6d, 0x83, 0xb6, 0x91, 0xe1, 0x5a, 0xe2, 0x0d, 0x2f, 0x32, 0x5c, 0x09, 0xd7, 0x81, 0x64,
+		0x01, 0xb8, 0x21, 0xca, 0x5c, 0x10, 0xab, 0x52, 0xb1, 0xaa, 0x51, 0xc1, 0x11, 0x9d, 0x91, 0x23,
+		0x62, 0x9d, 0xef, 0xca, 0x39, 0xcf, 0x95, 0x78, 0x7e, 0xab, 0x2e, 0xea, 0x7d, 0x0e, 0xe6, 0xf1,
+		0x72, 0x11, 0x10, 0x5f, 0xb2, 0xf7, 0x3c, 0x03, 0xe5, 0x07, 0x2c, 0x08, 0x63, 0xfb, 0x00, 0x0c,
+		0x17, 0x87, 0x61, 0x44, 0x5b, 0x24, 0xab, 0x0d, 0x39, 0x79, 0x59, 0x21, 0xf2, 0xb2, 0x60, 0xb3,
+		0xd8, 0xf2, 0x97, 0x7b, 0x33, 0x8b, 0x2d, 0x3f, 0xe1, 0x2a, 0x73, 0x26, 0x40, 0xc8, 0xa8, 0x97,
+		0x11, 0xa2, 0x5c, 0x06, 0x08, 0x79, 0x0d, 0x0d, 0x51, 0x49, 0x2a, 0x46, 0x0c, 0x75, 0x47, 0x68,
+		0x1b, 0x0f, 0x23, 0x77, 0x3e, 0xd6, 0x2e, 0x6c, 0xcb, 0x2c, 0x68, 0x3b, 0xab, 0x17, 0x35, 0x72,
+		0xaa, 0xfb, 0xdb, 0x1c, 0x78, 0x8b, 0xd1, 0x45, 0xaf, 0x58, 0xa7, 0x46, 0x45, 0x52, 0x93, 0xe2,
+		0x9c, 0xf9, 0xe5, 0x2f, 0xe7, 0xe6, 0x1a, 0xb6, 0xb9, 0x08, 0xad, 0x6a, 0x9c, 0x1a, 0x55, 0x38,
+		0x8d, 0xaa, 0x6f, 0xe6, 0x97, 0xe7, 0x5c, 0xe8, 0x5c, 0xea, 0x95, 0xdb, 0x2c, 0xea, 0x18, 0x96,
+		0xde, 0x91, 0x99, 0x80, 0xa5, 0x39, 0xa6, 0x78, 0x8c, 0xc3, 0x07, 0x34, 0x0d, 0x92, 0x3e, 0x83,
+		0xc5, 0x04, 0x30, 0x01, 0x27, 0x82, 0x81, 0x0e, 0x84, 0x10, 0x06, 0x21, 0xcc, 0x71, 0x88, 0x8b,
+		0x5b, 0x7f, 0x5c, 0xa3, 0xee, 0x78, 0xc5, 0x42, 0x18, 0xd3, 0xba, 0xe2, 0x88, 0x5c, 0x8c, 0xeb,
+		0x85, 0x23, 0x60, 0x21, 0x7f, 0x1f, 0x52, 0x0e, 0xa8, 0x33, 0xc6, 0xac, 0xf0, 0xbc, 0x56, 0x84,
+		0x77, 0x37, 0x6f, 0x11, 0x43, 0x9c, 0xcb, 0xb1, 0xea, 0x46, 0xf5, 0x9e, 0xc7, 0xaa, 0xcb, 0xc9,
+		0x56, 0x68, 0xa0, 0x4a, 0x6f, 0xee, 0x01, 0x4f, 0xe1, 0x55, 0x7a, 0xa9, 0xa7, 0x5c, 0x31, 0xca,
+		0x3c, 0xa7, 0xd6, 0x86, 0x5a, 0xee, 0x79, 0x13, 0x50, 0x25, 0x3d, 0x15, 0xd1, 0x3e, 0xc7, 0x53,
+		0xd8, 0xec, 0xea, 0x9d, 0x8b, 0x58, 0x78, 0x94, 0x5b, 0xdc, 0x0b, 0x2b, 0x5b, 0x3f, 0xe9, 0x15,
+		0x5c, 0xdd, 0x7f, 0xf7, 0xb1, 0x16, 0xb3, 0xda, 0xfe, 0xbf, 0x6c, 0xac, 0x65, 0xd6, 0x88, 0x32,
+		0x46, 0x62, 0xd5, 0x6b, 0xd9, 0x47, 0x28, 0x58, 0xb5, 0xed, 0xc3, 0x99, 0xd5, 0xd6, 0x06, 0xb4,
+		0x6b, 0x20, 0x96, 0x13, 0xfc, 0xba, 0x3c, 0x73, 0xf7, 0x79, 0x3e, 0x98, 0x94, 0x8d, 0x58, 0x4e,
+		0xf0, 0xbb, 0xfd, 0x43, 0xfe, 0xe9, 0x79, 0x69, 0xfb, 0xd9, 0xbc, 0x01, 0xab, 0x5e, 0xdb, 0x31,
+		0xd2, 0xdf, 0xe4, 0x4f, 0xa7, 0x1f, 0xcf, 0xea, 0xac, 0x36, 0xfb, 0x3f, 0x00, 0x00, 0x00, 0xff,
+		0xff, 0x03, 0x00, 0x82, 0xba, 0x7d, 0xe0, 0xcb, 0x33, 0x01, 0x00,
+	}
+)
+
+// ΛEnumTypes is a map, keyed by a YANG schema path, of the enumerated types that
+// correspond with the leaf. The type is represented as a reflect.Type. The naming
+// of the map ensures that there are no clashes with valid YANG identifiers.
+func initΛEnumTypes() {
+	ΛEnumTypes = map[string][]reflect.Type{
+		"/top/config/ext-ident": {
+			reflect.TypeOf((E_MarshaltestTypes_EXT_BASE)(0)),
+		},
+		"/top/config/ident": {
+			reflect.TypeOf((E_Marshaltest_BASE)(0)),
+		},
+		"/top/config/idents": {
+			reflect.TypeOf((E_Marshaltest_BASE)(0)),
+		},
+		"/top/config/state": {
+			reflect.TypeOf((E_Marshaltest_StateEnum)(0)),
+		},
+		"/top/config/un": {
+			reflect.TypeOf((E_Marshaltest_StateEnum)(0)),
+		},
+		"/top/multi-keys/multi-key/config/id": {
+			reflect.TypeOf((E_Marshaltest_StateEnum)(0)),
+		},
+	}
+}
@@ -18,7 +18,7 @@
 
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
-// 	protoc-gen-go v1.27.1
+// 	protoc-gen-go v1.26.0
 // 	protoc        v3.19.2
 // source: yext.proto
 
@@ -29,6 +29,7 @@ import (
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	descriptorpb "google.golang.org/protobuf/types/descriptorpb"
 	reflect "reflect"
+	sync "sync"
 )
 
 const (
@@ -38,6 +39,78 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// FieldAnnotation describes the YANG schema node from which a field of a
+// message is generated.
+type FieldAnnotation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// name is the name of the annotated field within the message.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// map_key stores the name of the key leaf of a YANG list that is
+	// represented as a protobuf map field, the map is keyed by the value
+	// of this leaf.
+	MapKey string `protobuf:"bytes,2,opt,name=map_key,json=mapKey,proto3" json:"map_key,omitempty"`
+	// fraction_digits stores the value of the fraction-digits statement of a
+	// YANG decimal64 type, such that values can be stored with the precision
+	// that is specified in the YANG schema.
+	FractionDigits uint32 `protobuf:"varint,3,opt,name=fraction_digits,json=fractionDigits,proto3" json:"fraction_digits,omitempty"`
+}
+
+func (x *FieldAnnotation) Reset() {
+	*x = FieldAnnotation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_yext_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FieldAnnotation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FieldAnnotation) ProtoMessage() {}
+
+func (x *FieldAnnotation) ProtoReflect() protoreflect.Message {
+	mi := &file_yext_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FieldAnnotation.ProtoReflect.Descriptor instead.
+func (*FieldAnnotation) Descriptor() ([]byte, []int) {
+	return file_yext_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *FieldAnnotation) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *FieldAnnotation) GetMapKey() string {
+	if x != nil {
+		return x.MapKey
+	}
+	return ""
+}
+
+func (x *FieldAnnotation) GetFractionDigits() uint32 {
+	if x != nil {
+		return x.FractionDigits
+	}
+	return 0
+}
+
 var file_yext_proto_extTypes = []protoimpl.ExtensionInfo{
 	{
 		ExtendedType:  (*descriptorpb.FieldOptions)(nil),
@@ -47,6 +120,14 @@ var file_yext_proto_extTypes = []protoimpl.ExtensionInfo{
 		Tag:           "bytes,1040,opt,name=schemapath",
 		Filename:      "yext.proto",
 	},
+	{
+		ExtendedType:  (*descriptorpb.MessageOptions)(nil),
+		ExtensionType: ([]*FieldAnnotation)(nil),
+		Field:         1040,
+		Name:          "yext.field_annotation",
+		Tag:           "bytes,1040,rep,name=field_annotation",
+		Filename:      "yext.proto",
+	},
 	{
 		ExtendedType:  (*descriptorpb.EnumValueOptions)(nil),
 		ExtensionType: (*string)(nil),
@@ -55,6 +136,14 @@ var file_yext_proto_extTypes = []protoimpl.ExtensionInfo{
 		Tag:           "bytes,1040,opt,name=yang_name",
 		Filename:      "yext.proto",
 	},
+	{
+		ExtendedType:  (*descriptorpb.MethodOptions)(nil),
+		ExtensionType: (*string)(nil),
+		Field:         1040,
+		Name:          "yext.rpc_path",
+		Tag:           "bytes,1040,opt,name=rpc_path",
+		Filename:      "yext.proto",
+	},
 }
 
 // Extension fields to descriptorpb.FieldOptions.
@@ -70,6 +159,18 @@ var (
 	E_Schemapath = &file_yext_proto_extTypes[0]
 )
 
+// Extension fields to descriptorpb.MessageOptions.
+var (
+	// field_annotation stores characteristics of the YANG schema node from
+	// which a field of the message is generated that cannot be described by
+	// the type of the field. The option is repeated, with one value for each
+	// annotated field. The field number for this extension is reserved in the
+	// global protobuf registry.
+	//
+	// repeated yext.FieldAnnotation field_annotation = 1040;
+	E_FieldAnnotation = &file_yext_proto_extTypes[1]
+)
+
 // Extension fields to descriptorpb.EnumValueOptions.
 var (
 	// yang_name stores the original YANG name of the enumerated value, for
@@ -77,7 +178,17 @@ var (
 	// reserved in the global protobuf registry.
 	//
 	// optional string yang_name = 1040;
-	E_YangName = &file_yext_proto_extTypes[1]
+	E_YangName = &file_yext_proto_extTypes[2]
+)
+
+// Extension fields to descriptorpb.MethodOptions.
+var (
+	// rpc_path stores the schema path of the YANG RPC from which a gRPC
+	// method is generated. The field number for this extension is reserved
+	// in the global protobuf registry.
+	//
+	// optional string rpc_path = 1040;
+	E_RpcPath = &file_yext_proto_extTypes[3]
 )
 
 var File_yext_proto protoreflect.FileDescriptor
@@ -86,31 +197,66 @@ var file_yext_proto_rawDesc = []byte{
 	0x0a, 0x0a, 0x79, 0x65, 0x78, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x04, 0x79, 0x65,
 	0x78, 0x74, 0x1a, 0x20, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 	0x62, 0x75, 0x66, 0x2f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x6f, 0x72, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x3a, 0x3e, 0x0a, 0x0a, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x70, 0x61,
-	0x74, 0x68, 0x12, 0x1d, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x18, 0x90, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61,
-	0x70, 0x61, 0x74, 0x68, 0x3a, 0x3f, 0x0a, 0x09, 0x79, 0x61, 0x6e, 0x67, 0x5f, 0x6e, 0x61, 0x6d,
-	0x65, 0x12, 0x21, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x4f, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x90, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x79, 0x61, 0x6e,
-	0x67, 0x4e, 0x61, 0x6d, 0x65, 0x42, 0x27, 0x5a, 0x25, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
-	0x63, 0x6f, 0x6d, 0x2f, 0x6f, 0x70, 0x65, 0x6e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2f, 0x79,
-	0x67, 0x6f, 0x74, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x79, 0x65, 0x78, 0x74, 0x62, 0x06,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x72, 0x6f, 0x74, 0x6f, 0x22, 0x67, 0x0a, 0x0f, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x41, 0x6e, 0x6e,
+	0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x6d,
+	0x61, 0x70, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x61,
+	0x70, 0x4b, 0x65, 0x79, 0x12, 0x27, 0x0a, 0x0f, 0x66, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x64, 0x69, 0x67, 0x69, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x66,
+	0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x69, 0x67, 0x69, 0x74, 0x73, 0x3a, 0x3e, 0x0a,
+	0x0a, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x70, 0x61, 0x74, 0x68, 0x12, 0x1d, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x46, 0x69,
+	0x65, 0x6c, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x90, 0x08, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x70, 0x61, 0x74, 0x68, 0x3a, 0x62, 0x0a,
+	0x10, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x5f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x1f, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x90, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x79, 0x65, 0x78, 0x74,
+	0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x0f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x3a, 0x3f, 0x0a, 0x09, 0x79, 0x61, 0x6e, 0x67, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x21,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x90, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x79, 0x61, 0x6e, 0x67, 0x4e, 0x61,
+	0x6d, 0x65, 0x3a, 0x3a, 0x0a, 0x08, 0x72, 0x70, 0x63, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x12, 0x1e,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x90,
+	0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x70, 0x63, 0x50, 0x61, 0x74, 0x68, 0x42, 0x27,
+	0x5a, 0x25, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6f, 0x70, 0x65,
+	0x6e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2f, 0x79, 0x67, 0x6f, 0x74, 0x2f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2f, 0x79, 0x65, 0x78, 0x74, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
+var (
+	file_yext_proto_rawDescOnce sync.Once
+	file_yext_proto_rawDescData = file_yext_proto_rawDesc
+)
+
+func file_yext_proto_rawDescGZIP() []byte {
+	file_yext_proto_rawDescOnce.Do(func() {
+		file_yext_proto_rawDescData = protoimpl.X.CompressGZIP(file_yext_proto_rawDescData)
+	})
+	return file_yext_proto_rawDescData
+}
+
+var file_yext_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
 var file_yext_proto_goTypes = []interface{}{
-	(*descriptorpb.FieldOptions)(nil),     // 0: google.protobuf.FieldOptions
-	(*descriptorpb.EnumValueOptions)(nil), // 1: google.protobuf.EnumValueOptions
+	(*FieldAnnotation)(nil),               // 0: yext.FieldAnnotation
+	(*descriptorpb.FieldOptions)(nil),     // 1: google.protobuf.FieldOptions
+	(*descriptorpb.MessageOptions)(nil),   // 2: google.protobuf.MessageOptions
+	(*descriptorpb.EnumValueOptions)(nil), // 3: google.protobuf.EnumValueOptions
+	(*descriptorpb.MethodOptions)(nil),    // 4: google.protobuf.MethodOptions
 }
 var file_yext_proto_depIdxs = []int32{
-	0, // 0: yext.schemapath:extendee -> google.protobuf.FieldOptions
-	1, // 1: yext.yang_name:extendee -> google.protobuf.EnumValueOptions
-	2, // [2:2] is the sub-list for method output_type
-	2, // [2:2] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	0, // [0:2] is the sub-list for extension extendee
+	1, // 0: yext.schemapath:extendee -> google.protobuf.FieldOptions
+	2, // 1: yext.field_annotation:extendee -> google.protobuf.MessageOptions
+	3, // 2: yext.yang_name:extendee -> google.protobuf.EnumValueOptions
+	4, // 3: yext.rpc_path:extendee -> google.protobuf.MethodOptions
+	0, // 4: yext.field_annotation:type_name -> yext.FieldAnnotation
+	5, // [5:5] is the sub-list for method output_type
+	5, // [5:5] is the sub-list for method input_type
+	4, // [4:5] is the sub-list for extension type_name
+	0, // [0:4] is the sub-list for extension extendee
 	0, // [0:0] is the sub-list for field type_name
 }
 
@@ -119,18 +265,33 @@ func file_yext_proto_init() {
 	if File_yext_proto != nil {
 		return
 	}
+	if !protoimpl.UnsafeEnabled {
+		file_yext_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FieldAnnotation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_yext_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   0,
-			NumExtensions: 2,
+			NumMessages:   1,
+			NumExtensions: 4,
 			NumServices:   0,
 		},
 		GoTypes:           file_yext_proto_goTypes,
 		DependencyIndexes: file_yext_proto_depIdxs,
+		MessageInfos:      file_yext_proto_msgTypes,
 		ExtensionInfos:    file_yext_proto_extTypes,
 	}.Build()
 	File_yext_proto = out.File
@@ -46,6 +46,9 @@ var (
 	generateFakeRoot       = flag.Bool("generate_fakeroot", false, "If set to true, a fake element at the root of the data tree is generated. The fake root's name can be controlled with the fakeroot_name flag.")
 	fakeRootName           = flag.String("fakeroot_name", "Device", "The name of the fake root entity.")
 	annotateSchemaPaths    = flag.Bool("add_schemapaths", true, "If set to true, the schema path of each YANG entity is added as a protobuf field option")
+	generateServices       = flag.Bool("generate_services", false, "If set to true, a gRPC service is generated for each module that defines YANG RPCs, with a method per RPC")
+	mapKeyedLists          = flag.Bool("map_keyed_lists", false, "If set to true, lists with a single key of string or integer type are output as protobuf map fields keyed by the value of the key.")
+	optionalScalars        = flag.Bool("optional_scalars", false, "If set to true, leaves are output as proto3 optional scalar fields rather than ywrapper messages.")
 	annotateEnumNames      = flag.Bool("add_enumnames", true, "If set to true, each value within output enums will be annotated with the label in the original YANG schema.")
 	packageHierarchy       = flag.Bool("package_hierarchy", false, "If set to true, an individual protobuf package is output per level of the YANG schema tree.")
 	callerName             = flag.String("caller_name", "proto_generator", "The name of the generator binary that should be recorded in output files.")
@@ -127,6 +130,9 @@ func main() {
 			NestedMessages:      !*packageHierarchy,
 			EnumPackageName:     *enumPackageName,
 			GoPackageBase:       *goPackageBase,
+			GenerateServices:    *generateServices,
+			MapKeyedLists:       *mapKeyedLists,
+			OptionalScalars:     *optionalScalars,
 		},
 	)
 
@@ -154,6 +160,9 @@ func main() {
 		for _, e := range p.Enums {
 			f.WriteString(e)
 		}
+		for _, s := range p.Services {
+			f.WriteString(s)
+		}
 		f.Sync()
 	}
 }
@@ -63,6 +63,21 @@ type ProtoOpts struct {
 	// package identifiers are appended to the go_package - such that
 	// the format <base>/<path>/<to>/<package> is used.
 	GoPackageBase string
+	// GenerateServices specifies whether a gRPC service should be generated
+	// for each module that defines YANG RPCs. The input and output of each
+	// RPC are output as messages, and used as the request and response of
+	// the corresponding method of the service.
+	GenerateServices bool
+	// MapKeyedLists specifies whether lists that have a single key of
+	// string or integer type should be output as protobuf map fields,
+	// keyed by the value of the key leaf, rather than as repeated key
+	// messages. Such fields are annotated with the name of the key leaf.
+	MapKeyedLists bool
+	// OptionalScalars specifies whether leaves should be output as proto3
+	// optional scalar fields rather than as ywrapper messages. Leaf-lists
+	// are output as repeated scalar fields. Leaves of decimal64 type
+	// continue to use the ywrapper Decimal64Value message.
+	OptionalScalars bool
 }
 
 // New returns a new instance of the CodeGenerator
@@ -89,6 +104,7 @@ type Proto3Package struct {
 	Header             string   // Header is the header text to be used in the package.
 	Messages           []string // Messages is a slice of strings containing the set of messages that are within the generated package.
 	Enums              []string // Enums is a slice of string containing the generated set of enumerations within the package.
+	Services           []string // Services is a slice of strings containing the set of gRPC services that are within the generated package.
 	UsesYwrapperImport bool     // UsesYwrapperImport indicates whether the ywrapper proto package is used within the generated package.
 	UsesYextImport     bool     // UsesYextImport indicates whether the yext proto package is used within the generated package.
 }
@@ -118,8 +134,12 @@ func (cg *CodeGenerator) Generate(yangFiles, includePaths []string) (*GeneratedC
 
 	// This flag is always true for proto generation.
 	cg.IROptions.TransformationOptions.UseDefiningModuleForTypedefEnumNames = true
+	parseOpts := cg.IROptions.ParseOptions
+	if cg.ProtoOptions.GenerateServices {
+		parseOpts.IncludeRPCs = true
+	}
 	opts := ygen.IROptions{
-		ParseOptions:                        cg.IROptions.ParseOptions,
+		ParseOptions:                        parseOpts,
 		TransformationOptions:               cg.IROptions.TransformationOptions,
 		NestedDirectories:                   cg.ProtoOptions.NestedMessages,
 		AbsoluteMapPaths:                    true,
@@ -173,6 +193,8 @@ func (cg *CodeGenerator) Generate(yangFiles, includePaths []string) (*GeneratedC
 			annotateSchemaPaths: cg.ProtoOptions.AnnotateSchemaPaths,
 			annotateEnumNames:   cg.ProtoOptions.AnnotateEnumNames,
 			nestedMessages:      cg.ProtoOptions.NestedMessages,
+			mapKeyedLists:       cg.ProtoOptions.MapKeyedLists,
+			optionalScalars:     cg.ProtoOptions.OptionalScalars,
 		})
 
 		if errs != nil {
@@ -219,6 +241,37 @@ func (cg *CodeGenerator) Generate(yangFiles, includePaths []string) (*GeneratedC
 		genProto.Packages[genMsg.PackageName] = tp
 	}
 
+	if cg.ProtoOptions.GenerateServices {
+		genSvcs, errs := writeProto3Services(ir, cg.ProtoOptions.AnnotateSchemaPaths)
+		if errs != nil {
+			yerr = util.AppendErrs(yerr, errs)
+		}
+		for _, genSvc := range genSvcs {
+			if genSvc.PackageName == "" {
+				genSvc.PackageName = basePackageName
+			} else {
+				genSvc.PackageName = fmt.Sprintf("%s.%s", basePackageName, genSvc.PackageName)
+			}
+
+			if pkgImports[genSvc.PackageName] == nil {
+				pkgImports[genSvc.PackageName] = map[string]interface{}{}
+			}
+			addNewKeys(pkgImports[genSvc.PackageName], genSvc.RequiredImports)
+
+			tp, ok := genProto.Packages[genSvc.PackageName]
+			if !ok {
+				tp = Proto3Package{
+					FilePath: protoPackageToFilePath(genSvc.PackageName),
+				}
+			}
+			tp.Services = append(tp.Services, genSvc.ServiceCode)
+			if genSvc.UsesYextImport {
+				tp.UsesYextImport = true
+			}
+			genProto.Packages[genSvc.PackageName] = tp
+		}
+	}
+
 	for n, pkg := range genProto.Packages {
 		var gpn string
 		if cg.ProtoOptions.GoPackageBase != "" {
@@ -395,6 +395,92 @@ func TestGenerateProto3(t *testing.T) {
 		wantOutputFiles: map[string]string{
 			"openconfig": filepath.Join(TestRoot, "testdata", "proto", "fakeroot-multimod.formatted-txt"),
 		},
+	}, {
+		name:    "yang rpcs with services and uncompressed paths",
+		inFiles: []string{filepath.Join(TestRoot, "testdata", "proto", "proto-rpc.yang")},
+		inConfig: CodeGenerator{
+			ProtoOptions: ProtoOpts{
+				AnnotateSchemaPaths: true,
+				GenerateServices:    true,
+			},
+		},
+		wantOutputFiles: map[string]string{
+			"openconfig.proto_rpc": filepath.Join(TestRoot, "testdata", "proto", "proto-rpc.uncompressed.proto_rpc.formatted-txt"),
+		},
+	}, {
+		name:    "yang rpcs with services and nested messages",
+		inFiles: []string{filepath.Join(TestRoot, "testdata", "proto", "proto-rpc.yang")},
+		inConfig: CodeGenerator{
+			IROptions: ygen.IROptions{
+				TransformationOptions: ygen.TransformationOpts{
+					GenerateFakeRoot:  true,
+					CompressBehaviour: genutil.PreferIntendedConfig,
+				},
+			},
+			ProtoOptions: ProtoOpts{
+				NestedMessages:   true,
+				GenerateServices: true,
+			},
+		},
+		wantOutputFiles: map[string]string{
+			"openconfig": filepath.Join(TestRoot, "testdata", "proto", "proto-rpc.compress.nested.formatted-txt"),
+		},
+	}, {
+		name:    "keyed lists as maps with uncompressed paths",
+		inFiles: []string{filepath.Join(TestRoot, "testdata", "proto", "proto-map-lists.yang")},
+		inConfig: CodeGenerator{
+			ProtoOptions: ProtoOpts{
+				AnnotateSchemaPaths: true,
+				MapKeyedLists:       true,
+			},
+		},
+		wantOutputFiles: map[string]string{
+			"openconfig.proto_map_lists":       filepath.Join(TestRoot, "testdata", "proto", "proto-map-lists.uncompressed.proto_map_lists.formatted-txt"),
+			"openconfig.proto_map_lists.lists": filepath.Join(TestRoot, "testdata", "proto", "proto-map-lists.uncompressed.proto_map_lists.lists.formatted-txt"),
+		},
+	}, {
+		name:    "keyed lists as maps with nested messages",
+		inFiles: []string{filepath.Join(TestRoot, "testdata", "proto", "proto-test-b.yang")},
+		inConfig: CodeGenerator{
+			IROptions: ygen.IROptions{
+				TransformationOptions: ygen.TransformationOpts{
+					GenerateFakeRoot:  true,
+					CompressBehaviour: genutil.PreferIntendedConfig,
+				},
+			},
+			ProtoOptions: ProtoOpts{
+				AnnotateSchemaPaths: true,
+				NestedMessages:      true,
+				MapKeyedLists:       true,
+			},
+		},
+		wantOutputFiles: map[string]string{
+			"openconfig": filepath.Join(TestRoot, "testdata", "proto", "proto-test-b.compress.nested.map.formatted-txt"),
+		},
+	}, {
+		name:    "leaves as proto3 optional scalar fields",
+		inFiles: []string{filepath.Join(TestRoot, "testdata", "proto", "proto-optional-scalars.yang")},
+		inConfig: CodeGenerator{
+			ProtoOptions: ProtoOpts{
+				AnnotateSchemaPaths: true,
+				OptionalScalars:     true,
+			},
+		},
+		wantOutputFiles: map[string]string{
+			"openconfig.proto_optional_scalars":         filepath.Join(TestRoot, "testdata", "proto", "proto-optional-scalars.uncompressed.proto_optional_scalars.formatted-txt"),
+			"openconfig.proto_optional_scalars.scalars": filepath.Join(TestRoot, "testdata", "proto", "proto-optional-scalars.uncompressed.proto_optional_scalars.scalars.formatted-txt"),
+		},
+	}, {
+		name:    "unions of built-in types including decimal64",
+		inFiles: []string{filepath.Join(TestRoot, "testdata", "proto", "proto-union-types.yang")},
+		inConfig: CodeGenerator{
+			ProtoOptions: ProtoOpts{
+				AnnotateSchemaPaths: true,
+			},
+		},
+		wantOutputFiles: map[string]string{
+			"openconfig.proto_union_types": filepath.Join(TestRoot, "testdata", "proto", "proto-union-types.uncompressed.proto_union_types.formatted-txt"),
+		},
 	}}
 
 	for _, tt := range tests {
@@ -476,6 +562,10 @@ func TestGenerateProto3(t *testing.T) {
 					fmt.Fprintf(&gotCodeBuf, "%s", gotEnum)
 				}
 
+				for _, gotSvc := range gotPkg.Services {
+					fmt.Fprintf(&gotCodeBuf, "%s", gotSvc)
+				}
+
 				wantCode := string(wantCodeBytes)
 
 				allCode.WriteString(gotCodeBuf.String())
@@ -511,6 +601,9 @@ func TestGenerateProto3(t *testing.T) {
 					for _, gotEnum := range gotPkg.Enums {
 						fmt.Fprintf(&gotCodeBuf, "%s", gotEnum)
 					}
+					for _, gotSvc := range gotPkg.Services {
+						fmt.Fprintf(&gotCodeBuf, "%s", gotSvc)
+					}
 				}
 
 				if diff := cmp.Diff(gotCodeBuf.String(), allCode.String()); diff != "" {
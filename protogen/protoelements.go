@@ -17,6 +17,7 @@ package protogen
 import (
 	"fmt"
 	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/openconfig/goyang/pkg/yang"
@@ -81,6 +82,49 @@ func NewProtoLangMapper(basePackageName, enumPackageName string) *ProtoLangMappe
 	}
 }
 
+// PopulateFieldFlags populates extra information for a field of a generated
+// protobuf message. For leaves and leaf-lists whose type is, or is a union
+// that includes, decimal64, the fraction-digits of the type are recorded such
+// that the precision of the YANG type can be annotated on the field.
+func (s *ProtoLangMapper) PopulateFieldFlags(nd ygen.NodeDetails, field *yang.Entry) map[string]string {
+	if nd.Type != ygen.LeafNode && nd.Type != ygen.LeafListNode || field.Type == nil {
+		return nil
+	}
+	n, ok := s.fractionDigits(field.Type, field)
+	if !ok {
+		return nil
+	}
+	return map[string]string{fractionDigitsFlagKey: strconv.Itoa(n)}
+}
+
+// fractionDigits returns the fraction-digits of the decimal64 type t, which
+// is used within the context entry ctx. Leafrefs are resolved to the type of
+// their target, and for unions, the greatest fraction-digits of the decimal64
+// types within the union is returned. It returns false if t does not include
+// a decimal64 type.
+func (s *ProtoLangMapper) fractionDigits(t *yang.YangType, ctx *yang.Entry) (int, bool) {
+	switch t.Kind {
+	case yang.Ydecimal64:
+		return t.FractionDigits, true
+	case yang.Yleafref:
+		target, err := s.ResolveLeafrefTarget(t.Path, ctx)
+		if err != nil || target.Type == nil {
+			return 0, false
+		}
+		return s.fractionDigits(target.Type, target)
+	case yang.Yunion:
+		var n int
+		var found bool
+		for _, st := range t.Type {
+			if sn, ok := s.fractionDigits(st, ctx); ok && (!found || sn > n) {
+				n, found = sn, true
+			}
+		}
+		return n, found
+	}
+	return 0, false
+}
+
 // resolveTypeArgs is a structure used as an input argument to the yangTypeToGoType
 // function which allows extra context to be handed on. This provides the ability
 // to use not only the YangType but also the yang.Entry that the type was part of
@@ -295,7 +339,7 @@ func (s *ProtoLangMapper) yangTypeToProtoType(args resolveTypeArgs, pargs resolv
 	case yang.Ystring:
 		return &ygen.MappedType{NativeType: ywrapperAccessor + "StringValue"}, nil
 	case yang.Ydecimal64:
-		return &ygen.MappedType{NativeType: ywrapperAccessor + "Decimal64Value"}, nil
+		return &ygen.MappedType{NativeType: protoDecimal64Type}, nil
 	case yang.Yleafref:
 		// We look up the leafref in the schema tree to be able to
 		// determine what type to map to.
@@ -332,11 +376,12 @@ func (s *ProtoLangMapper) yangTypeToProtoType(args resolveTypeArgs, pargs resolv
 		}, nil
 	case yang.Yunion:
 		return s.protoUnionType(args, pargs, opts)
+	case yang.Ybits:
+		// The mapping of bits to an enum that is described in the
+		// specification is not yet implemented, whether the bits type
+		// is used directly or within a union.
+		return nil, fmt.Errorf("unsupported type: %v, bits are not yet mapped to protobuf", args.yangType.Kind)
 	default:
-		// TODO(robjs): Implement types that are missing within this function.
-		// Missing types are:
-		//  - binary
-		//  - bits
 		// We cannot return an interface{} in protobuf, so therefore
 		// we just throw an error with types that we cannot map.
 		return nil, fmt.Errorf("unimplemented type: %v", args.yangType.Kind)
@@ -375,7 +420,7 @@ func (s *ProtoLangMapper) yangTypeToProtoScalarType(args resolveTypeArgs, pargs
 	case yang.Ydecimal64:
 		// Decimal64 continues to be a message even when we are mapping scalars
 		// as there is not an equivalent Protobuf type.
-		return &ygen.MappedType{NativeType: ywrapperAccessor + "Decimal64Value"}, nil
+		return &ygen.MappedType{NativeType: protoDecimal64Type}, nil
 	case yang.Yleafref:
 		target, err := s.ResolveLeafrefTarget(args.yangType.Path, args.contextEntry)
 		if err != nil {
@@ -408,10 +453,9 @@ func (s *ProtoLangMapper) yangTypeToProtoScalarType(args resolveTypeArgs, pargs
 		}, nil
 	case yang.Yunion:
 		return s.protoUnionType(args, pargs, opts)
+	case yang.Ybits:
+		return nil, fmt.Errorf("unsupported type in scalar generation: %v, bits are not yet mapped to protobuf", args.yangType.Kind)
 	default:
-		// TODO(robjs): implement missing types.
-		//	- binary
-		//	- bits
 		return nil, fmt.Errorf("unimplemented type in scalar generation: %s", args.yangType.Kind)
 	}
 }
@@ -566,7 +610,11 @@ func (s *ProtoLangMapper) protoMsgName(e *yang.Entry, compressPaths bool) string
 		s.uniqueProtoMsgNames[pkg] = make(map[string]bool)
 	}
 
-	n := genutil.MakeNameUnique(yang.CamelCase(e.Name), s.uniqueProtoMsgNames[pkg])
+	name := yang.CamelCase(e.Name)
+	if rn, ok := rpcMessageName(e); ok {
+		name = rn
+	}
+	n := genutil.MakeNameUnique(name, s.uniqueProtoMsgNames[pkg])
 	s.uniqueProtoMsgNames[pkg][n] = true
 
 	// Record that this was the proto message name that was used.
@@ -575,6 +623,23 @@ func (s *ProtoLangMapper) protoMsgName(e *yang.Entry, compressPaths bool) string
 	return n
 }
 
+// rpcMessageName returns the name of the protobuf message that represents the
+// input or output of a YANG RPC, which is named for the RPC with the suffix
+// "Request" or "Response" respectively. It returns false if e is not the input
+// or output of an RPC.
+func rpcMessageName(e *yang.Entry) (string, bool) {
+	if e.Parent == nil || e.Parent.RPC == nil {
+		return "", false
+	}
+	switch e.Kind {
+	case yang.InputEntry:
+		return fmt.Sprintf("%sRequest", yang.CamelCase(e.Parent.Name)), true
+	case yang.OutputEntry:
+		return fmt.Sprintf("%sResponse", yang.CamelCase(e.Parent.Name)), true
+	}
+	return "", false
+}
+
 // protobufPackage generates a protobuf package name for a yang.Entry by taking its
 // parent's path and converting it to a protobuf-style name. i.e., an entry with
 // the path /openconfig-interfaces/interfaces/interface/config/name returns
@@ -594,6 +659,11 @@ func (s *ProtoLangMapper) protobufPackage(e *yang.Entry, compressPaths bool) str
 	if compressPaths && e.IsList() || compressPaths && util.IsConfigState(e) {
 		parent = e.Parent.Parent
 	}
+	// The input and output of an RPC are output in the package of the module
+	// that defines the RPC, alongside the service that uses them.
+	if _, ok := rpcMessageName(e); ok {
+		parent = e.Parent.Parent
+	}
 
 	// If this entry has already had its parent's package calculated for it, then
 	// simply return the already calculated name.
@@ -151,6 +151,15 @@ func TestYangTypeToProtoType(t *testing.T) {
 			{yangType: &yang.YangType{Kind: yang.Ybits}},
 		},
 		wantErr: true,
+	}, {
+		name: "bits in a union",
+		in: []resolveTypeArgs{{
+			yangType: &yang.YangType{
+				Kind: yang.Yunion,
+				Type: []*yang.YangType{{Kind: yang.Ystring}, {Kind: yang.Ybits}},
+			},
+		}},
+		wantErr: true,
 	}, {
 		name: "union of string, uint32",
 		in: []resolveTypeArgs{
@@ -54,6 +54,18 @@ const (
 	ywrapperAccessor = "ywrapper."
 )
 
+// ywrapperScalarTypes maps the ywrapper messages that are used to represent
+// leaves to the protobuf scalar type that is used in their place when proto3
+// optional fields are output. The decimal64 wrapper has no scalar equivalent
+// and hence is not included.
+var ywrapperScalarTypes = map[string]string{
+	ywrapperAccessor + "IntValue":    "sint64",
+	ywrapperAccessor + "UintValue":   "uint64",
+	ywrapperAccessor + "BytesValue":  "bytes",
+	ywrapperAccessor + "BoolValue":   "bool",
+	ywrapperAccessor + "StringValue": "string",
+}
+
 const (
 	// protoEnumZeroName is the name given to the value 0 in each generated protobuf enum.
 	protoEnumZeroName string = "UNSET"
@@ -69,6 +81,11 @@ const (
 	// protoSchemaAnnotationOption specifies the name of the FieldOption used to annotate
 	// schemapaths into a protobuf message.
 	protoSchemaAnnotationOption = "(yext.schemapath)"
+	// protoDecimal64Type is the protobuf type used to represent YANG decimal64 values.
+	protoDecimal64Type = ywrapperAccessor + "Decimal64Value"
+	// fractionDigitsFlagKey is the key of the field flag that stores the
+	// fraction-digits of a decimal64 leaf.
+	fractionDigitsFlagKey = "proto:fraction-digits"
 	// protoMatchingListNameKeySuffix defines the suffix that should be added to a list
 	// key's name in the case that it matches the name of the list itself. This is required
 	// since in the case that we have YANG whereby there is a list that has a key
@@ -103,13 +120,38 @@ const (
 // Note, throughout this package private structs that have public fields are used
 // in text/template which cannot refer to unexported fields.
 type protoMsgField struct {
-	Tag         uint32           // Tag is the field number that should be used in the protobuf message.
-	Name        string           // Name is the field's name.
-	Type        string           // Type is the protobuf type for the field.
-	IsRepeated  bool             // IsRepeated indicates whether the field is repeated.
-	Options     []*protoOption   // Extensions is the set of field extensions that should be specified for the field.
-	IsOneOf     bool             // IsOneOf indicates that the field is a oneof and hence consists of multiple subfields.
-	OneOfFields []*protoMsgField // OneOfFields contains the set of fields within the oneof
+	Tag            uint32           // Tag is the field number that should be used in the protobuf message.
+	Name           string           // Name is the field's name.
+	Type           string           // Type is the protobuf type for the field.
+	IsRepeated     bool             // IsRepeated indicates whether the field is repeated.
+	IsOptional     bool             // IsOptional indicates whether the field is a proto3 optional field.
+	Options        []*protoOption   // Extensions is the set of field extensions that should be specified for the field.
+	IsOneOf        bool             // IsOneOf indicates that the field is a oneof and hence consists of multiple subfields.
+	OneOfFields    []*protoMsgField // OneOfFields contains the set of fields within the oneof
+	MapKey         string           // MapKey is the name of the key leaf of a list represented as a map field, it is annotated as an option of the message.
+	FractionDigits string           // FractionDigits is the fraction-digits of a decimal64 field, it is annotated as an option of the message.
+}
+
+// OneOfFieldOptions returns the options that should be output for oof, which is
+// a field within the oneof f. The options of the oneof are output for each of its
+// fields, followed by those that are specific to oof.
+func (f *protoMsgField) OneOfFieldOptions(oof *protoMsgField) []*protoOption {
+	return append(append([]*protoOption{}, f.Options...), oof.Options...)
+}
+
+// FieldAnnotations returns the fields of the message, including those within
+// oneofs, that are annotated using the yext.field_annotation option of the
+// message.
+func (m *protoMsg) FieldAnnotations() []*protoMsgField {
+	var fs []*protoMsgField
+	for _, f := range m.Fields {
+		for _, ff := range append([]*protoMsgField{f}, f.OneOfFields...) {
+			if ff.MapKey != "" || ff.FractionDigits != "" {
+				fs = append(fs, ff)
+			}
+		}
+	}
+	return fs
 }
 
 // protoOption describes a protobuf (message or field) option.
@@ -151,6 +193,24 @@ type protoEnum struct {
 	ValuePrefix string                   // ValuePrefix contains the string prefix that should be prepended to each value within the enumerated type.
 }
 
+// protoService represents a gRPC service that is generated for the RPCs
+// defined within a YANG module.
+type protoService struct {
+	Name    string         // Name is the service's name within the protobuf package.
+	Module  string         // Module is the name of the YANG module within which the RPCs are defined.
+	Methods []*protoMethod // Methods is the set of methods of the service, one per YANG RPC.
+}
+
+// protoMethod represents a method of a gRPC service that is generated for a
+// YANG RPC.
+type protoMethod struct {
+	Name     string // Name is the method's name within the service.
+	YANGPath string // YANGPath is the path to the RPC in the YANG schema, used in comments.
+	Input    string // Input is the name of the message used as the method's request.
+	Output   string // Output is the name of the message used as the method's response.
+	RPCPath  string // RPCPath is the schema path annotated on the method, it is empty if no annotation is output.
+}
+
 // proto3Header describes the header of a Protobuf3 package.
 type proto3Header struct {
 	PackageName            string   // PackageName is the name of the package that is to be output.
@@ -217,6 +277,12 @@ option go_package = "{{ .GoPackageName }}";
 // {{ .Name }} represents the {{ .YANGPath }} YANG schema element.
 {{ end -}}
 message {{ .Name }} {
+{{- range $f := .FieldAnnotations }}
+  option (yext.field_annotation) = {name: "{{ $f.Name }}"
+  {{- if $f.MapKey }} map_key: "{{ $f.MapKey }}"{{ end }}
+  {{- if $f.FractionDigits }} fraction_digits: {{ $f.FractionDigits }}{{ end -}}
+  };
+{{- end -}}
 {{- range $idx, $msg := .ChildMsgs -}}
 	{{- indentLines $msg.MessageCode -}}
 {{- end -}}
@@ -233,11 +299,22 @@ message {{ .Name }} {
   {{ if $field.IsOneOf -}}
   oneof {{ $field.Name }} {
     {{- range $ooField := .OneOfFields }}
-    {{ $ooField.Type }} {{ $ooField.Name }} = {{ $ooField.Tag }};
+    {{ $ooField.Type }} {{ $ooField.Name }} = {{ $ooField.Tag }}
+    {{- $ooOptions := $field.OneOfFieldOptions $ooField -}}
+    {{- $noOptions := len $ooOptions -}}
+    {{- if ne $noOptions 0 }} [
+      {{- range $i, $opt := $ooOptions -}}
+        {{- $opt.Name }} = {{ $opt.Value -}}
+        {{- if ne (inc $i) $noOptions -}}, {{- end }}
+      {{- end -}}
+    ]
+    {{- end -}}
+    ;
     {{- end }}
   }
   {{- else -}}
   {{ if $field.IsRepeated }}repeated {{ end -}}
+  {{ if $field.IsOptional }}optional {{ end -}}
   {{ $field.Type }} {{ $field.Name }} = {{ $field.Tag }}
   {{- $noOptions := len .Options -}}
   {{- if ne $noOptions 0 }} [
@@ -264,6 +341,22 @@ enum {{ .Name }} {
   ;
 {{- end }}
 }
+`)
+
+	// protoServiceTemplate is the template used to generate a service that
+	// contains a method for each RPC defined within a YANG module.
+	protoServiceTemplate = mustMakeTemplate("service", `
+// {{ .Name }} is generated for the RPCs defined in the {{ .Module }} YANG module.
+service {{ .Name }} {
+{{- range $method := .Methods }}
+  // {{ $method.Name }} represents the {{ $method.YANGPath }} YANG RPC.
+  rpc {{ $method.Name }}({{ $method.Input }}) returns ({{ $method.Output }})
+  {{- if $method.RPCPath }} {
+    option (yext.rpc_path) = "{{ $method.RPCPath }}";
+  }
+  {{- else }};{{ end }}
+{{- end }}
+}
 `)
 )
 
@@ -306,20 +399,22 @@ type protoMsgConfig struct {
 	annotateSchemaPaths bool   // annotateSchemaPaths uses the yext protobuf field extensions to annotate the paths from the schema into the output protobuf.
 	annotateEnumNames   bool   // annotateEnumNames uses the yext protobuf enum value extensions to annoate the original YANG name for an enum into the output protobuf.
 	nestedMessages      bool   // nestedMessages indicates whether nested messages should be output for the protobuf schema.
+	mapKeyedLists       bool   // mapKeyedLists indicates whether lists with a single string or integer key should be output as protobuf map fields.
+	optionalScalars     bool   // optionalScalars indicates whether leaves should be output as proto3 optional scalar fields rather than ywrapper messages.
 }
 
 // writeProto3Message outputs the generated Protobuf3 code for a particular protobuf message. It takes:
-//  - msg:               The Directory struct that describes a particular protobuf3 message.
-//  - msgs:              The set of other Directory structs, keyed by schema path, that represent the other proto3
-//                       messages to be generated.
-//  - protogen:             The current generator state.
-//  - cfg:		 The configuration for the message creation as defined in a protoMsgConfig struct.
-//  It returns a generatedProto3Message pointer which includes the definition of the proto3 message, particularly the
-//  name of the package it is within, the code for the message, and any imports for packages that are referenced by
-//  the message.
+//   - msg:               The Directory struct that describes a particular protobuf3 message.
+//   - msgs:              The set of other Directory structs, keyed by schema path, that represent the other proto3
+//     messages to be generated.
+//   - protogen:             The current generator state.
+//   - cfg:		 The configuration for the message creation as defined in a protoMsgConfig struct.
+//     It returns a generatedProto3Message pointer which includes the definition of the proto3 message, particularly the
+//     name of the package it is within, the code for the message, and any imports for packages that are referenced by
+//     the message.
 func writeProto3Msg(msg *ygen.ParsedDirectory, ir *ygen.IR, cfg *protoMsgConfig) (*generatedProto3Message, util.Errors) {
 	if cfg.nestedMessages {
-		if !outputNestedMessage(msg, cfg.compressPaths) {
+		if !outputNestedMessage(msg, cfg.compressPaths) && !isRPCMessage(msg, ir) {
 			return nil, nil
 		}
 		return writeProto3MsgNested(msg, ir, cfg)
@@ -338,6 +433,20 @@ func isChildOfModule(y *ygen.ParsedDirectory) bool {
 	return false
 }
 
+// isRPCMessage determines whether the Directory represents the input or
+// output of a YANG RPC.
+func isRPCMessage(msg *ygen.ParsedDirectory, ir *ygen.IR) bool {
+	if ir == nil {
+		return false
+	}
+	for _, rpc := range ir.RPCs {
+		if msg.Path == rpc.InputPath || msg.Path == rpc.OutputPath {
+			return true
+		}
+	}
+	return false
+}
+
 // outputNestedMessage determines whether the message represented by the supplied
 // Directory is a message that should be output when nested messages are being
 // created. The compressPaths argument specifies whether path compression is enabled.
@@ -359,10 +468,11 @@ func outputNestedMessage(msg *ygen.ParsedDirectory, compressPaths bool) bool {
 // writeProto3MsgNested returns a nested set of protobuf messages for the message
 // supplied, which is expected to be a top-level message that code generation is
 // being performed for. It takes:
-//  - msg: the top-level directory definition
-//  - msgs: the set of message definitions (keyed by path) that are to be output
-//  - protogen: the current code generation state.
-//  - cfg: the configuration for the current code generation.
+//   - msg: the top-level directory definition
+//   - msgs: the set of message definitions (keyed by path) that are to be output
+//   - protogen: the current code generation state.
+//   - cfg: the configuration for the current code generation.
+//
 // It returns a generated protobuf3 message.
 func writeProto3MsgNested(msg *ygen.ParsedDirectory, ir *ygen.IR, cfg *protoMsgConfig) (*generatedProto3Message, util.Errors) {
 	var gerrs util.Errors
@@ -493,12 +603,16 @@ func genProto3MsgCode(cfg *protoMsgConfig, pkg string, msgDefs []*protoMsg, path
 				if strings.HasPrefix(f.Type, ywrapperAccessor) {
 					usesYwrapperImport = true
 				}
-			}
-			for _, o := range field.Options {
-				if o.Name == protoSchemaAnnotationOption {
+				if usesYextOption(f.Options) {
 					usesYextImport = true
 				}
 			}
+			if usesYextOption(field.Options) {
+				usesYextImport = true
+			}
+		}
+		if len(msgDef.FieldAnnotations()) > 0 {
+			usesYextImport = true
 		}
 		// If there is any annotated enums, then make sure to mark the
 		// yext package for import.
@@ -528,6 +642,18 @@ func genProto3MsgCode(cfg *protoMsgConfig, pkg string, msgDefs []*protoMsg, path
 	}, nil
 }
 
+// usesYextOption returns true if any of the supplied options is defined
+// within the yext package.
+func usesYextOption(opts []*protoOption) bool {
+	for _, o := range opts {
+		switch o.Name {
+		case protoSchemaAnnotationOption:
+			return true
+		}
+	}
+	return false
+}
+
 // genProto3Msg takes an input Directory which describes a container or list entry
 // within the YANG schema and returns a protoMsg which can be mapped to the protobuf
 // code representing it. It uses the set of messages that have been extracted and the
@@ -627,7 +753,8 @@ func genProto3Msg(msg *ygen.ParsedDirectory, ir *ygen.IR, cfg *protoMsgConfig, p
 				errs = append(errs, err)
 				continue
 			}
-			fieldDef.Options = append(fieldDef.Options, o)
+			// The schema path is output as the first option of the field.
+			fieldDef.Options = append([]*protoOption{o}, fieldDef.Options...)
 		}
 
 		if err != nil {
@@ -689,8 +816,12 @@ func addProtoListField(fieldDef *protoMsgField, msgDef *protoMsg, args *protoDef
 
 	fieldDef.Type = listDef.listType
 
-	// Lists are always repeated fields.
-	fieldDef.IsRepeated = true
+	// Lists are repeated fields, unless they are represented as a map.
+	if listDef.mapKey == "" {
+		fieldDef.IsRepeated = true
+		return nKeyMsg, listDef.imports, nil
+	}
+	fieldDef.MapKey = listDef.mapKey
 	return nKeyMsg, listDef.imports, nil
 }
 
@@ -743,6 +874,19 @@ func addProtoLeafOrLeafListField(fieldDef *protoMsgField, msgDef *protoMsg, args
 
 	fieldDef.Type = d.protoType
 
+	if args.cfg.annotateSchemaPaths {
+		annotateFractionDigits(fieldDef, d, args.field)
+	}
+
+	// When optional scalars are being output, leaves that would otherwise be
+	// represented by a ywrapper message use the corresponding scalar type, with
+	// proto3 optional used to distinguish an unset field from one set to the
+	// default value.
+	if st, ok := ywrapperScalarTypes[d.protoType]; ok && args.cfg.optionalScalars {
+		fieldDef.Type = st
+		fieldDef.IsOptional = args.field.Type != ygen.LeafListNode
+	}
+
 	// For any enumerations that were within the field definition, glean them into the
 	// message definition.
 	for n, e := range d.enums {
@@ -779,6 +923,29 @@ func addProtoLeafOrLeafListField(fieldDef *protoMsgField, msgDef *protoMsg, args
 	return repeatedMsg, imports, nil
 }
 
+// annotateFractionDigits adds an annotation of the fraction-digits of the YANG
+// decimal64 type of field to each of the fields that represent it as a decimal64
+// value. This is the field itself, or in the case that the field is a union, the
+// member of the oneof, or of the repeated union message, corresponding to the
+// decimal64 type. Where a union has more than one decimal64 type, the greatest
+// fraction-digits of the types is used, such that all values can be represented.
+func annotateFractionDigits(fieldDef *protoMsgField, d *protoDefinedLeaf, field *ygen.NodeDetails) {
+	n, ok := field.Flags[fractionDigitsFlagKey]
+	if !ok {
+		return
+	}
+
+	fields := append([]*protoMsgField{fieldDef}, d.oneofs...)
+	if d.repeatedMsg != nil {
+		fields = append(fields, d.repeatedMsg.Fields...)
+	}
+	for _, f := range fields {
+		if f.Type == protoDecimal64Type {
+			f.FractionDigits = n
+		}
+	}
+}
+
 // writeProtoEnums takes a map of enumerated types within the YANG schema and
 // returns the mapped Protobuf enum definition corresponding to each type. If
 // the annotateEnumNames bool is set, then the original enum value label is
@@ -846,6 +1013,112 @@ func writeProtoEnums(enums map[string]*ygen.EnumeratedYANGType, annotateEnumName
 	return genEnums, nil
 }
 
+// generatedProto3Service contains the code for a proto3 service.
+type generatedProto3Service struct {
+	PackageName     string   // PackageName is the name of the package that the proto3 service should be within.
+	ServiceCode     string   // ServiceCode is the proto3 code that has been output for the service.
+	RequiredImports []string // RequiredImports is the set of imports that are required by the generated service.
+	UsesYextImport  bool     // UsesYextImport indicates whether the yext proto package is used within the generated service.
+}
+
+// emptyProtoMessage is the message used as the request or response of a
+// method that is generated for a YANG RPC which has no input or output, and
+// emptyProtoImport is the file within which it is defined.
+const (
+	emptyProtoMessage = "google.protobuf.Empty"
+	emptyProtoImport  = "google/protobuf/empty.proto"
+)
+
+// writeProto3Services takes the IR of a YANG schema and returns a service
+// for each module that defines YANG RPCs. Each RPC is mapped to a method
+// of the service, whose request and response are the messages generated for
+// the input and output of the RPC. If annotateRPCPaths is set, the schema path
+// of the RPC is stored as an option of the method.
+func writeProto3Services(ir *ygen.IR, annotateRPCPaths bool) ([]*generatedProto3Service, util.Errors) {
+	var errs util.Errors
+	svcs := map[string]*protoService{}
+	pkgs := map[string]*generatedProto3Service{}
+	methodNames := map[string]map[string]bool{}
+	var modules []string
+	for _, path := range ir.OrderedRPCPaths() {
+		rpc := ir.RPCs[path]
+		svc, ok := svcs[rpc.BelongingModule]
+		if !ok {
+			svc = &protoService{
+				Name:   fmt.Sprintf("%sService", yang.CamelCase(rpc.BelongingModule)),
+				Module: rpc.BelongingModule,
+			}
+			svcs[rpc.BelongingModule] = svc
+			pkgs[rpc.BelongingModule] = &generatedProto3Service{}
+			methodNames[rpc.BelongingModule] = map[string]bool{}
+			modules = append(modules, rpc.BelongingModule)
+		}
+		gs := pkgs[rpc.BelongingModule]
+
+		method := &protoMethod{
+			Name:     genutil.MakeNameUnique(yang.CamelCase(rpc.Name), methodNames[rpc.BelongingModule]),
+			YANGPath: rpc.Path,
+			Input:    emptyProtoMessage,
+			Output:   emptyProtoMessage,
+		}
+		methodNames[rpc.BelongingModule][method.Name] = true
+
+		for _, io := range []struct {
+			path string
+			name *string
+		}{
+			{rpc.InputPath, &method.Input},
+			{rpc.OutputPath, &method.Output},
+		} {
+			if io.path == "" {
+				gs.RequiredImports = append(gs.RequiredImports, emptyProtoImport)
+				continue
+			}
+			d, ok := ir.Directories[io.path]
+			if !ok {
+				errs = append(errs, fmt.Errorf("cannot find message for %s of RPC %s", io.path, rpc.Path))
+				continue
+			}
+			// The input and output of all RPCs within a module are expected
+			// to be output within the same package as one another.
+			if gs.PackageName != "" && gs.PackageName != d.PackageName {
+				errs = append(errs, fmt.Errorf("messages for RPCs in module %s are in different packages, %s and %s", rpc.BelongingModule, gs.PackageName, d.PackageName))
+				continue
+			}
+			gs.PackageName = d.PackageName
+			*io.name = d.Name
+		}
+
+		if annotateRPCPaths {
+			// Schema paths do not include the module name, consistently with
+			// the paths annotated on fields.
+			parts := strings.Split(rpc.Path, "/")
+			if len(parts) > 2 {
+				method.RPCPath = fmt.Sprintf("/%s", strings.Join(parts[2:], "/"))
+				gs.UsesYextImport = true
+			}
+		}
+		svc.Methods = append(svc.Methods, method)
+	}
+
+	var genSvcs []*generatedProto3Service
+	for _, mod := range modules {
+		var b bytes.Buffer
+		if err := protoServiceTemplate.Execute(&b, svcs[mod]); err != nil {
+			errs = append(errs, fmt.Errorf("cannot generate service for module %s: %v", mod, err))
+			continue
+		}
+		gs := pkgs[mod]
+		gs.ServiceCode = b.String()
+		genSvcs = append(genSvcs, gs)
+	}
+
+	if len(errs) != 0 {
+		return nil, errs
+	}
+	return genSvcs, nil
+}
+
 // genProtoEnum takes an input yang.Entry that contains an enumerated type
 // and returns a protoMsgEnum that contains its definition within the proto
 // schema. If the annotateEnumNames bool is set, then the original YANG name
@@ -873,6 +1146,7 @@ func genProtoEnum(enum *ygen.EnumeratedYANGType, annotateEnumNames, isLeafOrType
 type protoMsgListField struct {
 	listType string   // listType is the name of the message that represents a list member.
 	imports  []string // imports is the set of modules that are required by this list message.
+	mapKey   string   // mapKey is the name of the key leaf of a list that is represented as a map, it is empty for other lists.
 }
 
 // protoListDefinition takes an input field described by a yang.Entry, the generator context (the set of proto messages, and the generator
@@ -887,11 +1161,15 @@ func protoListDefinition(args *protoDefinitionArgs) (*protoMsgListField, *protoM
 	listMsgName := listMsg.Name
 	childPkg := listMsg.PackageName
 
+	keyName, keyType, isMap := protoMapKey(listMsg)
+	isMap = isMap && args.cfg.mapKeyedLists
+
 	var listKeyMsg *protoMsg
 	var listDef *protoMsgListField
-	if len(listMsg.ListKeys) == 0 {
+	if len(listMsg.ListKeys) == 0 || isMap {
 		// In proto3 we represent unkeyed lists as a
-		// repeated field of the list message.
+		// repeated field of the list message, and lists that are
+		// represented as a map use the list message as the map value.
 		listDef = &protoMsgListField{
 			listType: listMsgName,
 		}
@@ -903,6 +1181,10 @@ func protoListDefinition(args *protoDefinitionArgs) (*protoMsgListField, *protoM
 			}
 			listDef.imports = []string{importPath(args.cfg.baseImportPath, args.cfg.basePackageName, childPkg)}
 		}
+		if isMap {
+			listDef.listType = fmt.Sprintf("map<%s, %s>", keyType, listDef.listType)
+			listDef.mapKey = keyName
+		}
 	} else {
 		// YANG lists are mapped to a repeated message structure as described
 		// in the YANG to Protobuf transformation specification.
@@ -927,6 +1209,27 @@ func protoListDefinition(args *protoDefinitionArgs) (*protoMsgListField, *protoM
 	return listDef, listKeyMsg, nil
 }
 
+// protoMapKey determines whether the list described by the supplied directory
+// can be represented as a protobuf map, which is the case when the list has a
+// single key which is of string or integer type. It returns the YANG name of
+// the key leaf and the protobuf type of the key if so.
+func protoMapKey(listMsg *ygen.ParsedDirectory) (string, string, bool) {
+	if len(listMsg.ListKeys) != 1 {
+		return "", "", false
+	}
+	for name, k := range listMsg.ListKeys {
+		t := k.LangType
+		if t == nil || t.IsEnumeratedValue || t.UnionTypes != nil {
+			return "", "", false
+		}
+		switch t.NativeType {
+		case "string", "sint64", "uint64":
+			return name, t.NativeType, true
+		}
+	}
+	return "", "", false
+}
+
 // protoDefinedLeaf defines a YANG leaf within a protobuf message.
 type protoDefinedLeaf struct {
 	protoType   string                   // protoType is the protobuf type that the leaf should be mapped to.
@@ -1221,9 +1524,10 @@ func unionFieldToOneOf(fieldName string, field *ygen.NodeDetails, path string, m
 		// Split the type name on "." to ensure that we don't have oneof options
 		// that reference some other package in the type name. If there was a "."
 		// in the field name, then this means that we had a global enumeration
-		// present and hence should import this path.
+		// present and hence should import this path. Wrapper messages are the
+		// exception, since they are not enumerations.
 		tp := strings.Split(t, ".")
-		if len(tp) > 1 {
+		if len(tp) > 1 && !strings.HasPrefix(t, ywrapperAccessor) {
 			importGlobalEnums = true
 		}
 		tn := tp[len(tp)-1]
@@ -0,0 +1,1133 @@
+// Copyright 2021 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package protomap
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/openconfig/ygot/util"
+	"github.com/openconfig/ygot/ygot"
+
+	gpb "github.com/openconfig/gnmi/proto/gnmi"
+	wpb "github.com/openconfig/ygot/proto/ywrapper"
+)
+
+// goStructMapper stores the parameters used when mapping between a GoStruct
+// and a ygen-generated protobuf.
+type goStructMapper struct {
+	// enumTypes is the map of schema paths to the enumerated types that are
+	// used for the leaf at the path, as returned by the ΛEnumTypeMap method
+	// of the GoStruct. It is used to resolve enumerated values within unions.
+	enumTypes map[string][]reflect.Type
+	// ignoreExtraPaths indicates that protobuf fields that do not have a
+	// corresponding field in the GoStruct should be skipped.
+	ignoreExtraPaths bool
+}
+
+// enumTypeMapper is implemented by GoStructs that can return the
+// enumerated types used by each schema path.
+type enumTypeMapper interface {
+	ΛEnumTypeMap() map[string][]reflect.Type
+}
+
+// ProtoFromGoStruct populates the ygen-generated protobuf p with the contents
+// of the GoStruct s, which must represent the same YANG schema node. Fields of
+// the protobuf are mapped to fields of the GoStruct using their yext.schemapath
+// annotation, and the path tags of the GoStruct, such that p and s may be
+// generated with different path compression settings. Enumerated values are
+// mapped using the yext.yang_name annotation of the protobuf enum values, union
+// fields are mapped to the member of the protobuf oneof whose type corresponds
+// to the value of the union, and keyed lists are mapped to the repeated key
+// messages of the protobuf, or to its map fields where these are used.
+//
+// By default, p is assumed to correspond to the root of the schema; the
+// ProtobufMessagePrefix option can be used to specify the schema path of p.
+// Fields of p that do not have a corresponding field within s are not
+// populated.
+func ProtoFromGoStruct(s ygot.GoStruct, p proto.Message, opt ...UnmapOpt) error {
+	switch {
+	case util.IsValueNil(s):
+		return errors.New("nil GoStruct supplied")
+	case p == nil:
+		return errors.New("nil protobuf supplied")
+	}
+
+	base, err := hasProtoMsgPrefix(opt)
+	if err != nil {
+		return fmt.Errorf("invalid protobuf message prefix supplied in options, %v", err)
+	}
+
+	g := &goStructMapper{}
+	return g.protoFromStruct(reflect.ValueOf(s), p.ProtoReflect(), schemaPathNames(base))
+}
+
+// GoStructFromProto populates the GoStruct s with the contents of the
+// ygen-generated protobuf p, which must represent the same YANG schema node.
+// Fields are mapped as described for ProtoFromGoStruct. Where a protobuf field
+// is annotated with more than one schema path, each of the corresponding
+// GoStruct fields is populated.
+//
+// By default, p is assumed to correspond to the root of the schema; the
+// ProtobufMessagePrefix option can be used to specify the schema path of p.
+// An error is returned if a populated field of p does not have a
+// corresponding field within s, unless the IgnoreExtraPaths option is
+// specified.
+func GoStructFromProto(p proto.Message, s ygot.GoStruct, opt ...UnmapOpt) error {
+	switch {
+	case p == nil:
+		return errors.New("nil protobuf supplied")
+	case util.IsValueNil(s):
+		return errors.New("nil GoStruct supplied")
+	}
+
+	base, err := hasProtoMsgPrefix(opt)
+	if err != nil {
+		return fmt.Errorf("invalid protobuf message prefix supplied in options, %v", err)
+	}
+
+	g := &goStructMapper{ignoreExtraPaths: hasIgnoreExtraPaths(opt)}
+	if es, ok := s.(enumTypeMapper); ok {
+		g.enumTypes = es.ΛEnumTypeMap()
+	}
+	return g.structFromProto(p.ProtoReflect(), reflect.ValueOf(s), schemaPathNames(base))
+}
+
+// schemaPathNames returns the names of the elements of the path p.
+func schemaPathNames(p *gpb.Path) []string {
+	var names []string
+	for _, e := range p.GetElem() {
+		names = append(names, e.GetName())
+	}
+	return names
+}
+
+// protoFromStruct populates the fields of the protobuf message m from the
+// GoStruct sv, which must be a struct pointer. Both m and sv have the schema
+// path base.
+func (g *goStructMapper) protoFromStruct(sv reflect.Value, m protoreflect.Message, base []string) error {
+	fds := m.Descriptor().Fields()
+	seenOneof := map[protoreflect.FullName]bool{}
+	for i := 0; i < fds.Len(); i++ {
+		fd := fds.Get(i)
+
+		if isListMember(fd) {
+			// The member of a list's key message is the subtree of the
+			// list entry, which has the same schema path as the key.
+			nm := m.NewField(fd).Message()
+			if err := g.protoFromStruct(sv, nm, base); err != nil {
+				return err
+			}
+			m.Set(fd, protoreflect.ValueOfMessage(nm))
+			continue
+		}
+
+		oo := fd.ContainingOneof()
+		if oo != nil && !oo.IsSynthetic() {
+			if seenOneof[oo.FullName()] {
+				continue
+			}
+			seenOneof[oo.FullName()] = true
+		}
+
+		fv, err := goFieldForProtoField(sv, fd, base)
+		if err != nil {
+			return err
+		}
+		if isContainer(fd) && !fv.IsValid() {
+			// Containers that are not represented in the GoStruct, such as
+			// those removed by path compression, are populated from the
+			// descendants of the current GoStruct.
+			if err := g.protoContainerFromGo(sv, m, fd); err != nil {
+				return err
+			}
+			continue
+		}
+		if !fv.IsValid() || util.IsValueNil(fv.Interface()) {
+			continue
+		}
+
+		switch {
+		case oo != nil && !oo.IsSynthetic():
+			v, isEnum, err := goScalar(fv)
+			if err != nil {
+				return fmt.Errorf("cannot map field %s: %v", fd.FullName(), err)
+			}
+			if v == nil {
+				continue
+			}
+			mfd, pv, err := unionProtoValue(m, oo.Fields(), v, isEnum)
+			if err != nil {
+				return fmt.Errorf("cannot map union field %s: %v", oo.FullName(), err)
+			}
+			m.Set(mfd, pv)
+		case fd.IsMap():
+			if err := g.protoMapFromGo(fv, m, fd); err != nil {
+				return err
+			}
+		case fd.IsList():
+			if err := g.protoListFromGo(fv, m, fd); err != nil {
+				return err
+			}
+		case isContainer(fd):
+			if !util.IsValueStructPtr(fv) {
+				return fmt.Errorf("field %s maps to a container, but GoStruct field is %T", fd.FullName(), fv.Interface())
+			}
+			if err := g.protoContainerFromGo(fv, m, fd); err != nil {
+				return err
+			}
+		default:
+			v, isEnum, err := goScalar(fv)
+			if err != nil {
+				return fmt.Errorf("cannot map field %s: %v", fd.FullName(), err)
+			}
+			if v == nil {
+				continue
+			}
+			pv, err := protoValue(m.NewField(fd), fd, v, isEnum)
+			if err != nil {
+				return err
+			}
+			m.Set(fd, pv)
+		}
+	}
+	return nil
+}
+
+// protoContainerFromGo populates the container field fd of the message m from
+// the GoStruct sv, which corresponds to the container. The field is set only
+// if the container has populated descendants.
+func (g *goStructMapper) protoContainerFromGo(sv reflect.Value, m protoreflect.Message, fd protoreflect.FieldDescriptor) error {
+	paths, err := annotatedSchemaPath(fd)
+	if err != nil {
+		return err
+	}
+	nm := m.NewField(fd).Message()
+	if err := g.protoFromStruct(sv, nm, schemaPathNames(paths[0])); err != nil {
+		return err
+	}
+	if proto.Size(nm.Interface()) != 0 {
+		m.Set(fd, protoreflect.ValueOfMessage(nm))
+	}
+	return nil
+}
+
+// protoListFromGo populates the repeated field fd of the message m from the
+// GoStruct field fv, which is either a keyed list or a leaf-list.
+func (g *goStructMapper) protoListFromGo(fv reflect.Value, m protoreflect.Message, fd protoreflect.FieldDescriptor) error {
+	l := m.NewField(fd).List()
+
+	switch {
+	case fd.Kind() == protoreflect.MessageKind && isKeyMessage(fd.Message()):
+		if fv.Kind() != reflect.Map {
+			return fmt.Errorf("field %s maps to a keyed list, but GoStruct field is %T", fd.FullName(), fv.Interface())
+		}
+		paths, err := annotatedSchemaPath(fd)
+		if err != nil {
+			return err
+		}
+		listPath := schemaPathNames(paths[0])
+
+		// Sort the entries of the list such that the output is deterministic.
+		keys := fv.MapKeys()
+		sort.Slice(keys, func(i, j int) bool {
+			return fmt.Sprintf("%v", keys[i].Interface()) < fmt.Sprintf("%v", keys[j].Interface())
+		})
+		for _, k := range keys {
+			km := l.NewElement().Message()
+			if err := g.protoFromStruct(fv.MapIndex(k), km, listPath); err != nil {
+				return err
+			}
+			l.Append(protoreflect.ValueOfMessage(km))
+		}
+	default:
+		if fv.Kind() != reflect.Slice || util.IsTypeStructPtr(fv.Type().Elem()) {
+			return fmt.Errorf("field %s maps to a leaf-list, but GoStruct field is %T", fd.FullName(), fv.Interface())
+		}
+		for i := 0; i < fv.Len(); i++ {
+			v, isEnum, err := goScalar(fv.Index(i))
+			if err != nil {
+				return fmt.Errorf("cannot map field %s: %v", fd.FullName(), err)
+			}
+			if v == nil {
+				continue
+			}
+			ev := l.NewElement()
+			if fd.Kind() == protoreflect.MessageKind && isUnionMessage(fd.Message()) {
+				// Leaf-lists of unions are represented by a message
+				// containing a field for each type of the union.
+				um := ev.Message()
+				mfd, pv, err := unionProtoValue(um, um.Descriptor().Fields(), v, isEnum)
+				if err != nil {
+					return fmt.Errorf("cannot map union leaf-list %s: %v", fd.FullName(), err)
+				}
+				um.Set(mfd, pv)
+				l.Append(ev)
+				continue
+			}
+			pv, err := protoValue(ev, fd, v, isEnum)
+			if err != nil {
+				return err
+			}
+			l.Append(pv)
+		}
+	}
+
+	if l.Len() != 0 {
+		m.Set(fd, protoreflect.ValueOfList(l))
+	}
+	return nil
+}
+
+// protoMapFromGo populates the map field fd of the message m from the GoStruct
+// field fv, which is a keyed list with a single key. The map is keyed by the
+// keys of the GoStruct map.
+func (g *goStructMapper) protoMapFromGo(fv reflect.Value, m protoreflect.Message, fd protoreflect.FieldDescriptor) error {
+	if fv.Kind() != reflect.Map || fv.Type().Key().Kind() == reflect.Struct {
+		return fmt.Errorf("field %s maps to a keyed list with a single key, but GoStruct field is %T", fd.FullName(), fv.Interface())
+	}
+	paths, err := annotatedSchemaPath(fd)
+	if err != nil {
+		return err
+	}
+	listPath := schemaPathNames(paths[0])
+
+	mp := m.NewField(fd).Map()
+	iter := fv.MapRange()
+	for iter.Next() {
+		k, isEnum, err := goScalar(iter.Key())
+		if err != nil {
+			return fmt.Errorf("cannot map key of field %s: %v", fd.FullName(), err)
+		}
+		kv, err := protoValue(protoreflect.Value{}, fd.MapKey(), k, isEnum)
+		if err != nil {
+			return err
+		}
+		nm := mp.NewValue().Message()
+		if err := g.protoFromStruct(iter.Value(), nm, listPath); err != nil {
+			return err
+		}
+		mp.Set(kv.MapKey(), protoreflect.ValueOfMessage(nm))
+	}
+
+	if mp.Len() != 0 {
+		m.Set(fd, protoreflect.ValueOfMap(mp))
+	}
+	return nil
+}
+
+// structFromProto populates the GoStruct sv, which must be a struct pointer,
+// from the populated fields of the protobuf message m. Both m and sv have the
+// schema path base.
+func (g *goStructMapper) structFromProto(m protoreflect.Message, sv reflect.Value, base []string) error {
+	var rangeErr error
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		if !v.IsValid() {
+			return true
+		}
+		if err := g.structFieldFromProto(fd, v, sv, base); err != nil {
+			rangeErr = err
+			return false
+		}
+		return true
+	})
+	return rangeErr
+}
+
+// structFieldFromProto populates the GoStruct sv, which has the schema path
+// base, from the protobuf field fd with value v.
+func (g *goStructMapper) structFieldFromProto(fd protoreflect.FieldDescriptor, v protoreflect.Value, sv reflect.Value, base []string) error {
+	if isListMember(fd) {
+		return g.structFromProto(v.Message(), sv, base)
+	}
+
+	paths, err := annotatedSchemaPath(fd)
+	if err != nil {
+		return err
+	}
+
+	var mapped bool
+	for _, p := range paths {
+		idx, ok := fieldIndexForPath(sv.Type().Elem(), relativeSchemaPath(p, base))
+		if !ok {
+			continue
+		}
+		mapped = true
+
+		fv := fieldByIndex(sv, idx)
+		parent := sv
+		if len(idx) > 1 {
+			parent = fieldByIndex(sv, idx[:len(idx)-1])
+		}
+		absPath := "/" + util.SlicePathToString(schemaPathNames(p))
+
+		switch {
+		case fd.IsMap():
+			if err := g.goMapFromProto(fd, v.Map(), fv, absPath); err != nil {
+				return err
+			}
+		case fd.IsList() && fd.Kind() == protoreflect.MessageKind && isKeyMessage(fd.Message()):
+			if err := g.goListFromProto(v.List(), fv, schemaPathNames(p)); err != nil {
+				return err
+			}
+		case fd.IsList():
+			if err := g.goLeafListFromProto(fd, v.List(), fv, parent.Type(), absPath); err != nil {
+				return err
+			}
+		case isContainer(fd):
+			if !util.IsTypeStructPtr(fv.Type()) {
+				return fmt.Errorf("field %s maps to a container, but GoStruct field is %s", fd.FullName(), fv.Type())
+			}
+			if fv.IsNil() {
+				fv.Set(reflect.New(fv.Type().Elem()))
+			}
+			if err := g.structFromProto(v.Message(), fv, schemaPathNames(p)); err != nil {
+				return err
+			}
+		default:
+			n, isEnum, err := protoScalar(fd, v)
+			if err != nil {
+				return err
+			}
+			if n == nil {
+				continue
+			}
+			gv, err := g.goValue(fv.Type(), parent.Type(), n, isEnum, absPath)
+			if err != nil {
+				return fmt.Errorf("cannot map field %s to GoStruct: %v", fd.FullName(), err)
+			}
+			fv.Set(gv)
+		}
+	}
+
+	if !mapped && isContainer(fd) {
+		// Containers that are not represented in the GoStruct, such as
+		// those removed by path compression, are mapped to the descendants
+		// of the current GoStruct.
+		return g.structFromProto(v.Message(), sv, schemaPathNames(paths[0]))
+	}
+	if !mapped && !g.ignoreExtraPaths {
+		return fmt.Errorf("did not find a GoStruct field for protobuf field %s in %s", fd.FullName(), sv.Type())
+	}
+	return nil
+}
+
+// goListFromProto populates the GoStruct keyed list fv, which is a map, from
+// the repeated key messages l. The list has the schema path listPath.
+func (g *goStructMapper) goListFromProto(l protoreflect.List, fv reflect.Value, listPath []string) error {
+	if fv.Kind() != reflect.Map {
+		return fmt.Errorf("protobuf keyed list %s maps to GoStruct field of type %s", util.SlicePathToString(listPath), fv.Type())
+	}
+	if fv.IsNil() {
+		fv.Set(reflect.MakeMap(fv.Type()))
+	}
+
+	for i := 0; i < l.Len(); i++ {
+		km := l.Get(i).Message()
+		entry := reflect.New(fv.Type().Elem().Elem())
+		// Keys are populated even if they have the default value of their
+		// protobuf type, such that a key of 0 or "" is mapped.
+		if err := g.structFromProto(unpopRange{km}, entry, listPath); err != nil {
+			return err
+		}
+
+		key, err := listKeyForEntry(fv.Type().Key(), entry, km)
+		if err != nil {
+			return fmt.Errorf("cannot determine key for list %s: %v", util.SlicePathToString(listPath), err)
+		}
+		fv.SetMapIndex(key, entry)
+	}
+	return nil
+}
+
+// goMapFromProto populates the GoStruct keyed list fv, which is a map, from
+// the protobuf map field fd with value mp. The key leaf of each entry, named by
+// the map_key of the yext.field_annotation of the field, is populated from the
+// key of the protobuf map. The list has the schema path absPath.
+func (g *goStructMapper) goMapFromProto(fd protoreflect.FieldDescriptor, mp protoreflect.Map, fv reflect.Value, absPath string) error {
+	if fv.Kind() != reflect.Map || fv.Type().Key().Kind() == reflect.Struct {
+		return fmt.Errorf("protobuf map %s maps to GoStruct field of type %s", fd.FullName(), fv.Type())
+	}
+	keyName := mapKeyAnnotation(fd)
+	if keyName == "" {
+		return fmt.Errorf("invalid map, no key annotation, field: %s", fd.FullName())
+	}
+	if fv.IsNil() {
+		fv.Set(reflect.MakeMap(fv.Type()))
+	}
+
+	listPath := strings.Split(strings.TrimPrefix(absPath, "/"), "/")
+	entryT := fv.Type().Elem()
+	var rangeErr error
+	mp.Range(func(mk protoreflect.MapKey, mv protoreflect.Value) bool {
+		entry := reflect.New(entryT.Elem())
+		if err := g.structFromProto(mv.Message(), entry, listPath); err != nil {
+			rangeErr = err
+			return false
+		}
+
+		n, _, err := protoScalar(fd.MapKey(), mk.Value())
+		if err != nil {
+			rangeErr = err
+			return false
+		}
+		idx, ok := fieldIndexForPath(entryT.Elem(), []string{keyName})
+		if !ok {
+			rangeErr = fmt.Errorf("cannot find key leaf %s in %s", keyName, entryT)
+			return false
+		}
+		kf := fieldByIndex(entry, idx)
+		kv, err := g.goValue(kf.Type(), entryT, n, false, fmt.Sprintf("%s/%s", absPath, keyName))
+		if err != nil {
+			rangeErr = fmt.Errorf("cannot map key of protobuf map %s to GoStruct: %v", fd.FullName(), err)
+			return false
+		}
+		kf.Set(kv)
+
+		key, err := g.goValue(fv.Type().Key(), entryT, n, false, fmt.Sprintf("%s/%s", absPath, keyName))
+		if err != nil {
+			rangeErr = fmt.Errorf("cannot map key of protobuf map %s to GoStruct: %v", fd.FullName(), err)
+			return false
+		}
+		fv.SetMapIndex(key, entry)
+		return true
+	})
+	return rangeErr
+}
+
+// goLeafListFromProto populates the GoStruct leaf-list fv from the repeated
+// protobuf field fd with value l. The leaf-list is a field of the struct type
+// parentT and has the schema path absPath.
+func (g *goStructMapper) goLeafListFromProto(fd protoreflect.FieldDescriptor, l protoreflect.List, fv reflect.Value, parentT reflect.Type, absPath string) error {
+	if fv.Kind() != reflect.Slice || util.IsTypeStructPtr(fv.Type().Elem()) {
+		return fmt.Errorf("protobuf leaf-list %s maps to GoStruct field of type %s", fd.FullName(), fv.Type())
+	}
+
+	nl := reflect.MakeSlice(fv.Type(), 0, l.Len())
+	for i := 0; i < l.Len(); i++ {
+		efd, ev := fd, l.Get(i)
+		if fd.Kind() == protoreflect.MessageKind && isUnionMessage(fd.Message()) {
+			// Leaf-lists of unions have a single populated field in each
+			// of their members.
+			um := ev.Message()
+			efd = nil
+			um.Range(func(f protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+				efd, ev = f, v
+				return false
+			})
+			if efd == nil {
+				continue
+			}
+		}
+		n, isEnum, err := protoScalar(efd, ev)
+		if err != nil {
+			return err
+		}
+		if n == nil {
+			continue
+		}
+		gv, err := g.goValue(fv.Type().Elem(), parentT, n, isEnum, absPath)
+		if err != nil {
+			return fmt.Errorf("cannot map leaf-list %s to GoStruct: %v", fd.FullName(), err)
+		}
+		nl = reflect.Append(nl, gv)
+	}
+	fv.Set(nl)
+	return nil
+}
+
+// listKeyForEntry returns the key of type keyT for the list entry entry,
+// which has been populated from the key message km.
+func listKeyForEntry(keyT reflect.Type, entry reflect.Value, km protoreflect.Message) (reflect.Value, error) {
+	key := reflect.New(keyT).Elem()
+	if keyT.Kind() == reflect.Struct {
+		for i := 0; i < keyT.NumField(); i++ {
+			kf := entry.Elem().FieldByName(keyT.Field(i).Name)
+			if !kf.IsValid() || util.IsValueNil(kf.Interface()) {
+				return reflect.Value{}, fmt.Errorf("key field %s is not populated", keyT.Field(i).Name)
+			}
+			if kf.Kind() == reflect.Ptr {
+				kf = kf.Elem()
+			}
+			key.Field(i).Set(kf)
+		}
+		return key, nil
+	}
+
+	// For a single key, find the key leaf named by the annotation of the
+	// key field of the message, and retrieve its value from the entry.
+	var keyName string
+	fds := km.Descriptor().Fields()
+	for i := 0; i < fds.Len() && keyName == ""; i++ {
+		if isListMember(fds.Get(i)) {
+			continue
+		}
+		paths, err := annotatedSchemaPath(fds.Get(i))
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		if keyName, err = fieldName(paths[0]); err != nil {
+			return reflect.Value{}, err
+		}
+	}
+
+	idx, ok := fieldIndexForPath(entry.Type().Elem(), []string{keyName})
+	if !ok {
+		return reflect.Value{}, fmt.Errorf("cannot find key leaf %s in %s", keyName, entry.Type())
+	}
+	kf := fieldByIndex(entry, idx)
+	if util.IsValueNil(kf.Interface()) {
+		return reflect.Value{}, fmt.Errorf("key leaf %s is not populated", keyName)
+	}
+	if kf.Kind() == reflect.Ptr {
+		kf = kf.Elem()
+	}
+	if !kf.Type().AssignableTo(keyT) {
+		return reflect.Value{}, fmt.Errorf("key leaf %s of type %s cannot be used as key of type %s", keyName, kf.Type(), keyT)
+	}
+	key.Set(kf)
+	return key, nil
+}
+
+// goFieldForProtoField returns the value of the field of the GoStruct sv,
+// whose schema path is base, that corresponds to the protobuf field fd. Where
+// fd is annotated with multiple paths, the first populated field is returned.
+// An invalid value is returned if there is no corresponding field.
+func goFieldForProtoField(sv reflect.Value, fd protoreflect.FieldDescriptor, base []string) (reflect.Value, error) {
+	paths, err := annotatedSchemaPath(fd)
+	if err != nil {
+		return reflect.Value{}, err
+	}
+
+	var found reflect.Value
+	for _, p := range paths {
+		idx, ok := fieldIndexForPath(sv.Type().Elem(), relativeSchemaPath(p, base))
+		if !ok {
+			continue
+		}
+		fv, ok := fieldByIndexIfExists(sv, idx)
+		if !ok {
+			continue
+		}
+		if !found.IsValid() || util.IsValueNil(found.Interface()) {
+			found = fv
+		}
+	}
+	return found, nil
+}
+
+// relativeSchemaPath returns the names of the elements of the path p with the
+// prefix base removed. If base is not a prefix of p, nil is returned.
+func relativeSchemaPath(p *gpb.Path, base []string) []string {
+	names := schemaPathNames(p)
+	if len(names) < len(base) {
+		return nil
+	}
+	for i := range base {
+		if names[i] != base[i] {
+			return nil
+		}
+	}
+	return names[len(base):]
+}
+
+// fieldIndexForPath returns the index sequence of the field within the
+// struct type t, or one of its descendant containers, whose path tag matches
+// the relative schema path path.
+func fieldIndexForPath(t reflect.Type, path []string) ([]int, bool) {
+	if len(path) == 0 {
+		return nil, false
+	}
+	for i := 0; i < t.NumField(); i++ {
+		ft := t.Field(i)
+		if util.IsYgotAnnotation(ft) {
+			continue
+		}
+		sps, err := util.SchemaPaths(ft)
+		if err != nil {
+			continue
+		}
+		for _, sp := range sps {
+			if len(sp) > len(path) || !reflect.DeepEqual(sp, path[:len(sp)]) {
+				continue
+			}
+			if len(sp) == len(path) {
+				return []int{i}, true
+			}
+			if util.IsTypeStructPtr(ft.Type) {
+				if idx, ok := fieldIndexForPath(ft.Type.Elem(), path[len(sp):]); ok {
+					return append([]int{i}, idx...), true
+				}
+			}
+		}
+	}
+	return nil, false
+}
+
+// fieldByIndex returns the field of the struct pointer sv with the index
+// sequence idx, initialising any containers along the path to the field.
+func fieldByIndex(sv reflect.Value, idx []int) reflect.Value {
+	v := sv
+	for i, x := range idx {
+		f := v.Elem().Field(x)
+		if i == len(idx)-1 {
+			return f
+		}
+		if f.IsNil() {
+			f.Set(reflect.New(f.Type().Elem()))
+		}
+		v = f
+	}
+	return v
+}
+
+// fieldByIndexIfExists returns the field of the struct pointer sv with the
+// index sequence idx. It returns false if a container along the path to the
+// field is not populated.
+func fieldByIndexIfExists(sv reflect.Value, idx []int) (reflect.Value, bool) {
+	v := sv
+	for _, x := range idx {
+		if v.IsNil() {
+			return reflect.Value{}, false
+		}
+		v = v.Elem().Field(x)
+	}
+	return v, true
+}
+
+// goScalar returns the value of the GoStruct leaf (or leaf-list member) v as
+// one of string, bool, int64, uint64, float64 or []byte. Union values are
+// resolved to the value of their member. If the value is an enumerated value,
+// its YANG name is returned and the returned bool is set to true. A nil value
+// is returned if v is not populated.
+func goScalar(v reflect.Value) (interface{}, bool, error) {
+	if !v.IsValid() {
+		return nil, false, nil
+	}
+	if v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return nil, false, nil
+		}
+		v = v.Elem()
+	}
+	if e, ok := v.Interface().(ygot.GoEnum); ok {
+		name, err := ygot.EnumName(e)
+		if err != nil || name == "" {
+			return nil, false, err
+		}
+		return name, true, nil
+	}
+
+	switch v.Kind() {
+	case reflect.Ptr:
+		if v.IsNil() {
+			return nil, false, nil
+		}
+		if util.IsValueStructPtr(v) {
+			// Unions that are represented by wrapper structs have a
+			// single field containing their value.
+			if v.Elem().NumField() != 1 {
+				return nil, false, fmt.Errorf("invalid union value %T", v.Interface())
+			}
+			return goScalar(v.Elem().Field(0))
+		}
+		return goScalar(v.Elem())
+	case reflect.String:
+		return v.String(), false, nil
+	case reflect.Bool:
+		return v.Bool(), false, nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return v.Int(), false, nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return v.Uint(), false, nil
+	case reflect.Float32, reflect.Float64:
+		return v.Float(), false, nil
+	case reflect.Slice:
+		if v.Type().Elem().Kind() == reflect.Uint8 {
+			return v.Bytes(), false, nil
+		}
+	}
+	return nil, false, fmt.Errorf("unsupported GoStruct value %v (%T)", v.Interface(), v.Interface())
+}
+
+// unionProtoValue returns the field within fds, which are the fields
+// representing the types of a union, that can store the value v, along with
+// the protobuf value for the field. The field is selected according to the
+// type of v, and whether it is an enumerated value.
+func unionProtoValue(m protoreflect.Message, fds protoreflect.FieldDescriptors, v interface{}, isEnum bool) (protoreflect.FieldDescriptor, protoreflect.Value, error) {
+	for i := 0; i < fds.Len(); i++ {
+		fd := fds.Get(i)
+		if (fd.Kind() == protoreflect.EnumKind) != isEnum {
+			continue
+		}
+		if pv, err := protoValue(m.NewField(fd), fd, v, isEnum); err == nil {
+			return fd, pv, nil
+		}
+	}
+	return nil, protoreflect.Value{}, fmt.Errorf("no field can store value %v (%T)", v, v)
+}
+
+// protoValue returns the value of the (non-repeated) protobuf field fd, or a
+// member of the repeated field fd, corresponding to the value v, which is one
+// of the types returned by goScalar. The supplied nv is a new value for the
+// field, which is populated for message fields.
+func protoValue(nv protoreflect.Value, fd protoreflect.FieldDescriptor, v interface{}, isEnum bool) (protoreflect.Value, error) {
+	mismatch := fmt.Errorf("cannot store value %v (%T) in field %s of kind %s", v, v, fd.FullName(), fd.Kind())
+
+	switch fd.Kind() {
+	case protoreflect.EnumKind:
+		if !isEnum {
+			return protoreflect.Value{}, mismatch
+		}
+		return enumValue(fd, v)
+	case protoreflect.MessageKind:
+		if err := setWrapperValue(nv.Message(), fd, v, isEnum); err != nil {
+			return protoreflect.Value{}, mismatch
+		}
+		return nv, nil
+	case protoreflect.StringKind:
+		if s, ok := v.(string); ok && !isEnum {
+			return protoreflect.ValueOfString(s), nil
+		}
+	case protoreflect.BoolKind:
+		if b, ok := v.(bool); ok {
+			return protoreflect.ValueOfBool(b), nil
+		}
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		if n, ok := v.(int64); ok {
+			return protoreflect.ValueOfInt64(n), nil
+		}
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		if n, ok := v.(int64); ok && n >= math.MinInt32 && n <= math.MaxInt32 {
+			return protoreflect.ValueOfInt32(int32(n)), nil
+		}
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		if n, ok := v.(uint64); ok {
+			return protoreflect.ValueOfUint64(n), nil
+		}
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		if n, ok := v.(uint64); ok && n <= math.MaxUint32 {
+			return protoreflect.ValueOfUint32(uint32(n)), nil
+		}
+	case protoreflect.DoubleKind:
+		if f, ok := v.(float64); ok {
+			return protoreflect.ValueOfFloat64(f), nil
+		}
+	case protoreflect.FloatKind:
+		if f, ok := v.(float64); ok {
+			return protoreflect.ValueOfFloat32(float32(f)), nil
+		}
+	case protoreflect.BytesKind:
+		if b, ok := v.([]byte); ok {
+			return protoreflect.ValueOfBytes(b), nil
+		}
+	}
+	return protoreflect.Value{}, mismatch
+}
+
+// setWrapperValue sets the value of the ywrapper message m, which is the value
+// of the field fd, to v, which is one of the types returned by goScalar. The
+// ywrapper messages are handled using their descriptors such that dynamic
+// messages are supported.
+func setWrapperValue(m protoreflect.Message, fd protoreflect.FieldDescriptor, v interface{}, isEnum bool) error {
+	fds := m.Descriptor().Fields()
+	mismatch := fmt.Errorf("cannot store value %v (%T) in %s", v, v, m.Descriptor().FullName())
+
+	switch m.Descriptor().FullName() {
+	case wrapperName(&wpb.StringValue{}):
+		if s, ok := v.(string); ok && !isEnum {
+			m.Set(fds.ByName("value"), protoreflect.ValueOfString(s))
+			return nil
+		}
+	case wrapperName(&wpb.BoolValue{}):
+		if b, ok := v.(bool); ok {
+			m.Set(fds.ByName("value"), protoreflect.ValueOfBool(b))
+			return nil
+		}
+	case wrapperName(&wpb.IntValue{}):
+		if n, ok := v.(int64); ok {
+			m.Set(fds.ByName("value"), protoreflect.ValueOfInt64(n))
+			return nil
+		}
+	case wrapperName(&wpb.UintValue{}):
+		if n, ok := v.(uint64); ok {
+			m.Set(fds.ByName("value"), protoreflect.ValueOfUint64(n))
+			return nil
+		}
+	case wrapperName(&wpb.BytesValue{}):
+		if b, ok := v.([]byte); ok {
+			m.Set(fds.ByName("value"), protoreflect.ValueOfBytes(b))
+			return nil
+		}
+	case wrapperName(&wpb.Decimal64Value{}):
+		if f, ok := v.(float64); ok {
+			d, err := decimal64Value(f, 64, fractionDigitsAnnotation(fd))
+			if err != nil {
+				return err
+			}
+			m.Set(fds.ByName("digits"), protoreflect.ValueOfInt64(d.Digits))
+			m.Set(fds.ByName("precision"), protoreflect.ValueOfUint32(d.Precision))
+			return nil
+		}
+	}
+	return mismatch
+}
+
+// wrapperValue returns the value of the ywrapper message m as one of the
+// types returned by goScalar.
+func wrapperValue(m protoreflect.Message) (interface{}, error) {
+	fds := m.Descriptor().Fields()
+	switch m.Descriptor().FullName() {
+	case wrapperName(&wpb.StringValue{}):
+		return m.Get(fds.ByName("value")).String(), nil
+	case wrapperName(&wpb.BoolValue{}):
+		return m.Get(fds.ByName("value")).Bool(), nil
+	case wrapperName(&wpb.IntValue{}):
+		return m.Get(fds.ByName("value")).Int(), nil
+	case wrapperName(&wpb.UintValue{}):
+		return m.Get(fds.ByName("value")).Uint(), nil
+	case wrapperName(&wpb.BytesValue{}):
+		return m.Get(fds.ByName("value")).Bytes(), nil
+	case wrapperName(&wpb.Decimal64Value{}):
+		digits, precision := m.Get(fds.ByName("digits")).Int(), m.Get(fds.ByName("precision")).Uint()
+		return float64(digits) / math.Pow10(int(precision)), nil
+	}
+	return nil, fmt.Errorf("unsupported wrapper message type %s", m.Descriptor().FullName())
+}
+
+// wrapperName returns the full name of the ywrapper message m.
+func wrapperName(m proto.Message) protoreflect.FullName {
+	return m.ProtoReflect().Descriptor().FullName()
+}
+
+// decimal64Value returns the ywrapper Decimal64Value representing f, which
+// was converted from a float of the supplied bitSize. If fractionDigits is
+// non-zero, the value is rounded to that number of fraction digits, otherwise
+// the minimum precision required to represent it exactly is used.
+func decimal64Value(f float64, bitSize int, fractionDigits uint32) (*wpb.Decimal64Value, error) {
+	prec := -1
+	if fractionDigits != 0 {
+		prec = int(fractionDigits)
+	}
+	d, err := parseDecimal64(strconv.FormatFloat(f, 'f', prec, bitSize))
+	if err != nil {
+		return nil, fmt.Errorf("cannot represent %v as a decimal64 value, %v", f, err)
+	}
+	return &wpb.Decimal64Value{Digits: d.GetDigits(), Precision: d.GetPrecision()}, nil
+}
+
+// parseDecimal64 returns the gNMI Decimal64 representing the decimal number
+// in the string s, with the precision of the number of fractional digits in s.
+func parseDecimal64(s string) (*gpb.Decimal64, error) {
+	var precision int
+	if i := strings.Index(s, "."); i != -1 {
+		precision = len(s) - i - 1
+		s = s[:i] + s[i+1:]
+	}
+	d, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return nil, err
+	}
+	return &gpb.Decimal64{Digits: d, Precision: uint32(precision)}, nil
+}
+
+// protoScalar returns the value v of the protobuf field fd as one of string,
+// bool, int64, uint64, float64 or []byte. If the field is an enumeration, the
+// YANG name of the value is returned and the returned bool is set to true. A
+// nil value is returned if the enumeration is unset.
+func protoScalar(fd protoreflect.FieldDescriptor, v protoreflect.Value) (interface{}, bool, error) {
+	switch fd.Kind() {
+	case protoreflect.EnumKind:
+		ev := fd.Enum().Values().ByNumber(v.Enum())
+		if ev == nil {
+			return nil, false, fmt.Errorf("unknown value %d for enumeration %s", v.Enum(), fd.FullName())
+		}
+		name, ok, err := enumYANGName(ev)
+		if err != nil || !ok {
+			return nil, false, err
+		}
+		return name, true, nil
+	case protoreflect.MessageKind:
+		if !isWrapper(fd.Message()) {
+			return nil, false, fmt.Errorf("unsupported message type %s for field %s", fd.Message().FullName(), fd.FullName())
+		}
+		n, err := wrapperValue(v.Message())
+		return n, false, err
+	case protoreflect.StringKind:
+		return v.String(), false, nil
+	case protoreflect.BoolKind:
+		return v.Bool(), false, nil
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind, protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		return v.Int(), false, nil
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind, protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		return v.Uint(), false, nil
+	case protoreflect.DoubleKind, protoreflect.FloatKind:
+		return v.Float(), false, nil
+	case protoreflect.BytesKind:
+		return v.Bytes(), false, nil
+	}
+	return nil, false, fmt.Errorf("unsupported kind %s for field %s", fd.Kind(), fd.FullName())
+}
+
+// goEnumType is the reflect.Type of the ygot.GoEnum interface.
+var goEnumType = reflect.TypeOf((*ygot.GoEnum)(nil)).Elem()
+
+// goValue returns a value of type t, which is the type of a field of the
+// struct type parentT, corresponding to the value v, which is one of the
+// types returned by protoScalar. The schema path of the field, absPath, is
+// used to determine the enumerated types that may be used within a union.
+func (g *goStructMapper) goValue(t, parentT reflect.Type, v interface{}, isEnum bool, absPath string) (reflect.Value, error) {
+	switch {
+	case t.Implements(goEnumType):
+		name, ok := v.(string)
+		if !isEnum || !ok {
+			return reflect.Value{}, fmt.Errorf("cannot store non-enumerated value %v in enumerated type %s", v, t)
+		}
+		return enumFromName(t, name)
+	case t.Kind() == reflect.Interface:
+		return g.unionGoValue(t, parentT, v, isEnum, absPath)
+	case t.Kind() == reflect.Ptr:
+		ev, err := g.goValue(t.Elem(), parentT, v, isEnum, absPath)
+		if err != nil {
+			return reflect.Value{}, err
+		}
+		p := reflect.New(t.Elem())
+		p.Elem().Set(ev)
+		return p, nil
+	case isEnum:
+		return reflect.Value{}, fmt.Errorf("cannot store enumerated value %v in type %s", v, t)
+	}
+
+	nv := reflect.New(t).Elem()
+	switch n := v.(type) {
+	case string:
+		if t.Kind() == reflect.String {
+			nv.SetString(n)
+			return nv, nil
+		}
+	case bool:
+		if t.Kind() == reflect.Bool {
+			nv.SetBool(n)
+			return nv, nil
+		}
+	case int64:
+		switch t.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			if nv.OverflowInt(n) {
+				return reflect.Value{}, fmt.Errorf("value %d overflows type %s", n, t)
+			}
+			nv.SetInt(n)
+			return nv, nil
+		}
+	case uint64:
+		switch t.Kind() {
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			if nv.OverflowUint(n) {
+				return reflect.Value{}, fmt.Errorf("value %d overflows type %s", n, t)
+			}
+			nv.SetUint(n)
+			return nv, nil
+		}
+	case float64:
+		if t.Kind() == reflect.Float64 || t.Kind() == reflect.Float32 {
+			nv.SetFloat(n)
+			return nv, nil
+		}
+	case []byte:
+		if t.Kind() == reflect.Slice && t.Elem().Kind() == reflect.Uint8 {
+			nv.SetBytes(n)
+			return nv, nil
+		}
+	}
+	return reflect.Value{}, fmt.Errorf("cannot store value %v (%T) in type %s", v, v, t)
+}
+
+// unionGoValue returns a value of the union type t, which is the type of a
+// field of the struct type parentT, corresponding to the value v. The union
+// value is created using the To_ conversion method that is generated for
+// the union, using the candidate Go types for v in turn.
+func (g *goStructMapper) unionGoValue(t, parentT reflect.Type, v interface{}, isEnum bool, absPath string) (reflect.Value, error) {
+	var candidates []reflect.Value
+	switch n := v.(type) {
+	case string:
+		if !isEnum {
+			candidates = append(candidates, reflect.ValueOf(n))
+			break
+		}
+		for _, et := range g.enumTypes[absPath] {
+			if ev, err := enumFromName(et, n); err == nil {
+				candidates = append(candidates, ev)
+			}
+		}
+	case int64:
+		for _, k := range []reflect.Type{reflect.TypeOf(int64(0)), reflect.TypeOf(int32(0)), reflect.TypeOf(int16(0)), reflect.TypeOf(int8(0))} {
+			if !reflect.New(k).Elem().OverflowInt(n) {
+				candidates = append(candidates, reflect.ValueOf(n).Convert(k))
+			}
+		}
+	case uint64:
+		for _, k := range []reflect.Type{reflect.TypeOf(uint64(0)), reflect.TypeOf(uint32(0)), reflect.TypeOf(uint16(0)), reflect.TypeOf(uint8(0))} {
+			if !reflect.New(k).Elem().OverflowUint(n) {
+				candidates = append(candidates, reflect.ValueOf(n).Convert(k))
+			}
+		}
+	default:
+		candidates = append(candidates, reflect.ValueOf(v))
+	}
+
+	mn := "To_" + t.Name()
+	method := reflect.New(parentT).Elem().MethodByName(mn)
+	if !method.IsValid() {
+		return reflect.Value{}, fmt.Errorf("%s does not have a %s method", parentT, mn)
+	}
+	for _, c := range candidates {
+		ret := method.Call([]reflect.Value{c})
+		if len(ret) != 2 {
+			return reflect.Value{}, fmt.Errorf("%s method of %s returns %d values", mn, parentT, len(ret))
+		}
+		if !ret[1].IsNil() {
+			continue
+		}
+		// The returned value has the union interface type, hence it is
+		// unwrapped such that it can be used as the value of a field.
+		return ret[0], nil
+	}
+	return reflect.Value{}, fmt.Errorf("cannot store value %v (%T) in union type %s", v, v, t)
+}
+
+// enumFromName returns the value of the enumerated type t whose YANG name
+// is name.
+func enumFromName(t reflect.Type, name string) (reflect.Value, error) {
+	e, ok := reflect.New(t).Elem().Interface().(ygot.GoEnum)
+	if !ok {
+		return reflect.Value{}, fmt.Errorf("type %s is not an enumerated type", t)
+	}
+	for v, d := range e.ΛMap()[t.Name()] {
+		if d.Name == name {
+			nv := reflect.New(t).Elem()
+			nv.SetInt(v)
+			return nv, nil
+		}
+	}
+	return reflect.Value{}, fmt.Errorf("%s is not a valid value of enumerated type %s", name, t)
+}
@@ -0,0 +1,763 @@
+// Copyright 2021 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package protomap
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/openconfig/gnmi/errdiff"
+	"google.golang.org/protobuf/encoding/prototext"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+
+	yextpb "github.com/openconfig/ygot/proto/yext"
+	wpb "github.com/openconfig/ygot/proto/ywrapper"
+	epb "github.com/openconfig/ygot/protomap/testdata/exschemapath"
+	"github.com/openconfig/ygot/ygot"
+)
+
+// gsRoot and gsInterface are compressed GoStructs corresponding to the
+// exschemapath.Root message.
+type gsRoot struct {
+	Hostname  *string                 `path:"system/config/hostname"`
+	Interface map[string]*gsInterface `path:"interfaces/interface"`
+}
+
+func (*gsRoot) IsYANGGoStruct() {}
+
+type gsInterface struct {
+	Name        *string `path:"config/name|name"`
+	Description *string `path:"config/description"`
+}
+
+func (*gsInterface) IsYANGGoStruct() {}
+
+// gsURoot and its descendants are uncompressed GoStructs corresponding to
+// the exschemapath.Root message.
+type gsURoot struct {
+	System     *gsUSystem     `path:"system"`
+	Interfaces *gsUInterfaces `path:"interfaces"`
+}
+
+func (*gsURoot) IsYANGGoStruct() {}
+
+type gsUSystem struct {
+	Config *gsUSystemConfig `path:"config"`
+}
+
+func (*gsUSystem) IsYANGGoStruct() {}
+
+type gsUSystemConfig struct {
+	Hostname *string `path:"hostname"`
+}
+
+func (*gsUSystemConfig) IsYANGGoStruct() {}
+
+type gsUInterfaces struct {
+	Interface map[string]*gsUInterface `path:"interface"`
+}
+
+func (*gsUInterfaces) IsYANGGoStruct() {}
+
+type gsUInterface struct {
+	Name   *string             `path:"name"`
+	Config *gsUInterfaceConfig `path:"config"`
+}
+
+func (*gsUInterface) IsYANGGoStruct() {}
+
+type gsUInterfaceConfig struct {
+	Name        *string `path:"name"`
+	Description *string `path:"description"`
+}
+
+func (*gsUInterfaceConfig) IsYANGGoStruct() {}
+
+// gsExample and its descendants are GoStructs corresponding to the
+// exschemapath.ExampleMessage message.
+type gsExample struct {
+	Bo       *bool                        `path:"bool"`
+	By       []byte                       `path:"bytes"`
+	De       *float64                     `path:"decimal"`
+	In       *int64                       `path:"int"`
+	Str      *string                      `path:"string"`
+	Ui       *uint64                      `path:"uint"`
+	Ex       *gsExampleChild              `path:"message"`
+	Em       map[string]*gsListEntry      `path:"list-name"`
+	Multi    map[gsMultiKey]*gsMultiEntry `path:"multi-list"`
+	En       gsEnum                       `path:"enum"`
+	Compress *string                      `path:"state/compress"`
+	MapList  map[string]*gsMapEntry       `path:"map-list"`
+	MapUint  map[uint32]*gsMapUintEntry   `path:"map-uint-list"`
+}
+
+func (*gsExample) IsYANGGoStruct() {}
+
+type gsExampleChild struct {
+	Str *string `path:"str"`
+}
+
+func (*gsExampleChild) IsYANGGoStruct() {}
+
+type gsListEntry struct {
+	SingleKey    *string                   `path:"config/single-key|single-key"`
+	AnotherField *string                   `path:"another-field"`
+	ChildList    map[string]*gsNestedEntry `path:"child-list"`
+}
+
+func (*gsListEntry) IsYANGGoStruct() {}
+
+type gsNestedEntry struct {
+	KeyOne *string `path:"key-one"`
+	Str    *string `path:"str"`
+}
+
+func (*gsNestedEntry) IsYANGGoStruct() {}
+
+type gsMultiKey struct {
+	Index uint32
+	Name  string
+}
+
+type gsMultiEntry struct {
+	Index *uint32 `path:"config/index|index"`
+	Name  *string `path:"config/name|name"`
+	Child *string `path:"config/child"`
+}
+
+func (*gsMultiEntry) IsYANGGoStruct() {}
+
+type gsMapEntry struct {
+	Key   *string `path:"config/key|key"`
+	Value *string `path:"config/value"`
+}
+
+func (*gsMapEntry) IsYANGGoStruct() {}
+
+type gsMapUintEntry struct {
+	Index *uint32 `path:"index"`
+	Value *string `path:"value"`
+}
+
+func (*gsMapUintEntry) IsYANGGoStruct() {}
+
+type gsEnum int64
+
+const (
+	gsEnumUnset       gsEnum = 0
+	gsEnumValOne      gsEnum = 1
+	gsEnumValFortyTwo gsEnum = 42
+)
+
+func (gsEnum) IsYANGGoEnum() {}
+
+func (gsEnum) ΛMap() map[string]map[int64]ygot.EnumDefinition {
+	return map[string]map[int64]ygot.EnumDefinition{
+		"gsEnum": {
+			1:  {Name: "VAL_ONE"},
+			2:  {Name: "VAL_TWO"},
+			42: {Name: "VAL_FORTYTWO"},
+		},
+	}
+}
+
+func (e gsEnum) String() string {
+	return ygot.EnumLogString(e, int64(e), "gsEnum")
+}
+
+// gsUnion is a GoStruct corresponding to the message returned by
+// unionMessageType.
+type gsUnion struct {
+	Val  gsUnion_Val_Union    `path:"val"`
+	Vals []gsUnion_Vals_Union `path:"vals"`
+}
+
+func (*gsUnion) IsYANGGoStruct() {}
+
+func (*gsUnion) ΛEnumTypeMap() map[string][]reflect.Type {
+	return map[string][]reflect.Type{
+		"/val": {reflect.TypeOf(gsUnionEnum(0))},
+	}
+}
+
+type gsUnion_Val_Union interface {
+	Is_gsUnion_Val_Union()
+}
+
+type gsUnion_Vals_Union interface {
+	Is_gsUnion_Vals_Union()
+}
+
+type gsUnionString string
+
+func (gsUnionString) Is_gsUnion_Val_Union()  {}
+func (gsUnionString) Is_gsUnion_Vals_Union() {}
+
+type gsUnionInt64 int64
+
+func (gsUnionInt64) Is_gsUnion_Val_Union() {}
+
+type gsUnionUint64 uint64
+
+func (gsUnionUint64) Is_gsUnion_Vals_Union() {}
+
+type gsUnionFloat64 float64
+
+func (gsUnionFloat64) Is_gsUnion_Val_Union() {}
+
+type gsUnionEnum int64
+
+func (gsUnionEnum) IsYANGGoEnum()         {}
+func (gsUnionEnum) Is_gsUnion_Val_Union() {}
+
+func (gsUnionEnum) ΛMap() map[string]map[int64]ygot.EnumDefinition {
+	return map[string]map[int64]ygot.EnumDefinition{
+		"gsUnionEnum": {1: {Name: "A"}},
+	}
+}
+
+func (e gsUnionEnum) String() string {
+	return ygot.EnumLogString(e, int64(e), "gsUnionEnum")
+}
+
+func (*gsUnion) To_gsUnion_Val_Union(i interface{}) (gsUnion_Val_Union, error) {
+	switch v := i.(type) {
+	case string:
+		return gsUnionString(v), nil
+	case int64:
+		return gsUnionInt64(v), nil
+	case float64:
+		return gsUnionFloat64(v), nil
+	case gsUnionEnum:
+		return v, nil
+	}
+	return nil, fmt.Errorf("cannot convert %v to gsUnion_Val_Union, unknown union type, got: %T", i, i)
+}
+
+func (*gsUnion) To_gsUnion_Vals_Union(i interface{}) (gsUnion_Vals_Union, error) {
+	switch v := i.(type) {
+	case string:
+		return gsUnionString(v), nil
+	case uint64:
+		return gsUnionUint64(v), nil
+	}
+	return nil, fmt.Errorf("cannot convert %v to gsUnion_Vals_Union, unknown union type, got: %T", i, i)
+}
+
+// unionMessageType returns a message type equivalent to that generated by
+// protogen for a container with a union leaf "val" whose types are string,
+// int64, an enumeration and a decimal64 with two fraction digits, and a
+// leaf-list "vals" whose types are string and uint64.
+func unionMessageType(t *testing.T) protoreflect.MessageType {
+	schemaPath := func(p string) *descriptorpb.FieldOptions {
+		o := &descriptorpb.FieldOptions{}
+		proto.SetExtension(o, yextpb.E_Schemapath, p)
+		return o
+	}
+	unionOpts := &descriptorpb.MessageOptions{}
+	proto.SetExtension(unionOpts, yextpb.E_FieldAnnotation, []*yextpb.FieldAnnotation{{
+		Name:           "val_decimal64",
+		FractionDigits: 2,
+	}})
+	yangName := func(n string) *descriptorpb.EnumValueOptions {
+		o := &descriptorpb.EnumValueOptions{}
+		proto.SetExtension(o, yextpb.E_YangName, n)
+		return o
+	}
+	optional := descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum()
+
+	fdp := &descriptorpb.FileDescriptorProto{
+		Name:       proto.String("protomap/gsunion.proto"),
+		Package:    proto.String("gsunion"),
+		Syntax:     proto.String("proto3"),
+		Dependency: []string{wpb.File_ywrapper_proto.Path(), yextpb.File_yext_proto.Path()},
+		EnumType: []*descriptorpb.EnumDescriptorProto{{
+			Name: proto.String("UnionEnum"),
+			Value: []*descriptorpb.EnumValueDescriptorProto{
+				{Name: proto.String("UNIONENUM_UNSET"), Number: proto.Int32(0)},
+				{Name: proto.String("UNIONENUM_A"), Number: proto.Int32(1), Options: yangName("A")},
+			},
+		}},
+		MessageType: []*descriptorpb.DescriptorProto{{
+			Name:    proto.String("Union"),
+			Options: unionOpts,
+			Field: []*descriptorpb.FieldDescriptorProto{{
+				Name:       proto.String("val_string"),
+				Number:     proto.Int32(1),
+				Label:      optional,
+				Type:       descriptorpb.FieldDescriptorProto_TYPE_STRING.Enum(),
+				OneofIndex: proto.Int32(0),
+				Options:    schemaPath("/val"),
+			}, {
+				Name:       proto.String("val_sint64"),
+				Number:     proto.Int32(2),
+				Label:      optional,
+				Type:       descriptorpb.FieldDescriptorProto_TYPE_SINT64.Enum(),
+				OneofIndex: proto.Int32(0),
+				Options:    schemaPath("/val"),
+			}, {
+				Name:       proto.String("val_unionenum"),
+				Number:     proto.Int32(3),
+				Label:      optional,
+				Type:       descriptorpb.FieldDescriptorProto_TYPE_ENUM.Enum(),
+				TypeName:   proto.String(".gsunion.UnionEnum"),
+				OneofIndex: proto.Int32(0),
+				Options:    schemaPath("/val"),
+			}, {
+				Name:       proto.String("val_decimal64"),
+				Number:     proto.Int32(5),
+				Label:      optional,
+				Type:       descriptorpb.FieldDescriptorProto_TYPE_MESSAGE.Enum(),
+				TypeName:   proto.String(".ywrapper.Decimal64Value"),
+				OneofIndex: proto.Int32(0),
+				Options:    schemaPath("/val"),
+			}, {
+				Name:     proto.String("vals"),
+				Number:   proto.Int32(4),
+				Label:    descriptorpb.FieldDescriptorProto_LABEL_REPEATED.Enum(),
+				Type:     descriptorpb.FieldDescriptorProto_TYPE_MESSAGE.Enum(),
+				TypeName: proto.String(".gsunion.Union.ValsUnion"),
+				Options:  schemaPath("/vals"),
+			}},
+			NestedType: []*descriptorpb.DescriptorProto{{
+				Name: proto.String("ValsUnion"),
+				Field: []*descriptorpb.FieldDescriptorProto{{
+					Name:   proto.String("vals_string"),
+					Number: proto.Int32(1),
+					Label:  optional,
+					Type:   descriptorpb.FieldDescriptorProto_TYPE_STRING.Enum(),
+				}, {
+					Name:   proto.String("vals_uint64"),
+					Number: proto.Int32(2),
+					Label:  optional,
+					Type:   descriptorpb.FieldDescriptorProto_TYPE_UINT64.Enum(),
+				}},
+			}},
+			OneofDecl: []*descriptorpb.OneofDescriptorProto{{Name: proto.String("val")}},
+		}},
+	}
+
+	fd, err := protodesc.NewFile(fdp, protoregistry.GlobalFiles)
+	if err != nil {
+		t.Fatalf("cannot create union test file descriptor, %v", err)
+	}
+	return dynamicpb.NewMessageType(fd.Messages().ByName("Union"))
+}
+
+// unionMessage returns a message of the type returned by unionMessageType
+// populated with the supplied text format contents.
+func unionMessage(t *testing.T, text string) proto.Message {
+	m := unionMessageType(t).New().Interface()
+	if err := prototext.Unmarshal([]byte(text), m); err != nil {
+		t.Fatalf("cannot unmarshal union test message, %v", err)
+	}
+	return m
+}
+
+// gsOptional is a GoStruct corresponding to the message returned by
+// optionalMessageType.
+type gsOptional struct {
+	Bo   *bool    `path:"bo"`
+	In   *int64   `path:"in"`
+	Str  *string  `path:"str"`
+	Strs []string `path:"strs"`
+}
+
+func (*gsOptional) IsYANGGoStruct() {}
+
+// optionalMessageType returns a message type equivalent to that generated by
+// protogen with proto3 optional scalar fields enabled, for a container with
+// the leaves "bo", "in" and "str", and the leaf-list "strs".
+func optionalMessageType(t *testing.T) protoreflect.MessageType {
+	optional := func(name string, n int32, typ descriptorpb.FieldDescriptorProto_Type) *descriptorpb.FieldDescriptorProto {
+		o := &descriptorpb.FieldOptions{}
+		proto.SetExtension(o, yextpb.E_Schemapath, "/"+name)
+		return &descriptorpb.FieldDescriptorProto{
+			Name:           proto.String(name),
+			Number:         proto.Int32(n),
+			Label:          descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+			Type:           typ.Enum(),
+			OneofIndex:     proto.Int32(n - 1),
+			Proto3Optional: proto.Bool(true),
+			Options:        o,
+		}
+	}
+	strs := &descriptorpb.FieldOptions{}
+	proto.SetExtension(strs, yextpb.E_Schemapath, "/strs")
+
+	fdp := &descriptorpb.FileDescriptorProto{
+		Name:       proto.String("protomap/gsoptional.proto"),
+		Package:    proto.String("gsoptional"),
+		Syntax:     proto.String("proto3"),
+		Dependency: []string{yextpb.File_yext_proto.Path()},
+		MessageType: []*descriptorpb.DescriptorProto{{
+			Name: proto.String("Optional"),
+			Field: []*descriptorpb.FieldDescriptorProto{
+				optional("bo", 1, descriptorpb.FieldDescriptorProto_TYPE_BOOL),
+				optional("in", 2, descriptorpb.FieldDescriptorProto_TYPE_SINT64),
+				optional("str", 3, descriptorpb.FieldDescriptorProto_TYPE_STRING),
+				{
+					Name:    proto.String("strs"),
+					Number:  proto.Int32(4),
+					Label:   descriptorpb.FieldDescriptorProto_LABEL_REPEATED.Enum(),
+					Type:    descriptorpb.FieldDescriptorProto_TYPE_STRING.Enum(),
+					Options: strs,
+				},
+			},
+			OneofDecl: []*descriptorpb.OneofDescriptorProto{
+				{Name: proto.String("_bo")},
+				{Name: proto.String("_in")},
+				{Name: proto.String("_str")},
+			},
+		}},
+	}
+
+	fd, err := protodesc.NewFile(fdp, protoregistry.GlobalFiles)
+	if err != nil {
+		t.Fatalf("cannot create optional test file descriptor, %v", err)
+	}
+	return dynamicpb.NewMessageType(fd.Messages().ByName("Optional"))
+}
+
+// optionalMessage returns a message of the type returned by
+// optionalMessageType populated with the supplied text format contents.
+func optionalMessage(t *testing.T, text string) proto.Message {
+	m := optionalMessageType(t).New().Interface()
+	if err := prototext.Unmarshal([]byte(text), m); err != nil {
+		t.Fatalf("cannot unmarshal optional test message, %v", err)
+	}
+	return m
+}
+
+func TestGoStructProtoRoundTrip(t *testing.T) {
+	tests := []struct {
+		name     string
+		inStruct ygot.GoStruct
+		inProto  proto.Message
+		inOpts   []UnmapOpt
+	}{{
+		name: "compressed GoStruct with elided containers",
+		inStruct: &gsRoot{
+			Hostname: ygot.String("box0"),
+			Interface: map[string]*gsInterface{
+				"eth1": {Name: ygot.String("eth1")},
+				"eth0": {Name: ygot.String("eth0"), Description: ygot.String("uplink")},
+			},
+		},
+		inProto: &epb.Root{
+			System: &epb.System{Hostname: &wpb.StringValue{Value: "box0"}},
+			Interface: []*epb.Root_InterfaceKey{{
+				Name: "eth0",
+				Interface: &epb.Interface{
+					Description: &wpb.StringValue{Value: "uplink"},
+				},
+			}, {
+				Name:      "eth1",
+				Interface: &epb.Interface{},
+			}},
+		},
+	}, {
+		name: "uncompressed GoStruct",
+		inStruct: &gsURoot{
+			System: &gsUSystem{Config: &gsUSystemConfig{Hostname: ygot.String("box0")}},
+			Interfaces: &gsUInterfaces{
+				Interface: map[string]*gsUInterface{
+					"eth0": {
+						Name: ygot.String("eth0"),
+						Config: &gsUInterfaceConfig{
+							Name:        ygot.String("eth0"),
+							Description: ygot.String("uplink"),
+						},
+					},
+				},
+			},
+		},
+		inProto: &epb.Root{
+			System: &epb.System{Hostname: &wpb.StringValue{Value: "box0"}},
+			Interface: []*epb.Root_InterfaceKey{{
+				Name: "eth0",
+				Interface: &epb.Interface{
+					Description: &wpb.StringValue{Value: "uplink"},
+				},
+			}},
+		},
+	}, {
+		name: "scalars, enumerations and lists",
+		inStruct: &gsExample{
+			Bo:       ygot.Bool(true),
+			By:       []byte{42},
+			De:       ygot.Float64(3.14),
+			In:       ygot.Int64(-42),
+			Str:      ygot.String("hello"),
+			Ui:       ygot.Uint64(42),
+			Ex:       &gsExampleChild{Str: ygot.String("child")},
+			En:       gsEnumValFortyTwo,
+			Compress: ygot.String("compressed"),
+			Em: map[string]*gsListEntry{
+				"one": {
+					SingleKey:    ygot.String("one"),
+					AnotherField: ygot.String("value"),
+					ChildList: map[string]*gsNestedEntry{
+						"nested": {KeyOne: ygot.String("nested"), Str: ygot.String("nested-value")},
+					},
+				},
+			},
+			Multi: map[gsMultiKey]*gsMultiEntry{
+				{Index: 0, Name: "zero"}: {
+					Index: ygot.Uint32(0),
+					Name:  ygot.String("zero"),
+					Child: ygot.String("zero-child"),
+				},
+			},
+			MapList: map[string]*gsMapEntry{
+				"one": {Key: ygot.String("one"), Value: ygot.String("val-one")},
+				"two": {Key: ygot.String("two")},
+			},
+			MapUint: map[uint32]*gsMapUintEntry{
+				42: {Index: ygot.Uint32(42), Value: ygot.String("val-42")},
+			},
+		},
+		inProto: &epb.ExampleMessage{
+			Bo:       &wpb.BoolValue{Value: true},
+			By:       &wpb.BytesValue{Value: []byte{42}},
+			De:       &wpb.Decimal64Value{Digits: 314, Precision: 2},
+			In:       &wpb.IntValue{Value: -42},
+			Str:      &wpb.StringValue{Value: "hello"},
+			Ui:       &wpb.UintValue{Value: 42},
+			Ex:       &epb.ExampleMessageChild{Str: &wpb.StringValue{Value: "child"}},
+			En:       epb.ExampleEnum_ENUM_VALFORTYTWO,
+			Compress: &wpb.StringValue{Value: "compressed"},
+			Em: []*epb.ExampleMessageKey{{
+				SingleKey: "one",
+				Member: &epb.ExampleMessageListMember{
+					Str: &wpb.StringValue{Value: "value"},
+					ChildList: []*epb.NestedListKey{{
+						KeyOne: "nested",
+						Field:  &epb.NestedListMember{Str: &wpb.StringValue{Value: "nested-value"}},
+					}},
+				},
+			}},
+			Multi: []*epb.ExampleMessageMultiKey{{
+				Index: 0,
+				Name:  "zero",
+				Member: &epb.MultiKeyListMember{
+					Child: &wpb.StringValue{Value: "zero-child"},
+				},
+			}},
+			MapList: map[string]*epb.MapListMember{
+				"one": {Value: &wpb.StringValue{Value: "val-one"}},
+				"two": {},
+			},
+			MapUintList: map[uint64]*epb.MapUintListMember{
+				42: {Value: &wpb.StringValue{Value: "val-42"}},
+			},
+		},
+	}, {
+		name:     "message with prefix",
+		inStruct: &gsExampleChild{Str: ygot.String("child")},
+		inProto:  &epb.ExampleMessageChild{Str: &wpb.StringValue{Value: "child"}},
+		inOpts:   []UnmapOpt{ProtobufMessagePrefix(mustPath("/message"))},
+	}, {
+		name: "union with non-enumerated value and leaf-list of unions",
+		inStruct: &gsUnion{
+			Val:  gsUnionInt64(-42),
+			Vals: []gsUnion_Vals_Union{gsUnionString("forty-two"), gsUnionUint64(42)},
+		},
+		inProto: unionMessage(t, `val_sint64: -42 vals { vals_string: "forty-two" } vals { vals_uint64: 42 }`),
+	}, {
+		name:     "union with string value",
+		inStruct: &gsUnion{Val: gsUnionString("A")},
+		inProto:  unionMessage(t, `val_string: "A"`),
+	}, {
+		name:     "union with enumerated value",
+		inStruct: &gsUnion{Val: gsUnionEnum(1)},
+		inProto:  unionMessage(t, `val_unionenum: UNIONENUM_A`),
+	}, {
+		name:     "union with decimal64 value stored with annotated fraction digits",
+		inStruct: &gsUnion{Val: gsUnionFloat64(3.1)},
+		inProto:  unionMessage(t, `val_decimal64 { digits: 310 precision: 2 }`),
+	}, {
+		name: "optional scalars set to their default values",
+		inStruct: &gsOptional{
+			Bo:   ygot.Bool(false),
+			In:   ygot.Int64(0),
+			Str:  ygot.String(""),
+			Strs: []string{"one", ""},
+		},
+		inProto: optionalMessage(t, `bo: false in: 0 str: "" strs: "one" strs: ""`),
+	}, {
+		name:     "optional scalars partially set",
+		inStruct: &gsOptional{In: ygot.Int64(-42)},
+		inProto:  optionalMessage(t, `in: -42`),
+	}}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotProto := tt.inProto.ProtoReflect().New().Interface()
+			if err := ProtoFromGoStruct(tt.inStruct, gotProto, tt.inOpts...); err != nil {
+				t.Fatalf("ProtoFromGoStruct(%v): got unexpected error, %v", tt.inStruct, err)
+			}
+			if diff := cmp.Diff(tt.inProto, gotProto, protocmp.Transform()); diff != "" {
+				t.Errorf("ProtoFromGoStruct(%v): did not get expected protobuf, (-want, +got):\n%s", tt.inStruct, diff)
+			}
+
+			gotStruct := reflect.New(reflect.TypeOf(tt.inStruct).Elem()).Interface().(ygot.GoStruct)
+			if err := GoStructFromProto(tt.inProto, gotStruct, tt.inOpts...); err != nil {
+				t.Fatalf("GoStructFromProto(%v): got unexpected error, %v", tt.inProto, err)
+			}
+			if diff := cmp.Diff(tt.inStruct, gotStruct); diff != "" {
+				t.Errorf("GoStructFromProto(%v): did not get expected GoStruct, (-want, +got):\n%s", tt.inProto, diff)
+			}
+		})
+	}
+}
+
+// gsBadContainer is a GoStruct whose field has the path of a container
+// within exschemapath.ExampleMessage.
+type gsBadContainer struct {
+	Ex *string `path:"message"`
+}
+
+func (*gsBadContainer) IsYANGGoStruct() {}
+
+func TestProtoFromGoStruct(t *testing.T) {
+	tests := []struct {
+		name             string
+		inStruct         ygot.GoStruct
+		inProto          proto.Message
+		want             proto.Message
+		wantErrSubstring string
+	}{{
+		name:     "unset enumeration",
+		inStruct: &gsExample{En: gsEnumUnset, Str: ygot.String("hello")},
+		inProto:  &epb.ExampleMessage{},
+		want:     &epb.ExampleMessage{Str: &wpb.StringValue{Value: "hello"}},
+	}, {
+		name:     "protobuf representing a subtree of the GoStruct",
+		inStruct: &gsExample{Ex: &gsExampleChild{Str: ygot.String("child")}, Str: ygot.String("hello")},
+		inProto:  &epb.ExampleMessageChild{},
+		want:     &epb.ExampleMessageChild{Str: &wpb.StringValue{Value: "child"}},
+	}, {
+		name:             "nil GoStruct",
+		inStruct:         (*gsExample)(nil),
+		inProto:          &epb.ExampleMessage{},
+		wantErrSubstring: "nil GoStruct",
+	}, {
+		name:             "nil protobuf",
+		inStruct:         &gsExample{},
+		wantErrSubstring: "nil protobuf",
+	}, {
+		name:             "container mapped to leaf",
+		inStruct:         &gsBadContainer{Ex: ygot.String("invalid")},
+		inProto:          &epb.ExampleMessage{},
+		wantErrSubstring: "maps to a container",
+	}}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ProtoFromGoStruct(tt.inStruct, tt.inProto)
+			if diff := errdiff.Substring(err, tt.wantErrSubstring); diff != "" {
+				t.Fatalf("ProtoFromGoStruct(%v): did not get expected error, %s", tt.inStruct, diff)
+			}
+			if err != nil {
+				return
+			}
+			if diff := cmp.Diff(tt.want, tt.inProto, protocmp.Transform()); diff != "" {
+				t.Errorf("ProtoFromGoStruct(%v): did not get expected protobuf, (-want, +got):\n%s", tt.inStruct, diff)
+			}
+		})
+	}
+}
+
+func TestGoStructFromProto(t *testing.T) {
+	tests := []struct {
+		name             string
+		inProto          proto.Message
+		inStruct         ygot.GoStruct
+		inOpts           []UnmapOpt
+		want             ygot.GoStruct
+		wantErrSubstring string
+	}{{
+		name:     "unset enumeration",
+		inProto:  &epb.ExampleMessage{En: epb.ExampleEnum_ENUM_UNSET, Str: &wpb.StringValue{Value: "hello"}},
+		inStruct: &gsExample{},
+		want:     &gsExample{Str: ygot.String("hello")},
+	}, {
+		name:     "existing contents retained",
+		inProto:  &epb.ExampleMessage{Str: &wpb.StringValue{Value: "hello"}},
+		inStruct: &gsExample{En: gsEnumValOne},
+		want:     &gsExample{En: gsEnumValOne, Str: ygot.String("hello")},
+	}, {
+		name: "protobuf with fields not in GoStruct",
+		inProto: &epb.ExampleMessage{
+			Str: &wpb.StringValue{Value: "hello"},
+			Ex:  &epb.ExampleMessageChild{Str: &wpb.StringValue{Value: "child"}},
+		},
+		inStruct:         &gsExampleChild{},
+		wantErrSubstring: "did not find a GoStruct field",
+	}, {
+		name: "protobuf with fields not in GoStruct, ignored",
+		inProto: &epb.ExampleMessage{
+			Str: &wpb.StringValue{Value: "hello"},
+			Ex:  &epb.ExampleMessageChild{Str: &wpb.StringValue{Value: "child"}},
+		},
+		inStruct: &gsExampleChild{},
+		inOpts:   []UnmapOpt{IgnoreExtraPaths()},
+		want:     &gsExampleChild{Str: ygot.String("child")},
+	}, {
+		name:             "nil protobuf",
+		inStruct:         &gsExample{},
+		wantErrSubstring: "nil protobuf",
+	}, {
+		name:             "nil GoStruct",
+		inProto:          &epb.ExampleMessage{},
+		inStruct:         (*gsExample)(nil),
+		wantErrSubstring: "nil GoStruct",
+	}, {
+		name:             "container mapped to leaf",
+		inProto:          &epb.ExampleMessage{Ex: &epb.ExampleMessageChild{}},
+		inStruct:         &gsBadContainer{},
+		wantErrSubstring: "maps to a container",
+	}, {
+		name:             "invalid prefix",
+		inProto:          &epb.ExampleMessage{},
+		inStruct:         &gsExample{},
+		inOpts:           []UnmapOpt{ProtobufMessagePrefix(nil)},
+		wantErrSubstring: "invalid protobuf message prefix",
+	}}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := GoStructFromProto(tt.inProto, tt.inStruct, tt.inOpts...)
+			if diff := errdiff.Substring(err, tt.wantErrSubstring); diff != "" {
+				t.Fatalf("GoStructFromProto(%v): did not get expected error, %s", tt.inProto, diff)
+			}
+			if err != nil {
+				return
+			}
+			if diff := cmp.Diff(tt.want, tt.inStruct); diff != "" {
+				t.Errorf("GoStructFromProto(%v): did not get expected GoStruct, (-want, +got):\n%s", tt.inProto, diff)
+			}
+		})
+	}
+}
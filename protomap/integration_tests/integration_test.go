@@ -7,6 +7,7 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/openconfig/gribi/v1/proto/gribi_aft"
+	aftenums "github.com/openconfig/gribi/v1/proto/gribi_aft/enums"
 	"github.com/openconfig/ygot/protomap"
 	"github.com/openconfig/ygot/testutil"
 	"github.com/openconfig/ygot/ygot"
@@ -14,6 +15,8 @@ import (
 	"google.golang.org/protobuf/testing/protocmp"
 
 	gpb "github.com/openconfig/gnmi/proto/gnmi"
+	wpb "github.com/openconfig/ygot/proto/ywrapper"
+	epb "github.com/openconfig/ygot/protomap/testdata/exschemapath"
 )
 
 func mustPath(p string) *gpb.Path {
@@ -70,3 +73,125 @@ func TestGRIBIAFT(t *testing.T) {
 		})
 	}
 }
+
+func TestRoundTrip(t *testing.T) {
+	tests := []struct {
+		desc    string
+		inProto proto.Message
+	}{{
+		desc: "gRIBI IPv4 entry",
+		inProto: &gribi_aft.Afts{
+			Ipv4Entry: []*gribi_aft.Afts_Ipv4EntryKey{{
+				Prefix: "1.0.0.0/24",
+				Ipv4Entry: &gribi_aft.Afts_Ipv4Entry{
+					NextHopGroup: &wpb.UintValue{Value: 42},
+				},
+			}},
+		},
+	}, {
+		desc: "full gRIBI AFT tree",
+		// Label and policy forwarding entries are not included since the
+		// gRIBI protobufs were generated without schema paths for the fields
+		// of oneofs that represent unions.
+		inProto: &gribi_aft.Device{
+			Afts: &gribi_aft.Afts{
+				Ipv4Entry: []*gribi_aft.Afts_Ipv4EntryKey{{
+					Prefix: "1.0.0.0/24",
+					Ipv4Entry: &gribi_aft.Afts_Ipv4Entry{
+						DecapsulateHeader:           aftenums.OpenconfigAftTypesEncapsulationHeaderType_OPENCONFIGAFTTYPESENCAPSULATIONHEADERTYPE_GRE,
+						Metadata:                    &wpb.BytesValue{Value: []byte{1, 2, 3}},
+						NextHopGroup:                &wpb.UintValue{Value: 42},
+						NextHopGroupNetworkInstance: &wpb.StringValue{Value: "DEFAULT"},
+					},
+				}, {
+					Prefix: "2.0.0.0/24",
+					Ipv4Entry: &gribi_aft.Afts_Ipv4Entry{
+						NextHopGroup: &wpb.UintValue{Value: 84},
+					},
+				}},
+				Ipv6Entry: []*gribi_aft.Afts_Ipv6EntryKey{{
+					Prefix: "2001:db8::/32",
+					Ipv6Entry: &gribi_aft.Afts_Ipv6Entry{
+						NextHopGroup: &wpb.UintValue{Value: 42},
+					},
+				}},
+				NextHop: []*gribi_aft.Afts_NextHopKey{{
+					Index: 1,
+					NextHop: &gribi_aft.Afts_NextHop{
+						IpAddress: &wpb.StringValue{Value: "192.0.2.1"},
+						InterfaceRef: &gribi_aft.Afts_NextHop_InterfaceRef{
+							Interface:    &wpb.StringValue{Value: "eth0"},
+							Subinterface: &wpb.UintValue{Value: 0},
+						},
+						PushedMplsLabelStack: []*gribi_aft.Afts_NextHop_PushedMplsLabelStackUnion{
+							{PushedMplsLabelStackUint64: 200},
+							{PushedMplsLabelStackUint64: 300},
+						},
+					},
+				}, {
+					Index: 2,
+					NextHop: &gribi_aft.Afts_NextHop{
+						IpInIp: &gribi_aft.Afts_NextHop_IpInIp{
+							SrcIp: &wpb.StringValue{Value: "192.0.2.1"},
+							DstIp: &wpb.StringValue{Value: "192.0.2.2"},
+						},
+					},
+				}},
+				NextHopGroup: []*gribi_aft.Afts_NextHopGroupKey{{
+					Id: 42,
+					NextHopGroup: &gribi_aft.Afts_NextHopGroup{
+						BackupNextHopGroup: &wpb.UintValue{Value: 84},
+						NextHop: []*gribi_aft.Afts_NextHopGroup_NextHopKey{{
+							Index:   1,
+							NextHop: &gribi_aft.Afts_NextHopGroup_NextHop{Weight: &wpb.UintValue{Value: 1}},
+						}, {
+							Index:   2,
+							NextHop: &gribi_aft.Afts_NextHopGroup_NextHop{Weight: &wpb.UintValue{Value: 3}},
+						}},
+					},
+				}},
+			},
+		},
+	}, {
+		desc: "leaf-lists, unions and keyed lists",
+		inProto: &epb.ExampleMessage{
+			Str:      &wpb.StringValue{Value: "hello"},
+			De:       &wpb.Decimal64Value{Digits: 1234, Precision: 1},
+			LeafList: []*wpb.StringValue{{Value: "one"}, {Value: "two"}},
+			EnumList: []epb.ExampleEnum{epb.ExampleEnum_ENUM_VALONE},
+			Union:    &epb.ExampleMessage_UnionSint64{UnionSint64: -42},
+			UnionList: []*epb.ExampleMessage_UnionListUnion{
+				{UnionListString: "hello"},
+				{UnionListUint64: 42},
+			},
+			UnionKeyList: []*epb.UnionKeyListKey{{
+				Key: &epb.UnionKeyListKey_KeyString{KeyString: "forty-two"},
+				Member: &epb.UnionKeyListMember{
+					Value: &wpb.StringValue{Value: "value"},
+				},
+			}},
+			EnumKeyList: []*epb.EnumKeyListKey{{
+				Key:    epb.ExampleEnum_ENUM_VALTWO,
+				Member: &epb.EnumKeyListMember{},
+			}},
+		},
+	}}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			paths, err := protomap.PathsFromProto(tt.inProto)
+			if err != nil {
+				t.Fatalf("cannot map proto to paths, %v", err)
+			}
+
+			got := tt.inProto.ProtoReflect().New().Interface()
+			if err := protomap.ProtoFromPaths(got, paths); err != nil {
+				t.Fatalf("cannot map paths to proto, %v", err)
+			}
+
+			if diff := cmp.Diff(got, tt.inProto, protocmp.Transform()); diff != "" {
+				t.Fatalf("did not get expected results, diff(-got,+want):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,572 @@
+// Copyright 2021 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package protomap
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/openconfig/gnmi/value"
+	"github.com/openconfig/ygot/util"
+	"github.com/openconfig/ygot/ygot"
+
+	gpb "github.com/openconfig/gnmi/proto/gnmi"
+	wpb "github.com/openconfig/ygot/proto/ywrapper"
+)
+
+// ProtoFromNotifications unmarshals the contents of the gNMI Notifications ns
+// into the ygot-generated protobuf p, which is modified in place. The
+// Notifications are applied in the order that they are supplied. Within each
+// Notification, the deleted paths are cleared from the protobuf - removing
+// the matching entries where a path refers to entries of a keyed list - before
+// the updates are mapped into it.
+//
+// The path of each update and delete is relative to the prefix of its
+// Notification. The value of an update may be any scalar TypedValue, a
+// LeaflistVal, or a JSON_IETF or JSON value. JSON values are decoded
+// according to the types of the protobuf fields that they are mapped to, as
+// described in RFC7951.
+//
+// The set of UnmapOpts that are provided (opt) are used to control the
+// behaviour of unmarshalling the specified data as per ProtoFromPaths. Since
+// the prefix of each Notification is used, ValuePathPrefix cannot be supplied.
+func ProtoFromNotifications(p proto.Message, ns []*gpb.Notification, opt ...UnmapOpt) error {
+	if p == nil {
+		return errors.New("nil protobuf supplied")
+	}
+
+	for _, o := range opt {
+		if _, ok := o.(*valuePathPrefix); ok {
+			return errors.New("ValuePathPrefix cannot be used with Notifications, the Notification prefix is used")
+		}
+	}
+
+	protoPrefix, err := hasProtoMsgPrefix(opt)
+	if err != nil {
+		return fmt.Errorf("invalid protobuf message prefix supplied in options, %v", err)
+	}
+
+	for _, n := range ns {
+		for _, d := range n.GetDelete() {
+			absPath := joinPaths(n.GetPrefix(), d)
+			if !pathMatchesSchemaPrefix(absPath, protoPrefix) {
+				return fmt.Errorf("invalid path provided, absolute paths must be used, %s does not have prefix %s", absPath, protoPrefix)
+			}
+			if err := deletePath(p.ProtoReflect(), absPath, schemaPathNames(protoPrefix)); err != nil {
+				return fmt.Errorf("cannot delete path %s, %v", absPath, err)
+			}
+		}
+
+		vals := map[*gpb.Path]interface{}{}
+		for _, u := range n.GetUpdate() {
+			absPath := joinPaths(n.GetPrefix(), u.GetPath())
+			switch {
+			case u.GetVal().GetJsonIetfVal() != nil:
+				err = jsonLeaves(p.ProtoReflect().Descriptor(), protoPrefix, absPath, u.GetVal().GetJsonIetfVal(), vals)
+			case u.GetVal().GetJsonVal() != nil:
+				err = jsonLeaves(p.ProtoReflect().Descriptor(), protoPrefix, absPath, u.GetVal().GetJsonVal(), vals)
+			default:
+				vals[absPath] = u.GetVal()
+			}
+			if err != nil {
+				return fmt.Errorf("cannot decode JSON value for path %s, %v", absPath, err)
+			}
+		}
+		if err := ProtoFromPaths(p, vals, opt...); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// NotificationsFromProto returns a gNMI Notification containing the
+// populated fields of the ygot-generated protobuf p, marked with the
+// timestamp ts. The prefix of the Notification is the longest path
+// that is common to all of the updates within it.
+func NotificationsFromProto(p proto.Message, ts int64) ([]*gpb.Notification, error) {
+	vals, err := PathsFromProto(p)
+	if err != nil {
+		return nil, err
+	}
+
+	type pathString struct {
+		p *gpb.Path
+		s string
+	}
+	var paths []pathString
+	for path := range vals {
+		s, err := ygot.PathToString(path)
+		if err != nil {
+			return nil, fmt.Errorf("invalid path %s, %v", path, err)
+		}
+		paths = append(paths, pathString{p: path, s: s})
+	}
+	// Sort the paths such that the order of the updates is deterministic.
+	sort.Slice(paths, func(i, j int) bool { return paths[i].s < paths[j].s })
+
+	var ps []*gpb.Path
+	for _, path := range paths {
+		ps = append(ps, path.p)
+	}
+	prefix := commonPrefix(ps)
+
+	n := &gpb.Notification{Timestamp: ts}
+	if len(prefix) != 0 {
+		n.Prefix = &gpb.Path{Elem: prefix}
+	}
+	for _, path := range ps {
+		tv, err := typedValue(vals[path])
+		if err != nil {
+			return nil, fmt.Errorf("cannot map value for path %s, %v", path, err)
+		}
+		n.Update = append(n.Update, &gpb.Update{
+			Path: &gpb.Path{Elem: path.Elem[len(prefix):]},
+			Val:  tv,
+		})
+	}
+	return []*gpb.Notification{n}, nil
+}
+
+// commonPrefix returns the longest set of path elements that is a prefix of
+// all of the supplied paths. At least one element of each path is excluded from
+// the prefix, such that no path is empty once the prefix is removed.
+func commonPrefix(paths []*gpb.Path) []*gpb.PathElem {
+	if len(paths) == 0 {
+		return nil
+	}
+	n := len(paths[0].GetElem()) - 1
+	for _, p := range paths[1:] {
+		if l := len(p.GetElem()) - 1; l < n {
+			n = l
+		}
+		for i := 0; i < n; i++ {
+			if !util.PathElemsEqual(paths[0].Elem[i], p.Elem[i]) {
+				n = i
+				break
+			}
+		}
+	}
+	if n <= 0 {
+		return nil
+	}
+	return paths[0].Elem[:n]
+}
+
+// typedValue returns the gNMI TypedValue for v, which is a value returned
+// by PathsFromProto.
+func typedValue(v interface{}) (*gpb.TypedValue, error) {
+	switch t := v.(type) {
+	case *gpb.Decimal64:
+		return &gpb.TypedValue{Value: &gpb.TypedValue_DecimalVal{DecimalVal: t}}, nil
+	case []interface{}:
+		sa := &gpb.ScalarArray{}
+		for _, e := range t {
+			tv, err := typedValue(e)
+			if err != nil {
+				return nil, err
+			}
+			sa.Element = append(sa.Element, tv)
+		}
+		return &gpb.TypedValue{Value: &gpb.TypedValue_LeaflistVal{LeaflistVal: sa}}, nil
+	}
+	return value.FromScalar(v)
+}
+
+// joinPaths returns the path that results from appending the elements of p
+// to those of prefix.
+func joinPaths(prefix, p *gpb.Path) *gpb.Path {
+	return &gpb.Path{
+		Elem: append(append([]*gpb.PathElem{}, prefix.GetElem()...), p.GetElem()...),
+	}
+}
+
+// deletePath clears the fields of the message m, which has the schema path
+// base, that correspond to the absolute data tree path p. Where p refers to
+// a keyed list, the entries of the list whose keys match those in p are
+// removed. Paths that do not correspond to populated fields are ignored.
+func deletePath(m protoreflect.Message, p *gpb.Path, base []string) error {
+	names := schemaPathNames(p)
+	fds := m.Descriptor().Fields()
+	for i := 0; i < fds.Len(); i++ {
+		fd := fds.Get(i)
+		if fd.IsMap() {
+			continue
+		}
+		annotatedPath, err := annotatedSchemaPath(fd)
+		if err != nil {
+			return err
+		}
+		for _, ap := range annotatedPath {
+			an := schemaPathNames(ap)
+			if !namesHavePrefix(an, base) {
+				return fmt.Errorf("annotation %s does not match the supplied prefix %s", ap, util.SlicePathToString(base))
+			}
+
+			isList := fd.IsList() && !isLeafList(fd)
+			switch {
+			case namesHavePrefix(an, names):
+				// The deleted path is the path of the field, or one of its
+				// ancestors, and hence the field is removed.
+				if isList && len(an) == len(names) && len(p.Elem[len(an)-1].GetKey()) != 0 {
+					deleteListEntries(m, fd, an, p.Elem[len(an)-1].GetKey())
+					break
+				}
+				m.Clear(fd)
+			case !m.Has(fd) || !namesHavePrefix(names, an):
+			case isContainer(fd):
+				cm := m.Mutable(fd).Message()
+				if err := deletePath(cm, p, an); err != nil {
+					return err
+				}
+				if proto.Size(cm.Interface()) == 0 {
+					m.Clear(fd)
+				}
+			case isList:
+				l := m.Mutable(fd).List()
+				for j := 0; j < l.Len(); j++ {
+					km := l.Get(j).Message()
+					if !listEntryMatches(km, an, p.Elem[len(an)-1].GetKey()) {
+						continue
+					}
+					if err := deleteFromListMember(km, p, an); err != nil {
+						return err
+					}
+				}
+			default:
+				continue
+			}
+			// The field has been handled by one of its annotations.
+			break
+		}
+	}
+	return nil
+}
+
+// deleteFromListMember clears the fields corresponding to the path p within
+// the member message of the list entry km, which has the schema path listPath.
+func deleteFromListMember(km protoreflect.Message, p *gpb.Path, listPath []string) error {
+	fds := km.Descriptor().Fields()
+	for i := 0; i < fds.Len(); i++ {
+		fd := fds.Get(i)
+		if !isListMember(fd) || !km.Has(fd) {
+			continue
+		}
+		if err := deletePath(km.Mutable(fd).Message(), p, listPath); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// deleteListEntries removes the entries of the list field fd of message m,
+// which has the schema path listPath, that match the supplied keys.
+func deleteListEntries(m protoreflect.Message, fd protoreflect.FieldDescriptor, listPath []string, keys map[string]string) {
+	if !m.Has(fd) {
+		return
+	}
+	l := m.Get(fd).List()
+	nl := m.NewField(fd).List()
+	for i := 0; i < l.Len(); i++ {
+		if !listEntryMatches(l.Get(i).Message(), listPath, keys) {
+			nl.Append(l.Get(i))
+		}
+	}
+	if nl.Len() == 0 {
+		m.Clear(fd)
+		return
+	}
+	m.Set(fd, protoreflect.ValueOfList(nl))
+}
+
+// listEntryMatches determines whether the key message km of an entry of the
+// list with schema path listPath has the values specified by keys, which are
+// the keys of a gNMI path element. A key with the value "*" matches any entry.
+func listEntryMatches(km protoreflect.Message, listPath []string, keys map[string]string) bool {
+	for name, want := range keys {
+		if want == "*" {
+			continue
+		}
+		keyPath := append(append([]string{}, listPath...), name)
+		var matched bool
+		fds := km.Descriptor().Fields()
+		for i := 0; i < fds.Len() && !matched; i++ {
+			fd := fds.Get(i)
+			if isListMember(fd) {
+				continue
+			}
+			if oo := fd.ContainingOneof(); oo != nil && !oo.IsSynthetic() && km.WhichOneof(oo) != fd {
+				continue
+			}
+			annotatedPath, err := annotatedSchemaPath(fd)
+			if err != nil {
+				continue
+			}
+			for _, ap := range annotatedPath {
+				if !stringSlicesEqual(schemaPathNames(ap), keyPath) {
+					continue
+				}
+				v, ok, err := fieldValueFromProto(fd, km.Get(fd))
+				if err != nil || !ok {
+					return false
+				}
+				if fmt.Sprintf("%v", v) != want {
+					return false
+				}
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return false
+		}
+	}
+	return true
+}
+
+// stringSlicesEqual determines whether the string slices a and b are equal.
+func stringSlicesEqual(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// jsonValue is a leaf value that was decoded from RFC7951 JSON. Its concrete
+// type is determined by the protobuf field that it is mapped to.
+type jsonValue struct {
+	// v is the value decoded by encoding/json, with numbers decoded as
+	// json.Number.
+	v interface{}
+}
+
+// String returns a human-readable form of the JSON value.
+func (j *jsonValue) String() string { return fmt.Sprintf("%v", j.v) }
+
+// jsonLeaves decodes the JSON value b at the absolute path p, and stores each
+// of the leaves that it contains in vals. md is the descriptor of the
+// protobuf message which has the schema path protoPrefix, which is used to
+// determine the keys of any lists within the value.
+func jsonLeaves(md protoreflect.MessageDescriptor, protoPrefix, p *gpb.Path, b []byte, vals map[*gpb.Path]interface{}) error {
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+	var v interface{}
+	if err := dec.Decode(&v); err != nil {
+		return err
+	}
+	return flattenJSON(md, schemaPathNames(protoPrefix), p, v, vals)
+}
+
+// flattenJSON stores each leaf within the decoded JSON value v, which is at
+// the path p, in vals.
+func flattenJSON(md protoreflect.MessageDescriptor, base []string, p *gpb.Path, v interface{}, vals map[*gpb.Path]interface{}) error {
+	switch t := v.(type) {
+	case map[string]interface{}:
+		for k, cv := range t {
+			if err := flattenJSON(md, base, appendElem(p, &gpb.PathElem{Name: stripModule(k)}), cv, vals); err != nil {
+				return err
+			}
+		}
+		return nil
+	case []interface{}:
+		if len(t) == 0 {
+			break
+		}
+		if _, ok := t[0].(map[string]interface{}); !ok {
+			break
+		}
+		// An array of objects is a keyed list.
+		names := schemaPathNames(p)
+		keyNames, ok, err := listKeyNames(md, base, names)
+		switch {
+		case err != nil:
+			return err
+		case !ok:
+			return fmt.Errorf("cannot find list %s in message %s", util.SlicePathToString(names), md.FullName())
+		}
+		for _, e := range t {
+			obj, ok := e.(map[string]interface{})
+			if !ok {
+				return fmt.Errorf("invalid list entry %v for list %s", e, util.SlicePathToString(names))
+			}
+			keys := map[string]string{}
+			for k, kv := range obj {
+				if n := stripModule(k); keyNames[n] {
+					keys[n] = jsonKeyString(kv)
+				}
+			}
+			if len(keys) != len(keyNames) {
+				return fmt.Errorf("list entry %v for list %s does not specify all keys", e, util.SlicePathToString(names))
+			}
+			ep := proto.Clone(p).(*gpb.Path)
+			ep.Elem[len(ep.Elem)-1].Key = keys
+			if err := flattenJSON(md, base, ep, obj, vals); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	vals[p] = &jsonValue{v: v}
+	return nil
+}
+
+// appendElem returns a copy of the path p with the element e appended.
+func appendElem(p *gpb.Path, e *gpb.PathElem) *gpb.Path {
+	return &gpb.Path{Elem: append(append([]*gpb.PathElem{}, p.GetElem()...), e)}
+}
+
+// stripModule removes the module name qualifier from an RFC7951 member name
+// or identity value, returning the unqualified name.
+func stripModule(s string) string {
+	if i := strings.Index(s, ":"); i != -1 {
+		return s[i+1:]
+	}
+	return s
+}
+
+// jsonKeyString returns the string form of the JSON value v, for use as the
+// value of a key in a gNMI path.
+func jsonKeyString(v interface{}) string {
+	if s, ok := v.(string); ok {
+		return stripModule(s)
+	}
+	return fmt.Sprintf("%v", v)
+}
+
+// listKeyNames returns the names of the keys of the list with the schema path
+// listPath, which is found within the message md that has the schema path
+// base. It returns false if the list is not found.
+func listKeyNames(md protoreflect.MessageDescriptor, base, listPath []string) (map[string]bool, bool, error) {
+	fds := md.Fields()
+	for i := 0; i < fds.Len(); i++ {
+		fd := fds.Get(i)
+		if isListMember(fd) {
+			if keys, ok, err := listKeyNames(fd.Message(), base, listPath); err != nil || ok {
+				return keys, ok, err
+			}
+			continue
+		}
+		if fd.IsMap() || fd.Kind() != protoreflect.MessageKind || isLeafList(fd) || isWrapper(fd.Message()) {
+			continue
+		}
+		annotatedPath, err := annotatedSchemaPath(fd)
+		if err != nil {
+			return nil, false, err
+		}
+		for _, ap := range annotatedPath {
+			an := schemaPathNames(ap)
+			switch {
+			case fd.IsList() && stringSlicesEqual(an, listPath):
+				keys := map[string]bool{}
+				kfds := fd.Message().Fields()
+				for j := 0; j < kfds.Len(); j++ {
+					kap, err := annotatedSchemaPath(kfds.Get(j))
+					if err != nil {
+						continue
+					}
+					for _, k := range kap {
+						if kn := schemaPathNames(k); len(kn) == len(listPath)+1 && namesHavePrefix(kn, listPath) {
+							keys[kn[len(kn)-1]] = true
+						}
+					}
+				}
+				return keys, true, nil
+			case len(an) < len(listPath) && namesHavePrefix(listPath, an):
+				if keys, ok, err := listKeyNames(fd.Message(), an, listPath); err != nil || ok {
+					return keys, ok, err
+				}
+			}
+		}
+	}
+	return nil, false, nil
+}
+
+// goValue returns the Go value of the JSON value for the protobuf field fd,
+// such that it can be mapped to the field by fieldValue.
+func (j *jsonValue) goValue(fd protoreflect.FieldDescriptor) (interface{}, error) {
+	kind := fd.Kind()
+	if kind == protoreflect.MessageKind {
+		switch fd.Message().FullName() {
+		case wrapperName(&wpb.StringValue{}):
+			kind = protoreflect.StringKind
+		case wrapperName(&wpb.UintValue{}):
+			kind = protoreflect.Uint64Kind
+		case wrapperName(&wpb.IntValue{}):
+			kind = protoreflect.Int64Kind
+		case wrapperName(&wpb.BoolValue{}):
+			kind = protoreflect.BoolKind
+		case wrapperName(&wpb.BytesValue{}):
+			kind = protoreflect.BytesKind
+		case wrapperName(&wpb.Decimal64Value{}):
+			// Decimal64 values are encoded as strings in RFC7951 JSON.
+			d, err := parseDecimal64(jsonKeyString(j.v))
+			if err != nil {
+				return nil, fmt.Errorf("invalid decimal64 value %v for field %s, %v", j.v, fd.FullName(), err)
+			}
+			return d, nil
+		default:
+			return nil, fmt.Errorf("cannot map JSON value %v to message field %s", j.v, fd.FullName())
+		}
+	}
+
+	var s string
+	switch t := j.v.(type) {
+	case string:
+		s = t
+	case json.Number:
+		s = t.String()
+	case bool:
+		if kind != protoreflect.BoolKind {
+			return nil, fmt.Errorf("got bool value for %s field %s", kind, fd.FullName())
+		}
+		return t, nil
+	default:
+		return nil, fmt.Errorf("got %T value for %s field %s", j.v, kind, fd.FullName())
+	}
+
+	switch kind {
+	case protoreflect.EnumKind:
+		// Identity values are qualified with the name of their module.
+		return stripModule(s), nil
+	case protoreflect.StringKind:
+		if _, ok := j.v.(string); !ok {
+			return nil, fmt.Errorf("got number value for string field %s", fd.FullName())
+		}
+		return s, nil
+	case protoreflect.BytesKind:
+		return base64.StdEncoding.DecodeString(s)
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind,
+		protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		return strconv.ParseInt(s, 10, 64)
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind, protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return strconv.ParseUint(s, 10, 64)
+	case protoreflect.FloatKind, protoreflect.DoubleKind:
+		return strconv.ParseFloat(s, 64)
+	}
+	return nil, fmt.Errorf("got %T value for %s field %s", j.v, kind, fd.FullName())
+}
@@ -0,0 +1,431 @@
+// Copyright 2021 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package protomap
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/openconfig/gnmi/errdiff"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	gpb "github.com/openconfig/gnmi/proto/gnmi"
+	wpb "github.com/openconfig/ygot/proto/ywrapper"
+	epb "github.com/openconfig/ygot/protomap/testdata/exschemapath"
+)
+
+func TestProtoFromNotifications(t *testing.T) {
+	tests := []struct {
+		desc             string
+		inProto          proto.Message
+		inNotifications  []*gpb.Notification
+		inOpt            []UnmapOpt
+		wantProto        proto.Message
+		wantErrSubstring string
+	}{{
+		desc:    "scalar updates with prefix",
+		inProto: &epb.ExampleMessage{},
+		inNotifications: []*gpb.Notification{{
+			Prefix: mustPath("/union-key-list[key=forty-two]"),
+			Update: []*gpb.Update{{
+				Path: mustPath("config/value"),
+				Val:  &gpb.TypedValue{Value: &gpb.TypedValue_StringVal{StringVal: "value"}},
+			}},
+		}, {
+			Update: []*gpb.Update{{
+				Path: mustPath("/decimal"),
+				Val:  &gpb.TypedValue{Value: &gpb.TypedValue_DecimalVal{DecimalVal: &gpb.Decimal64{Digits: 1234, Precision: 1}}},
+			}, {
+				Path: mustPath("/enum"),
+				Val:  &gpb.TypedValue{Value: &gpb.TypedValue_StringVal{StringVal: "VAL_ONE"}},
+			}},
+		}},
+		wantProto: &epb.ExampleMessage{
+			De: &wpb.Decimal64Value{Digits: 1234, Precision: 1},
+			En: epb.ExampleEnum_ENUM_VALONE,
+			UnionKeyList: []*epb.UnionKeyListKey{{
+				Key: &epb.UnionKeyListKey_KeyString{KeyString: "forty-two"},
+				Member: &epb.UnionKeyListMember{
+					Value: &wpb.StringValue{Value: "value"},
+				},
+			}},
+		},
+	}, {
+		desc:    "updates to an existing list entry",
+		inProto: &epb.ExampleMessage{},
+		inNotifications: []*gpb.Notification{{
+			Update: []*gpb.Update{{
+				Path: mustPath("/enum-key-list[key=VAL_ONE]/config/value"),
+				Val:  &gpb.TypedValue{Value: &gpb.TypedValue_StringVal{StringVal: "one"}},
+			}},
+		}, {
+			Update: []*gpb.Update{{
+				Path: mustPath("/enum-key-list[key=VAL_ONE]/config/value"),
+				Val:  &gpb.TypedValue{Value: &gpb.TypedValue_StringVal{StringVal: "uno"}},
+			}},
+		}},
+		wantProto: &epb.ExampleMessage{
+			EnumKeyList: []*epb.EnumKeyListKey{{
+				Key: epb.ExampleEnum_ENUM_VALONE,
+				Member: &epb.EnumKeyListMember{
+					Value: &wpb.StringValue{Value: "uno"},
+				},
+			}},
+		},
+	}, {
+		desc: "delete leaf",
+		inProto: &epb.ExampleMessage{
+			Str: &wpb.StringValue{Value: "hello"},
+			Ex: &epb.ExampleMessageChild{
+				Str: &wpb.StringValue{Value: "world"},
+			},
+		},
+		inNotifications: []*gpb.Notification{{
+			Delete: []*gpb.Path{mustPath("/string"), mustPath("/message/str")},
+		}},
+		wantProto: &epb.ExampleMessage{},
+	}, {
+		desc: "delete union",
+		inProto: &epb.ExampleMessage{
+			Union: &epb.ExampleMessage_UnionSint64{UnionSint64: 42},
+		},
+		inNotifications: []*gpb.Notification{{
+			Delete: []*gpb.Path{mustPath("/union")},
+		}},
+		wantProto: &epb.ExampleMessage{},
+	}, {
+		desc: "delete before update",
+		inProto: &epb.ExampleMessage{
+			Str: &wpb.StringValue{Value: "hello"},
+		},
+		inNotifications: []*gpb.Notification{{
+			Delete: []*gpb.Path{mustPath("/string")},
+			Update: []*gpb.Update{{
+				Path: mustPath("/string"),
+				Val:  &gpb.TypedValue{Value: &gpb.TypedValue_StringVal{StringVal: "world"}},
+			}},
+		}},
+		wantProto: &epb.ExampleMessage{
+			Str: &wpb.StringValue{Value: "world"},
+		},
+	}, {
+		desc: "delete list entry",
+		inProto: &epb.ExampleMessage{
+			EnumKeyList: []*epb.EnumKeyListKey{{
+				Key:    epb.ExampleEnum_ENUM_VALONE,
+				Member: &epb.EnumKeyListMember{},
+			}, {
+				Key:    epb.ExampleEnum_ENUM_VALTWO,
+				Member: &epb.EnumKeyListMember{},
+			}},
+		},
+		inNotifications: []*gpb.Notification{{
+			Delete: []*gpb.Path{mustPath("/enum-key-list[key=VAL_ONE]")},
+		}},
+		wantProto: &epb.ExampleMessage{
+			EnumKeyList: []*epb.EnumKeyListKey{{
+				Key:    epb.ExampleEnum_ENUM_VALTWO,
+				Member: &epb.EnumKeyListMember{},
+			}},
+		},
+	}, {
+		desc: "delete list entries with union key",
+		inProto: &epb.ExampleMessage{
+			UnionKeyList: []*epb.UnionKeyListKey{{
+				Key: &epb.UnionKeyListKey_KeyUint64{KeyUint64: 42},
+			}, {
+				Key: &epb.UnionKeyListKey_KeyString{KeyString: "forty-two"},
+			}},
+		},
+		inNotifications: []*gpb.Notification{{
+			Delete: []*gpb.Path{mustPath("/union-key-list[key=42]")},
+		}},
+		wantProto: &epb.ExampleMessage{
+			UnionKeyList: []*epb.UnionKeyListKey{{
+				Key: &epb.UnionKeyListKey_KeyString{KeyString: "forty-two"},
+			}},
+		},
+	}, {
+		desc: "delete all list entries",
+		inProto: &epb.ExampleMessage{
+			EnumKeyList: []*epb.EnumKeyListKey{{
+				Key: epb.ExampleEnum_ENUM_VALONE,
+			}, {
+				Key: epb.ExampleEnum_ENUM_VALTWO,
+			}},
+		},
+		inNotifications: []*gpb.Notification{{
+			Delete: []*gpb.Path{mustPath("/enum-key-list[key=*]")},
+		}},
+		wantProto: &epb.ExampleMessage{},
+	}, {
+		desc: "delete leaf within list entry",
+		inProto: &epb.ExampleMessage{
+			EnumKeyList: []*epb.EnumKeyListKey{{
+				Key: epb.ExampleEnum_ENUM_VALONE,
+				Member: &epb.EnumKeyListMember{
+					Value: &wpb.StringValue{Value: "one"},
+				},
+			}, {
+				Key: epb.ExampleEnum_ENUM_VALTWO,
+				Member: &epb.EnumKeyListMember{
+					Value: &wpb.StringValue{Value: "two"},
+				},
+			}},
+		},
+		inNotifications: []*gpb.Notification{{
+			Prefix: mustPath("/enum-key-list[key=VAL_TWO]"),
+			Delete: []*gpb.Path{mustPath("config")},
+		}},
+		wantProto: &epb.ExampleMessage{
+			EnumKeyList: []*epb.EnumKeyListKey{{
+				Key: epb.ExampleEnum_ENUM_VALONE,
+				Member: &epb.EnumKeyListMember{
+					Value: &wpb.StringValue{Value: "one"},
+				},
+			}, {
+				Key:    epb.ExampleEnum_ENUM_VALTWO,
+				Member: &epb.EnumKeyListMember{},
+			}},
+		},
+	}, {
+		desc:    "delete of unpopulated path",
+		inProto: &epb.ExampleMessage{},
+		inNotifications: []*gpb.Notification{{
+			Delete: []*gpb.Path{mustPath("/message/str"), mustPath("/list-name[single-key=one]")},
+		}},
+		wantProto: &epb.ExampleMessage{},
+	}, {
+		desc:    "JSON_IETF leaves",
+		inProto: &epb.ExampleMessage{},
+		inNotifications: []*gpb.Notification{{
+			Update: []*gpb.Update{{
+				Path: mustPath("/uint"),
+				Val:  &gpb.TypedValue{Value: &gpb.TypedValue_JsonIetfVal{JsonIetfVal: []byte(`"18446744073709551615"`)}},
+			}, {
+				Path: mustPath("/enum"),
+				Val:  &gpb.TypedValue{Value: &gpb.TypedValue_JsonIetfVal{JsonIetfVal: []byte(`"example-module:VAL_TWO"`)}},
+			}, {
+				Path: mustPath("/leaf-list"),
+				Val:  &gpb.TypedValue{Value: &gpb.TypedValue_JsonIetfVal{JsonIetfVal: []byte(`["one", "two"]`)}},
+			}, {
+				Path: mustPath("/union"),
+				Val:  &gpb.TypedValue{Value: &gpb.TypedValue_JsonIetfVal{JsonIetfVal: []byte(`"-42"`)}},
+			}},
+		}},
+		wantProto: &epb.ExampleMessage{
+			Ui:       &wpb.UintValue{Value: 18446744073709551615},
+			En:       epb.ExampleEnum_ENUM_VALTWO,
+			LeafList: []*wpb.StringValue{{Value: "one"}, {Value: "two"}},
+			Union:    &epb.ExampleMessage_UnionSint64{UnionSint64: -42},
+		},
+	}, {
+		desc:    "JSON_IETF tree",
+		inProto: &epb.ExampleMessage{},
+		inNotifications: []*gpb.Notification{{
+			Update: []*gpb.Update{{
+				Path: &gpb.Path{},
+				Val: &gpb.TypedValue{Value: &gpb.TypedValue_JsonIetfVal{JsonIetfVal: []byte(`{
+					"example-module:decimal": "12.34",
+					"example-module:bool": true,
+					"message": {"str": "hello"},
+					"enum-key-list": [{
+						"key": "example-module:VAL_ONE",
+						"config": {"key": "example-module:VAL_ONE", "value": "one"}
+					}],
+					"union-list": ["hello", "42"]
+				}`)}},
+			}},
+		}},
+		wantProto: &epb.ExampleMessage{
+			De: &wpb.Decimal64Value{Digits: 1234, Precision: 2},
+			Bo: &wpb.BoolValue{Value: true},
+			Ex: &epb.ExampleMessageChild{
+				Str: &wpb.StringValue{Value: "hello"},
+			},
+			EnumKeyList: []*epb.EnumKeyListKey{{
+				Key: epb.ExampleEnum_ENUM_VALONE,
+				Member: &epb.EnumKeyListMember{
+					Value: &wpb.StringValue{Value: "one"},
+				},
+			}},
+			UnionList: []*epb.ExampleMessage_UnionListUnion{
+				{UnionListString: "hello"},
+				{UnionListUint64: 42},
+			},
+		},
+	}, {
+		desc:    "JSON list entry without keys",
+		inProto: &epb.ExampleMessage{},
+		inNotifications: []*gpb.Notification{{
+			Update: []*gpb.Update{{
+				Path: mustPath("/enum-key-list"),
+				Val:  &gpb.TypedValue{Value: &gpb.TypedValue_JsonIetfVal{JsonIetfVal: []byte(`[{"config": {"value": "one"}}]`)}},
+			}},
+		}},
+		wantErrSubstring: "does not specify all keys",
+	}, {
+		desc:    "invalid JSON",
+		inProto: &epb.ExampleMessage{},
+		inNotifications: []*gpb.Notification{{
+			Update: []*gpb.Update{{
+				Path: mustPath("/string"),
+				Val:  &gpb.TypedValue{Value: &gpb.TypedValue_JsonIetfVal{JsonIetfVal: []byte(`{`)}},
+			}},
+		}},
+		wantErrSubstring: "cannot decode JSON value",
+	}, {
+		desc:    "JSON value of wrong type",
+		inProto: &epb.ExampleMessage{},
+		inNotifications: []*gpb.Notification{{
+			Update: []*gpb.Update{{
+				Path: mustPath("/string"),
+				Val:  &gpb.TypedValue{Value: &gpb.TypedValue_JsonIetfVal{JsonIetfVal: []byte(`42`)}},
+			}},
+		}},
+		wantErrSubstring: "got number value for string field",
+	}, {
+		desc:    "value path prefix",
+		inProto: &epb.ExampleMessage{},
+		inOpt:   []UnmapOpt{ValuePathPrefix(mustPath("/message"))},
+		inNotifications: []*gpb.Notification{{
+			Update: []*gpb.Update{{
+				Path: mustPath("str"),
+				Val:  &gpb.TypedValue{Value: &gpb.TypedValue_StringVal{StringVal: "hello"}},
+			}},
+		}},
+		wantErrSubstring: "ValuePathPrefix cannot be used",
+	}, {
+		desc:             "nil input",
+		wantErrSubstring: "nil protobuf supplied",
+	}}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			err := ProtoFromNotifications(tt.inProto, tt.inNotifications, tt.inOpt...)
+			if diff := errdiff.Substring(err, tt.wantErrSubstring); diff != "" {
+				t.Fatalf("did not get expected error, %s", diff)
+			}
+			if err != nil {
+				return
+			}
+
+			if diff := cmp.Diff(tt.inProto, tt.wantProto, protocmp.Transform()); diff != "" {
+				t.Fatalf("did not get expected results, diff(-got,+want):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestNotificationsFromProto(t *testing.T) {
+	tests := []struct {
+		desc              string
+		inProto           proto.Message
+		wantNotifications []*gpb.Notification
+		wantErrSubstring  string
+	}{{
+		desc: "single leaf",
+		inProto: &epb.ExampleMessage{
+			Str: &wpb.StringValue{Value: "hello"},
+		},
+		wantNotifications: []*gpb.Notification{{
+			Timestamp: 42,
+			Update: []*gpb.Update{{
+				Path: mustPath("/string"),
+				Val:  &gpb.TypedValue{Value: &gpb.TypedValue_StringVal{StringVal: "hello"}},
+			}},
+		}},
+	}, {
+		desc: "common prefix within list entry",
+		inProto: &epb.ExampleMessage{
+			EnumKeyList: []*epb.EnumKeyListKey{{
+				Key: epb.ExampleEnum_ENUM_VALONE,
+				Member: &epb.EnumKeyListMember{
+					Value: &wpb.StringValue{Value: "one"},
+				},
+			}},
+		},
+		wantNotifications: []*gpb.Notification{{
+			Timestamp: 42,
+			Prefix:    mustPath("/enum-key-list[key=VAL_ONE]"),
+			Update: []*gpb.Update{{
+				Path: mustPath("config/key"),
+				Val:  &gpb.TypedValue{Value: &gpb.TypedValue_StringVal{StringVal: "VAL_ONE"}},
+			}, {
+				Path: mustPath("config/value"),
+				Val:  &gpb.TypedValue{Value: &gpb.TypedValue_StringVal{StringVal: "one"}},
+			}, {
+				Path: mustPath("key"),
+				Val:  &gpb.TypedValue{Value: &gpb.TypedValue_StringVal{StringVal: "VAL_ONE"}},
+			}},
+		}},
+	}, {
+		desc: "decimal64 and leaf-list values",
+		inProto: &epb.ExampleMessage{
+			De: &wpb.Decimal64Value{Digits: 1234, Precision: 1},
+			UnionList: []*epb.ExampleMessage_UnionListUnion{
+				{UnionListString: "hello"},
+				{UnionListUint64: 42},
+			},
+		},
+		wantNotifications: []*gpb.Notification{{
+			Timestamp: 42,
+			Update: []*gpb.Update{{
+				Path: mustPath("/decimal"),
+				Val:  &gpb.TypedValue{Value: &gpb.TypedValue_DecimalVal{DecimalVal: &gpb.Decimal64{Digits: 1234, Precision: 1}}},
+			}, {
+				Path: mustPath("/union-list"),
+				Val: &gpb.TypedValue{Value: &gpb.TypedValue_LeaflistVal{LeaflistVal: &gpb.ScalarArray{
+					Element: []*gpb.TypedValue{
+						{Value: &gpb.TypedValue_StringVal{StringVal: "hello"}},
+						{Value: &gpb.TypedValue_UintVal{UintVal: 42}},
+					},
+				}}},
+			}},
+		}},
+	}, {
+		desc: "invalid protobuf",
+		inProto: &epb.InvalidMessage{
+			MapField: map[string]string{"one": "two"},
+		},
+		wantErrSubstring: "invalid map, no key annotation",
+	}}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			got, err := NotificationsFromProto(tt.inProto, 42)
+			if diff := errdiff.Substring(err, tt.wantErrSubstring); diff != "" {
+				t.Fatalf("did not get expected error, %s", diff)
+			}
+			if err != nil {
+				return
+			}
+
+			if diff := cmp.Diff(got, tt.wantNotifications, protocmp.Transform()); diff != "" {
+				t.Fatalf("did not get expected notifications, diff(-got,+want):\n%s", diff)
+			}
+
+			// The notifications must be able to be mapped back to the input.
+			rt := tt.inProto.ProtoReflect().New().Interface()
+			if err := ProtoFromNotifications(rt, got); err != nil {
+				t.Fatalf("cannot map notifications to protobuf, %v", err)
+			}
+			if diff := cmp.Diff(rt, tt.inProto, protocmp.Transform()); diff != "" {
+				t.Fatalf("did not get expected protobuf after round trip, diff(-got,+want):\n%s", diff)
+			}
+		})
+	}
+}
@@ -20,6 +20,9 @@ package protomap
 import (
 	"errors"
 	"fmt"
+	"math"
+	"sort"
+	"strconv"
 	"strings"
 
 	"google.golang.org/protobuf/proto"
@@ -71,44 +74,36 @@ func pathsFromProtoInternal(p proto.Message, vals map[*gpb.Path]interface{}, bas
 // with the specified value. It appends entries to the supplied vals map, keyed by the data tree path that
 // the fields map to, and with the parsed value from the supplied protobuf message.
 func parseField(fd protoreflect.FieldDescriptor, v protoreflect.Value, vals map[*gpb.Path]interface{}, basePath *gpb.Path) error {
-	if fd.IsMap() {
-		return errors.New("map fields are not supported in ygen-generated protobufs")
-	}
-
 	annotatedPath, err := annotatedSchemaPath(fd)
 	if err != nil {
 		return err
 	}
 
-	// Set to scalar value by default -- we extract the value from the
-	// wrapper message, or child messages if required.
-	val := v.Interface()
+	if fd.IsMap() {
+		return parseMap(fd, v, vals, basePath, annotatedPath)
+	}
 
 	if fd.IsList() {
+		if isLeafList(fd) {
+			return parseLeafList(fd, v, vals, basePath, annotatedPath)
+		}
 		return parseList(fd, v, vals, basePath, annotatedPath)
 	}
 
-	// Handle messages that are field values
-	if fd.Kind() == protoreflect.MessageKind {
-		switch t := v.Message().Interface().(type) {
-		case *wpb.BoolValue:
-			val = t.GetValue()
-		case *wpb.BytesValue:
-			val = t.GetValue()
-		case *wpb.Decimal64Value:
-			return fmt.Errorf("unhandled type, decimal64")
-		case *wpb.IntValue:
-			val = t.GetValue()
-		case *wpb.StringValue:
-			val = t.GetValue()
-		case *wpb.UintValue:
-			val = t.GetValue()
-		case proto.Message:
-			if len(annotatedPath) != 1 {
-				return fmt.Errorf("invalid container, maps to >1 schema path, field: %s", fd.FullName())
-			}
-			return pathsFromProtoInternal(t, vals, basePath)
+	// Handle messages that are containers, rather than field values.
+	if isContainer(fd) {
+		if len(annotatedPath) != 1 {
+			return fmt.Errorf("invalid container, maps to >1 schema path, field: %s", fd.FullName())
 		}
+		return pathsFromProtoInternal(v.Message().Interface(), vals, basePath)
+	}
+
+	val, ok, err := fieldValueFromProto(fd, v)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return nil
 	}
 
 	// Handle cases where there is >1 path specified for a field based on
@@ -175,8 +170,6 @@ func parseList(fd protoreflect.FieldDescriptor, v protoreflect.Value, vals map[*
 	}
 	listPath := mapPath[0]
 
-	// TODO(robjs): This handles the case where we have a list - but not a leaf-list.
-	//              Add implementation to handle leaf lists.
 	l := v.List()
 	if fd.Kind() != protoreflect.MessageKind {
 		return fmt.Errorf("invalid list, value is not a proto message, %s - is %T", fd.FullName(), l.NewElement())
@@ -244,6 +237,115 @@ func parseList(fd protoreflect.FieldDescriptor, v protoreflect.Value, vals map[*
 	return nil
 }
 
+// parseMap parses the field described by fd, with value v - which must be a map field
+// in the protobuf that represents a YANG list with a single key, and appends the values
+// within each entry of the list to the value map. The map is keyed by the value of the
+// key leaf named by the map_key of the yext.field_annotation of the field, and its
+// values are the messages representing the subtree under the list at each key.
+func parseMap(fd protoreflect.FieldDescriptor, v protoreflect.Value, vals map[*gpb.Path]interface{}, basePath *gpb.Path, mapPath []*gpb.Path) error {
+	if len(mapPath) != 1 {
+		return fmt.Errorf("invalid map, does not map to 1 schema path, field: %s", fd.FullName())
+	}
+	keyName := mapKeyAnnotation(fd)
+	if keyName == "" {
+		return fmt.Errorf("invalid map, no key annotation, field: %s", fd.FullName())
+	}
+	if fd.MapValue().Kind() != protoreflect.MessageKind {
+		return fmt.Errorf("invalid map, value is not a proto message, field: %s", fd.FullName())
+	}
+
+	var rangeErr error
+	v.Map().Range(func(mk protoreflect.MapKey, mv protoreflect.Value) bool {
+		key := mk.Interface()
+		kv, err := ygot.KeyValueAsString(key)
+		if err != nil {
+			rangeErr = fmt.Errorf("cannot map list key %v, %v", key, err)
+			return false
+		}
+
+		p := proto.Clone(resolvedPath(basePath, mapPath[0])).(*gpb.Path)
+		p.Elem[len(p.Elem)-1].Key = map[string]string{keyName: kv}
+
+		// The key leaf is not included in the map value, hence its value is
+		// taken from the key of the map.
+		kp := proto.Clone(p).(*gpb.Path)
+		kp.Elem = append(kp.Elem, &gpb.PathElem{Name: keyName})
+		vals[kp] = key
+
+		if err := pathsFromProtoInternal(mv.Message().Interface(), vals, p); err != nil {
+			rangeErr = err
+			return false
+		}
+		return true
+	})
+	return rangeErr
+}
+
+// parseLeafList parses the field described by fd, with value v - which must be a
+// repeated field in the protobuf that represents a YANG leaf-list, and appends the
+// values of the leaf-list to the value map as a []interface{}. The members of the
+// leaf-list are either scalar, enumerated or wrapper values, or messages
+// representing a union, of which a single field is populated.
+func parseLeafList(fd protoreflect.FieldDescriptor, v protoreflect.Value, vals map[*gpb.Path]interface{}, basePath *gpb.Path, mapPath []*gpb.Path) error {
+	l := v.List()
+	llVal := []interface{}{}
+	for i := 0; i < l.Len(); i++ {
+		efd, ev := fd, l.Get(i)
+		if fd.Kind() == protoreflect.MessageKind && isUnionMessage(fd.Message()) {
+			efd = nil
+			ev.Message().Range(func(f protoreflect.FieldDescriptor, uv protoreflect.Value) bool {
+				efd, ev = f, uv
+				return false
+			})
+			if efd == nil {
+				return fmt.Errorf("invalid leaf-list, union member %d has no populated field, %s", i, fd.FullName())
+			}
+		}
+		val, ok, err := fieldValueFromProto(efd, ev)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			return fmt.Errorf("invalid leaf-list, member %d is unset, %s", i, fd.FullName())
+		}
+		llVal = append(llVal, val)
+	}
+
+	for _, path := range mapPath {
+		vals[resolvedPath(basePath, path)] = llVal
+	}
+	return nil
+}
+
+// fieldValueFromProto returns the value of the non-repeated protobuf field fd, or
+// the member of the repeated field fd, with value v. Wrapper messages are
+// unwrapped, decimal64 values are returned as a gNMI Decimal64 message, and
+// enumerated values are returned as the YANG name of the enumeration value. The
+// bool return value is false if the value is an enumeration that is unset.
+func fieldValueFromProto(fd protoreflect.FieldDescriptor, v protoreflect.Value) (interface{}, bool, error) {
+	switch fd.Kind() {
+	case protoreflect.EnumKind:
+		ev := fd.Enum().Values().ByNumber(v.Enum())
+		if ev == nil {
+			return nil, false, fmt.Errorf("unknown value %d for enumeration %s", v.Enum(), fd.FullName())
+		}
+		return enumYANGName(ev)
+	case protoreflect.MessageKind:
+		if d, ok := v.Message().Interface().(*wpb.Decimal64Value); ok {
+			return &gpb.Decimal64{Digits: d.GetDigits(), Precision: d.GetPrecision()}, true, nil
+		}
+		if !isWrapper(fd.Message()) {
+			return nil, false, fmt.Errorf("unhandled message type %s, field: %s", fd.Message().FullName(), fd.FullName())
+		}
+		val, err := wrapperValue(v.Message())
+		if err != nil {
+			return nil, false, err
+		}
+		return val, true, nil
+	}
+	return v.Interface(), true, nil
+}
+
 // parsedListField returns the details of an individual field of a message
 // which corresponds to a YANG list (is 'repeated').
 type parsedListField struct {
@@ -305,9 +407,17 @@ func parseListField(fd protoreflect.FieldDescriptor, v protoreflect.Value, baseP
 		mappedPaths = append(mappedPaths, p)
 	}
 
-	kv, err := ygot.KeyValueAsString(v.Interface())
-	if err != nil {
+	val, ok, err := fieldValueFromProto(fd, v)
+	switch {
+	case err != nil:
 		return nil, fmt.Errorf("cannot map list key %v, %v", v.Interface(), err)
+	case !ok:
+		return nil, fmt.Errorf("list key %s is unset", fd.FullName())
+	}
+
+	kv, err := ygot.KeyValueAsString(val)
+	if err != nil {
+		return nil, fmt.Errorf("cannot map list key %v, %v", val, err)
 	}
 
 	p := &parsedListField{
@@ -316,7 +426,7 @@ func parseListField(fd protoreflect.FieldDescriptor, v protoreflect.Value, baseP
 	}
 
 	for _, path := range mappedPaths {
-		p.mappedValues[resolvedPath(basePath, path)] = v.Interface()
+		p.mappedValues[resolvedPath(basePath, path)] = val
 	}
 	return p, nil
 }
@@ -341,6 +451,101 @@ func annotatedSchemaPath(fd protoreflect.FieldDescriptor) ([]*gpb.Path, error) {
 	return paths, nil
 }
 
+// schemaPathAnnotation returns the value of the yext.schemapath annotation of
+// the field fd, or the empty string if it is not annotated.
+func schemaPathAnnotation(fd protoreflect.FieldDescriptor) string {
+	po, ok := fd.Options().(*descriptorpb.FieldOptions)
+	if !ok || po == nil {
+		return ""
+	}
+	return proto.GetExtension(po, yextpb.E_Schemapath).(string)
+}
+
+// fieldAnnotation returns the yext.field_annotation option of the message
+// containing the field fd that describes fd, or nil if there is none.
+func fieldAnnotation(fd protoreflect.FieldDescriptor) *yextpb.FieldAnnotation {
+	mo, ok := fd.ContainingMessage().Options().(*descriptorpb.MessageOptions)
+	if !ok || mo == nil {
+		return nil
+	}
+	for _, a := range proto.GetExtension(mo, yextpb.E_FieldAnnotation).([]*yextpb.FieldAnnotation) {
+		if a.GetName() == string(fd.Name()) {
+			return a
+		}
+	}
+	return nil
+}
+
+// mapKeyAnnotation returns the map_key of the yext.field_annotation of the
+// field fd, which is the name of the key leaf of the YANG list represented by
+// a map field. It returns the empty string if the field is not annotated.
+func mapKeyAnnotation(fd protoreflect.FieldDescriptor) string {
+	return fieldAnnotation(fd).GetMapKey()
+}
+
+// fractionDigitsAnnotation returns the fraction_digits of the
+// yext.field_annotation of the field fd, which is the fraction-digits of the
+// YANG decimal64 type represented by the field. It returns zero if the field
+// is not annotated.
+func fractionDigitsAnnotation(fd protoreflect.FieldDescriptor) uint32 {
+	return fieldAnnotation(fd).GetFractionDigits()
+}
+
+// isListMember determines whether the field fd is the member of a key message
+// representing a YANG list, which is the only message field that is not
+// annotated with a schema path.
+func isListMember(fd protoreflect.FieldDescriptor) bool {
+	return fd.Kind() == protoreflect.MessageKind && !fd.IsList() && schemaPathAnnotation(fd) == ""
+}
+
+// isContainer determines whether the field fd is a message representing a
+// YANG container.
+func isContainer(fd protoreflect.FieldDescriptor) bool {
+	return fd.Kind() == protoreflect.MessageKind && !fd.IsList() && !fd.IsMap() && !isWrapper(fd.Message())
+}
+
+// isUnionMessage determines whether the message md represents a member of a
+// leaf-list of unions, such that none of its fields are annotated with a
+// schema path, and each field represents one of the types of the union.
+func isUnionMessage(md protoreflect.MessageDescriptor) bool {
+	fds := md.Fields()
+	if fds.Len() == 0 {
+		return false
+	}
+	for i := 0; i < fds.Len(); i++ {
+		fd := fds.Get(i)
+		if fd.IsList() || schemaPathAnnotation(fd) != "" || fd.Kind() == protoreflect.MessageKind && !isWrapper(fd.Message()) {
+			return false
+		}
+	}
+	return true
+}
+
+// isLeafList determines whether the repeated field fd represents a YANG
+// leaf-list, such that its members are scalar, enumerated or wrapper values,
+// or messages representing a union.
+func isLeafList(fd protoreflect.FieldDescriptor) bool {
+	return fd.IsList() && (fd.Kind() != protoreflect.MessageKind || isWrapper(fd.Message()) || isUnionMessage(fd.Message()))
+}
+
+// isKeyMessage determines whether the message md is a key message
+// representing an entry of a keyed YANG list.
+func isKeyMessage(md protoreflect.MessageDescriptor) bool {
+	fds := md.Fields()
+	for i := 0; i < fds.Len(); i++ {
+		if isListMember(fds.Get(i)) {
+			return true
+		}
+	}
+	return false
+}
+
+// isWrapper determines whether the message md is one of the ywrapper
+// messages used to represent YANG leaves.
+func isWrapper(md protoreflect.MessageDescriptor) bool {
+	return md.ParentFile().Package() == wrapperName(&wpb.StringValue{}).Parent()
+}
+
 // fieldName returns the name last element of the path supplied - corresponding
 // to the field that is being described by the specified path.
 func fieldName(path *gpb.Path) (string, error) {
@@ -363,7 +568,7 @@ func resolvedPath(basePath, annotatedPath *gpb.Path) *gpb.Path {
 }
 
 // UnmapOpt marks that a particular option can be supplied as an argument
-// to the ProtoFromPaths function.
+// to the ProtoFromPaths, ProtoFromGoStruct and GoStructFromProto functions.
 type UnmapOpt interface {
 	isUnmapOpt()
 }
@@ -373,7 +578,9 @@ type UnmapOpt interface {
 // fields in the protobuf.
 //
 // This option is typically used in conjunction with path compression where there
-// are some leaves that do not have corresponding fields.
+// are some leaves that do not have corresponding fields. When supplied to
+// GoStructFromProto, it indicates that populated fields of the protobuf that do
+// not have corresponding fields in the GoStruct should be ignored.
 func IgnoreExtraPaths() *ignoreExtraPaths { return &ignoreExtraPaths{} }
 
 type ignoreExtraPaths struct{}
@@ -407,7 +614,9 @@ func (*protoMsgPrefix) isUnmapOpt() {}
 
 // ProtoFromPaths takes an input ygot-generated protobuf and unmarshals the values provided in vals into the map.
 // The vals map must be keyed by the gNMI path to the leaf, with the interface{} value being the value that the
-// leaf at the field should be set to.
+// leaf at the field should be set to. Values for leaf-lists must be a []interface{}, or a gNMI TypedValue
+// containing a LeaflistVal. Entries of keyed lists are created according to the keys specified in the paths
+// of the values within them.
 //
 // The protobuf p is modified in place to add the values.
 //
@@ -429,108 +638,690 @@ func ProtoFromPaths(p proto.Message, vals map[*gpb.Path]interface{}, opt ...Unma
 		return fmt.Errorf("invalid protobuf message prefix supplied in options, %v", err)
 	}
 
-	schemaPath := func(p *gpb.Path) *gpb.Path {
-		np := proto.Clone(p).(*gpb.Path)
-		for _, e := range np.Elem {
-			e.Key = nil
-		}
-		return np
-	}
-
-	// directCh is a map between the absolute schema path for a particular value, and
-	// the value specified.
-	directCh := map[*gpb.Path]interface{}{}
+	// leaves stores the values that are to be mapped into the protobuf, along
+	// with their absolute data tree paths.
+	leaves := []*pathVal{}
 	for p, v := range vals {
 		absPath := &gpb.Path{
-			Elem: append(append([]*gpb.PathElem{}, schemaPath(valPrefix).Elem...), p.Elem...),
+			Elem: append(append([]*gpb.PathElem{}, valPrefix.Elem...), p.Elem...),
 		}
 
-		if !util.PathMatchesPathElemPrefix(absPath, protoPrefix) {
+		if !pathMatchesSchemaPrefix(absPath, protoPrefix) {
 			return fmt.Errorf("invalid path provided, absolute paths must be used, %s does not have prefix %s", absPath, protoPrefix)
 		}
+		leaves = append(leaves, &pathVal{path: absPath, val: v})
+	}
 
-		// make the path absolute, and a schema path.
-		pp := util.TrimGNMIPathElemPrefix(absPath, protoPrefix)
+	u := &unmapper{mapped: map[*pathVal]bool{}, inField: map[*pathVal]bool{}}
+	if err := u.unmapMessage(p.ProtoReflect(), leaves, schemaPathNames(protoPrefix)); err != nil {
+		return err
+	}
 
-		if len(pp.GetElem()) == 1 {
-			directCh[pp] = v
-		}
-		// TODO(robjs): it'd be good to have something here that tells us whether we are in
-		// a compressed schema. Potentially we should add something to the generated protobuf
-		// as a fileoption that would give us this indication.
-		if len(pp.Elem) == 2 {
-			if pp.Elem[len(pp.Elem)-2].Name == "config" || pp.Elem[len(pp.Elem)-2].Name == "state" {
-				directCh[pp] = v
+	if !hasIgnoreExtraPaths(opt) {
+		for _, l := range leaves {
+			pp := &gpb.Path{Elem: l.path.Elem[len(protoPrefix.Elem):]}
+			// Paths that are not direct children of the message, and are not
+			// within one of its container or list fields, are not checked.
+			if !u.mapped[l] && (u.inField[l] || isDirectChild(pp)) {
+				return fmt.Errorf("did not map path %s to a proto field", pp)
 			}
 		}
 	}
 
-	mapped := map[*gpb.Path]bool{}
-	m := p.ProtoReflect()
-	var rangeErr error
-	unpopRange{m}.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+	return nil
+}
+
+// isDirectChild determines whether the path p, which is relative to a
+// message, refers to a direct child of the message. Fields within a config
+// or state container are considered direct children, since such containers
+// are removed from compressed schemas.
+//
+// TODO(robjs): it'd be good to have something here that tells us whether we are in
+// a compressed schema. Potentially we should add something to the generated protobuf
+// as a fileoption that would give us this indication.
+func isDirectChild(p *gpb.Path) bool {
+	switch len(p.GetElem()) {
+	case 1:
+		return true
+	case 2:
+		return p.Elem[0].GetName() == "config" || p.Elem[0].GetName() == "state"
+	}
+	return false
+}
+
+// pathVal is a value that is to be mapped into a protobuf by ProtoFromPaths,
+// along with the absolute data tree path of the value.
+type pathVal struct {
+	// path is the absolute data tree path of the value.
+	path *gpb.Path
+	// val is the value to be mapped.
+	val interface{}
+}
+
+// unmapper stores the state of mapping a set of values into a protobuf.
+type unmapper struct {
+	// mapped records the values that have been mapped to a protobuf field.
+	mapped map[*pathVal]bool
+	// inField records the values whose paths are within a container or list
+	// field of the protobuf, such that they are expected to be mapped.
+	inField map[*pathVal]bool
+}
+
+// unmapMessage maps the values in leaves into the fields of the protobuf
+// message m, which has the schema path base. Values that are mapped are
+// recorded by the unmapper.
+func (u *unmapper) unmapMessage(m protoreflect.Message, leaves []*pathVal, base []string) error {
+	fds := m.Descriptor().Fields()
+	seenOneof := map[protoreflect.FullName]bool{}
+	for i := 0; i < fds.Len(); i++ {
+		fd := fds.Get(i)
 		annotatedPath, err := annotatedSchemaPath(fd)
 		if err != nil {
-			rangeErr = err
-			return false
+			return err
 		}
-
+		var paths [][]string
 		for _, ap := range annotatedPath {
-			if !util.PathMatchesPathElemPrefix(ap, protoPrefix) {
-				rangeErr = fmt.Errorf("annotation %s does not match the supplied prefix %s", ap, protoPrefix)
-				return false
+			names := schemaPathNames(ap)
+			if !namesHavePrefix(names, base) {
+				return fmt.Errorf("annotation %s does not match the supplied prefix %s", ap, util.SlicePathToString(base))
+			}
+			paths = append(paths, names)
+		}
+
+		oo := fd.ContainingOneof()
+		if oo != nil && !oo.IsSynthetic() {
+			if seenOneof[oo.FullName()] {
+				continue
+			}
+			seenOneof[oo.FullName()] = true
+		}
+
+		switch {
+		case fd.IsMap():
+			if len(paths) != 1 {
+				return fmt.Errorf("invalid map, does not map to 1 schema path, field: %s", fd.FullName())
+			}
+			if err := u.unmapMap(m, fd, leaves, paths[0]); err != nil {
+				return err
+			}
+		case fd.IsList() && !isLeafList(fd):
+			if len(paths) != 1 {
+				return fmt.Errorf("invalid list, does not map to 1 schema path, field: %s", fd.FullName())
+			}
+			if err := u.unmapList(m, fd, leaves, paths[0]); err != nil {
+				return err
+			}
+		case isContainer(fd):
+			var children []*pathVal
+			for _, l := range leaves {
+				switch {
+				case !pathMatchesAny(l.path, paths, true):
+				case len(l.path.Elem) == len(paths[0]):
+					return fmt.Errorf("cannot map value %v to container field %s", l.val, fd.FullName())
+				default:
+					u.inField[l] = true
+					children = append(children, l)
+				}
+			}
+			if len(children) == 0 {
+				continue
+			}
+			if len(paths) != 1 {
+				return fmt.Errorf("invalid container, maps to >1 schema path, field: %s", fd.FullName())
 			}
-			trimmedAP := util.TrimGNMIPathElemPrefix(ap, protoPrefix)
-			for chp, chv := range directCh {
-				if proto.Equal(trimmedAP, chp) {
-					switch fd.Kind() {
-					case protoreflect.MessageKind:
-						v, isWrap, err := makeWrapper(m, fd, chv)
-						if err != nil {
-							rangeErr = err
-							return false
-						}
-						if !isWrap {
-							// TODO(robjs): recurse into the message if it wasn't a wrapper
-							// type.
-							rangeErr = fmt.Errorf("unimplemented: child messages, field %s", fd.FullName())
-							return false
-						}
-						mapped[chp] = true
-						m.Set(fd, protoreflect.ValueOfMessage(v))
-					case protoreflect.EnumKind:
-						v, err := enumValue(fd, chv)
-						if err != nil {
-							rangeErr = err
-							return false
-						}
-						mapped[chp] = true
+			nm := m.NewField(fd).Message()
+			if m.Has(fd) {
+				nm = m.Get(fd).Message()
+			}
+			if err := u.unmapMessage(nm, children, paths[0]); err != nil {
+				return err
+			}
+			if proto.Size(nm.Interface()) != 0 {
+				m.Set(fd, protoreflect.ValueOfMessage(nm))
+			}
+		default:
+			for _, l := range leaves {
+				if !pathMatchesAny(l.path, paths, false) {
+					continue
+				}
+				var err error
+				switch {
+				case oo != nil && !oo.IsSynthetic():
+					err = setUnionField(m, fieldSlice(oo.Fields()), l.val)
+				case fd.IsList():
+					err = setLeafListField(m, fd, l.val)
+				default:
+					var v protoreflect.Value
+					if v, err = fieldValue(fd, l.val); err == nil {
 						m.Set(fd, v)
-					default:
-						rangeErr = fmt.Errorf("unknown field kind %s for %s", fd.Kind(), fd.FullName())
-						return false
 					}
 				}
+				if err != nil {
+					return err
+				}
+				u.mapped[l] = true
 			}
 		}
-		return true
-	})
+	}
+	return nil
+}
 
-	if rangeErr != nil {
-		return rangeErr
+// unmapList maps the values in leaves that are within the YANG list with the
+// schema path listPath into the repeated field fd of message m. An entry of
+// the list is created for each set of keys found in the paths of the values.
+// The key fields of each entry are populated from values for the key leaves
+// where they are supplied, or otherwise from the keys of the path.
+func (u *unmapper) unmapList(m protoreflect.Message, fd protoreflect.FieldDescriptor, leaves []*pathVal, listPath []string) error {
+	type listEntry struct {
+		keys   map[string]string
+		leaves []*pathVal
+	}
+	entries := map[string]*listEntry{}
+	for _, l := range leaves {
+		if len(l.path.Elem) <= len(listPath) || !pathMatchesAny(l.path, [][]string{listPath}, true) {
+			continue
+		}
+		keys := l.path.Elem[len(listPath)-1].GetKey()
+		if len(keys) == 0 {
+			return fmt.Errorf("invalid path %s, no keys specified for list %s", l.path, fd.FullName())
+		}
+		u.inField[l] = true
+		// fmt prints maps in key order, hence ks is the same for each path
+		// with the same keys.
+		ks := fmt.Sprintf("%v", keys)
+		if entries[ks] == nil {
+			entries[ks] = &listEntry{keys: keys}
+		}
+		entries[ks].leaves = append(entries[ks].leaves, l)
+	}
+	if len(entries) == 0 {
+		return nil
 	}
 
-	if !hasIgnoreExtraPaths(opt) {
-		for chp := range directCh {
-			if !mapped[chp] {
-				return fmt.Errorf("did not map path %s to a proto field", chp)
+	// Sort the entries such that the order of the list is deterministic.
+	var order []string
+	for ks := range entries {
+		order = append(order, ks)
+	}
+	sort.Strings(order)
+
+	l := m.Mutable(fd).List()
+	for _, ks := range order {
+		e := entries[ks]
+		// Values for an entry that already exists in the list are mapped
+		// into the existing entry.
+		km, exists := findListEntry(l, listPath, e.keys)
+		if !exists {
+			km = l.NewElement().Message()
+		}
+		kfds := km.Descriptor().Fields()
+		seenOneof := map[protoreflect.FullName]bool{}
+		for i := 0; i < kfds.Len(); i++ {
+			kfd := kfds.Get(i)
+			if isListMember(kfd) {
+				nm := km.NewField(kfd).Message()
+				if km.Has(kfd) {
+					nm = km.Get(kfd).Message()
+				}
+				if err := u.unmapMessage(nm, e.leaves, listPath); err != nil {
+					return err
+				}
+				km.Set(kfd, protoreflect.ValueOfMessage(nm))
+				continue
+			}
+
+			// Keys that are unions are represented by a oneof, each of
+			// whose fields is a candidate for the key value.
+			candidates := []protoreflect.FieldDescriptor{kfd}
+			if oo := kfd.ContainingOneof(); oo != nil && !oo.IsSynthetic() {
+				if seenOneof[oo.FullName()] {
+					continue
+				}
+				seenOneof[oo.FullName()] = true
+				candidates = fieldSlice(oo.Fields())
+			}
+			if err := u.setListKey(km, candidates, e.keys, e.leaves); err != nil {
+				return fmt.Errorf("cannot map key of list %s, %v", fd.FullName(), err)
+			}
+		}
+		if !exists {
+			l.Append(protoreflect.ValueOfMessage(km))
+		}
+	}
+	return nil
+}
+
+// unmapMap maps the values in leaves that are within the YANG list with the
+// schema path listPath into the map field fd of message m. The map is keyed by
+// the value of the key leaf named by the map_key of the yext.field_annotation
+// of the field, which is parsed from the keys of the paths of the values, and
+// an entry of the map is created for each key found.
+func (u *unmapper) unmapMap(m protoreflect.Message, fd protoreflect.FieldDescriptor, leaves []*pathVal, listPath []string) error {
+	keyName := mapKeyAnnotation(fd)
+	entries := map[string][]*pathVal{}
+	for _, l := range leaves {
+		if len(l.path.Elem) <= len(listPath) || !pathMatchesAny(l.path, [][]string{listPath}, true) {
+			continue
+		}
+		if keyName == "" {
+			return fmt.Errorf("invalid map, no key annotation, field: %s", fd.FullName())
+		}
+		kv, ok := l.path.Elem[len(listPath)-1].GetKey()[keyName]
+		if !ok {
+			return fmt.Errorf("invalid path %s, key %s not specified for list %s", l.path, keyName, fd.FullName())
+		}
+		u.inField[l] = true
+		entries[kv] = append(entries[kv], l)
+	}
+	if len(entries) == 0 {
+		return nil
+	}
+
+	mp := m.Mutable(fd).Map()
+	for kv, el := range entries {
+		k, err := keyValueFromString(fd.MapKey(), kv)
+		if err != nil {
+			return fmt.Errorf("cannot map key of list %s, %v", fd.FullName(), err)
+		}
+		mk := k.MapKey()
+
+		// Values for an entry that already exists in the map are mapped
+		// into the existing entry.
+		nm := mp.NewValue().Message()
+		if mp.Has(mk) {
+			nm = mp.Get(mk).Message()
+		}
+		if err := u.unmapMessage(nm, el, listPath); err != nil {
+			return err
+		}
+		mp.Set(mk, protoreflect.ValueOfMessage(nm))
+
+		// The key leaf is represented by the key of the map, hence values
+		// for it are considered to be mapped.
+		for _, l := range el {
+			rel := &gpb.Path{Elem: l.path.Elem[len(listPath):]}
+			if isDirectChild(rel) && rel.Elem[len(rel.Elem)-1].GetName() == keyName {
+				u.mapped[l] = true
 			}
 		}
 	}
+	return nil
+}
+
+// findListEntry returns the key message of the entry of the list l, which has
+// the schema path listPath, whose keys are equal to keys. It returns false if
+// there is no such entry.
+func findListEntry(l protoreflect.List, listPath []string, keys map[string]string) (protoreflect.Message, bool) {
+	for i := 0; i < l.Len(); i++ {
+		if km := l.Get(i).Message(); listEntryMatches(km, listPath, keys) {
+			return km, true
+		}
+	}
+	return nil, false
+}
 
+// setListKey populates the key field of the key message km from the
+// candidates, of which there is more than one when the key is a union. The key
+// is populated from the value of the key leaf if it is within leaves, or
+// otherwise by parsing the value of the key in the data tree path of the list
+// entry, which is supplied in keys.
+func (u *unmapper) setListKey(km protoreflect.Message, candidates []protoreflect.FieldDescriptor, keys map[string]string, leaves []*pathVal) error {
+	annotatedPath, err := annotatedSchemaPath(candidates[0])
+	if err != nil {
+		return err
+	}
+	var paths [][]string
+	for _, ap := range annotatedPath {
+		paths = append(paths, schemaPathNames(ap))
+	}
+	keyName, err := fieldName(annotatedPath[0])
+	if err != nil {
+		return err
+	}
+
+	var set bool
+	for _, l := range leaves {
+		if !pathMatchesAny(l.path, paths, false) {
+			continue
+		}
+		if err := setUnionField(km, candidates, l.val); err != nil {
+			return err
+		}
+		u.mapped[l] = true
+		set = true
+	}
+	if set {
+		return nil
+	}
+
+	kv, ok := keys[keyName]
+	if !ok {
+		return fmt.Errorf("key %s is not specified in %v", keyName, keys)
+	}
+	for _, fd := range orderedUnionFields(candidates) {
+		if v, err := keyValueFromString(fd, kv); err == nil {
+			km.Set(fd, v)
+			return nil
+		}
+	}
+	return fmt.Errorf("cannot map value %s of key %s to field %s", kv, keyName, candidates[0].FullName())
+}
+
+// setUnionField sets the field of message m that can store val, selected from
+// fds, which are the fields representing each type of a union. If a single
+// field is supplied, it is set to val.
+func setUnionField(m protoreflect.Message, fds []protoreflect.FieldDescriptor, val interface{}) error {
+	if len(fds) == 1 {
+		v, err := fieldValue(fds[0], val)
+		if err != nil {
+			return err
+		}
+		m.Set(fds[0], v)
+		return nil
+	}
+	for _, fd := range orderedUnionFields(fds) {
+		if v, err := fieldValue(fd, val); err == nil {
+			m.Set(fd, v)
+			return nil
+		}
+	}
+	return fmt.Errorf("cannot map value %v (%T) to any type of the union in %s", val, val, m.Descriptor().FullName())
+}
+
+// orderedUnionFields returns the fields representing the types of a union in
+// the order in which they should be considered when mapping a value to the
+// union. Enumerated types are considered first, and strings last, such that
+// the more specific types are preferred.
+func orderedUnionFields(fds []protoreflect.FieldDescriptor) []protoreflect.FieldDescriptor {
+	rank := func(fd protoreflect.FieldDescriptor) int {
+		switch fd.Kind() {
+		case protoreflect.EnumKind:
+			return 0
+		case protoreflect.StringKind:
+			return 2
+		}
+		return 1
+	}
+	ordered := append([]protoreflect.FieldDescriptor{}, fds...)
+	sort.SliceStable(ordered, func(i, j int) bool { return rank(ordered[i]) < rank(ordered[j]) })
+	return ordered
+}
+
+// setLeafListField sets the repeated field fd of message m, which represents a
+// YANG leaf-list, to val. The value must be a []interface{}, or a TypedValue
+// containing a leaf-list.
+func setLeafListField(m protoreflect.Message, fd protoreflect.FieldDescriptor, val interface{}) error {
+	var elems []interface{}
+	switch t := val.(type) {
+	case []interface{}:
+		elems = t
+	case *gpb.TypedValue:
+		if t.GetLeaflistVal() == nil {
+			return fmt.Errorf("got non-leaf-list value for leaf-list field, field: %s, value: %v", fd.FullName(), val)
+		}
+		for _, e := range t.GetLeaflistVal().GetElement() {
+			elems = append(elems, e)
+		}
+	case *jsonValue:
+		jl, ok := t.v.([]interface{})
+		if !ok {
+			return fmt.Errorf("got non-leaf-list value for leaf-list field, field: %s, value: %v", fd.FullName(), val)
+		}
+		for _, e := range jl {
+			elems = append(elems, &jsonValue{v: e})
+		}
+	default:
+		return fmt.Errorf("got non-leaf-list value for leaf-list field, field: %s, value: %v", fd.FullName(), val)
+	}
+
+	l := m.NewField(fd).List()
+	for _, e := range elems {
+		if fd.Kind() == protoreflect.MessageKind && isUnionMessage(fd.Message()) {
+			um := l.NewElement().Message()
+			if err := setUnionField(um, fieldSlice(um.Descriptor().Fields()), e); err != nil {
+				return err
+			}
+			l.Append(protoreflect.ValueOfMessage(um))
+			continue
+		}
+		v, err := fieldValue(fd, e)
+		if err != nil {
+			return err
+		}
+		l.Append(v)
+	}
+	m.Set(fd, protoreflect.ValueOfList(l))
 	return nil
 }
 
+// fieldValue returns the value of the non-repeated field fd, or of a member of
+// the repeated field fd, corresponding to val.
+func fieldValue(fd protoreflect.FieldDescriptor, val interface{}) (protoreflect.Value, error) {
+	if jv, ok := val.(*jsonValue); ok {
+		v, err := jv.goValue(fd)
+		if err != nil {
+			return protoreflect.Value{}, err
+		}
+		val = v
+	}
+
+	switch fd.Kind() {
+	case protoreflect.MessageKind:
+		v, isWrap, err := makeWrapper(fd, val)
+		if err != nil {
+			return protoreflect.Value{}, err
+		}
+		if !isWrap {
+			return protoreflect.Value{}, fmt.Errorf("cannot map value %v to non-wrapper message field %s", val, fd.FullName())
+		}
+		return protoreflect.ValueOfMessage(v), nil
+	case protoreflect.EnumKind:
+		return enumValue(fd, val)
+	}
+	return scalarValue(fd, val)
+}
+
+// scalarValue returns the value of the scalar field fd corresponding to val,
+// which must be of a Go type corresponding to the kind of the field, or a
+// TypedValue.
+func scalarValue(fd protoreflect.FieldDescriptor, val interface{}) (protoreflect.Value, error) {
+	if tv, ok := val.(*gpb.TypedValue); ok {
+		sv, err := value.ToScalar(tv)
+		if err != nil {
+			return protoreflect.Value{}, fmt.Errorf("cannot convert TypedValue to scalar, %s", tv)
+		}
+		val = sv
+	}
+
+	switch fd.Kind() {
+	case protoreflect.StringKind:
+		if s, ok := val.(string); ok {
+			return protoreflect.ValueOfString(s), nil
+		}
+	case protoreflect.BoolKind:
+		if b, ok := val.(bool); ok {
+			return protoreflect.ValueOfBool(b), nil
+		}
+	case protoreflect.BytesKind:
+		if b, ok := val.([]byte); ok {
+			return protoreflect.ValueOfBytes(b), nil
+		}
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		if n, ok := intValue(val); ok && n >= math.MinInt32 && n <= math.MaxInt32 {
+			return protoreflect.ValueOfInt32(int32(n)), nil
+		}
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		if n, ok := intValue(val); ok {
+			return protoreflect.ValueOfInt64(n), nil
+		}
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		if n, ok := uintValue(val); ok && n <= math.MaxUint32 {
+			return protoreflect.ValueOfUint32(uint32(n)), nil
+		}
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		if n, ok := uintValue(val); ok {
+			return protoreflect.ValueOfUint64(n), nil
+		}
+	case protoreflect.FloatKind:
+		switch f := val.(type) {
+		case float32:
+			return protoreflect.ValueOfFloat32(f), nil
+		case float64:
+			return protoreflect.ValueOfFloat32(float32(f)), nil
+		}
+	case protoreflect.DoubleKind:
+		switch f := val.(type) {
+		case float32:
+			return protoreflect.ValueOfFloat64(float64(f)), nil
+		case float64:
+			return protoreflect.ValueOfFloat64(f), nil
+		}
+	default:
+		return protoreflect.Value{}, fmt.Errorf("unknown field kind %s for %s", fd.Kind(), fd.FullName())
+	}
+	return protoreflect.Value{}, fmt.Errorf("got %T value for %s field, field: %s, value: %v", val, fd.Kind(), fd.FullName(), val)
+}
+
+// intValue returns val as an int64 if it is a signed integer type.
+func intValue(val interface{}) (int64, bool) {
+	switch n := val.(type) {
+	case int:
+		return int64(n), true
+	case int8:
+		return int64(n), true
+	case int16:
+		return int64(n), true
+	case int32:
+		return int64(n), true
+	case int64:
+		return n, true
+	}
+	return 0, false
+}
+
+// uintValue returns val as a uint64 if it is an unsigned integer type.
+func uintValue(val interface{}) (uint64, bool) {
+	switch n := val.(type) {
+	case uint:
+		return uint64(n), true
+	case uint8:
+		return uint64(n), true
+	case uint16:
+		return uint64(n), true
+	case uint32:
+		return uint64(n), true
+	case uint64:
+		return n, true
+	}
+	return 0, false
+}
+
+// keyValueFromString returns the value of the list key field fd corresponding
+// to the string s, which is the value of the key in a gNMI path.
+func keyValueFromString(fd protoreflect.FieldDescriptor, s string) (protoreflect.Value, error) {
+	switch fd.Kind() {
+	case protoreflect.EnumKind:
+		return enumValue(fd, s)
+	case protoreflect.StringKind:
+		return protoreflect.ValueOfString(s), nil
+	case protoreflect.BoolKind:
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return protoreflect.Value{}, err
+		}
+		return protoreflect.ValueOfBool(b), nil
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		n, err := strconv.ParseInt(s, 10, 32)
+		if err != nil {
+			return protoreflect.Value{}, err
+		}
+		return protoreflect.ValueOfInt32(int32(n)), nil
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		n, err := strconv.ParseInt(s, 10, 64)
+		if err != nil {
+			return protoreflect.Value{}, err
+		}
+		return protoreflect.ValueOfInt64(n), nil
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		n, err := strconv.ParseUint(s, 10, 32)
+		if err != nil {
+			return protoreflect.Value{}, err
+		}
+		return protoreflect.ValueOfUint32(uint32(n)), nil
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		n, err := strconv.ParseUint(s, 10, 64)
+		if err != nil {
+			return protoreflect.Value{}, err
+		}
+		return protoreflect.ValueOfUint64(n), nil
+	}
+	return protoreflect.Value{}, fmt.Errorf("unsupported list key kind %s for %s", fd.Kind(), fd.FullName())
+}
+
+// fieldSlice returns the fields in fds as a slice.
+func fieldSlice(fds protoreflect.FieldDescriptors) []protoreflect.FieldDescriptor {
+	var s []protoreflect.FieldDescriptor
+	for i := 0; i < fds.Len(); i++ {
+		s = append(s, fds.Get(i))
+	}
+	return s
+}
+
+// pathMatchesAny determines whether the element names of the path p are equal
+// to one of the schema paths in paths, ignoring any keys in p. If prefix is
+// true, p matches if one of the paths is a prefix of it.
+func pathMatchesAny(p *gpb.Path, paths [][]string, prefix bool) bool {
+	for _, names := range paths {
+		if len(p.GetElem()) < len(names) || !prefix && len(p.GetElem()) != len(names) {
+			continue
+		}
+		match := true
+		for i, n := range names {
+			if p.GetElem()[i].GetName() != n {
+				match = false
+				break
+			}
+		}
+		if match {
+			return true
+		}
+	}
+	return false
+}
+
+// namesHavePrefix determines whether the path element names in prefix are a
+// prefix of those in names.
+func namesHavePrefix(names, prefix []string) bool {
+	if len(names) < len(prefix) {
+		return false
+	}
+	for i, n := range prefix {
+		if names[i] != n {
+			return false
+		}
+	}
+	return true
+}
+
+// pathMatchesSchemaPrefix determines whether the path p has the prefix
+// prefix. Keys are compared only where they are specified in the prefix, such
+// that a schema path prefix matches any data tree path below it.
+func pathMatchesSchemaPrefix(p, prefix *gpb.Path) bool {
+	if len(p.GetElem()) < len(prefix.GetElem()) || p.GetOrigin() != prefix.GetOrigin() {
+		return false
+	}
+	for i, e := range prefix.GetElem() {
+		pe := p.GetElem()[i]
+		switch {
+		case pe.GetName() != e.GetName():
+			return false
+		case len(e.GetKey()) != 0 && !util.PathElemsEqual(pe, e):
+			return false
+		}
+	}
+	return true
+}
+
 // hasIgnoreExtraPaths checks whether the supplied opts slice contains the
 // ignoreExtraPaths option.
 func hasIgnoreExtraPaths(opts []UnmapOpt) bool {
@@ -570,52 +1361,108 @@ func hasValuePathPrefix(opts []UnmapOpt) (*gpb.Path, error) {
 	return &gpb.Path{}, nil
 }
 
-// makeWrapper generates a new message for field fd of the proto message msg with the value set to val.
-// The field fd must describe a field that has a message type. An error is returned if the wrong
-// type of payload is provided for the message. The second, boolean, return argument specifies whether
-// the message provided was a known wrapper type.
-func makeWrapper(msg protoreflect.Message, fd protoreflect.FieldDescriptor, val interface{}) (protoreflect.Message, bool, error) {
-	var wasTypedVal bool
+// makeWrapper generates a new message for field fd with the value set to val.
+// The field fd must describe a field that has a message type. An error is returned
+// if the wrong type of payload is provided for the message. The second, boolean,
+// return argument specifies whether the message provided was a known wrapper type.
+func makeWrapper(fd protoreflect.FieldDescriptor, val interface{}) (protoreflect.Message, bool, error) {
+	if !isWrapper(fd.Message()) {
+		return nil, false, nil
+	}
+
 	if tv, ok := val.(*gpb.TypedValue); ok {
-		pv, err := value.ToScalar(tv)
-		if err != nil {
-			return nil, false, fmt.Errorf("cannot convert TypedValue to scalar, %s", tv)
+		switch {
+		case tv.GetDecimalVal() != nil:
+			// Decimal values are not converted to a scalar, since
+			// the conversion loses precision.
+			val = tv.GetDecimalVal()
+		default:
+			pv, err := value.ToScalar(tv)
+			if err != nil {
+				return nil, false, fmt.Errorf("cannot convert TypedValue to scalar, %s", tv)
+			}
+			val = pv
 		}
-		val = pv
-		wasTypedVal = true
 	}
 
-	newV := msg.NewField(fd)
-	switch newV.Message().Interface().(type) {
-	case *wpb.StringValue:
+	switch fd.Message().FullName() {
+	case wrapperName(&wpb.StringValue{}):
 		nsv, ok := val.(string)
 		if !ok {
 			return nil, false, fmt.Errorf("got non-string value for string field, field: %s, value: %v", fd.FullName(), val)
 		}
 		return (&wpb.StringValue{Value: nsv}).ProtoReflect(), true, nil
-	case *wpb.UintValue:
-		var nsv uint64
-		switch {
-		case wasTypedVal:
-			nsv = val.(uint64)
-		default:
-			iv, ok := val.(uint)
-			if !ok {
-				return nil, false, fmt.Errorf("got non-uint value for uint field, field: %s, value: %v", fd.FullName(), val)
-			}
-			nsv = uint64(iv)
+	case wrapperName(&wpb.UintValue{}):
+		nsv, ok := uintValue(val)
+		if !ok {
+			return nil, false, fmt.Errorf("got non-uint value for uint field, field: %s, value: %v", fd.FullName(), val)
 		}
-
 		return (&wpb.UintValue{Value: nsv}).ProtoReflect(), true, nil
-	case *wpb.BytesValue:
+	case wrapperName(&wpb.IntValue{}):
+		nsv, ok := intValue(val)
+		if !ok {
+			return nil, false, fmt.Errorf("got non-int value for int field, field: %s, value: %v", fd.FullName(), val)
+		}
+		return (&wpb.IntValue{Value: nsv}).ProtoReflect(), true, nil
+	case wrapperName(&wpb.BoolValue{}):
+		bv, ok := val.(bool)
+		if !ok {
+			return nil, false, fmt.Errorf("got non-bool value for bool field, field: %s, value: %v", fd.FullName(), val)
+		}
+		return (&wpb.BoolValue{Value: bv}).ProtoReflect(), true, nil
+	case wrapperName(&wpb.BytesValue{}):
 		bv, ok := val.([]byte)
 		if !ok {
 			return nil, false, fmt.Errorf("got non-byte slice value for bytes field, field: %s, value: %v", fd.FullName(), val)
 		}
 		return (&wpb.BytesValue{Value: bv}).ProtoReflect(), true, nil
-	default:
-		return nil, false, nil
+	case wrapperName(&wpb.Decimal64Value{}):
+		var (
+			dv  *wpb.Decimal64Value
+			err error
+		)
+		n := fractionDigitsAnnotation(fd)
+		switch d := val.(type) {
+		case *gpb.Decimal64:
+			dv, err = scaleDecimal64(&wpb.Decimal64Value{Digits: d.GetDigits(), Precision: d.GetPrecision()}, n)
+		case float64:
+			dv, err = decimal64Value(d, 64, n)
+		case float32:
+			dv, err = decimal64Value(float64(d), 32, n)
+		default:
+			err = fmt.Errorf("got non-decimal value for decimal64 field, field: %s, value: %v", fd.FullName(), val)
+		}
+		if err != nil {
+			return nil, false, err
+		}
+		return dv.ProtoReflect(), true, nil
+	}
+	return nil, false, fmt.Errorf("unknown wrapper type %s, field: %s", fd.Message().FullName(), fd.FullName())
+}
+
+// scaleDecimal64 returns the decimal64 value d with the precision set to the
+// supplied fractionDigits, such that values are stored with the precision of
+// the YANG type that they correspond to. d is returned unmodified if
+// fractionDigits is zero. It returns an error if d cannot be represented
+// exactly with the fractionDigits.
+func scaleDecimal64(d *wpb.Decimal64Value, fractionDigits uint32) (*wpb.Decimal64Value, error) {
+	if fractionDigits == 0 {
+		return d, nil
+	}
+	digits, precision := d.GetDigits(), d.GetPrecision()
+	for ; precision > fractionDigits; precision-- {
+		if digits%10 != 0 {
+			return nil, fmt.Errorf("decimal64 value with digits %d and precision %d has more than %d fraction digits", d.GetDigits(), d.GetPrecision(), fractionDigits)
+		}
+		digits /= 10
+	}
+	for ; precision < fractionDigits; precision++ {
+		if digits > math.MaxInt64/10 || digits < math.MinInt64/10 {
+			return nil, fmt.Errorf("decimal64 value with digits %d and precision %d cannot be represented with %d fraction digits", d.GetDigits(), d.GetPrecision(), fractionDigits)
+		}
+		digits *= 10
 	}
+	return &wpb.Decimal64Value{Digits: digits, Precision: precision}, nil
 }
 
 // enumValue returns the concrete implementation of the enumeration with the yang_name annotation set
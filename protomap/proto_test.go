@@ -82,11 +82,103 @@ func TestPathsFromProtoInternal(t *testing.T) {
 			mustPath("/message/str"): "hello",
 		},
 	}, {
-		desc: "decimal64 messages currently unsupported",
+		desc: "decimal64 message",
 		inMsg: &epb.ExampleMessage{
 			De: &wpb.Decimal64Value{Digits: 1234, Precision: 1},
 		},
-		wantErrSubstring: "unhandled type, decimal64",
+		wantPaths: map[*gpb.Path]interface{}{
+			mustPath("/decimal"): &gpb.Decimal64{Digits: 1234, Precision: 1},
+		},
+	}, {
+		desc: "leaf-list of wrapper values",
+		inMsg: &epb.ExampleMessage{
+			LeafList: []*wpb.StringValue{{Value: "one"}, {Value: "two"}},
+		},
+		wantPaths: map[*gpb.Path]interface{}{
+			mustPath("/leaf-list"): []interface{}{"one", "two"},
+		},
+	}, {
+		desc: "leaf-list of enumerated values",
+		inMsg: &epb.ExampleMessage{
+			EnumList: []epb.ExampleEnum{epb.ExampleEnum_ENUM_VALONE, epb.ExampleEnum_ENUM_VALFORTYTWO},
+		},
+		wantPaths: map[*gpb.Path]interface{}{
+			mustPath("/enum-list"): []interface{}{"VAL_ONE", "VAL_FORTYTWO"},
+		},
+	}, {
+		desc: "union with string value",
+		inMsg: &epb.ExampleMessage{
+			Union: &epb.ExampleMessage_UnionString{UnionString: "hello"},
+		},
+		wantPaths: map[*gpb.Path]interface{}{
+			mustPath("/union"): "hello",
+		},
+	}, {
+		desc: "union with int value",
+		inMsg: &epb.ExampleMessage{
+			Union: &epb.ExampleMessage_UnionSint64{UnionSint64: -42},
+		},
+		wantPaths: map[*gpb.Path]interface{}{
+			mustPath("/union"): int64(-42),
+		},
+	}, {
+		desc: "union with enumerated value",
+		inMsg: &epb.ExampleMessage{
+			Union: &epb.ExampleMessage_UnionExampleenum{UnionExampleenum: epb.ExampleEnum_ENUM_VALTWO},
+		},
+		wantPaths: map[*gpb.Path]interface{}{
+			mustPath("/union"): "VAL_TWO",
+		},
+	}, {
+		desc: "leaf-list of unions",
+		inMsg: &epb.ExampleMessage{
+			UnionList: []*epb.ExampleMessage_UnionListUnion{
+				{UnionListString: "hello"},
+				{UnionListUint64: 42},
+				{UnionListExampleenum: epb.ExampleEnum_ENUM_VALONE},
+			},
+		},
+		wantPaths: map[*gpb.Path]interface{}{
+			mustPath("/union-list"): []interface{}{"hello", uint64(42), "VAL_ONE"},
+		},
+	}, {
+		desc:  "optional scalars set to their default values",
+		inMsg: optionalMessage(t, `bo: false str: "" strs: ""`),
+		wantPaths: map[*gpb.Path]interface{}{
+			mustPath("/bo"):   false,
+			mustPath("/str"):  "",
+			mustPath("/strs"): []interface{}{""},
+		},
+	}, {
+		desc: "list with union key",
+		inMsg: &epb.ExampleMessage{
+			UnionKeyList: []*epb.UnionKeyListKey{{
+				Key: &epb.UnionKeyListKey_KeyUint64{KeyUint64: 42},
+				Member: &epb.UnionKeyListMember{
+					Value: &wpb.StringValue{Value: "forty-two"},
+				},
+			}},
+		},
+		wantPaths: map[*gpb.Path]interface{}{
+			mustPath("/union-key-list[key=42]/key"):          uint64(42),
+			mustPath("/union-key-list[key=42]/config/key"):   uint64(42),
+			mustPath("/union-key-list[key=42]/config/value"): "forty-two",
+		},
+	}, {
+		desc: "list with enumerated key",
+		inMsg: &epb.ExampleMessage{
+			EnumKeyList: []*epb.EnumKeyListKey{{
+				Key: epb.ExampleEnum_ENUM_VALONE,
+				Member: &epb.EnumKeyListMember{
+					Value: &wpb.StringValue{Value: "one"},
+				},
+			}},
+		},
+		wantPaths: map[*gpb.Path]interface{}{
+			mustPath("/enum-key-list[key=VAL_ONE]/key"):          "VAL_ONE",
+			mustPath("/enum-key-list[key=VAL_ONE]/config/key"):   "VAL_ONE",
+			mustPath("/enum-key-list[key=VAL_ONE]/config/value"): "one",
+		},
 	}, {
 		desc: "multiple paths specified",
 		inMsg: &epb.Root_InterfaceKey{
@@ -101,7 +193,7 @@ func TestPathsFromProtoInternal(t *testing.T) {
 		inMsg: &epb.InvalidMessage{
 			MapField: map[string]string{"hello": "world"},
 		},
-		wantErrSubstring: "map fields are not supported",
+		wantErrSubstring: "invalid map, no key annotation",
 	}, {
 		desc: "invalid message with missing annotation",
 		inMsg: &epb.InvalidMessage{
@@ -123,6 +215,24 @@ func TestPathsFromProtoInternal(t *testing.T) {
 			mustPath("/list-name[single-key=key-one]/config/single-key"): "key-one",
 			mustPath("/list-name[single-key=key-one]/another-field"):     "hello-world",
 		},
+	}, {
+		desc: "list represented as a map",
+		inMsg: &epb.ExampleMessage{
+			MapList: map[string]*epb.MapListMember{
+				"one": {Value: &wpb.StringValue{Value: "val-one"}},
+				"two": {},
+			},
+			MapUintList: map[uint64]*epb.MapUintListMember{
+				42: {Value: &wpb.StringValue{Value: "val-42"}},
+			},
+		},
+		wantPaths: map[*gpb.Path]interface{}{
+			mustPath("/map-list[key=one]/key"):          "one",
+			mustPath("/map-list[key=one]/config/value"): "val-one",
+			mustPath("/map-list[key=two]/key"):          "two",
+			mustPath("/map-uint-list[index=42]/index"):  uint64(42),
+			mustPath("/map-uint-list[index=42]/value"):  "val-42",
+		},
 	}, {
 		desc: "nested list",
 		inMsg: &epb.ExampleMessage{
@@ -216,11 +326,13 @@ func TestPathsFromProtoInternal(t *testing.T) {
 		},
 		wantErrSubstring: "invalid list, does not map to 1 schema path",
 	}, {
-		desc: "repeated field that is not a list - unsupported",
+		desc: "repeated field that is not a list is a leaf-list",
 		inMsg: &epb.InvalidMessage{
 			Ke: []string{"one"},
 		},
-		wantErrSubstring: "invalid list, value is not a proto message",
+		wantPaths: map[*gpb.Path]interface{}{
+			mustPath("/three"): []interface{}{"one"},
+		},
 	}, {
 		desc: "list with bad key type",
 		inMsg: &epb.InvalidMessage{
@@ -327,6 +439,85 @@ func TestProtoFromPaths(t *testing.T) {
 		wantProto: &epb.ExampleMessage{
 			Ui: &wpb.UintValue{Value: 64},
 		},
+	}, {
+		desc:    "optional scalars",
+		inProto: optionalMessage(t, ""),
+		inVals: map[*gpb.Path]interface{}{
+			mustPath("/in"):   int64(0),
+			mustPath("/str"):  "hello",
+			mustPath("/strs"): []interface{}{"one", "two"},
+		},
+		wantProto: optionalMessage(t, `in: 0 str: "hello" strs: "one" strs: "two"`),
+	}, {
+		desc:    "decimal64 union member with annotated fraction digits",
+		inProto: unionMessage(t, ""),
+		inVals: map[*gpb.Path]interface{}{
+			mustPath("/val"): &gpb.Decimal64{Digits: 31, Precision: 1},
+		},
+		wantProto: unionMessage(t, `val_decimal64 { digits: 310 precision: 2 }`),
+	}, {
+		desc:    "decimal64 with trailing zeros beyond annotated fraction digits",
+		inProto: unionMessage(t, ""),
+		inVals: map[*gpb.Path]interface{}{
+			mustPath("/val"): &gpb.Decimal64{Digits: 31400, Precision: 4},
+		},
+		wantProto: unionMessage(t, `val_decimal64 { digits: 314 precision: 2 }`),
+	}, {
+		desc:    "float value rounded to annotated fraction digits",
+		inProto: unionMessage(t, ""),
+		inVals: map[*gpb.Path]interface{}{
+			mustPath("/val"): 0.1 + 0.2,
+		},
+		wantProto: unionMessage(t, `val_decimal64 { digits: 30 precision: 2 }`),
+	}, {
+		desc:    "list represented as a map",
+		inProto: &epb.ExampleMessage{},
+		inVals: map[*gpb.Path]interface{}{
+			mustPath("/map-list[key=one]/key"):          "one",
+			mustPath("/map-list[key=one]/config/value"): "val-one",
+			mustPath("/map-list[key=two]/config/key"):   "two",
+			mustPath("/map-uint-list[index=42]/value"):  "val-42",
+		},
+		wantProto: &epb.ExampleMessage{
+			MapList: map[string]*epb.MapListMember{
+				"one": {Value: &wpb.StringValue{Value: "val-one"}},
+				"two": {},
+			},
+			MapUintList: map[uint64]*epb.MapUintListMember{
+				42: {Value: &wpb.StringValue{Value: "val-42"}},
+			},
+		},
+	}, {
+		desc: "list represented as a map, with existing entry",
+		inProto: &epb.ExampleMessage{
+			MapUintList: map[uint64]*epb.MapUintListMember{
+				42: {},
+				84: {Value: &wpb.StringValue{Value: "val-84"}},
+			},
+		},
+		inVals: map[*gpb.Path]interface{}{
+			mustPath("/map-uint-list[index=42]/value"): "val-42",
+		},
+		wantProto: &epb.ExampleMessage{
+			MapUintList: map[uint64]*epb.MapUintListMember{
+				42: {Value: &wpb.StringValue{Value: "val-42"}},
+				84: {Value: &wpb.StringValue{Value: "val-84"}},
+			},
+		},
+	}, {
+		desc:    "list represented as a map, missing key",
+		inProto: &epb.ExampleMessage{},
+		inVals: map[*gpb.Path]interface{}{
+			mustPath("/map-list[name=one]/config/value"): "val-one",
+		},
+		wantErrSubstring: "key key not specified",
+	}, {
+		desc:    "list represented as a map, invalid key value",
+		inProto: &epb.ExampleMessage{},
+		inVals: map[*gpb.Path]interface{}{
+			mustPath("/map-uint-list[index=forty-two]/value"): "val-42",
+		},
+		wantErrSubstring: "cannot map key of list",
 	}, {
 		desc:    "non uint value for uint",
 		inProto: &epb.ExampleMessage{},
@@ -358,7 +549,203 @@ func TestProtoFromPaths(t *testing.T) {
 		inVals: map[*gpb.Path]interface{}{
 			mustPath("/message"): &gpb.Path{},
 		},
-		wantErrSubstring: "unimplemented",
+		wantErrSubstring: "cannot map value",
+	}, {
+		desc:    "decimal64 field",
+		inProto: &epb.ExampleMessage{},
+		inVals: map[*gpb.Path]interface{}{
+			mustPath("/decimal"): &gpb.TypedValue{
+				Value: &gpb.TypedValue_DecimalVal{DecimalVal: &gpb.Decimal64{Digits: 1234, Precision: 1}},
+			},
+		},
+		wantProto: &epb.ExampleMessage{
+			De: &wpb.Decimal64Value{Digits: 1234, Precision: 1},
+		},
+	}, {
+		desc:    "decimal64 field from float",
+		inProto: &epb.ExampleMessage{},
+		inVals: map[*gpb.Path]interface{}{
+			mustPath("/decimal"): 123.45,
+		},
+		wantProto: &epb.ExampleMessage{
+			De: &wpb.Decimal64Value{Digits: 12345, Precision: 2},
+		},
+	}, {
+		desc:    "child message",
+		inProto: &epb.ExampleMessage{},
+		inVals: map[*gpb.Path]interface{}{
+			mustPath("/message/str"): "hello",
+		},
+		wantProto: &epb.ExampleMessage{
+			Ex: &epb.ExampleMessageChild{
+				Str: &wpb.StringValue{Value: "hello"},
+			},
+		},
+	}, {
+		desc:    "leaf-list of wrapper values",
+		inProto: &epb.ExampleMessage{},
+		inVals: map[*gpb.Path]interface{}{
+			mustPath("/leaf-list"): []interface{}{"one", "two"},
+		},
+		wantProto: &epb.ExampleMessage{
+			LeafList: []*wpb.StringValue{{Value: "one"}, {Value: "two"}},
+		},
+	}, {
+		desc:    "leaf-list from TypedValue",
+		inProto: &epb.ExampleMessage{},
+		inVals: map[*gpb.Path]interface{}{
+			mustPath("/enum-list"): &gpb.TypedValue{
+				Value: &gpb.TypedValue_LeaflistVal{
+					LeaflistVal: &gpb.ScalarArray{
+						Element: []*gpb.TypedValue{
+							{Value: &gpb.TypedValue_StringVal{StringVal: "VAL_ONE"}},
+							{Value: &gpb.TypedValue_StringVal{StringVal: "VAL_TWO"}},
+						},
+					},
+				},
+			},
+		},
+		wantProto: &epb.ExampleMessage{
+			EnumList: []epb.ExampleEnum{epb.ExampleEnum_ENUM_VALONE, epb.ExampleEnum_ENUM_VALTWO},
+		},
+	}, {
+		desc:    "non-leaf-list value for leaf-list",
+		inProto: &epb.ExampleMessage{},
+		inVals: map[*gpb.Path]interface{}{
+			mustPath("/leaf-list"): "one",
+		},
+		wantErrSubstring: "got non-leaf-list value for leaf-list field",
+	}, {
+		desc:    "union with string value",
+		inProto: &epb.ExampleMessage{},
+		inVals: map[*gpb.Path]interface{}{
+			mustPath("/union"): "hello",
+		},
+		wantProto: &epb.ExampleMessage{
+			Union: &epb.ExampleMessage_UnionString{UnionString: "hello"},
+		},
+	}, {
+		desc:    "union with int value",
+		inProto: &epb.ExampleMessage{},
+		inVals: map[*gpb.Path]interface{}{
+			mustPath("/union"): int64(-42),
+		},
+		wantProto: &epb.ExampleMessage{
+			Union: &epb.ExampleMessage_UnionSint64{UnionSint64: -42},
+		},
+	}, {
+		desc:    "union with enumerated value",
+		inProto: &epb.ExampleMessage{},
+		inVals: map[*gpb.Path]interface{}{
+			mustPath("/union"): "VAL_TWO",
+		},
+		wantProto: &epb.ExampleMessage{
+			Union: &epb.ExampleMessage_UnionExampleenum{UnionExampleenum: epb.ExampleEnum_ENUM_VALTWO},
+		},
+	}, {
+		desc:    "union with value of no member type",
+		inProto: &epb.ExampleMessage{},
+		inVals: map[*gpb.Path]interface{}{
+			mustPath("/union"): true,
+		},
+		wantErrSubstring: "cannot map value true (bool) to any type of the union",
+	}, {
+		desc:    "leaf-list of unions",
+		inProto: &epb.ExampleMessage{},
+		inVals: map[*gpb.Path]interface{}{
+			mustPath("/union-list"): []interface{}{"hello", uint64(42), "VAL_ONE"},
+		},
+		wantProto: &epb.ExampleMessage{
+			UnionList: []*epb.ExampleMessage_UnionListUnion{
+				{UnionListString: "hello"},
+				{UnionListUint64: 42},
+				{UnionListExampleenum: epb.ExampleEnum_ENUM_VALONE},
+			},
+		},
+	}, {
+		desc:    "keyed list",
+		inProto: &epb.ExampleMessage{},
+		inVals: map[*gpb.Path]interface{}{
+			mustPath("/list-name[single-key=one]/another-field"): "value-one",
+			mustPath("/list-name[single-key=two]/another-field"): "value-two",
+		},
+		wantProto: &epb.ExampleMessage{
+			Em: []*epb.ExampleMessageKey{{
+				SingleKey: "one",
+				Member: &epb.ExampleMessageListMember{
+					Str: &wpb.StringValue{Value: "value-one"},
+				},
+			}, {
+				SingleKey: "two",
+				Member: &epb.ExampleMessageListMember{
+					Str: &wpb.StringValue{Value: "value-two"},
+				},
+			}},
+		},
+	}, {
+		desc:    "list with union key",
+		inProto: &epb.ExampleMessage{},
+		inVals: map[*gpb.Path]interface{}{
+			mustPath("/union-key-list[key=42]/config/value"): "forty-two",
+		},
+		wantProto: &epb.ExampleMessage{
+			UnionKeyList: []*epb.UnionKeyListKey{{
+				Key: &epb.UnionKeyListKey_KeyUint64{KeyUint64: 42},
+				Member: &epb.UnionKeyListMember{
+					Value: &wpb.StringValue{Value: "forty-two"},
+				},
+			}},
+		},
+	}, {
+		desc:    "list with union key from typed key leaf",
+		inProto: &epb.ExampleMessage{},
+		inVals: map[*gpb.Path]interface{}{
+			mustPath("/union-key-list[key=42]/config/key"):   "42",
+			mustPath("/union-key-list[key=42]/config/value"): "forty-two",
+		},
+		wantProto: &epb.ExampleMessage{
+			UnionKeyList: []*epb.UnionKeyListKey{{
+				Key: &epb.UnionKeyListKey_KeyString{KeyString: "42"},
+				Member: &epb.UnionKeyListMember{
+					Value: &wpb.StringValue{Value: "forty-two"},
+				},
+			}},
+		},
+	}, {
+		desc:    "list with enumerated key",
+		inProto: &epb.ExampleMessage{},
+		inVals: map[*gpb.Path]interface{}{
+			mustPath("/enum-key-list[key=VAL_ONE]/config/value"): "one",
+		},
+		wantProto: &epb.ExampleMessage{
+			EnumKeyList: []*epb.EnumKeyListKey{{
+				Key: epb.ExampleEnum_ENUM_VALONE,
+				Member: &epb.EnumKeyListMember{
+					Value: &wpb.StringValue{Value: "one"},
+				},
+			}},
+		},
+	}, {
+		desc:    "list with invalid enumerated key",
+		inProto: &epb.ExampleMessage{},
+		inVals: map[*gpb.Path]interface{}{
+			mustPath("/enum-key-list[key=NO-EXIST]/config/value"): "one",
+		},
+		wantErrSubstring: "cannot map key of list",
+	}, {
+		desc:    "list path without keys",
+		inProto: &epb.ExampleMessage{},
+		inVals: map[*gpb.Path]interface{}{
+			mustPath("/enum-key-list/config/value"): "one",
+		},
+		wantErrSubstring: "no keys specified for list",
+	}, {
+		desc:    "unknown field within list",
+		inProto: &epb.ExampleMessage{},
+		inVals: map[*gpb.Path]interface{}{
+			mustPath("/enum-key-list[key=VAL_ONE]/config/unknown"): "one",
+		},
+		wantErrSubstring: "did not map path",
 	}, {
 		desc:    "unknown field",
 		inProto: &epb.ExampleMessage{},
@@ -486,9 +873,9 @@ func TestProtoFromPaths(t *testing.T) {
 		desc:    "invalid message with bad field type",
 		inProto: &epb.BadMessageKeyTwo{},
 		inVals: map[*gpb.Path]interface{}{
-			mustPath("one"): "42",
+			mustPath("one"): 42,
 		},
-		wantErrSubstring: "unknown field kind",
+		wantErrSubstring: "got int value for string field",
 	}, {
 		desc:    "extra paths, not ignored",
 		inProto: &epb.Interface{},
@@ -593,3 +980,50 @@ func TestProtoFromPaths(t *testing.T) {
 		})
 	}
 }
+
+func TestScaleDecimal64(t *testing.T) {
+	tests := []struct {
+		desc             string
+		in               *wpb.Decimal64Value
+		inFractionDigits uint32
+		want             *wpb.Decimal64Value
+		wantErrSubstring string
+	}{{
+		desc:             "no fraction digits",
+		in:               &wpb.Decimal64Value{Digits: 1234, Precision: 3},
+		inFractionDigits: 0,
+		want:             &wpb.Decimal64Value{Digits: 1234, Precision: 3},
+	}, {
+		desc:             "increased precision",
+		in:               &wpb.Decimal64Value{Digits: -42, Precision: 0},
+		inFractionDigits: 3,
+		want:             &wpb.Decimal64Value{Digits: -42000, Precision: 3},
+	}, {
+		desc:             "reduced precision",
+		in:               &wpb.Decimal64Value{Digits: 4200, Precision: 3},
+		inFractionDigits: 1,
+		want:             &wpb.Decimal64Value{Digits: 42, Precision: 1},
+	}, {
+		desc:             "too many fraction digits",
+		in:               &wpb.Decimal64Value{Digits: 3141, Precision: 3},
+		inFractionDigits: 2,
+		wantErrSubstring: "has more than 2 fraction digits",
+	}, {
+		desc:             "overflow",
+		in:               &wpb.Decimal64Value{Digits: 922337203685477580, Precision: 0},
+		inFractionDigits: 18,
+		wantErrSubstring: "cannot be represented with 18 fraction digits",
+	}}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			got, err := scaleDecimal64(tt.in, tt.inFractionDigits)
+			if diff := errdiff.Substring(err, tt.wantErrSubstring); diff != "" {
+				t.Fatalf("did not get expected error, %s", diff)
+			}
+			if diff := cmp.Diff(tt.want, got, protocmp.Transform()); diff != "" {
+				t.Errorf("did not get expected value, (-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -1,6 +1,6 @@
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
-// 	protoc-gen-go v1.27.1
+// 	protoc-gen-go v1.26.0
 // 	protoc        v3.12.4
 // source: exschemapath.proto
 
@@ -239,6 +239,18 @@ type ExampleMessage struct {
 	Multi    []*ExampleMessageMultiKey `protobuf:"bytes,9,rep,name=multi,proto3" json:"multi,omitempty"`
 	En       ExampleEnum               `protobuf:"varint,10,opt,name=en,proto3,enum=exschemapath.ExampleEnum" json:"en,omitempty"`
 	Compress *ywrapper.StringValue     `protobuf:"bytes,11,opt,name=compress,proto3" json:"compress,omitempty"`
+	LeafList []*ywrapper.StringValue   `protobuf:"bytes,12,rep,name=leaf_list,json=leafList,proto3" json:"leaf_list,omitempty"`
+	EnumList []ExampleEnum             `protobuf:"varint,13,rep,packed,name=enum_list,json=enumList,proto3,enum=exschemapath.ExampleEnum" json:"enum_list,omitempty"`
+	// Types that are assignable to Union:
+	//	*ExampleMessage_UnionString
+	//	*ExampleMessage_UnionSint64
+	//	*ExampleMessage_UnionExampleenum
+	Union        isExampleMessage_Union           `protobuf_oneof:"union"`
+	UnionList    []*ExampleMessage_UnionListUnion `protobuf:"bytes,17,rep,name=union_list,json=unionList,proto3" json:"union_list,omitempty"`
+	UnionKeyList []*UnionKeyListKey               `protobuf:"bytes,18,rep,name=union_key_list,json=unionKeyList,proto3" json:"union_key_list,omitempty"`
+	EnumKeyList  []*EnumKeyListKey                `protobuf:"bytes,19,rep,name=enum_key_list,json=enumKeyList,proto3" json:"enum_key_list,omitempty"`
+	MapList      map[string]*MapListMember        `protobuf:"bytes,20,rep,name=map_list,json=mapList,proto3" json:"map_list,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	MapUintList  map[uint64]*MapUintListMember    `protobuf:"bytes,21,rep,name=map_uint_list,json=mapUintList,proto3" json:"map_uint_list,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 }
 
 func (x *ExampleMessage) Reset() {
@@ -350,6 +362,105 @@ func (x *ExampleMessage) GetCompress() *ywrapper.StringValue {
 	return nil
 }
 
+func (x *ExampleMessage) GetLeafList() []*ywrapper.StringValue {
+	if x != nil {
+		return x.LeafList
+	}
+	return nil
+}
+
+func (x *ExampleMessage) GetEnumList() []ExampleEnum {
+	if x != nil {
+		return x.EnumList
+	}
+	return nil
+}
+
+func (m *ExampleMessage) GetUnion() isExampleMessage_Union {
+	if m != nil {
+		return m.Union
+	}
+	return nil
+}
+
+func (x *ExampleMessage) GetUnionString() string {
+	if x, ok := x.GetUnion().(*ExampleMessage_UnionString); ok {
+		return x.UnionString
+	}
+	return ""
+}
+
+func (x *ExampleMessage) GetUnionSint64() int64 {
+	if x, ok := x.GetUnion().(*ExampleMessage_UnionSint64); ok {
+		return x.UnionSint64
+	}
+	return 0
+}
+
+func (x *ExampleMessage) GetUnionExampleenum() ExampleEnum {
+	if x, ok := x.GetUnion().(*ExampleMessage_UnionExampleenum); ok {
+		return x.UnionExampleenum
+	}
+	return ExampleEnum_ENUM_UNSET
+}
+
+func (x *ExampleMessage) GetUnionList() []*ExampleMessage_UnionListUnion {
+	if x != nil {
+		return x.UnionList
+	}
+	return nil
+}
+
+func (x *ExampleMessage) GetUnionKeyList() []*UnionKeyListKey {
+	if x != nil {
+		return x.UnionKeyList
+	}
+	return nil
+}
+
+func (x *ExampleMessage) GetEnumKeyList() []*EnumKeyListKey {
+	if x != nil {
+		return x.EnumKeyList
+	}
+	return nil
+}
+
+func (x *ExampleMessage) GetMapList() map[string]*MapListMember {
+	if x != nil {
+		return x.MapList
+	}
+	return nil
+}
+
+func (x *ExampleMessage) GetMapUintList() map[uint64]*MapUintListMember {
+	if x != nil {
+		return x.MapUintList
+	}
+	return nil
+}
+
+type isExampleMessage_Union interface {
+	isExampleMessage_Union()
+}
+
+type ExampleMessage_UnionString struct {
+	UnionString string `protobuf:"bytes,14,opt,name=union_string,json=unionString,proto3,oneof"`
+}
+
+type ExampleMessage_UnionSint64 struct {
+	UnionSint64 int64 `protobuf:"zigzag64,15,opt,name=union_sint64,json=unionSint64,proto3,oneof"`
+}
+
+type ExampleMessage_UnionExampleenum struct {
+	UnionExampleenum ExampleEnum `protobuf:"varint,16,opt,name=union_exampleenum,json=unionExampleenum,proto3,enum=exschemapath.ExampleEnum,oneof"`
+}
+
+func (*ExampleMessage_UnionString) isExampleMessage_Union() {}
+
+func (*ExampleMessage_UnionSint64) isExampleMessage_Union() {}
+
+func (*ExampleMessage_UnionExampleenum) isExampleMessage_Union() {}
+
 type ExampleMessageChild struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1089,6 +1200,337 @@ func (x *InvalidKeyPathKey) GetKey() string {
 	return ""
 }
 
+type UnionKeyListKey struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Key:
+	//	*UnionKeyListKey_KeyString
+	//	*UnionKeyListKey_KeyUint64
+	Key    isUnionKeyListKey_Key `protobuf_oneof:"key"`
+	Member *UnionKeyListMember   `protobuf:"bytes,3,opt,name=member,proto3" json:"member,omitempty"`
+}
+
+func (x *UnionKeyListKey) Reset() {
+	*x = UnionKeyListKey{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exschemapath_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnionKeyListKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnionKeyListKey) ProtoMessage() {}
+
+func (x *UnionKeyListKey) ProtoReflect() protoreflect.Message {
+	mi := &file_exschemapath_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnionKeyListKey.ProtoReflect.Descriptor instead.
+func (*UnionKeyListKey) Descriptor() ([]byte, []int) {
+	return file_exschemapath_proto_rawDescGZIP(), []int{17}
+}
+
+func (m *UnionKeyListKey) GetKey() isUnionKeyListKey_Key {
+	if m != nil {
+		return m.Key
+	}
+	return nil
+}
+
+func (x *UnionKeyListKey) GetKeyString() string {
+	if x, ok := x.GetKey().(*UnionKeyListKey_KeyString); ok {
+		return x.KeyString
+	}
+	return ""
+}
+
+func (x *UnionKeyListKey) GetKeyUint64() uint64 {
+	if x, ok := x.GetKey().(*UnionKeyListKey_KeyUint64); ok {
+		return x.KeyUint64
+	}
+	return 0
+}
+
+func (x *UnionKeyListKey) GetMember() *UnionKeyListMember {
+	if x != nil {
+		return x.Member
+	}
+	return nil
+}
+
+type isUnionKeyListKey_Key interface {
+	isUnionKeyListKey_Key()
+}
+
+type UnionKeyListKey_KeyString struct {
+	KeyString string `protobuf:"bytes,1,opt,name=key_string,json=keyString,proto3,oneof"`
+}
+
+type UnionKeyListKey_KeyUint64 struct {
+	KeyUint64 uint64 `protobuf:"varint,2,opt,name=key_uint64,json=keyUint64,proto3,oneof"`
+}
+
+func (*UnionKeyListKey_KeyString) isUnionKeyListKey_Key() {}
+
+func (*UnionKeyListKey_KeyUint64) isUnionKeyListKey_Key() {}
+
+type UnionKeyListMember struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Value *ywrapper.StringValue `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *UnionKeyListMember) Reset() {
+	*x = UnionKeyListMember{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exschemapath_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UnionKeyListMember) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnionKeyListMember) ProtoMessage() {}
+
+func (x *UnionKeyListMember) ProtoReflect() protoreflect.Message {
+	mi := &file_exschemapath_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnionKeyListMember.ProtoReflect.Descriptor instead.
+func (*UnionKeyListMember) Descriptor() ([]byte, []int) {
+	return file_exschemapath_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *UnionKeyListMember) GetValue() *ywrapper.StringValue {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+type EnumKeyListKey struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Key    ExampleEnum        `protobuf:"varint,1,opt,name=key,proto3,enum=exschemapath.ExampleEnum" json:"key,omitempty"`
+	Member *EnumKeyListMember `protobuf:"bytes,2,opt,name=member,proto3" json:"member,omitempty"`
+}
+
+func (x *EnumKeyListKey) Reset() {
+	*x = EnumKeyListKey{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exschemapath_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EnumKeyListKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnumKeyListKey) ProtoMessage() {}
+
+func (x *EnumKeyListKey) ProtoReflect() protoreflect.Message {
+	mi := &file_exschemapath_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnumKeyListKey.ProtoReflect.Descriptor instead.
+func (*EnumKeyListKey) Descriptor() ([]byte, []int) {
+	return file_exschemapath_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *EnumKeyListKey) GetKey() ExampleEnum {
+	if x != nil {
+		return x.Key
+	}
+	return ExampleEnum_ENUM_UNSET
+}
+
+func (x *EnumKeyListKey) GetMember() *EnumKeyListMember {
+	if x != nil {
+		return x.Member
+	}
+	return nil
+}
+
+type EnumKeyListMember struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Value *ywrapper.StringValue `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *EnumKeyListMember) Reset() {
+	*x = EnumKeyListMember{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exschemapath_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EnumKeyListMember) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnumKeyListMember) ProtoMessage() {}
+
+func (x *EnumKeyListMember) ProtoReflect() protoreflect.Message {
+	mi := &file_exschemapath_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnumKeyListMember.ProtoReflect.Descriptor instead.
+func (*EnumKeyListMember) Descriptor() ([]byte, []int) {
+	return file_exschemapath_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *EnumKeyListMember) GetValue() *ywrapper.StringValue {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+type MapListMember struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Value *ywrapper.StringValue `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *MapListMember) Reset() {
+	*x = MapListMember{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exschemapath_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MapListMember) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MapListMember) ProtoMessage() {}
+
+func (x *MapListMember) ProtoReflect() protoreflect.Message {
+	mi := &file_exschemapath_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MapListMember.ProtoReflect.Descriptor instead.
+func (*MapListMember) Descriptor() ([]byte, []int) {
+	return file_exschemapath_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *MapListMember) GetValue() *ywrapper.StringValue {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
+type MapUintListMember struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Value *ywrapper.StringValue `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *MapUintListMember) Reset() {
+	*x = MapUintListMember{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exschemapath_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MapUintListMember) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MapUintListMember) ProtoMessage() {}
+
+func (x *MapUintListMember) ProtoReflect() protoreflect.Message {
+	mi := &file_exschemapath_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MapUintListMember.ProtoReflect.Descriptor instead.
+func (*MapUintListMember) Descriptor() ([]byte, []int) {
+	return file_exschemapath_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *MapUintListMember) GetValue() *ywrapper.StringValue {
+	if x != nil {
+		return x.Value
+	}
+	return nil
+}
+
 type Root_InterfaceKey struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1101,7 +1543,7 @@ type Root_InterfaceKey struct {
 func (x *Root_InterfaceKey) Reset() {
 	*x = Root_InterfaceKey{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_exschemapath_proto_msgTypes[17]
+		mi := &file_exschemapath_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1114,7 +1556,7 @@ func (x *Root_InterfaceKey) String() string {
 func (*Root_InterfaceKey) ProtoMessage() {}
 
 func (x *Root_InterfaceKey) ProtoReflect() protoreflect.Message {
-	mi := &file_exschemapath_proto_msgTypes[17]
+	mi := &file_exschemapath_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1144,6 +1586,69 @@ func (x *Root_InterfaceKey) GetInterface() *Interface {
 	return nil
 }
 
+type ExampleMessage_UnionListUnion struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	UnionListString      string      `protobuf:"bytes,1,opt,name=union_list_string,json=unionListString,proto3" json:"union_list_string,omitempty"`
+	UnionListUint64      uint64      `protobuf:"varint,2,opt,name=union_list_uint64,json=unionListUint64,proto3" json:"union_list_uint64,omitempty"`
+	UnionListExampleenum ExampleEnum `protobuf:"varint,3,opt,name=union_list_exampleenum,json=unionListExampleenum,proto3,enum=exschemapath.ExampleEnum" json:"union_list_exampleenum,omitempty"`
+}
+
+func (x *ExampleMessage_UnionListUnion) Reset() {
+	*x = ExampleMessage_UnionListUnion{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_exschemapath_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExampleMessage_UnionListUnion) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExampleMessage_UnionListUnion) ProtoMessage() {}
+
+func (x *ExampleMessage_UnionListUnion) ProtoReflect() protoreflect.Message {
+	mi := &file_exschemapath_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExampleMessage_UnionListUnion.ProtoReflect.Descriptor instead.
+func (*ExampleMessage_UnionListUnion) Descriptor() ([]byte, []int) {
+	return file_exschemapath_proto_rawDescGZIP(), []int{3, 0}
+}
+
+func (x *ExampleMessage_UnionListUnion) GetUnionListString() string {
+	if x != nil {
+		return x.UnionListString
+	}
+	return ""
+}
+
+func (x *ExampleMessage_UnionListUnion) GetUnionListUint64() uint64 {
+	if x != nil {
+		return x.UnionListUint64
+	}
+	return 0
+}
+
+func (x *ExampleMessage_UnionListUnion) GetUnionListExampleenum() ExampleEnum {
+	if x != nil {
+		return x.UnionListExampleenum
+	}
+	return ExampleEnum_ENUM_UNSET
+}
+
 var File_exschemapath_proto protoreflect.FileDescriptor
 
 var file_exschemapath_proto_rawDesc = []byte{
@@ -1187,7 +1692,7 @@ var file_exschemapath_proto_rawDesc = []byte{
 	0x2e, 0x79, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
 	0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x1a, 0x82, 0x41, 0x17, 0x2f, 0x73, 0x79, 0x73, 0x74, 0x65,
 	0x6d, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2f, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d,
-	0x65, 0x52, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x80, 0x05, 0x0a, 0x0e,
+	0x65, 0x52, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0xaf, 0x0e, 0x0a, 0x0e,
 	0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x2d,
 	0x0a, 0x02, 0x62, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x79, 0x77, 0x72,
 	0x61, 0x70, 0x70, 0x65, 0x72, 0x2e, 0x42, 0x6f, 0x6f, 0x6c, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42,
@@ -1227,139 +1732,264 @@ var file_exschemapath_proto_rawDesc = []byte{
 	0x65, 0x73, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x79, 0x77, 0x72, 0x61,
 	0x70, 0x70, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65,
 	0x42, 0x12, 0x82, 0x41, 0x0f, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x2f, 0x63, 0x6f, 0x6d, 0x70,
-	0x72, 0x65, 0x73, 0x73, 0x52, 0x08, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x22, 0x4f,
-	0x0a, 0x13, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x43, 0x68, 0x69, 0x6c, 0x64, 0x12, 0x38, 0x0a, 0x03, 0x73, 0x74, 0x72, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x79, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x2e, 0x53, 0x74,
-	0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x0f, 0x82, 0x41, 0x0c, 0x2f, 0x6d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2f, 0x73, 0x74, 0x72, 0x52, 0x03, 0x73, 0x74, 0x72, 0x22,
-	0xa9, 0x01, 0x0a, 0x11, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x4b, 0x65, 0x79, 0x12, 0x54, 0x0a, 0x0a, 0x73, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x5f,
-	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x35, 0x82, 0x41, 0x32, 0x2f, 0x6c,
-	0x69, 0x73, 0x74, 0x2d, 0x6e, 0x61, 0x6d, 0x65, 0x2f, 0x73, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x2d,
-	0x6b, 0x65, 0x79, 0x7c, 0x2f, 0x6c, 0x69, 0x73, 0x74, 0x2d, 0x6e, 0x61, 0x6d, 0x65, 0x2f, 0x63,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2f, 0x73, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x2d, 0x6b, 0x65, 0x79,
-	0x52, 0x09, 0x73, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x4b, 0x65, 0x79, 0x12, 0x3e, 0x0a, 0x06, 0x6d,
-	0x65, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x65, 0x78,
-	0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x70, 0x61, 0x74, 0x68, 0x2e, 0x45, 0x78, 0x61, 0x6d, 0x70,
-	0x6c, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x6d,
-	0x62, 0x65, 0x72, 0x52, 0x06, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x22, 0xb6, 0x01, 0x0a, 0x18,
-	0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4c, 0x69,
-	0x73, 0x74, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x44, 0x0a, 0x03, 0x73, 0x74, 0x72, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x79, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72,
-	0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x1b, 0x82, 0x41,
-	0x18, 0x2f, 0x6c, 0x69, 0x73, 0x74, 0x2d, 0x6e, 0x61, 0x6d, 0x65, 0x2f, 0x61, 0x6e, 0x6f, 0x74,
-	0x68, 0x65, 0x72, 0x2d, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x03, 0x73, 0x74, 0x72, 0x12, 0x54,
-	0x0a, 0x0a, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x02, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x65, 0x78, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x70, 0x61, 0x74,
-	0x68, 0x2e, 0x4e, 0x65, 0x73, 0x74, 0x65, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x4b, 0x65, 0x79, 0x42,
-	0x18, 0x82, 0x41, 0x15, 0x2f, 0x6c, 0x69, 0x73, 0x74, 0x2d, 0x6e, 0x61, 0x6d, 0x65, 0x2f, 0x63,
-	0x68, 0x69, 0x6c, 0x64, 0x2d, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x09, 0x63, 0x68, 0x69, 0x6c, 0x64,
-	0x4c, 0x69, 0x73, 0x74, 0x22, 0x80, 0x01, 0x0a, 0x0d, 0x4e, 0x65, 0x73, 0x74, 0x65, 0x64, 0x4c,
-	0x69, 0x73, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x39, 0x0a, 0x07, 0x6b, 0x65, 0x79, 0x5f, 0x6f, 0x6e,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x20, 0x82, 0x41, 0x1d, 0x2f, 0x6c, 0x69, 0x73,
-	0x74, 0x2d, 0x6e, 0x61, 0x6d, 0x65, 0x2f, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x2d, 0x6c, 0x69, 0x73,
-	0x74, 0x2f, 0x6b, 0x65, 0x79, 0x2d, 0x6f, 0x6e, 0x65, 0x52, 0x06, 0x6b, 0x65, 0x79, 0x4f, 0x6e,
-	0x65, 0x12, 0x34, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x72, 0x65, 0x73, 0x73, 0x52, 0x08, 0x63, 0x6f, 0x6d, 0x70, 0x72, 0x65, 0x73, 0x73, 0x12, 0x41,
+	0x0a, 0x09, 0x6c, 0x65, 0x61, 0x66, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x0c, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x15, 0x2e, 0x79, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x0d, 0x82, 0x41, 0x0a, 0x2f, 0x6c, 0x65,
+	0x61, 0x66, 0x2d, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x08, 0x6c, 0x65, 0x61, 0x66, 0x4c, 0x69, 0x73,
+	0x74, 0x12, 0x45, 0x0a, 0x09, 0x65, 0x6e, 0x75, 0x6d, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x0d,
+	0x20, 0x03, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x65, 0x78, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x70,
+	0x61, 0x74, 0x68, 0x2e, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x45, 0x6e, 0x75, 0x6d, 0x42,
+	0x0d, 0x82, 0x41, 0x0a, 0x2f, 0x65, 0x6e, 0x75, 0x6d, 0x2d, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x08,
+	0x65, 0x6e, 0x75, 0x6d, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x0c, 0x75, 0x6e, 0x69, 0x6f,
+	0x6e, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09,
+	0x82, 0x41, 0x06, 0x2f, 0x75, 0x6e, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x0b, 0x75, 0x6e, 0x69,
+	0x6f, 0x6e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x2e, 0x0a, 0x0c, 0x75, 0x6e, 0x69, 0x6f,
+	0x6e, 0x5f, 0x73, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x12, 0x42, 0x09,
+	0x82, 0x41, 0x06, 0x2f, 0x75, 0x6e, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x0b, 0x75, 0x6e, 0x69,
+	0x6f, 0x6e, 0x53, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x12, 0x53, 0x0a, 0x11, 0x75, 0x6e, 0x69, 0x6f,
+	0x6e, 0x5f, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x65, 0x6e, 0x75, 0x6d, 0x18, 0x10, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x65, 0x78, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x70, 0x61,
+	0x74, 0x68, 0x2e, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x45, 0x6e, 0x75, 0x6d, 0x42, 0x09,
+	0x82, 0x41, 0x06, 0x2f, 0x75, 0x6e, 0x69, 0x6f, 0x6e, 0x48, 0x00, 0x52, 0x10, 0x75, 0x6e, 0x69,
+	0x6f, 0x6e, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x65, 0x6e, 0x75, 0x6d, 0x12, 0x5a, 0x0a,
+	0x0a, 0x75, 0x6e, 0x69, 0x6f, 0x6e, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x11, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x2b, 0x2e, 0x65, 0x78, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x70, 0x61, 0x74, 0x68,
+	0x2e, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e,
+	0x55, 0x6e, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x6e, 0x69, 0x6f, 0x6e, 0x42, 0x0e,
+	0x82, 0x41, 0x0b, 0x2f, 0x75, 0x6e, 0x69, 0x6f, 0x6e, 0x2d, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x09,
+	0x75, 0x6e, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x57, 0x0a, 0x0e, 0x75, 0x6e, 0x69,
+	0x6f, 0x6e, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x12, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1d, 0x2e, 0x65, 0x78, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x70, 0x61, 0x74, 0x68,
+	0x2e, 0x55, 0x6e, 0x69, 0x6f, 0x6e, 0x4b, 0x65, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x4b, 0x65, 0x79,
+	0x42, 0x12, 0x82, 0x41, 0x0f, 0x2f, 0x75, 0x6e, 0x69, 0x6f, 0x6e, 0x2d, 0x6b, 0x65, 0x79, 0x2d,
+	0x6c, 0x69, 0x73, 0x74, 0x52, 0x0c, 0x75, 0x6e, 0x69, 0x6f, 0x6e, 0x4b, 0x65, 0x79, 0x4c, 0x69,
+	0x73, 0x74, 0x12, 0x53, 0x0a, 0x0d, 0x65, 0x6e, 0x75, 0x6d, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x6c,
+	0x69, 0x73, 0x74, 0x18, 0x13, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x65, 0x78, 0x73, 0x63,
+	0x68, 0x65, 0x6d, 0x61, 0x70, 0x61, 0x74, 0x68, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x4b, 0x65, 0x79,
+	0x4c, 0x69, 0x73, 0x74, 0x4b, 0x65, 0x79, 0x42, 0x11, 0x82, 0x41, 0x0e, 0x2f, 0x65, 0x6e, 0x75,
+	0x6d, 0x2d, 0x6b, 0x65, 0x79, 0x2d, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x0b, 0x65, 0x6e, 0x75, 0x6d,
+	0x4b, 0x65, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x52, 0x0a, 0x08, 0x6d, 0x61, 0x70, 0x5f, 0x6c,
+	0x69, 0x73, 0x74, 0x18, 0x14, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x65, 0x78, 0x73, 0x63,
+	0x68, 0x65, 0x6d, 0x61, 0x70, 0x61, 0x74, 0x68, 0x2e, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x4d, 0x61, 0x70, 0x4c, 0x69, 0x73, 0x74, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x42, 0x0c, 0x82, 0x41, 0x09, 0x2f, 0x6d, 0x61, 0x70, 0x2d, 0x6c, 0x69,
+	0x73, 0x74, 0x52, 0x07, 0x6d, 0x61, 0x70, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x64, 0x0a, 0x0d, 0x6d,
+	0x61, 0x70, 0x5f, 0x75, 0x69, 0x6e, 0x74, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x15, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x65, 0x78, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x70, 0x61, 0x74,
+	0x68, 0x2e, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x2e, 0x4d, 0x61, 0x70, 0x55, 0x69, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x42, 0x11, 0x82, 0x41, 0x0e, 0x2f, 0x6d, 0x61, 0x70, 0x2d, 0x75, 0x69, 0x6e, 0x74, 0x2d,
+	0x6c, 0x69, 0x73, 0x74, 0x52, 0x0b, 0x6d, 0x61, 0x70, 0x55, 0x69, 0x6e, 0x74, 0x4c, 0x69, 0x73,
+	0x74, 0x1a, 0xb9, 0x01, 0x0a, 0x0e, 0x55, 0x6e, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x55,
+	0x6e, 0x69, 0x6f, 0x6e, 0x12, 0x2a, 0x0a, 0x11, 0x75, 0x6e, 0x69, 0x6f, 0x6e, 0x5f, 0x6c, 0x69,
+	0x73, 0x74, 0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0f, 0x75, 0x6e, 0x69, 0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x12, 0x2a, 0x0a, 0x11, 0x75, 0x6e, 0x69, 0x6f, 0x6e, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x75,
+	0x69, 0x6e, 0x74, 0x36, 0x34, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x75, 0x6e, 0x69,
+	0x6f, 0x6e, 0x4c, 0x69, 0x73, 0x74, 0x55, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x12, 0x4f, 0x0a, 0x16,
+	0x75, 0x6e, 0x69, 0x6f, 0x6e, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x65, 0x78, 0x61, 0x6d, 0x70,
+	0x6c, 0x65, 0x65, 0x6e, 0x75, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x65,
+	0x78, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x70, 0x61, 0x74, 0x68, 0x2e, 0x45, 0x78, 0x61, 0x6d,
+	0x70, 0x6c, 0x65, 0x45, 0x6e, 0x75, 0x6d, 0x52, 0x14, 0x75, 0x6e, 0x69, 0x6f, 0x6e, 0x4c, 0x69,
+	0x73, 0x74, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x65, 0x6e, 0x75, 0x6d, 0x1a, 0x57, 0x0a,
+	0x0c, 0x4d, 0x61, 0x70, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
+	0x31, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b,
+	0x2e, 0x65, 0x78, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x70, 0x61, 0x74, 0x68, 0x2e, 0x4d, 0x61,
+	0x70, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x5f, 0x0a, 0x10, 0x4d, 0x61, 0x70, 0x55, 0x69, 0x6e,
+	0x74, 0x4c, 0x69, 0x73, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x35, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x65, 0x78,
+	0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x70, 0x61, 0x74, 0x68, 0x2e, 0x4d, 0x61, 0x70, 0x55, 0x69,
+	0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x3a, 0x2b, 0x82, 0x41, 0x0f, 0x0a, 0x08, 0x6d, 0x61,
+	0x70, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x03, 0x6b, 0x65, 0x79, 0x82, 0x41, 0x16, 0x0a, 0x0d,
+	0x6d, 0x61, 0x70, 0x5f, 0x75, 0x69, 0x6e, 0x74, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x05, 0x69,
+	0x6e, 0x64, 0x65, 0x78, 0x42, 0x07, 0x0a, 0x05, 0x75, 0x6e, 0x69, 0x6f, 0x6e, 0x22, 0x4f, 0x0a,
+	0x13, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x43,
+	0x68, 0x69, 0x6c, 0x64, 0x12, 0x38, 0x0a, 0x03, 0x73, 0x74, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x15, 0x2e, 0x79, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x0f, 0x82, 0x41, 0x0c, 0x2f, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x2f, 0x73, 0x74, 0x72, 0x52, 0x03, 0x73, 0x74, 0x72, 0x22, 0xa9,
+	0x01, 0x0a, 0x11, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x4b, 0x65, 0x79, 0x12, 0x54, 0x0a, 0x0a, 0x73, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x5f, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x35, 0x82, 0x41, 0x32, 0x2f, 0x6c, 0x69,
+	0x73, 0x74, 0x2d, 0x6e, 0x61, 0x6d, 0x65, 0x2f, 0x73, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x2d, 0x6b,
+	0x65, 0x79, 0x7c, 0x2f, 0x6c, 0x69, 0x73, 0x74, 0x2d, 0x6e, 0x61, 0x6d, 0x65, 0x2f, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x2f, 0x73, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x2d, 0x6b, 0x65, 0x79, 0x52,
+	0x09, 0x73, 0x69, 0x6e, 0x67, 0x6c, 0x65, 0x4b, 0x65, 0x79, 0x12, 0x3e, 0x0a, 0x06, 0x6d, 0x65,
+	0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x65, 0x78, 0x73,
+	0x63, 0x68, 0x65, 0x6d, 0x61, 0x70, 0x61, 0x74, 0x68, 0x2e, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c,
+	0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x6d, 0x62,
+	0x65, 0x72, 0x52, 0x06, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x22, 0xb6, 0x01, 0x0a, 0x18, 0x45,
+	0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4c, 0x69, 0x73,
+	0x74, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x44, 0x0a, 0x03, 0x73, 0x74, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x79, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x2e,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x1b, 0x82, 0x41, 0x18,
+	0x2f, 0x6c, 0x69, 0x73, 0x74, 0x2d, 0x6e, 0x61, 0x6d, 0x65, 0x2f, 0x61, 0x6e, 0x6f, 0x74, 0x68,
+	0x65, 0x72, 0x2d, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x03, 0x73, 0x74, 0x72, 0x12, 0x54, 0x0a,
+	0x0a, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1b, 0x2e, 0x65, 0x78, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x70, 0x61, 0x74, 0x68,
+	0x2e, 0x4e, 0x65, 0x73, 0x74, 0x65, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x4b, 0x65, 0x79, 0x42, 0x18,
+	0x82, 0x41, 0x15, 0x2f, 0x6c, 0x69, 0x73, 0x74, 0x2d, 0x6e, 0x61, 0x6d, 0x65, 0x2f, 0x63, 0x68,
+	0x69, 0x6c, 0x64, 0x2d, 0x6c, 0x69, 0x73, 0x74, 0x52, 0x09, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x4c,
+	0x69, 0x73, 0x74, 0x22, 0x80, 0x01, 0x0a, 0x0d, 0x4e, 0x65, 0x73, 0x74, 0x65, 0x64, 0x4c, 0x69,
+	0x73, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x39, 0x0a, 0x07, 0x6b, 0x65, 0x79, 0x5f, 0x6f, 0x6e, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x20, 0x82, 0x41, 0x1d, 0x2f, 0x6c, 0x69, 0x73, 0x74,
+	0x2d, 0x6e, 0x61, 0x6d, 0x65, 0x2f, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x2d, 0x6c, 0x69, 0x73, 0x74,
+	0x2f, 0x6b, 0x65, 0x79, 0x2d, 0x6f, 0x6e, 0x65, 0x52, 0x06, 0x6b, 0x65, 0x79, 0x4f, 0x6e, 0x65,
+	0x12, 0x34, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1e, 0x2e, 0x65, 0x78, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x70, 0x61, 0x74, 0x68, 0x2e, 0x4e,
+	0x65, 0x73, 0x74, 0x65, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52,
+	0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x22, 0x59, 0x0a, 0x10, 0x4e, 0x65, 0x73, 0x74, 0x65, 0x64,
+	0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x45, 0x0a, 0x03, 0x73, 0x74,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x79, 0x77, 0x72, 0x61, 0x70, 0x70,
+	0x65, 0x72, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x1c,
+	0x82, 0x41, 0x19, 0x2f, 0x6c, 0x69, 0x73, 0x74, 0x2d, 0x6e, 0x61, 0x6d, 0x65, 0x2f, 0x63, 0x68,
+	0x69, 0x6c, 0x64, 0x2d, 0x6c, 0x69, 0x73, 0x74, 0x2f, 0x73, 0x74, 0x72, 0x52, 0x03, 0x73, 0x74,
+	0x72, 0x22, 0xd8, 0x01, 0x0a, 0x16, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x4b, 0x65, 0x79, 0x12, 0x43, 0x0a, 0x05,
+	0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x2d, 0x82, 0x41, 0x2a,
+	0x2f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x2d, 0x6c, 0x69, 0x73, 0x74, 0x2f, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x7c, 0x2f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x2d, 0x6c, 0x69, 0x73, 0x74, 0x2f, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x2f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x12, 0x3f, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x2b, 0x82, 0x41, 0x28, 0x2f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x2d, 0x6c, 0x69, 0x73, 0x74, 0x2f,
+	0x6e, 0x61, 0x6d, 0x65, 0x7c, 0x2f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x2d, 0x6c, 0x69, 0x73, 0x74,
+	0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2f, 0x6e, 0x61, 0x6d, 0x65, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x38, 0x0a, 0x06, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x20, 0x2e, 0x65, 0x78, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x70, 0x61, 0x74,
+	0x68, 0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x4b, 0x65, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65,
+	0x6d, 0x62, 0x65, 0x72, 0x52, 0x06, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x5e, 0x0a, 0x12,
+	0x4d, 0x75, 0x6c, 0x74, 0x69, 0x4b, 0x65, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x6d, 0x62,
+	0x65, 0x72, 0x12, 0x48, 0x0a, 0x05, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x15, 0x2e, 0x79, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x72,
+	0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x1b, 0x82, 0x41, 0x18, 0x2f, 0x6d, 0x75,
+	0x6c, 0x74, 0x69, 0x2d, 0x6c, 0x69, 0x73, 0x74, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2f,
+	0x63, 0x68, 0x69, 0x6c, 0x64, 0x52, 0x05, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x22, 0xb1, 0x06, 0x0a,
+	0x0e, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12,
+	0x5d, 0x0a, 0x09, 0x6d, 0x61, 0x70, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x65, 0x78, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x70, 0x61, 0x74,
+	0x68, 0x2e, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x2e, 0x4d, 0x61, 0x70, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x14,
+	0x82, 0x41, 0x11, 0x2f, 0x61, 0x6e, 0x2f, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x2f, 0x66,
+	0x69, 0x65, 0x6c, 0x64, 0x52, 0x08, 0x6d, 0x61, 0x70, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x23,
+	0x0a, 0x0d, 0x6e, 0x6f, 0x5f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6e, 0x6f, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x3d, 0x0a, 0x02, 0x6b, 0x6d, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1f, 0x2e, 0x65, 0x78, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x70, 0x61, 0x74, 0x68, 0x2e, 0x45,
+	0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4b, 0x65, 0x79,
+	0x42, 0x0c, 0x82, 0x41, 0x09, 0x2f, 0x6f, 0x6e, 0x65, 0x7c, 0x2f, 0x74, 0x77, 0x6f, 0x52, 0x02,
+	0x6b, 0x6d, 0x12, 0x19, 0x0a, 0x02, 0x6b, 0x65, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x42, 0x09,
+	0x82, 0x41, 0x06, 0x2f, 0x74, 0x68, 0x72, 0x65, 0x65, 0x52, 0x02, 0x6b, 0x65, 0x12, 0x35, 0x0a,
+	0x02, 0x62, 0x6b, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x65, 0x78, 0x73, 0x63,
+	0x68, 0x65, 0x6d, 0x61, 0x70, 0x61, 0x74, 0x68, 0x2e, 0x42, 0x61, 0x64, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x4b, 0x65, 0x79, 0x42, 0x08, 0x82, 0x41, 0x05, 0x2f, 0x66, 0x6f, 0x75, 0x72,
+	0x52, 0x02, 0x62, 0x6b, 0x12, 0x38, 0x0a, 0x02, 0x62, 0x6d, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b,
 	0x32, 0x1e, 0x2e, 0x65, 0x78, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x70, 0x61, 0x74, 0x68, 0x2e,
-	0x4e, 0x65, 0x73, 0x74, 0x65, 0x64, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72,
-	0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x22, 0x59, 0x0a, 0x10, 0x4e, 0x65, 0x73, 0x74, 0x65,
-	0x64, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x45, 0x0a, 0x03, 0x73,
-	0x74, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x79, 0x77, 0x72, 0x61, 0x70,
-	0x70, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42,
-	0x1c, 0x82, 0x41, 0x19, 0x2f, 0x6c, 0x69, 0x73, 0x74, 0x2d, 0x6e, 0x61, 0x6d, 0x65, 0x2f, 0x63,
-	0x68, 0x69, 0x6c, 0x64, 0x2d, 0x6c, 0x69, 0x73, 0x74, 0x2f, 0x73, 0x74, 0x72, 0x52, 0x03, 0x73,
-	0x74, 0x72, 0x22, 0xd8, 0x01, 0x0a, 0x16, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x4d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x4b, 0x65, 0x79, 0x12, 0x43, 0x0a,
-	0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x2d, 0x82, 0x41,
-	0x2a, 0x2f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x2d, 0x6c, 0x69, 0x73, 0x74, 0x2f, 0x69, 0x6e, 0x64,
-	0x65, 0x78, 0x7c, 0x2f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x2d, 0x6c, 0x69, 0x73, 0x74, 0x2f, 0x63,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x52, 0x05, 0x69, 0x6e, 0x64,
-	0x65, 0x78, 0x12, 0x3f, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x2b, 0x82, 0x41, 0x28, 0x2f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x2d, 0x6c, 0x69, 0x73, 0x74,
-	0x2f, 0x6e, 0x61, 0x6d, 0x65, 0x7c, 0x2f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x2d, 0x6c, 0x69, 0x73,
-	0x74, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2f, 0x6e, 0x61, 0x6d, 0x65, 0x52, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x12, 0x38, 0x0a, 0x06, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x65, 0x78, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x70, 0x61,
-	0x74, 0x68, 0x2e, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x4b, 0x65, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x4d,
-	0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x06, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x5e, 0x0a,
-	0x12, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x4b, 0x65, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x6d,
-	0x62, 0x65, 0x72, 0x12, 0x48, 0x0a, 0x05, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x42, 0x61, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72,
+	0x42, 0x08, 0x82, 0x41, 0x05, 0x2f, 0x66, 0x69, 0x76, 0x65, 0x52, 0x02, 0x62, 0x6d, 0x12, 0x59,
+	0x0a, 0x16, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x5f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15,
+	0x2e, 0x79, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x0c, 0x82, 0x41, 0x09, 0x2f, 0x6f, 0x6e, 0x65, 0x5b, 0x74,
+	0x77, 0x6f, 0x5d, 0x52, 0x14, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x41, 0x6e, 0x6e, 0x6f,
+	0x74, 0x61, 0x74, 0x65, 0x64, 0x50, 0x61, 0x74, 0x68, 0x12, 0x3e, 0x0a, 0x06, 0x62, 0x6b, 0x5f,
+	0x74, 0x77, 0x6f, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x65, 0x78, 0x73, 0x63,
+	0x68, 0x65, 0x6d, 0x61, 0x70, 0x61, 0x74, 0x68, 0x2e, 0x42, 0x61, 0x64, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x4b, 0x65, 0x79, 0x54, 0x77, 0x6f, 0x42, 0x07, 0x82, 0x41, 0x04, 0x2f, 0x73,
+	0x69, 0x78, 0x52, 0x05, 0x62, 0x6b, 0x54, 0x77, 0x6f, 0x12, 0x79, 0x0a, 0x22, 0x6d, 0x75, 0x6c,
+	0x74, 0x69, 0x70, 0x6c, 0x65, 0x5f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x5f, 0x66, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x65, 0x78, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61,
+	0x70, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x42, 0x0e, 0x82, 0x41, 0x0b, 0x2f, 0x73, 0x69, 0x78, 0x7c, 0x2f, 0x73, 0x65,
+	0x76, 0x65, 0x6e, 0x52, 0x1f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x65, 0x41, 0x6e, 0x6e,
+	0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x46, 0x6f, 0x72, 0x43, 0x6f, 0x6e, 0x74, 0x61,
+	0x69, 0x6e, 0x65, 0x72, 0x12, 0x3e, 0x0a, 0x04, 0x62, 0x6b, 0x70, 0x6d, 0x18, 0x0a, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x65, 0x78, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x70, 0x61, 0x74,
+	0x68, 0x2e, 0x42, 0x61, 0x64, 0x4b, 0x65, 0x79, 0x50, 0x61, 0x74, 0x68, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x42, 0x09, 0x82, 0x41, 0x06, 0x2f, 0x65, 0x69, 0x67, 0x68, 0x74, 0x52, 0x04,
+	0x62, 0x6b, 0x70, 0x6d, 0x12, 0x3d, 0x0a, 0x04, 0x69, 0x6b, 0x70, 0x6b, 0x18, 0x0b, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x65, 0x78, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x70, 0x61, 0x74,
+	0x68, 0x2e, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x4b, 0x65, 0x79, 0x50, 0x61, 0x74, 0x68,
+	0x4b, 0x65, 0x79, 0x42, 0x08, 0x82, 0x41, 0x05, 0x2f, 0x6e, 0x69, 0x6e, 0x65, 0x52, 0x04, 0x69,
+	0x6b, 0x70, 0x6b, 0x1a, 0x3b, 0x0a, 0x0d, 0x4d, 0x61, 0x70, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
+	0x22, 0x36, 0x0a, 0x10, 0x42, 0x61, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4b, 0x65,
+	0x79, 0x54, 0x77, 0x6f, 0x12, 0x22, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x10, 0x82, 0x41, 0x0d, 0x2f, 0x6f, 0x6e, 0x65, 0x7c, 0x2f, 0x6f, 0x6e, 0x65, 0x2f,
+	0x74, 0x77, 0x6f, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x22, 0x3f, 0x0a, 0x0d, 0x42, 0x61, 0x64, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4b, 0x65, 0x79, 0x12, 0x2e, 0x0a, 0x0c, 0x62, 0x61, 0x64,
+	0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x02, 0x42,
+	0x0c, 0x82, 0x41, 0x09, 0x2f, 0x66, 0x6f, 0x75, 0x72, 0x2f, 0x6b, 0x65, 0x79, 0x52, 0x0a, 0x62,
+	0x61, 0x64, 0x4b, 0x65, 0x79, 0x54, 0x79, 0x70, 0x65, 0x22, 0x58, 0x0a, 0x10, 0x42, 0x61, 0x64,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1c, 0x0a,
+	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0x82, 0x41, 0x07, 0x2f,
+	0x6f, 0x6b, 0x2d, 0x6b, 0x65, 0x79, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x26, 0x0a, 0x08, 0x62,
+	0x61, 0x64, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x42, 0x0b, 0x82,
+	0x41, 0x08, 0x2f, 0x62, 0x61, 0x64, 0x2d, 0x6b, 0x65, 0x79, 0x52, 0x07, 0x62, 0x61, 0x64, 0x54,
+	0x79, 0x70, 0x65, 0x22, 0x2b, 0x0a, 0x11, 0x42, 0x61, 0x64, 0x4b, 0x65, 0x79, 0x50, 0x61, 0x74,
+	0x68, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0x82, 0x41, 0x01, 0x2f, 0x52, 0x03, 0x6b, 0x65, 0x79,
+	0x22, 0x33, 0x0a, 0x11, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x4b, 0x65, 0x79, 0x50, 0x61,
+	0x74, 0x68, 0x4b, 0x65, 0x79, 0x12, 0x1e, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x0c, 0x82, 0x41, 0x09, 0x2f, 0x6f, 0x6e, 0x65, 0x5b, 0x74, 0x77, 0x6f, 0x5d,
+	0x52, 0x03, 0x6b, 0x65, 0x79, 0x22, 0xfa, 0x01, 0x0a, 0x0f, 0x55, 0x6e, 0x69, 0x6f, 0x6e, 0x4b,
+	0x65, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x52, 0x0a, 0x0a, 0x6b, 0x65, 0x79,
+	0x5f, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x31, 0x82,
+	0x41, 0x2e, 0x2f, 0x75, 0x6e, 0x69, 0x6f, 0x6e, 0x2d, 0x6b, 0x65, 0x79, 0x2d, 0x6c, 0x69, 0x73,
+	0x74, 0x2f, 0x6b, 0x65, 0x79, 0x7c, 0x2f, 0x75, 0x6e, 0x69, 0x6f, 0x6e, 0x2d, 0x6b, 0x65, 0x79,
+	0x2d, 0x6c, 0x69, 0x73, 0x74, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2f, 0x6b, 0x65, 0x79,
+	0x48, 0x00, 0x52, 0x09, 0x6b, 0x65, 0x79, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x12, 0x52, 0x0a,
+	0x0a, 0x6b, 0x65, 0x79, 0x5f, 0x75, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x04, 0x42, 0x31, 0x82, 0x41, 0x2e, 0x2f, 0x75, 0x6e, 0x69, 0x6f, 0x6e, 0x2d, 0x6b, 0x65, 0x79,
+	0x2d, 0x6c, 0x69, 0x73, 0x74, 0x2f, 0x6b, 0x65, 0x79, 0x7c, 0x2f, 0x75, 0x6e, 0x69, 0x6f, 0x6e,
+	0x2d, 0x6b, 0x65, 0x79, 0x2d, 0x6c, 0x69, 0x73, 0x74, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x2f, 0x6b, 0x65, 0x79, 0x48, 0x00, 0x52, 0x09, 0x6b, 0x65, 0x79, 0x55, 0x69, 0x6e, 0x74, 0x36,
+	0x34, 0x12, 0x38, 0x0a, 0x06, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x20, 0x2e, 0x65, 0x78, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x70, 0x61, 0x74, 0x68,
+	0x2e, 0x55, 0x6e, 0x69, 0x6f, 0x6e, 0x4b, 0x65, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x6d,
+	0x62, 0x65, 0x72, 0x52, 0x06, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x42, 0x05, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x22, 0x62, 0x0a, 0x12, 0x55, 0x6e, 0x69, 0x6f, 0x6e, 0x4b, 0x65, 0x79, 0x4c, 0x69,
+	0x73, 0x74, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x4c, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x79, 0x77, 0x72, 0x61, 0x70, 0x70,
+	0x65, 0x72, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x1f,
+	0x82, 0x41, 0x1c, 0x2f, 0x75, 0x6e, 0x69, 0x6f, 0x6e, 0x2d, 0x6b, 0x65, 0x79, 0x2d, 0x6c, 0x69,
+	0x73, 0x74, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0xa7, 0x01, 0x0a, 0x0e, 0x45, 0x6e, 0x75, 0x6d, 0x4b,
+	0x65, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x5c, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e, 0x65, 0x78, 0x73, 0x63, 0x68, 0x65, 0x6d,
+	0x61, 0x70, 0x61, 0x74, 0x68, 0x2e, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x45, 0x6e, 0x75,
+	0x6d, 0x42, 0x2f, 0x82, 0x41, 0x2c, 0x2f, 0x65, 0x6e, 0x75, 0x6d, 0x2d, 0x6b, 0x65, 0x79, 0x2d,
+	0x6c, 0x69, 0x73, 0x74, 0x2f, 0x6b, 0x65, 0x79, 0x7c, 0x2f, 0x65, 0x6e, 0x75, 0x6d, 0x2d, 0x6b,
+	0x65, 0x79, 0x2d, 0x6c, 0x69, 0x73, 0x74, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2f, 0x6b,
+	0x65, 0x79, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x37, 0x0a, 0x06, 0x6d, 0x65, 0x6d, 0x62, 0x65,
+	0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x65, 0x78, 0x73, 0x63, 0x68, 0x65,
+	0x6d, 0x61, 0x70, 0x61, 0x74, 0x68, 0x2e, 0x45, 0x6e, 0x75, 0x6d, 0x4b, 0x65, 0x79, 0x4c, 0x69,
+	0x73, 0x74, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x06, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72,
+	0x22, 0x60, 0x0a, 0x11, 0x45, 0x6e, 0x75, 0x6d, 0x4b, 0x65, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x4d,
+	0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x4b, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x79, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x2e,
+	0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x1e, 0x82, 0x41, 0x1b,
+	0x2f, 0x65, 0x6e, 0x75, 0x6d, 0x2d, 0x6b, 0x65, 0x79, 0x2d, 0x6c, 0x69, 0x73, 0x74, 0x2f, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x22, 0x57, 0x0a, 0x0d, 0x4d, 0x61, 0x70, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x6d,
+	0x62, 0x65, 0x72, 0x12, 0x46, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01,
 	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x79, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x2e, 0x53, 0x74,
-	0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x1b, 0x82, 0x41, 0x18, 0x2f, 0x6d,
-	0x75, 0x6c, 0x74, 0x69, 0x2d, 0x6c, 0x69, 0x73, 0x74, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x2f, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x52, 0x05, 0x63, 0x68, 0x69, 0x6c, 0x64, 0x22, 0xb1, 0x06,
-	0x0a, 0x0e, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x12, 0x5d, 0x0a, 0x09, 0x6d, 0x61, 0x70, 0x5f, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x65, 0x78, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x70, 0x61,
-	0x74, 0x68, 0x2e, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
-	0x65, 0x2e, 0x4d, 0x61, 0x70, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x42,
-	0x14, 0x82, 0x41, 0x11, 0x2f, 0x61, 0x6e, 0x2f, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x2f,
-	0x66, 0x69, 0x65, 0x6c, 0x64, 0x52, 0x08, 0x6d, 0x61, 0x70, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x12,
-	0x23, 0x0a, 0x0d, 0x6e, 0x6f, 0x5f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6e, 0x6f, 0x41, 0x6e, 0x6e, 0x6f, 0x74, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3d, 0x0a, 0x02, 0x6b, 0x6d, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x1f, 0x2e, 0x65, 0x78, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x70, 0x61, 0x74, 0x68, 0x2e,
-	0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4b, 0x65,
-	0x79, 0x42, 0x0c, 0x82, 0x41, 0x09, 0x2f, 0x6f, 0x6e, 0x65, 0x7c, 0x2f, 0x74, 0x77, 0x6f, 0x52,
-	0x02, 0x6b, 0x6d, 0x12, 0x19, 0x0a, 0x02, 0x6b, 0x65, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x42,
-	0x09, 0x82, 0x41, 0x06, 0x2f, 0x74, 0x68, 0x72, 0x65, 0x65, 0x52, 0x02, 0x6b, 0x65, 0x12, 0x35,
-	0x0a, 0x02, 0x62, 0x6b, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x65, 0x78, 0x73,
-	0x63, 0x68, 0x65, 0x6d, 0x61, 0x70, 0x61, 0x74, 0x68, 0x2e, 0x42, 0x61, 0x64, 0x4d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x4b, 0x65, 0x79, 0x42, 0x08, 0x82, 0x41, 0x05, 0x2f, 0x66, 0x6f, 0x75,
-	0x72, 0x52, 0x02, 0x62, 0x6b, 0x12, 0x38, 0x0a, 0x02, 0x62, 0x6d, 0x18, 0x06, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x1e, 0x2e, 0x65, 0x78, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x70, 0x61, 0x74, 0x68,
-	0x2e, 0x42, 0x61, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4d, 0x65, 0x6d, 0x62, 0x65,
-	0x72, 0x42, 0x08, 0x82, 0x41, 0x05, 0x2f, 0x66, 0x69, 0x76, 0x65, 0x52, 0x02, 0x62, 0x6d, 0x12,
-	0x59, 0x0a, 0x16, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x5f, 0x61, 0x6e, 0x6e, 0x6f, 0x74,
-	0x61, 0x74, 0x65, 0x64, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x19, 0x82, 0x41, 0x16, 0x2f, 0x6d,
+	0x61, 0x70, 0x2d, 0x6c, 0x69, 0x73, 0x74, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2f, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x59, 0x0a, 0x11, 0x4d,
+	0x61, 0x70, 0x55, 0x69, 0x6e, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72,
+	0x12, 0x44, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
 	0x15, 0x2e, 0x79, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e,
-	0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x0c, 0x82, 0x41, 0x09, 0x2f, 0x6f, 0x6e, 0x65, 0x5b,
-	0x74, 0x77, 0x6f, 0x5d, 0x52, 0x14, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x41, 0x6e, 0x6e,
-	0x6f, 0x74, 0x61, 0x74, 0x65, 0x64, 0x50, 0x61, 0x74, 0x68, 0x12, 0x3e, 0x0a, 0x06, 0x62, 0x6b,
-	0x5f, 0x74, 0x77, 0x6f, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x65, 0x78, 0x73,
-	0x63, 0x68, 0x65, 0x6d, 0x61, 0x70, 0x61, 0x74, 0x68, 0x2e, 0x42, 0x61, 0x64, 0x4d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x4b, 0x65, 0x79, 0x54, 0x77, 0x6f, 0x42, 0x07, 0x82, 0x41, 0x04, 0x2f,
-	0x73, 0x69, 0x78, 0x52, 0x05, 0x62, 0x6b, 0x54, 0x77, 0x6f, 0x12, 0x79, 0x0a, 0x22, 0x6d, 0x75,
-	0x6c, 0x74, 0x69, 0x70, 0x6c, 0x65, 0x5f, 0x61, 0x6e, 0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x5f, 0x66, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72,
-	0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x65, 0x78, 0x73, 0x63, 0x68, 0x65, 0x6d,
-	0x61, 0x70, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x4d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x42, 0x0e, 0x82, 0x41, 0x0b, 0x2f, 0x73, 0x69, 0x78, 0x7c, 0x2f, 0x73,
-	0x65, 0x76, 0x65, 0x6e, 0x52, 0x1f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x65, 0x41, 0x6e,
-	0x6e, 0x6f, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x46, 0x6f, 0x72, 0x43, 0x6f, 0x6e, 0x74,
-	0x61, 0x69, 0x6e, 0x65, 0x72, 0x12, 0x3e, 0x0a, 0x04, 0x62, 0x6b, 0x70, 0x6d, 0x18, 0x0a, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x65, 0x78, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x70, 0x61,
-	0x74, 0x68, 0x2e, 0x42, 0x61, 0x64, 0x4b, 0x65, 0x79, 0x50, 0x61, 0x74, 0x68, 0x4d, 0x65, 0x73,
-	0x73, 0x61, 0x67, 0x65, 0x42, 0x09, 0x82, 0x41, 0x06, 0x2f, 0x65, 0x69, 0x67, 0x68, 0x74, 0x52,
-	0x04, 0x62, 0x6b, 0x70, 0x6d, 0x12, 0x3d, 0x0a, 0x04, 0x69, 0x6b, 0x70, 0x6b, 0x18, 0x0b, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x65, 0x78, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x70, 0x61,
-	0x74, 0x68, 0x2e, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x4b, 0x65, 0x79, 0x50, 0x61, 0x74,
-	0x68, 0x4b, 0x65, 0x79, 0x42, 0x08, 0x82, 0x41, 0x05, 0x2f, 0x6e, 0x69, 0x6e, 0x65, 0x52, 0x04,
-	0x69, 0x6b, 0x70, 0x6b, 0x1a, 0x3b, 0x0a, 0x0d, 0x4d, 0x61, 0x70, 0x46, 0x69, 0x65, 0x6c, 0x64,
-	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
-	0x01, 0x22, 0x36, 0x0a, 0x10, 0x42, 0x61, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4b,
-	0x65, 0x79, 0x54, 0x77, 0x6f, 0x12, 0x22, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x10, 0x82, 0x41, 0x0d, 0x2f, 0x6f, 0x6e, 0x65, 0x7c, 0x2f, 0x6f, 0x6e, 0x65,
-	0x2f, 0x74, 0x77, 0x6f, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x22, 0x3f, 0x0a, 0x0d, 0x42, 0x61, 0x64,
-	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4b, 0x65, 0x79, 0x12, 0x2e, 0x0a, 0x0c, 0x62, 0x61,
-	0x64, 0x5f, 0x6b, 0x65, 0x79, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x02,
-	0x42, 0x0c, 0x82, 0x41, 0x09, 0x2f, 0x66, 0x6f, 0x75, 0x72, 0x2f, 0x6b, 0x65, 0x79, 0x52, 0x0a,
-	0x62, 0x61, 0x64, 0x4b, 0x65, 0x79, 0x54, 0x79, 0x70, 0x65, 0x22, 0x58, 0x0a, 0x10, 0x42, 0x61,
-	0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1c,
-	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0x82, 0x41, 0x07,
-	0x2f, 0x6f, 0x6b, 0x2d, 0x6b, 0x65, 0x79, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x26, 0x0a, 0x08,
-	0x62, 0x61, 0x64, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x42, 0x0b,
-	0x82, 0x41, 0x08, 0x2f, 0x62, 0x61, 0x64, 0x2d, 0x6b, 0x65, 0x79, 0x52, 0x07, 0x62, 0x61, 0x64,
-	0x54, 0x79, 0x70, 0x65, 0x22, 0x2b, 0x0a, 0x11, 0x42, 0x61, 0x64, 0x4b, 0x65, 0x79, 0x50, 0x61,
-	0x74, 0x68, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a, 0x03, 0x6b, 0x65, 0x79,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x04, 0x82, 0x41, 0x01, 0x2f, 0x52, 0x03, 0x6b, 0x65,
-	0x79, 0x22, 0x33, 0x0a, 0x11, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x4b, 0x65, 0x79, 0x50,
-	0x61, 0x74, 0x68, 0x4b, 0x65, 0x79, 0x12, 0x1e, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x0c, 0x82, 0x41, 0x09, 0x2f, 0x6f, 0x6e, 0x65, 0x5b, 0x74, 0x77, 0x6f,
-	0x5d, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x2a, 0x7e, 0x0a, 0x0b, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c,
+	0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x17, 0x82, 0x41, 0x14, 0x2f, 0x6d, 0x61, 0x70, 0x2d,
+	0x75, 0x69, 0x6e, 0x74, 0x2d, 0x6c, 0x69, 0x73, 0x74, 0x2f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x2a, 0x7e, 0x0a, 0x0b, 0x45, 0x78, 0x61, 0x6d, 0x70, 0x6c,
 	0x65, 0x45, 0x6e, 0x75, 0x6d, 0x12, 0x0e, 0x0a, 0x0a, 0x45, 0x4e, 0x55, 0x4d, 0x5f, 0x55, 0x4e,
 	0x53, 0x45, 0x54, 0x10, 0x00, 0x12, 0x1b, 0x0a, 0x0b, 0x45, 0x4e, 0x55, 0x4d, 0x5f, 0x56, 0x41,
 	0x4c, 0x4f, 0x4e, 0x45, 0x10, 0x01, 0x1a, 0x0a, 0x82, 0x41, 0x07, 0x56, 0x41, 0x4c, 0x5f, 0x4f,
@@ -1387,74 +2017,101 @@ func file_exschemapath_proto_rawDescGZIP() []byte {
 }
 
 var file_exschemapath_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_exschemapath_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
+var file_exschemapath_proto_msgTypes = make([]protoimpl.MessageInfo, 28)
 var file_exschemapath_proto_goTypes = []interface{}{
-	(ExampleEnum)(0),                 // 0: exschemapath.ExampleEnum
-	(*Root)(nil),                     // 1: exschemapath.Root
-	(*Interface)(nil),                // 2: exschemapath.Interface
-	(*System)(nil),                   // 3: exschemapath.System
-	(*ExampleMessage)(nil),           // 4: exschemapath.ExampleMessage
-	(*ExampleMessageChild)(nil),      // 5: exschemapath.ExampleMessageChild
-	(*ExampleMessageKey)(nil),        // 6: exschemapath.ExampleMessageKey
-	(*ExampleMessageListMember)(nil), // 7: exschemapath.ExampleMessageListMember
-	(*NestedListKey)(nil),            // 8: exschemapath.NestedListKey
-	(*NestedListMember)(nil),         // 9: exschemapath.NestedListMember
-	(*ExampleMessageMultiKey)(nil),   // 10: exschemapath.ExampleMessageMultiKey
-	(*MultiKeyListMember)(nil),       // 11: exschemapath.MultiKeyListMember
-	(*InvalidMessage)(nil),           // 12: exschemapath.InvalidMessage
-	(*BadMessageKeyTwo)(nil),         // 13: exschemapath.BadMessageKeyTwo
-	(*BadMessageKey)(nil),            // 14: exschemapath.BadMessageKey
-	(*BadMessageMember)(nil),         // 15: exschemapath.BadMessageMember
-	(*BadKeyPathMessage)(nil),        // 16: exschemapath.BadKeyPathMessage
-	(*InvalidKeyPathKey)(nil),        // 17: exschemapath.InvalidKeyPathKey
-	(*Root_InterfaceKey)(nil),        // 18: exschemapath.Root.InterfaceKey
-	nil,                              // 19: exschemapath.InvalidMessage.MapFieldEntry
-	(*ywrapper.StringValue)(nil),     // 20: ywrapper.StringValue
-	(*ywrapper.BoolValue)(nil),       // 21: ywrapper.BoolValue
-	(*ywrapper.BytesValue)(nil),      // 22: ywrapper.BytesValue
-	(*ywrapper.Decimal64Value)(nil),  // 23: ywrapper.Decimal64Value
-	(*ywrapper.IntValue)(nil),        // 24: ywrapper.IntValue
-	(*ywrapper.UintValue)(nil),       // 25: ywrapper.UintValue
+	(ExampleEnum)(0),                      // 0: exschemapath.ExampleEnum
+	(*Root)(nil),                          // 1: exschemapath.Root
+	(*Interface)(nil),                     // 2: exschemapath.Interface
+	(*System)(nil),                        // 3: exschemapath.System
+	(*ExampleMessage)(nil),                // 4: exschemapath.ExampleMessage
+	(*ExampleMessageChild)(nil),           // 5: exschemapath.ExampleMessageChild
+	(*ExampleMessageKey)(nil),             // 6: exschemapath.ExampleMessageKey
+	(*ExampleMessageListMember)(nil),      // 7: exschemapath.ExampleMessageListMember
+	(*NestedListKey)(nil),                 // 8: exschemapath.NestedListKey
+	(*NestedListMember)(nil),              // 9: exschemapath.NestedListMember
+	(*ExampleMessageMultiKey)(nil),        // 10: exschemapath.ExampleMessageMultiKey
+	(*MultiKeyListMember)(nil),            // 11: exschemapath.MultiKeyListMember
+	(*InvalidMessage)(nil),                // 12: exschemapath.InvalidMessage
+	(*BadMessageKeyTwo)(nil),              // 13: exschemapath.BadMessageKeyTwo
+	(*BadMessageKey)(nil),                 // 14: exschemapath.BadMessageKey
+	(*BadMessageMember)(nil),              // 15: exschemapath.BadMessageMember
+	(*BadKeyPathMessage)(nil),             // 16: exschemapath.BadKeyPathMessage
+	(*InvalidKeyPathKey)(nil),             // 17: exschemapath.InvalidKeyPathKey
+	(*UnionKeyListKey)(nil),               // 18: exschemapath.UnionKeyListKey
+	(*UnionKeyListMember)(nil),            // 19: exschemapath.UnionKeyListMember
+	(*EnumKeyListKey)(nil),                // 20: exschemapath.EnumKeyListKey
+	(*EnumKeyListMember)(nil),             // 21: exschemapath.EnumKeyListMember
+	(*MapListMember)(nil),                 // 22: exschemapath.MapListMember
+	(*MapUintListMember)(nil),             // 23: exschemapath.MapUintListMember
+	(*Root_InterfaceKey)(nil),             // 24: exschemapath.Root.InterfaceKey
+	(*ExampleMessage_UnionListUnion)(nil), // 25: exschemapath.ExampleMessage.UnionListUnion
+	nil,                                   // 26: exschemapath.ExampleMessage.MapListEntry
+	nil,                                   // 27: exschemapath.ExampleMessage.MapUintListEntry
+	nil,                                   // 28: exschemapath.InvalidMessage.MapFieldEntry
+	(*ywrapper.StringValue)(nil),          // 29: ywrapper.StringValue
+	(*ywrapper.BoolValue)(nil),            // 30: ywrapper.BoolValue
+	(*ywrapper.BytesValue)(nil),           // 31: ywrapper.BytesValue
+	(*ywrapper.Decimal64Value)(nil),       // 32: ywrapper.Decimal64Value
+	(*ywrapper.IntValue)(nil),             // 33: ywrapper.IntValue
+	(*ywrapper.UintValue)(nil),            // 34: ywrapper.UintValue
 }
 var file_exschemapath_proto_depIdxs = []int32{
 	3,  // 0: exschemapath.Root.system:type_name -> exschemapath.System
-	18, // 1: exschemapath.Root.interface:type_name -> exschemapath.Root.InterfaceKey
-	20, // 2: exschemapath.Interface.description:type_name -> ywrapper.StringValue
-	20, // 3: exschemapath.System.hostname:type_name -> ywrapper.StringValue
-	21, // 4: exschemapath.ExampleMessage.bo:type_name -> ywrapper.BoolValue
-	22, // 5: exschemapath.ExampleMessage.by:type_name -> ywrapper.BytesValue
-	23, // 6: exschemapath.ExampleMessage.de:type_name -> ywrapper.Decimal64Value
-	24, // 7: exschemapath.ExampleMessage.in:type_name -> ywrapper.IntValue
-	20, // 8: exschemapath.ExampleMessage.str:type_name -> ywrapper.StringValue
-	25, // 9: exschemapath.ExampleMessage.ui:type_name -> ywrapper.UintValue
+	24, // 1: exschemapath.Root.interface:type_name -> exschemapath.Root.InterfaceKey
+	29, // 2: exschemapath.Interface.description:type_name -> ywrapper.StringValue
+	29, // 3: exschemapath.System.hostname:type_name -> ywrapper.StringValue
+	30, // 4: exschemapath.ExampleMessage.bo:type_name -> ywrapper.BoolValue
+	31, // 5: exschemapath.ExampleMessage.by:type_name -> ywrapper.BytesValue
+	32, // 6: exschemapath.ExampleMessage.de:type_name -> ywrapper.Decimal64Value
+	33, // 7: exschemapath.ExampleMessage.in:type_name -> ywrapper.IntValue
+	29, // 8: exschemapath.ExampleMessage.str:type_name -> ywrapper.StringValue
+	34, // 9: exschemapath.ExampleMessage.ui:type_name -> ywrapper.UintValue
 	5,  // 10: exschemapath.ExampleMessage.ex:type_name -> exschemapath.ExampleMessageChild
 	6,  // 11: exschemapath.ExampleMessage.em:type_name -> exschemapath.ExampleMessageKey
 	10, // 12: exschemapath.ExampleMessage.multi:type_name -> exschemapath.ExampleMessageMultiKey
 	0,  // 13: exschemapath.ExampleMessage.en:type_name -> exschemapath.ExampleEnum
-	20, // 14: exschemapath.ExampleMessage.compress:type_name -> ywrapper.StringValue
-	20, // 15: exschemapath.ExampleMessageChild.str:type_name -> ywrapper.StringValue
-	7,  // 16: exschemapath.ExampleMessageKey.member:type_name -> exschemapath.ExampleMessageListMember
-	20, // 17: exschemapath.ExampleMessageListMember.str:type_name -> ywrapper.StringValue
-	8,  // 18: exschemapath.ExampleMessageListMember.child_list:type_name -> exschemapath.NestedListKey
-	9,  // 19: exschemapath.NestedListKey.field:type_name -> exschemapath.NestedListMember
-	20, // 20: exschemapath.NestedListMember.str:type_name -> ywrapper.StringValue
-	11, // 21: exschemapath.ExampleMessageMultiKey.member:type_name -> exschemapath.MultiKeyListMember
-	20, // 22: exschemapath.MultiKeyListMember.child:type_name -> ywrapper.StringValue
-	19, // 23: exschemapath.InvalidMessage.map_field:type_name -> exschemapath.InvalidMessage.MapFieldEntry
-	6,  // 24: exschemapath.InvalidMessage.km:type_name -> exschemapath.ExampleMessageKey
-	14, // 25: exschemapath.InvalidMessage.bk:type_name -> exschemapath.BadMessageKey
-	15, // 26: exschemapath.InvalidMessage.bm:type_name -> exschemapath.BadMessageMember
-	20, // 27: exschemapath.InvalidMessage.invalid_annotated_path:type_name -> ywrapper.StringValue
-	13, // 28: exschemapath.InvalidMessage.bk_two:type_name -> exschemapath.BadMessageKeyTwo
-	12, // 29: exschemapath.InvalidMessage.multiple_annotations_for_container:type_name -> exschemapath.InvalidMessage
-	16, // 30: exschemapath.InvalidMessage.bkpm:type_name -> exschemapath.BadKeyPathMessage
-	17, // 31: exschemapath.InvalidMessage.ikpk:type_name -> exschemapath.InvalidKeyPathKey
-	2,  // 32: exschemapath.Root.InterfaceKey.interface:type_name -> exschemapath.Interface
-	33, // [33:33] is the sub-list for method output_type
-	33, // [33:33] is the sub-list for method input_type
-	33, // [33:33] is the sub-list for extension type_name
-	33, // [33:33] is the sub-list for extension extendee
-	0,  // [0:33] is the sub-list for field type_name
+	29, // 14: exschemapath.ExampleMessage.compress:type_name -> ywrapper.StringValue
+	29, // 15: exschemapath.ExampleMessage.leaf_list:type_name -> ywrapper.StringValue
+	0,  // 16: exschemapath.ExampleMessage.enum_list:type_name -> exschemapath.ExampleEnum
+	0,  // 17: exschemapath.ExampleMessage.union_exampleenum:type_name -> exschemapath.ExampleEnum
+	25, // 18: exschemapath.ExampleMessage.union_list:type_name -> exschemapath.ExampleMessage.UnionListUnion
+	18, // 19: exschemapath.ExampleMessage.union_key_list:type_name -> exschemapath.UnionKeyListKey
+	20, // 20: exschemapath.ExampleMessage.enum_key_list:type_name -> exschemapath.EnumKeyListKey
+	26, // 21: exschemapath.ExampleMessage.map_list:type_name -> exschemapath.ExampleMessage.MapListEntry
+	27, // 22: exschemapath.ExampleMessage.map_uint_list:type_name -> exschemapath.ExampleMessage.MapUintListEntry
+	29, // 23: exschemapath.ExampleMessageChild.str:type_name -> ywrapper.StringValue
+	7,  // 24: exschemapath.ExampleMessageKey.member:type_name -> exschemapath.ExampleMessageListMember
+	29, // 25: exschemapath.ExampleMessageListMember.str:type_name -> ywrapper.StringValue
+	8,  // 26: exschemapath.ExampleMessageListMember.child_list:type_name -> exschemapath.NestedListKey
+	9,  // 27: exschemapath.NestedListKey.field:type_name -> exschemapath.NestedListMember
+	29, // 28: exschemapath.NestedListMember.str:type_name -> ywrapper.StringValue
+	11, // 29: exschemapath.ExampleMessageMultiKey.member:type_name -> exschemapath.MultiKeyListMember
+	29, // 30: exschemapath.MultiKeyListMember.child:type_name -> ywrapper.StringValue
+	28, // 31: exschemapath.InvalidMessage.map_field:type_name -> exschemapath.InvalidMessage.MapFieldEntry
+	6,  // 32: exschemapath.InvalidMessage.km:type_name -> exschemapath.ExampleMessageKey
+	14, // 33: exschemapath.InvalidMessage.bk:type_name -> exschemapath.BadMessageKey
+	15, // 34: exschemapath.InvalidMessage.bm:type_name -> exschemapath.BadMessageMember
+	29, // 35: exschemapath.InvalidMessage.invalid_annotated_path:type_name -> ywrapper.StringValue
+	13, // 36: exschemapath.InvalidMessage.bk_two:type_name -> exschemapath.BadMessageKeyTwo
+	12, // 37: exschemapath.InvalidMessage.multiple_annotations_for_container:type_name -> exschemapath.InvalidMessage
+	16, // 38: exschemapath.InvalidMessage.bkpm:type_name -> exschemapath.BadKeyPathMessage
+	17, // 39: exschemapath.InvalidMessage.ikpk:type_name -> exschemapath.InvalidKeyPathKey
+	19, // 40: exschemapath.UnionKeyListKey.member:type_name -> exschemapath.UnionKeyListMember
+	29, // 41: exschemapath.UnionKeyListMember.value:type_name -> ywrapper.StringValue
+	0,  // 42: exschemapath.EnumKeyListKey.key:type_name -> exschemapath.ExampleEnum
+	21, // 43: exschemapath.EnumKeyListKey.member:type_name -> exschemapath.EnumKeyListMember
+	29, // 44: exschemapath.EnumKeyListMember.value:type_name -> ywrapper.StringValue
+	29, // 45: exschemapath.MapListMember.value:type_name -> ywrapper.StringValue
+	29, // 46: exschemapath.MapUintListMember.value:type_name -> ywrapper.StringValue
+	2,  // 47: exschemapath.Root.InterfaceKey.interface:type_name -> exschemapath.Interface
+	0,  // 48: exschemapath.ExampleMessage.UnionListUnion.union_list_exampleenum:type_name -> exschemapath.ExampleEnum
+	22, // 49: exschemapath.ExampleMessage.MapListEntry.value:type_name -> exschemapath.MapListMember
+	23, // 50: exschemapath.ExampleMessage.MapUintListEntry.value:type_name -> exschemapath.MapUintListMember
+	51, // [51:51] is the sub-list for method output_type
+	51, // [51:51] is the sub-list for method input_type
+	51, // [51:51] is the sub-list for extension type_name
+	51, // [51:51] is the sub-list for extension extendee
+	0,  // [0:51] is the sub-list for field type_name
 }
 
 func init() { file_exschemapath_proto_init() }
@@ -1668,6 +2325,78 @@ func file_exschemapath_proto_init() {
 			}
 		}
 		file_exschemapath_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnionKeyListKey); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exschemapath_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UnionKeyListMember); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exschemapath_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EnumKeyListKey); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exschemapath_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EnumKeyListMember); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exschemapath_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MapListMember); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exschemapath_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MapUintListMember); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_exschemapath_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Root_InterfaceKey); i {
 			case 0:
 				return &v.state
@@ -1679,6 +2408,27 @@ func file_exschemapath_proto_init() {
 				return nil
 			}
 		}
+		file_exschemapath_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExampleMessage_UnionListUnion); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_exschemapath_proto_msgTypes[3].OneofWrappers = []interface{}{
+		(*ExampleMessage_UnionString)(nil),
+		(*ExampleMessage_UnionSint64)(nil),
+		(*ExampleMessage_UnionExampleenum)(nil),
+	}
+	file_exschemapath_proto_msgTypes[17].OneofWrappers = []interface{}{
+		(*UnionKeyListKey_KeyString)(nil),
+		(*UnionKeyListKey_KeyUint64)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -1686,7 +2436,7 @@ func file_exschemapath_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_exschemapath_proto_rawDesc,
 			NumEnums:      1,
-			NumMessages:   19,
+			NumMessages:   28,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -173,9 +173,10 @@ func NotificationSetEqual(a, b []*gnmipb.Notification, opts ...ComparerOpt) bool
 
 // JSONIETFComparer compares the two provided JSON IETF TypedValues to
 // determine whether their contents are the same. If either value is
-// invalid JSON, the function returns false.
+// invalid JSON, the function returns false. Both JSON objects and arrays, such
+// as those used for keyless lists, can be compared.
 func JSONIETFComparer(a, b []byte) bool {
-	aj, bj := map[string]interface{}{}, map[string]interface{}{}
+	var aj, bj interface{}
 	if err := json.Unmarshal(a, &aj); err != nil {
 		return false
 	}
@@ -215,7 +215,10 @@ func SchemaTreeString(schema *yang.Entry, prefix string) string {
 //           IpPrefix : "255.255.255.0/20" [ip-prefix (leaf)]
 //           MasklengthRange : "20..24" [masklength-range (leaf)]
 //         PrefixSetName : "prefix1" [prefix-set-name (leaf)]
-func DataSchemaTreesString(schema *yang.Entry, dataTree interface{}) string {
+// The values of nodes that are tagged as sensitive, or whose schema has one
+// of the supplied sensitiveExts extensions, along with those of their
+// descendants, are output as RedactedValue.
+func DataSchemaTreesString(schema *yang.Entry, dataTree interface{}, sensitiveExts ...string) string {
 	printFieldsIterFunc := func(ni *NodeInfo, in, out interface{}) (errs Errors) {
 		outs := out.(*string)
 		prefix := ""
@@ -225,9 +228,14 @@ func DataSchemaTreesString(schema *yang.Entry, dataTree interface{}) string {
 
 		fStr := fmt.Sprintf("%s%s", prefix, ni.StructField.Name)
 		schemaStr := fmt.Sprintf("[%s (%s)]", ni.Schema.Name, SchemaTypeStr(ni.Schema))
+		sensitive := isSensitiveNode(ni, sensitiveExts)
 		switch {
+		case IsValueScalar(ni.FieldValue) && sensitive:
+			*outs += fmt.Sprintf("  %s : %s %s\n", fStr, RedactedValue, schemaStr)
 		case IsValueScalar(ni.FieldValue):
 			*outs += fmt.Sprintf("  %s : %s %s\n", fStr, pretty.Sprint(ni.FieldValue.Interface()), schemaStr)
+		case !IsNilOrInvalidValue(ni.FieldKey) && sensitive:
+			*outs += fmt.Sprintf("%s%s\n", prefix, RedactedValue)
 		case !IsNilOrInvalidValue(ni.FieldKey):
 			*outs += fmt.Sprintf("%s%v\n", prefix, ni.FieldKey)
 		case !IsNilOrInvalidValue(ni.FieldValue):
@@ -243,3 +251,14 @@ func DataSchemaTreesString(schema *yang.Entry, dataTree interface{}) string {
 
 	return outStr
 }
+
+// isSensitiveNode returns true if the node ni, or any of its ancestors, is
+// tagged as sensitive or has a schema with one of the supplied extensions.
+func isSensitiveNode(ni *NodeInfo, exts []string) bool {
+	for n := ni; n != nil; n = n.Parent {
+		if IsSensitive(n.StructField) || HasExtension(n.Schema, exts) {
+			return true
+		}
+	}
+	return false
+}
@@ -292,5 +292,99 @@ func TestDataSchemaTreesString(t *testing.T) {
 	}
 }
 
+func TestDataSchemaTreesStringSensitive(t *testing.T) {
+	containerSchema := &yang.Entry{
+		Name: "container",
+		Kind: yang.DirectoryEntry,
+		Dir: map[string]*yang.Entry{
+			"password": {
+				Kind: yang.LeafEntry,
+				Name: "password",
+				Type: &yang.YangType{Kind: yang.Ystring},
+				Exts: []*yang.Statement{{Keyword: "oc-ext:sensitive"}},
+			},
+			"secret": {
+				Kind: yang.LeafEntry,
+				Name: "secret",
+				Type: &yang.YangType{Kind: yang.Ystring},
+			},
+			"name": {
+				Kind: yang.LeafEntry,
+				Name: "name",
+				Type: &yang.YangType{Kind: yang.Ystring},
+			},
+		},
+	}
+
+	type ContainerStruct struct {
+		Name     *string `path:"name"`
+		Password *string `path:"password"`
+		Secret   *string `path:"secret" ygotSensitive:"true"`
+	}
+
+	container := &ContainerStruct{
+		Name:     String("foo"),
+		Password: String("hunter2"),
+		Secret:   String("s3cret"),
+	}
+
+	got := DataSchemaTreesString(containerSchema, container, "sensitive")
+	want := `     [container (container)]
+      Name : "foo" [name (leaf)]
+      Password : REDACTED [password (leaf)]
+      Secret : REDACTED [secret (leaf)]
+`
+	if got != want {
+		t.Errorf("got:\n%swant:\n%s", got, want)
+	}
+}
+
+func TestHasExtension(t *testing.T) {
+	tests := []struct {
+		name    string
+		inEntry *yang.Entry
+		inNames []string
+		want    bool
+	}{{
+		name:    "nil entry",
+		inNames: []string{"sensitive"},
+	}, {
+		name:    "no extensions",
+		inEntry: &yang.Entry{Name: "leaf"},
+		inNames: []string{"sensitive"},
+	}, {
+		name: "unprefixed name",
+		inEntry: &yang.Entry{
+			Name: "leaf",
+			Exts: []*yang.Statement{{Keyword: "oc-ext:sensitive"}},
+		},
+		inNames: []string{"sensitive"},
+		want:    true,
+	}, {
+		name: "prefixed name",
+		inEntry: &yang.Entry{
+			Name: "leaf",
+			Exts: []*yang.Statement{{Keyword: "nacm:default-deny-all"}},
+		},
+		inNames: []string{"oc-ext:sensitive", "nacm:default-deny-all"},
+		want:    true,
+	}, {
+		name: "prefixed name with different prefix",
+		inEntry: &yang.Entry{
+			Name: "leaf",
+			Exts: []*yang.Statement{{Keyword: "ext:sensitive"}},
+		},
+		inNames: []string{"oc-ext:sensitive"},
+	}}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := HasExtension(tt.inEntry, tt.inNames); got != tt.want {
+				t.Errorf("HasExtension(%v, %v): got %v, want %v", tt.inEntry, tt.inNames, got, tt.want)
+			}
+		})
+	}
+}
+
 func String(s string) *string { return &s }
 func Int32(i int32) *int32    { return &i }
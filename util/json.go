@@ -0,0 +1,104 @@
+// Copyright 2021 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// JSONMember returns the name and value of the member of the RFC7951 JSON
+// object obj that represents the data node name. If obj has no member named
+// name, then module prefixes of name and of the members of obj are not
+// considered.
+func JSONMember(obj map[string]interface{}, name string) (string, interface{}, bool) {
+	if v, ok := obj[name]; ok {
+		return name, v, true
+	}
+	for k, v := range obj {
+		if StripModulePrefix(k) == StripModulePrefix(name) {
+			return k, v, true
+		}
+	}
+	return "", nil, false
+}
+
+// JSONKeyString returns the string representation, as used within gNMI paths,
+// of the RFC7951 JSON value v of a list key, as decoded by encoding/json.
+func JSONKeyString(v interface{}) string {
+	if f, ok := v.(float64); ok {
+		return strconv.FormatFloat(f, 'f', -1, 64)
+	}
+	return fmt.Sprintf("%v", v)
+}
+
+// FindJSONListEntry returns the index of the entry of the RFC7951 JSON array
+// l that has the supplied key values, or -1 if there is no such entry. Values
+// of identityref keys match with or without their module prefix.
+func FindJSONListEntry(l []interface{}, keys map[string]string) (int, error) {
+	for i, e := range l {
+		obj, ok := e.(map[string]interface{})
+		if !ok {
+			return -1, fmt.Errorf("list entry is not a JSON object: %v", e)
+		}
+		if jsonEntryHasKeys(obj, keys) {
+			return i, nil
+		}
+	}
+	return -1, nil
+}
+
+// jsonEntryHasKeys returns true if the JSON object of a list entry has the
+// supplied key values.
+func jsonEntryHasKeys(entry map[string]interface{}, keys map[string]string) bool {
+	for k, want := range keys {
+		_, v, ok := JSONMember(entry, k)
+		if !ok {
+			return false
+		}
+		got := JSONKeyString(v)
+		if got != want && StripModulePrefix(got) != StripModulePrefix(want) {
+			return false
+		}
+	}
+	return true
+}
+
+// JSONPointer returns the RFC6901 JSON Pointer consisting of the supplied
+// reference tokens.
+func JSONPointer(tokens []string) string {
+	var b strings.Builder
+	for _, t := range tokens {
+		b.WriteString("/" + strings.NewReplacer("~", "~0", "/", "~1").Replace(t))
+	}
+	return b.String()
+}
+
+// ParseJSONPointer returns the reference tokens of the RFC6901 JSON Pointer
+// ptr.
+func ParseJSONPointer(ptr string) ([]string, error) {
+	if ptr == "" {
+		return nil, nil
+	}
+	if !strings.HasPrefix(ptr, "/") {
+		return nil, fmt.Errorf("invalid JSON pointer %q", ptr)
+	}
+	toks := strings.Split(ptr[1:], "/")
+	for i, t := range toks {
+		toks[i] = strings.NewReplacer("~1", "/", "~0", "~").Replace(t)
+	}
+	return toks, nil
+}
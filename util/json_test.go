@@ -0,0 +1,120 @@
+// Copyright 2021 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package util
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/openconfig/gnmi/errdiff"
+)
+
+func TestFindJSONListEntry(t *testing.T) {
+	tests := []struct {
+		desc    string
+		inList  []interface{}
+		inKeys  map[string]string
+		want    int
+		wantErr string
+	}{{
+		desc: "string key",
+		inList: []interface{}{
+			map[string]interface{}{"name": "a"},
+			map[string]interface{}{"name": "b"},
+		},
+		inKeys: map[string]string{"name": "b"},
+		want:   1,
+	}, {
+		desc: "numeric key",
+		inList: []interface{}{
+			map[string]interface{}{"id": float64(42)},
+		},
+		inKeys: map[string]string{"id": "42"},
+		want:   0,
+	}, {
+		desc: "prefixed member and identityref key",
+		inList: []interface{}{
+			map[string]interface{}{"mod:type": "mod:ETHERNET"},
+		},
+		inKeys: map[string]string{"type": "ETHERNET"},
+		want:   0,
+	}, {
+		desc: "no matching entry",
+		inList: []interface{}{
+			map[string]interface{}{"name": "a"},
+		},
+		inKeys: map[string]string{"name": "b"},
+		want:   -1,
+	}, {
+		desc:    "entry is not an object",
+		inList:  []interface{}{"a"},
+		inKeys:  map[string]string{"name": "a"},
+		want:    -1,
+		wantErr: "list entry is not a JSON object",
+	}}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			got, err := FindJSONListEntry(tt.inList, tt.inKeys)
+			if diff := errdiff.Substring(err, tt.wantErr); diff != "" {
+				t.Fatalf("FindJSONListEntry(%v, %v): %s", tt.inList, tt.inKeys, diff)
+			}
+			if got != tt.want {
+				t.Errorf("FindJSONListEntry(%v, %v): got %d, want %d", tt.inList, tt.inKeys, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestJSONPointer(t *testing.T) {
+	tests := []struct {
+		desc     string
+		inTokens []string
+		want     string
+	}{{
+		desc: "empty",
+		want: "",
+	}, {
+		desc:     "simple tokens",
+		inTokens: []string{"interfaces", "interface", "0"},
+		want:     "/interfaces/interface/0",
+	}, {
+		desc:     "escaped tokens",
+		inTokens: []string{"a/b", "c~d"},
+		want:     "/a~1b/c~0d",
+	}}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			got := JSONPointer(tt.inTokens)
+			if got != tt.want {
+				t.Fatalf("JSONPointer(%v): got %q, want %q", tt.inTokens, got, tt.want)
+			}
+			toks, err := ParseJSONPointer(got)
+			if err != nil {
+				t.Fatalf("ParseJSONPointer(%q): unexpected error: %v", got, err)
+			}
+			if diff := cmp.Diff(tt.inTokens, toks); diff != "" {
+				t.Errorf("ParseJSONPointer(%q): did not get expected tokens, (-want, +got):\n%s", got, diff)
+			}
+		})
+	}
+}
+
+func TestParseJSONPointerError(t *testing.T) {
+	if _, err := ParseJSONPointer("a/b"); err == nil {
+		t.Errorf("ParseJSONPointer(%q): did not get expected error", "a/b")
+	}
+}
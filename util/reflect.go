@@ -614,6 +614,11 @@ func (node *PathQueryNodeMemo) GetRoot() *PathQueryNodeMemo {
 // It returns a slice of errors encountered while processing the field.
 type FieldIteratorFunc func(ni *NodeInfo, in, out interface{}) Errors
 
+// SkipDescendants can be returned, as the only error, by the FieldIteratorFunc
+// supplied to ForEachField to indicate that the descendants of the current
+// node should not be traversed. It is never returned by ForEachField.
+var SkipDescendants = errors.New("skip descendants")
+
 // ForEachField recursively iterates through the fields of value (which may be
 // any Go type) and executes iterFunction on each field. Any nil fields
 // (including value) are traversed in the schema tree only. This is done to
@@ -624,7 +629,8 @@ type FieldIteratorFunc func(ni *NodeInfo, in, out interface{}) Errors
 //   schema is the schema corresponding to value.
 //   in, out are passed to the iterator function and can be used to carry state
 //     and return results from the iterator.
-//   iterFunction is executed on each scalar field.
+//   iterFunction is executed on each scalar field. If it returns
+//     SkipDescendants, the descendants of the field are not traversed.
 // It returns a slice of errors encountered while processing the struct.
 func ForEachField(schema *yang.Entry, value interface{}, in, out interface{}, iterFunction FieldIteratorFunc) Errors {
 	if IsValueNil(value) {
@@ -651,7 +657,11 @@ func forEachFieldInternal(ni *NodeInfo, in, out interface{}, iterFunction FieldI
 	}
 
 	var errs Errors
-	errs = AppendErrs(errs, iterFunction(ni, in, out))
+	ierrs := iterFunction(ni, in, out)
+	if len(ierrs) == 1 && ierrs[0] == SkipDescendants {
+		return nil
+	}
+	errs = AppendErrs(errs, ierrs)
 
 	// Special processing where an "in" input value is provided.
 	var newPathQueryMemo func() *PathQueryNodeMemo
@@ -770,14 +780,15 @@ func forEachFieldInternal(ni *NodeInfo, in, out interface{}, iterFunction FieldI
 				errs = AppendErrs(errs, forEachFieldInternal(nn, in, out, iterFunction))
 			}
 		} else {
-			for _, key := range ni.FieldValue.MapKeys() {
+			keys := ni.FieldValue.MapKeys()
+			for _, key := range keys {
 				nn := *ni
 				nn.Schema = &schema
 				nn.Parent = ni
 				nn.PathFromParent = []string{schema.Name}
 				nn.FieldValue = ni.FieldValue.MapIndex(key)
 				nn.FieldKey = key
-				nn.FieldKeys = ni.FieldValue.MapKeys()
+				nn.FieldKeys = keys
 				switch in.(type) {
 				case *PathQueryNodeMemo: // Memoization of path queries requested.
 					errs = AppendErrs(errs, forEachFieldInternal(&nn, newPathQueryMemo(), out, iterFunction))
@@ -888,12 +899,13 @@ func forEachDataFieldInternal(ni *NodeInfo, in, out interface{}, iterFunction Fi
 		if IsNilOrInvalidValue(v) {
 			return errs
 		}
-		for _, key := range ni.FieldValue.MapKeys() {
+		keys := ni.FieldValue.MapKeys()
+		for _, key := range keys {
 			nn := *ni
 			nn.Parent = ni
 			nn.FieldValue = ni.FieldValue.MapIndex(key)
 			nn.FieldKey = key
-			nn.FieldKeys = ni.FieldValue.MapKeys()
+			nn.FieldKeys = keys
 			errs = AppendErrs(errs, forEachDataFieldInternal(&nn, in, out, iterFunction))
 		}
 	}
@@ -1337,6 +1337,19 @@ func TestForEachField(t *testing.T) {
 			iterFunc: printFieldsIterFunc,
 			wantOut:  `FieldA : "test", `,
 		},
+		{
+			desc:         "struct of struct skipping descendants",
+			schema:       forEachContainerSchema,
+			parentStruct: &StructOfStructs{BasicStructField: basicStruct1, BasicStructPtrField: &basicStruct2},
+			in:           nil,
+			iterFunc: func(ni *NodeInfo, in, out interface{}) Errors {
+				if ni.StructField.Name == "BasicStructField" {
+					return NewErrs(SkipDescendants)
+				}
+				return printFieldsIterFunc(ni, in, out)
+			},
+			wantOut: `Int32Field : 43, StringField : "forty three", Int32PtrField : 4343, StringPtrField : "forty three ptr", `,
+		},
 	}
 
 	for _, tt := range tests {
@@ -208,6 +208,35 @@ func IsYgotAnnotation(s reflect.StructField) bool {
 	return ok
 }
 
+// RedactedValue is the value that is output in place of data that is marked
+// as sensitive.
+const RedactedValue = "REDACTED"
+
+// IsSensitive reports whether struct field s has been tagged as storing
+// sensitive data, which should be redacted when it is output.
+func IsSensitive(s reflect.StructField) bool {
+	_, ok := s.Tag.Lookup("ygotSensitive")
+	return ok
+}
+
+// HasExtension reports whether the schema entry e has an extension statement
+// whose keyword is one of the supplied names. Names that are not qualified
+// with a prefix (e.g., "sensitive" rather than "oc-ext:sensitive") match an
+// extension with any prefix.
+func HasExtension(e *yang.Entry, names []string) bool {
+	if e == nil {
+		return false
+	}
+	for _, ext := range e.Exts {
+		for _, n := range names {
+			if ext.Keyword == n || (!strings.Contains(n, ":") && StripModulePrefix(ext.Keyword) == n) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 // IsYangPresence reports whether struct field s is a YANG presence container.
 func IsYangPresence(s reflect.StructField) bool {
 	_, ok := s.Tag.Lookup("yangPresence")
@@ -41,6 +41,10 @@ type ParseOpts struct {
 	// code generation. This is due to the fact that some schemas (e.g., OpenConfig
 	// interfaces) currently result in overlapping entities (e.g., /interfaces).
 	ExcludeModules []string
+	// IncludeRPCs specifies whether the input and output of each RPC that is
+	// defined within the YANG modules should be mapped to directories within
+	// the generated code. When set, the RPCs are also described in the IR.
+	IncludeRPCs bool
 	// YANGParseOptions provides the options that should be handed to the
 	// github.com/openconfig/goyang/pkg/yang library. These specify how the
 	// input YANG files should be parsed.
@@ -185,6 +189,10 @@ type mappedYANGDefinitions struct {
 	// leaves that are of type enumeration, identityref, or unions that contain either of
 	// these types. The map is keyed by the string path to the entry in the YANG schema.
 	enumEntries map[string]*yang.Entry
+	// rpcEntries is the set of RPCs that are defined within the input YANG,
+	// which is populated only if RPCs are to be included in the output code.
+	// The map is keyed by the string path to the RPC in the YANG schema.
+	rpcEntries map[string]*yang.Entry
 	// schematree is a copy of the YANG schema tree, containing only leaf
 	// entries, such that schema paths can be referenced.
 	schematree *schemaTree
@@ -221,6 +229,7 @@ func mappedDefinitions(yangFiles, includePaths []string, opts IROptions) (*mappe
 	// them from the modules that are provided as an argument.
 	dirs := map[string]*yang.Entry{}
 	enums := map[string]*yang.Entry{}
+	rpcs := map[string]*yang.Entry{}
 	var rootElems, treeElems []*yang.Entry
 	for _, module := range modules {
 		// Need to transform the AST based on compression behaviour.
@@ -238,6 +247,10 @@ func mappedDefinitions(yangFiles, includePaths []string, opts IROptions) (*mappe
 			}
 			treeElems = append(treeElems, e)
 		}
+
+		if opts.ParseOptions.IncludeRPCs && !excluded[module.Name] {
+			errs = append(errs, findRPCEntities(module, dirs, enums, rpcs, opts.ParseOptions.ExcludeModules, opts.TransformationOptions.CompressBehaviour.CompressEnabled(), modules)...)
+		}
 	}
 	if errs != nil {
 		return nil, errs
@@ -276,6 +289,7 @@ func mappedDefinitions(yangFiles, includePaths []string, opts IROptions) (*mappe
 	return &mappedYANGDefinitions{
 		directoryEntries: dirs,
 		enumEntries:      enums,
+		rpcEntries:       rpcs,
 		schematree:       st,
 		modules:          ms,
 		modelData:        modelData,
@@ -356,6 +370,29 @@ func findMappableEntities(e *yang.Entry, dirs map[string]*yang.Entry, enums map[
 	return errs
 }
 
+// findRPCEntities finds the RPCs that are defined within the module e, and
+// appends them to the rpcs map, keyed by their schema path. The input and
+// output of each RPC are appended to the dirs map such that they are mapped
+// as directories in the generated code, and their descendants are mapped as
+// per findMappableEntities.
+func findRPCEntities(e *yang.Entry, dirs, enums, rpcs map[string]*yang.Entry, excludeModules []string, compressPaths bool, modules []*yang.Entry) util.Errors {
+	var errs util.Errors
+	for _, ch := range e.Dir {
+		if ch.RPC == nil {
+			continue
+		}
+		rpcs[ch.Path()] = ch
+		for _, io := range []*yang.Entry{ch.RPC.Input, ch.RPC.Output} {
+			if io == nil {
+				continue
+			}
+			dirs[io.Path()] = io
+			errs = util.AppendErrs(errs, findMappableEntities(io, dirs, enums, excludeModules, compressPaths, modules))
+		}
+	}
+	return errs
+}
+
 // findRootEntries finds the entities that are at the root of the YANG schema tree,
 // and returns them.
 func findRootEntries(structs map[string]*yang.Entry, compressPaths bool) map[string]*yang.Entry {
@@ -175,10 +175,35 @@ func GenerateIR(yangFiles, includePaths []string, langMapper LangMapper, opts IR
 		return nil, errs
 	}
 
+	var rpcs map[string]*ParsedRPC
+	if len(mdef.rpcEntries) != 0 {
+		rpcs = make(map[string]*ParsedRPC, len(mdef.rpcEntries))
+	}
+	for p, e := range mdef.rpcEntries {
+		mod, err := e.InstantiatingModule()
+		if err != nil {
+			return nil, util.AppendErr(errs, fmt.Errorf("cannot find instantiating module for RPC %s: %v", p, err))
+		}
+		rpc := &ParsedRPC{
+			Name:            e.Name,
+			Path:            p,
+			BelongingModule: mod,
+			Description:     e.Description,
+		}
+		if e.RPC.Input != nil {
+			rpc.InputPath = e.RPC.Input.Path()
+		}
+		if e.RPC.Output != nil {
+			rpc.OutputPath = e.RPC.Output.Path()
+		}
+		rpcs[p] = rpc
+	}
+
 	return &IR{
 		Directories:   dirDets,
 		Enums:         enumDefinitionMap,
 		ModelData:     mdef.modelData,
+		RPCs:          rpcs,
 		opts:          opts,
 		fakeroot:      rootEntry,
 		parsedModules: mdef.modules,
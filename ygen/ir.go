@@ -301,6 +301,12 @@ type IR struct {
 	// ModelData stores the metadata extracted from the input YANG modules.
 	ModelData []*gpb.ModelData
 
+	// RPCs is the set of YANG RPCs that are defined within the input
+	// modules, keyed by the absolute YANG path of the RPC. It is populated
+	// only when the IncludeRPCs parse option is set, in which case the
+	// input and output of each RPC are included within Directories.
+	RPCs map[string]*ParsedRPC
+
 	// opts stores the IROptions that were used to generate the IR.
 	opts IROptions
 
@@ -352,6 +358,21 @@ func (ir *IR) OrderedDirectoryPathsByName() []string {
 	return paths
 }
 
+// OrderedRPCPaths returns the absolute YANG paths of all ParsedRPC entries
+// in the IR in lexicographical order.
+func (ir *IR) OrderedRPCPaths() []string {
+	if ir == nil {
+		return nil
+	}
+
+	paths := make([]string, 0, len(ir.RPCs))
+	for path := range ir.RPCs {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	return paths
+}
+
 // SchemaTree returns a JSON serialised tree of the schema for the set of
 // modules used to generate the IR. The JSON document that is returned is
 // always rooted on a yang.Entry which corresponds to the root item, and stores
@@ -372,6 +393,28 @@ func (ir *IR) SchemaTree(inclDescriptions bool) ([]byte, error) {
 	return rawSchema, nil
 }
 
+// ParsedRPC describes a YANG RPC that is defined within the input schema.
+// The input and output of the RPC are each represented by a ParsedDirectory.
+type ParsedRPC struct {
+	// Name is the name of the RPC in the YANG schema.
+	Name string
+	// Path is the absolute YANG schema path of the RPC.
+	Path string
+	// BelongingModule is the name of the module in which the RPC is
+	// defined.
+	BelongingModule string
+	// InputPath is the absolute YANG schema path of the directory that
+	// represents the input of the RPC. It is empty if the RPC has no
+	// input.
+	InputPath string
+	// OutputPath is the absolute YANG schema path of the directory that
+	// represents the output of the RPC. It is empty if the RPC has no
+	// output.
+	OutputPath string
+	// Description is the description of the RPC in the YANG schema.
+	Description string
+}
+
 // ParsedDirectory describes an internal node within the generated
 // code. Such a 'directory' may represent a struct, or a message,
 // in the generated code. It represents a YANG 'container' or 'list'.
@@ -90,8 +90,9 @@ func (p *pathSpec) String() string {
 
 // nodeValuePath takes an input util.NodeInfo struct describing an element within
 // a GoStruct tree (be it a leaf, leaf-list, container or list) and returns the
-// set of paths that the value represents as a pathSpec pointer.
-func nodeValuePath(ni *util.NodeInfo, schemaPaths [][]string) (*pathSpec, error) {
+// set of paths that the value represents as a pathSpec pointer. The keylessMode
+// specifies how the paths of entries within keyless lists are determined.
+func nodeValuePath(ni *util.NodeInfo, schemaPaths [][]string, keylessMode KeylessListMode) (*pathSpec, error) {
 	if ni.Parent == nil || ni.Parent.Annotation == nil {
 		return nodeRootPath(schemaPaths), nil
 	}
@@ -101,6 +102,10 @@ func nodeValuePath(ni *util.NodeInfo, schemaPaths [][]string) (*pathSpec, error)
 		return nil, err
 	}
 
+	if keylessMode == KeylessListIndexKeys && isKeylessList(ni.Parent.FieldValue) {
+		return nodeKeylessListPath(ni, cp)
+	}
+
 	if l, ok := ni.FieldValue.Interface().(KeyHelperGoStruct); ok {
 		return nodeMapPath(l, cp)
 	}
@@ -188,6 +193,63 @@ func nodeMapPath(list KeyHelperGoStruct, parentPath *pathSpec) (*pathSpec, error
 	}, nil
 }
 
+// nodeKeylessListPath takes an input NodeInfo describing an entry within a
+// keyless list, and the path of the list itself, and returns the data tree path
+// of the entry. The entry is identified by the KeylessListIndexKey pseudo key,
+// whose value is the index of the entry within the list.
+func nodeKeylessListPath(ni *util.NodeInfo, parentPath *pathSpec) (*pathSpec, error) {
+	if parentPath == nil || parentPath.gNMIPaths == nil {
+		return nil, fmt.Errorf("invalid keyless list member with no parent")
+	}
+
+	list := ni.Parent.FieldValue
+	idx := -1
+	for i := 0; i < list.Len(); i++ {
+		if list.Index(i).Pointer() == ni.FieldValue.Pointer() {
+			idx = i
+			break
+		}
+	}
+	if idx == -1 {
+		return nil, fmt.Errorf("could not find entry %v within keyless list at %v", ni.FieldValue.Interface(), parentPath)
+	}
+
+	gPaths := []*gnmipb.Path{}
+	for _, p := range parentPath.gNMIPaths {
+		np := proto.Clone(p).(*gnmipb.Path)
+		np.Elem[len(p.Elem)-1].Key = map[string]string{KeylessListIndexKey: fmt.Sprintf("%d", idx)}
+		gPaths = append(gPaths, np)
+	}
+	return &pathSpec{
+		gNMIPaths: gPaths,
+	}, nil
+}
+
+// isKeylessList determines whether the supplied value is a YANG list that does
+// not have keys, which is represented as a slice of struct pointers.
+func isKeylessList(v reflect.Value) bool {
+	return v.IsValid() && v.Kind() == reflect.Slice && util.IsTypeStructPtr(v.Type().Elem())
+}
+
+// keylessListSkip is an annotation used to mark nodes within a keyless list
+// whose values are already included in the value of the list itself, such that
+// they are not examined individually.
+type keylessListSkip struct{}
+
+// hasKeylessListSkip determines whether the supplied NodeInfo is annotated with
+// a keylessListSkip annotation.
+func hasKeylessListSkip(ni *util.NodeInfo) bool {
+	if ni == nil {
+		return false
+	}
+	for _, a := range ni.Annotation {
+		if _, ok := a.(*keylessListSkip); ok {
+			return true
+		}
+	}
+	return false
+}
+
 // getPathSpec extracts the pathSpec pointer from the supplied NodeInfo's annotations.
 func getPathSpec(ni *util.NodeInfo) (*pathSpec, error) {
 	for _, a := range ni.Annotation {
@@ -209,14 +271,44 @@ func getPathSpec(ni *util.NodeInfo) (*pathSpec, error) {
 // A specific Annotation is used to store the absolute path of the entity during
 // the walk.
 func findSetLeaves(s GoStruct, opts ...DiffOpt) (map[*pathSpec]interface{}, error) {
+	leaves, _, err := findSetLeavesAndDefaults(s, opts...)
+	return leaves, err
+}
+
+// findSetLeavesAndDefaults returns the leaves that are set within the supplied
+// GoStruct s, as per findSetLeaves. If the opts specify a with-defaults mode
+// other than WithDefaultsExplicit, leaves that have their default value are
+// considered to be unset. In the WithDefaultsReportAll and
+// WithDefaultsReportAllTagged modes, the default values of the leaves that are
+// returned are also returned, keyed by the path of the leaf, such that a leaf
+// that is unset can be reported with its default value.
+func findSetLeavesAndDefaults(s GoStruct, opts ...DiffOpt) (map[*pathSpec]interface{}, map[*pathSpec]interface{}, error) {
 	pathOpt := hasDiffPathOpt(opts)
 	processedPaths := map[string]bool{}
 
+	d, err := newDefaulter(s, hasWithDefaults(opts))
+	if err != nil {
+		return nil, nil, err
+	}
+	defaults := map[*pathSpec]interface{}{}
+
+	keylessMode := KeylessListUnsupported
+	if pathOpt != nil {
+		keylessMode = pathOpt.KeylessListMode
+	}
+
 	findSetIterFunc := func(ni *util.NodeInfo, in, out interface{}) (errs util.Errors) {
 		if reflect.DeepEqual(ni.StructField, reflect.StructField{}) {
 			return
 		}
 
+		// Nodes within a keyless list that is rendered as a single value
+		// have already been included in the value of the list.
+		if hasKeylessListSkip(ni.Parent) {
+			ni.Annotation = []interface{}{&keylessListSkip{}}
+			return
+		}
+
 		// Handle the case of having an annotated struct - in the diff case we
 		// do not process schema annotations.
 		if util.IsYgotAnnotation(ni.StructField) {
@@ -239,7 +331,7 @@ func findSetLeaves(s GoStruct, opts ...DiffOpt) (map[*pathSpec]interface{}, erro
 			sp = [][]string{leastSpecificPath(sp)}
 		}
 
-		vp, err := nodeValuePath(ni, sp)
+		vp, err := nodeValuePath(ni, sp, keylessMode)
 		if err != nil {
 			return util.NewErrs(err)
 		}
@@ -262,6 +354,18 @@ func findSetLeaves(s GoStruct, opts ...DiffOpt) (map[*pathSpec]interface{}, erro
 
 		ni.Annotation = []interface{}{vp}
 
+		if isKeylessList(ni.FieldValue) {
+			switch keylessMode {
+			case KeylessListAsJSONIETF:
+				// The entire list is a single value, so its children are
+				// not examined further.
+				ni.Annotation = append(ni.Annotation, &keylessListSkip{})
+			case KeylessListIndexKeys:
+				// Each entry in the list is examined individually.
+				return
+			}
+		}
+
 		// Ignore non-data, or default data values.
 		if util.IsNilOrInvalidValue(ni.FieldValue) || util.IsValueNilOrDefault(ni.FieldValue.Interface()) || util.IsValueStructPtr(ni.FieldValue) || util.IsValueMap(ni.FieldValue) {
 			return
@@ -283,6 +387,19 @@ func findSetLeaves(s GoStruct, opts ...DiffOpt) (map[*pathSpec]interface{}, erro
 			}
 		}
 
+		// A leaf that has its default value is considered to be unset,
+		// since it is equivalent to the leaf not being set in all of the
+		// with-defaults modes other than explicit.
+		if d != nil && ni.Parent != nil {
+			parent := ni.Parent.FieldValue.Interface()
+			if d.isDefault(parent, ni.StructField.Name, ni.FieldValue) {
+				return
+			}
+			if def, ok := d.value(parent, ni.StructField.Name); ok && d.mode != WithDefaultsTrim {
+				defaults[vp] = def.Interface()
+			}
+		}
+
 		outs := out.(map[*pathSpec]interface{})
 		outs[vp] = ival
 
@@ -291,10 +408,10 @@ func findSetLeaves(s GoStruct, opts ...DiffOpt) (map[*pathSpec]interface{}, erro
 
 	out := map[*pathSpec]interface{}{}
 	if errs := util.ForEachDataField(s, nil, out, findSetIterFunc); errs != nil {
-		return nil, fmt.Errorf("error from ForEachDataField iteration: %v", errs)
+		return nil, nil, fmt.Errorf("error from ForEachDataField iteration: %v", errs)
 	}
 
-	return out, nil
+	return out, defaults, nil
 }
 
 // hasDiffPathOpt extracts a DiffPathOpt from the opts slice provided. In
@@ -383,6 +500,10 @@ type DiffPathOpt struct {
 	// generated structs, which can result in duplication of list key leaves in
 	// the diff output.
 	MapToSinglePath bool
+	// KeylessListMode specifies how YANG lists without keys are mapped to
+	// paths in the diff output. When KeylessListAsJSONIETF is used, a change
+	// to any entry within the list results in an update of the entire list.
+	KeylessListMode KeylessListMode
 }
 
 // IsDiffOpt marks DiffPathOpt as a diff option.
@@ -401,6 +522,9 @@ func (*DiffPathOpt) IsDiffOpt() {}
 // Annotation fields that are contained within the supplied original or modified
 // GoStruct are skipped.
 //
+// If a WithDefaultsConfig is supplied, leaves that have their default value
+// are compared according to the with-defaults mode that it specifies.
+//
 // A set of options for diff's behaviour, as specified by the supplied DiffOpts
 // can be used to modify the behaviour of the Diff function per the individual
 // option's specification.
@@ -415,7 +539,7 @@ func Diff(original, modified GoStruct, opts ...DiffOpt) (*gnmipb.Notification, e
 		return nil, fmt.Errorf("cannot diff structs of different types, original: %T, modified: %T", original, modified)
 	}
 
-	origLeaves, err := findSetLeaves(original, opts...)
+	origLeaves, origDefaults, err := findSetLeavesAndDefaults(original, opts...)
 	if err != nil {
 		return nil, fmt.Errorf("could not extract set leaves from original struct: %v", err)
 	}
@@ -446,7 +570,14 @@ func Diff(original, modified GoStruct, opts ...DiffOpt) (*gnmipb.Notification, e
 		}
 		if !origMatched {
 			// This leaf was set in the original struct, but not in the modified
-			// struct, therefore it has been deleted.
+			// struct, therefore it has been deleted - unless the with-defaults
+			// mode specifies that it is reported with its default value.
+			if def, ok := origDefaults[origPath]; ok {
+				if err := appendUpdate(n, origPath, def); err != nil {
+					return nil, err
+				}
+				continue
+			}
 			n.Delete = append(n.Delete, origPath.gNMIPaths...)
 		}
 	}
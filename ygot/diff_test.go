@@ -385,7 +385,7 @@ func TestNodeValuePath(t *testing.T) {
 	}}
 
 	for _, tt := range tests {
-		got, err := nodeValuePath(tt.inNI, tt.inSchemaPaths)
+		got, err := nodeValuePath(tt.inNI, tt.inSchemaPaths, KeylessListUnsupported)
 		if err != nil && !strings.Contains(err.Error(), tt.wantErr) {
 			t.Errorf("%s: nodeValuePath(%v, %v): did not get expected error, got: %v, want: %v", tt.desc, tt.inNI, tt.inSchemaPaths, err, tt.wantErr)
 		}
@@ -1526,6 +1526,91 @@ func TestDiff(t *testing.T) {
 				},
 			}},
 		},
+	}, {
+		desc: "keyless list change with index keys",
+		inOrig: &renderExample{
+			KeylessList: []*renderExampleList{{Val: String("one")}, {Val: String("two")}},
+		},
+		inMod: &renderExample{
+			KeylessList: []*renderExampleList{{Val: String("one")}, {Val: String("three")}},
+		},
+		inOpts: []DiffOpt{
+			&DiffPathOpt{MapToSinglePath: true, KeylessListMode: KeylessListIndexKeys},
+		},
+		want: &gnmipb.Notification{
+			Update: []*gnmipb.Update{{
+				Path: &gnmipb.Path{
+					Elem: []*gnmipb.PathElem{{
+						Name: "keyless-list",
+						Key:  map[string]string{"index": "1"},
+					}, {
+						Name: "val",
+					}},
+				},
+				Val: &gnmipb.TypedValue{Value: &gnmipb.TypedValue_StringVal{"three"}},
+			}},
+		},
+	}, {
+		desc: "keyless list entry removed with index keys",
+		inOrig: &renderExample{
+			KeylessList: []*renderExampleList{{Val: String("one")}, {Val: String("two")}},
+		},
+		inMod: &renderExample{
+			KeylessList: []*renderExampleList{{Val: String("one")}},
+		},
+		inOpts: []DiffOpt{
+			&DiffPathOpt{MapToSinglePath: true, KeylessListMode: KeylessListIndexKeys},
+		},
+		want: &gnmipb.Notification{
+			Delete: []*gnmipb.Path{{
+				Elem: []*gnmipb.PathElem{{
+					Name: "keyless-list",
+					Key:  map[string]string{"index": "1"},
+				}, {
+					Name: "val",
+				}},
+			}},
+		},
+	}, {
+		desc: "keyless list change as JSON_IETF",
+		inOrig: &renderExample{
+			KeylessList: []*renderExampleList{{Val: String("one")}},
+		},
+		inMod: &renderExample{
+			KeylessList: []*renderExampleList{{Val: String("two")}},
+		},
+		inOpts: []DiffOpt{
+			&DiffPathOpt{KeylessListMode: KeylessListAsJSONIETF},
+		},
+		want: &gnmipb.Notification{
+			Update: []*gnmipb.Update{{
+				Path: &gnmipb.Path{
+					Elem: []*gnmipb.PathElem{{
+						Name: "keyless-list",
+					}},
+				},
+				Val: &gnmipb.TypedValue{Value: &gnmipb.TypedValue_JsonIetfVal{[]byte(`[
+  {
+    "state": {
+      "val": "two"
+    },
+    "val": "two"
+  }
+]`)}},
+			}},
+		},
+	}, {
+		desc: "unchanged keyless list as JSON_IETF",
+		inOrig: &renderExample{
+			KeylessList: []*renderExampleList{{Val: String("one")}},
+		},
+		inMod: &renderExample{
+			KeylessList: []*renderExampleList{{Val: String("one")}},
+		},
+		inOpts: []DiffOpt{
+			&DiffPathOpt{KeylessListMode: KeylessListAsJSONIETF},
+		},
+		want: &gnmipb.Notification{},
 	}}
 
 	for _, tt := range tests {
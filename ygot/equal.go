@@ -0,0 +1,204 @@
+// Copyright 2021 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ygot
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"github.com/openconfig/ygot/util"
+	"google.golang.org/protobuf/proto"
+
+	gnmipb "github.com/openconfig/gnmi/proto/gnmi"
+)
+
+// EqualOpt is an interface that is implemented by the options to the Equal
+// function.
+type EqualOpt interface {
+	// IsEqualOpt is a marker method for each EqualOpt.
+	IsEqualOpt()
+}
+
+// IgnoreState is an EqualOpt that indicates that leaves that are within a
+// container named "state" - which is used to store operational state in
+// OpenConfig schemas - should be ignored when comparing GoStructs.
+type IgnoreState struct{}
+
+// IsEqualOpt marks IgnoreState as an EqualOpt.
+func (*IgnoreState) IsEqualOpt() {}
+
+// IgnorePaths is an EqualOpt that indicates that leaves whose paths are
+// prefixed by any of the supplied Paths should be ignored when comparing
+// GoStructs. The paths are relative to the GoStructs being compared, and may
+// contain wildcard names or keys, specified as "*".
+type IgnorePaths struct {
+	Paths []*gnmipb.Path
+}
+
+// IsEqualOpt marks IgnorePaths as an EqualOpt.
+func (*IgnorePaths) IsEqualOpt() {}
+
+// Equal returns true if the GoStructs a and b, which must be of the same type,
+// represent the same YANG data tree. Unlike reflect.DeepEqual, containers,
+// lists and leaf-lists that do not contain any data are considered to be
+// equal to those that are not populated, and enumerated and union values are
+// compared according to the YANG value that they represent, rather than their
+// Go representation. The options supplied can be used to exclude parts of the
+// data tree from the comparison.
+func Equal(a, b GoStruct, opts ...EqualOpt) (bool, error) {
+	if reflect.TypeOf(a) != reflect.TypeOf(b) {
+		return false, fmt.Errorf("cannot compare structs of different types, a: %T, b: %T", a, b)
+	}
+
+	aLeaves, err := equalLeaves(a, opts)
+	if err != nil {
+		return false, fmt.Errorf("could not extract set leaves from a: %v", err)
+	}
+	bLeaves, err := equalLeaves(b, opts)
+	if err != nil {
+		return false, fmt.Errorf("could not extract set leaves from b: %v", err)
+	}
+
+	if len(aLeaves) != len(bLeaves) {
+		return false, nil
+	}
+	for p, av := range aLeaves {
+		bv, ok := bLeaves[p]
+		if !ok || !proto.Equal(av, bv) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// equalLeaves returns the populated leaves of the GoStruct s, keyed by the
+// string representation of their path, with the value of each leaf encoded
+// as a TypedValue. Leaves that are excluded by the supplied options are not
+// returned.
+func equalLeaves(s GoStruct, opts []EqualOpt) (map[string]*gnmipb.TypedValue, error) {
+	var ignoreState bool
+	var ignorePaths []*gnmipb.Path
+	for _, o := range opts {
+		switch v := o.(type) {
+		case *IgnoreState:
+			ignoreState = true
+		case *IgnorePaths:
+			ignorePaths = append(ignorePaths, v.Paths...)
+		}
+	}
+
+	leaves, err := findSetLeaves(s, &DiffPathOpt{KeylessListMode: KeylessListIndexKeys})
+	if err != nil {
+		return nil, err
+	}
+
+	out := map[string]*gnmipb.TypedValue{}
+	for vp, v := range leaves {
+		if rv := reflect.ValueOf(v); rv.Kind() == reflect.Slice && rv.Len() == 0 {
+			continue
+		}
+		tv, err := EncodeTypedValue(v, gnmipb.Encoding_JSON_IETF)
+		if err != nil {
+			return nil, fmt.Errorf("cannot represent field value %v as TypedValue for path %v: %v", v, vp, err)
+		}
+		for _, p := range vp.gNMIPaths {
+			if ignoreState && isStatePath(p) || matchesAnyPath(p, ignorePaths) {
+				continue
+			}
+			ps, err := PathToString(p)
+			if err != nil {
+				return nil, err
+			}
+			out[ps] = tv
+		}
+	}
+	return out, nil
+}
+
+// isStatePath returns true if the path p is within a container named "state".
+func isStatePath(p *gnmipb.Path) bool {
+	// The leaf itself is not considered, since it is not a container.
+	for i := 0; i < len(p.GetElem())-1; i++ {
+		if p.GetElem()[i].GetName() == "state" {
+			return true
+		}
+	}
+	return false
+}
+
+// matchesAnyPath returns true if any of the supplied queries, which may
+// contain wildcards, is a prefix of the path p.
+func matchesAnyPath(p *gnmipb.Path, queries []*gnmipb.Path) bool {
+	for _, q := range queries {
+		if util.PathMatchesQuery(p, q) {
+			return true
+		}
+	}
+	return false
+}
+
+// Hash returns a SHA-256 digest of the canonical form of the GoStruct s, such
+// that GoStructs for which Equal returns true, when no options are supplied,
+// have the same digest. The canonical form is the RFC7951 JSON representation
+// of s, with module names prepended to all elements, and containers, lists
+// and leaf-lists that do not contain data removed.
+func Hash(s GoStruct) ([]byte, error) {
+	j, err := ConstructIETFJSON(s, &RFC7951JSONConfig{AppendModuleName: true})
+	if err != nil {
+		return nil, fmt.Errorf("cannot construct RFC7951 JSON: %v", err)
+	}
+
+	// encoding/json sorts the keys of JSON objects, and lists are output
+	// sorted by their keys, hence the output is deterministic.
+	pj, ok := pruneEmptyJSON(j)
+	if !ok {
+		pj = nil
+	}
+	b, err := json.Marshal(pj)
+	if err != nil {
+		return nil, fmt.Errorf("cannot marshal JSON: %v", err)
+	}
+	h := sha256.Sum256(b)
+	return h[:], nil
+}
+
+// pruneEmptyJSON removes JSON objects and arrays that do not contain any
+// values from the supplied JSON tree, returning false if v itself is an empty
+// object or array. JSON null values are retained, since [null] is the RFC7951
+// encoding of a leaf of type empty.
+func pruneEmptyJSON(v interface{}) (interface{}, bool) {
+	switch t := v.(type) {
+	case map[string]interface{}:
+		for k, cv := range t {
+			if pv, ok := pruneEmptyJSON(cv); ok {
+				t[k] = pv
+				continue
+			}
+			delete(t, k)
+		}
+		return t, len(t) != 0
+	case []interface{}:
+		out := []interface{}{}
+		for _, cv := range t {
+			if pv, ok := pruneEmptyJSON(cv); ok {
+				out = append(out, pv)
+			}
+		}
+		return out, len(out) != 0
+	}
+	return v, true
+}
@@ -0,0 +1,300 @@
+// Copyright 2021 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ygot
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	"github.com/openconfig/gnmi/errdiff"
+
+	gnmipb "github.com/openconfig/gnmi/proto/gnmi"
+)
+
+// equalStateStruct is a GoStruct that contains both configuration and
+// state leaves, used for testing Equal.
+type equalStateStruct struct {
+	Name    *string `path:"config/name"`
+	Counter *uint64 `path:"state/counter"`
+}
+
+func (*equalStateStruct) IsYANGGoStruct()                         {}
+func (*equalStateStruct) ΛValidate(...ValidationOption) error     { return nil }
+func (*equalStateStruct) ΛEnumTypeMap() map[string][]reflect.Type { return nil }
+func (*equalStateStruct) ΛBelongingModule() string                { return "" }
+
+func TestEqual(t *testing.T) {
+	tests := []struct {
+		name             string
+		inA              GoStruct
+		inB              GoStruct
+		inOpts           []EqualOpt
+		want             bool
+		wantErrSubstring string
+	}{{
+		name: "identical structs",
+		inA:  &renderExample{Str: String("foo"), IntVal: Int32(42)},
+		inB:  &renderExample{Str: String("foo"), IntVal: Int32(42)},
+		want: true,
+	}, {
+		name: "different leaf value",
+		inA:  &renderExample{Str: String("foo")},
+		inB:  &renderExample{Str: String("bar")},
+		want: false,
+	}, {
+		name: "leaf only set in one struct",
+		inA:  &renderExample{Str: String("foo")},
+		inB:  &renderExample{Str: String("foo"), IntVal: Int32(42)},
+		want: false,
+	}, {
+		name: "empty container is equal to unset container",
+		inA:  &renderExample{Str: String("foo")},
+		inB:  &renderExample{Str: String("foo"), Ch: &renderExampleChild{}},
+		want: true,
+	}, {
+		name: "empty list and leaf-list are equal to unset fields",
+		inA:  &renderExample{},
+		inB: &renderExample{
+			List:     map[uint32]*renderExampleList{},
+			LeafList: []string{},
+		},
+		want: true,
+	}, {
+		name: "nil struct is equal to empty struct",
+		inA:  (*renderExample)(nil),
+		inB:  &renderExample{Ch: &renderExampleChild{}},
+		want: true,
+	}, {
+		name: "same list entries",
+		inA: &renderExample{
+			List: map[uint32]*renderExampleList{
+				1: {Val: String("one")},
+				2: {Val: String("two")},
+			},
+		},
+		inB: &renderExample{
+			List: map[uint32]*renderExampleList{
+				2: {Val: String("two")},
+				1: {Val: String("one")},
+			},
+		},
+		want: true,
+	}, {
+		name: "different leaf-list order",
+		inA:  &renderExample{LeafList: []string{"a", "b"}},
+		inB:  &renderExample{LeafList: []string{"b", "a"}},
+		want: false,
+	}, {
+		name: "same enum value",
+		inA:  &renderExample{EnumField: EnumTestVALONE},
+		inB:  &renderExample{EnumField: EnumTestVALONE},
+		want: true,
+	}, {
+		name: "different enum value",
+		inA:  &renderExample{EnumField: EnumTestVALONE},
+		inB:  &renderExample{EnumField: EnumTestVALTWO},
+		want: false,
+	}, {
+		name: "union values with different types representing the same value",
+		inA:  &renderExample{UnionVal: &renderExampleUnionEnum{EnumTestVALONE}},
+		inB:  &renderExample{UnionVal: &renderExampleUnionString{"VAL_ONE"}},
+		want: true,
+	}, {
+		name: "union values with different values",
+		inA:  &renderExample{UnionVal: &renderExampleUnionString{"foo"}},
+		inB:  &renderExample{UnionVal: &renderExampleUnionInt64{42}},
+		want: false,
+	}, {
+		name: "keyless lists with the same entries",
+		inA: &renderExample{
+			KeylessList: []*renderExampleList{{Val: String("one")}},
+		},
+		inB: &renderExample{
+			KeylessList: []*renderExampleList{{Val: String("one")}},
+		},
+		want: true,
+	}, {
+		name: "keyless lists with different order",
+		inA: &renderExample{
+			KeylessList: []*renderExampleList{{Val: String("one")}, {Val: String("two")}},
+		},
+		inB: &renderExample{
+			KeylessList: []*renderExampleList{{Val: String("two")}, {Val: String("one")}},
+		},
+		want: false,
+	}, {
+		name: "different state, not ignored",
+		inA:  &equalStateStruct{Name: String("foo"), Counter: Uint64(1)},
+		inB:  &equalStateStruct{Name: String("foo"), Counter: Uint64(2)},
+		want: false,
+	}, {
+		name:   "different state, ignored",
+		inA:    &equalStateStruct{Name: String("foo"), Counter: Uint64(1)},
+		inB:    &equalStateStruct{Name: String("foo")},
+		inOpts: []EqualOpt{&IgnoreState{}},
+		want:   true,
+	}, {
+		name:   "different config, state ignored",
+		inA:    &equalStateStruct{Name: String("foo")},
+		inB:    &equalStateStruct{Name: String("bar")},
+		inOpts: []EqualOpt{&IgnoreState{}},
+		want:   false,
+	}, {
+		name: "ignored paths",
+		inA: &renderExample{
+			Str: String("foo"),
+			List: map[uint32]*renderExampleList{
+				1: {Val: String("one")},
+			},
+		},
+		inB: &renderExample{
+			Str: String("bar"),
+			List: map[uint32]*renderExampleList{
+				2: {Val: String("two")},
+			},
+		},
+		inOpts: []EqualOpt{&IgnorePaths{
+			Paths: []*gnmipb.Path{{
+				Elem: []*gnmipb.PathElem{{Name: "str"}},
+			}, {
+				Elem: []*gnmipb.PathElem{{Name: "list", Key: map[string]string{"val": "*"}}},
+			}},
+		}},
+		want: true,
+	}, {
+		name: "difference outside of ignored paths",
+		inA:  &renderExample{Str: String("foo"), IntVal: Int32(1)},
+		inB:  &renderExample{Str: String("bar"), IntVal: Int32(2)},
+		inOpts: []EqualOpt{&IgnorePaths{
+			Paths: []*gnmipb.Path{{
+				Elem: []*gnmipb.PathElem{{Name: "str"}},
+			}},
+		}},
+		want: false,
+	}, {
+		name:             "different types",
+		inA:              &renderExample{},
+		inB:              &renderExampleChild{},
+		wantErrSubstring: "cannot compare structs of different types",
+	}, {
+		name: "invalid struct",
+		inA: &renderExample{
+			UnionVal: &renderExampleUnionInvalid{String: "foo", Int8: 42},
+		},
+		inB:              &renderExample{},
+		wantErrSubstring: "could not extract set leaves from a",
+	}}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Equal(tt.inA, tt.inB, tt.inOpts...)
+			if diff := errdiff.Substring(err, tt.wantErrSubstring); diff != "" {
+				t.Fatalf("Equal(%v, %v): did not get expected error, %s", tt.inA, tt.inB, diff)
+			}
+			if err != nil {
+				return
+			}
+			if got != tt.want {
+				t.Errorf("Equal(%v, %v): got %v, want %v", tt.inA, tt.inB, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHash(t *testing.T) {
+	tests := []struct {
+		name             string
+		inA              GoStruct
+		inB              GoStruct
+		wantEqual        bool
+		wantErrSubstring string
+	}{{
+		name:      "identical structs",
+		inA:       &renderExample{Str: String("foo"), EnumField: EnumTestVALONE},
+		inB:       &renderExample{Str: String("foo"), EnumField: EnumTestVALONE},
+		wantEqual: true,
+	}, {
+		name:      "different leaf value",
+		inA:       &renderExample{Str: String("foo")},
+		inB:       &renderExample{Str: String("bar")},
+		wantEqual: false,
+	}, {
+		name: "empty containers, lists and leaf-lists",
+		inA:  &renderExample{Str: String("foo")},
+		inB: &renderExample{
+			Str:      String("foo"),
+			Ch:       &renderExampleChild{},
+			List:     map[uint32]*renderExampleList{},
+			LeafList: []string{},
+		},
+		wantEqual: true,
+	}, {
+		name:      "empty leaf set and unset",
+		inA:       &renderExample{Str: String("foo"), Empty: true},
+		inB:       &renderExample{Str: String("foo")},
+		wantEqual: false,
+	}, {
+		name:      "empty leaf set in both",
+		inA:       &renderExample{Empty: true},
+		inB:       &renderExample{Empty: true},
+		wantEqual: true,
+	}, {
+		name: "list entries inserted in different order",
+		inA: &renderExample{
+			List: map[uint32]*renderExampleList{
+				1: {Val: String("one")},
+				2: {Val: String("two")},
+				3: {Val: String("three")},
+			},
+		},
+		inB: &renderExample{
+			List: map[uint32]*renderExampleList{
+				3: {Val: String("three")},
+				2: {Val: String("two")},
+				1: {Val: String("one")},
+			},
+		},
+		wantEqual: true,
+	}, {
+		name: "invalid struct",
+		inA: &renderExample{
+			UnionVal: &renderExampleUnionInvalid{String: "foo", Int8: 42},
+		},
+		inB:              &renderExample{},
+		wantErrSubstring: "cannot construct RFC7951 JSON",
+	}}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotA, err := Hash(tt.inA)
+			if diff := errdiff.Substring(err, tt.wantErrSubstring); diff != "" {
+				t.Fatalf("Hash(%v): did not get expected error, %s", tt.inA, diff)
+			}
+			if err != nil {
+				return
+			}
+
+			gotB, err := Hash(tt.inB)
+			if err != nil {
+				t.Fatalf("Hash(%v): got unexpected error: %v", tt.inB, err)
+			}
+
+			if got := bytes.Equal(gotA, gotB); got != tt.wantEqual {
+				t.Errorf("Hash(%v) = %x, Hash(%v) = %x, got equal: %v, want equal: %v", tt.inA, gotA, tt.inB, gotB, got, tt.wantEqual)
+			}
+		})
+	}
+}
@@ -0,0 +1,164 @@
+// Copyright 2021 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ygot
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"math/big"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	gnmipb "github.com/openconfig/gnmi/proto/gnmi"
+)
+
+const (
+	// FlatSeparator is the string that separates the path of a leaf from
+	// its value within each line of the flat text format.
+	FlatSeparator = " = "
+	// FlatComment is the prefix of lines that are ignored within the flat
+	// text format.
+	FlatComment = "#"
+)
+
+// flatLine is a single line of the flat text format.
+type flatLine struct {
+	// path is the path of the leaf, and str is the path as rendered by
+	// PathToString.
+	path *gnmipb.Path
+	str  string
+	// value is the rendered value of the leaf.
+	value string
+}
+
+// MarshalFlat renders the GoStruct s in the flat text format, in which each
+// leaf that is set is output on a line of the form:
+//
+//	/interfaces/interface[name=eth0]/config/mtu = 1500
+//
+// The path of each leaf is rendered by PathToString, and lines are sorted by
+// path, as per ComparePaths. Each element of a leaf-list is output on a
+// separate line, in the order of the leaf-list. Values are rendered as for
+// gNMI, with enumerated values and identities rendered by name, and binary
+// values rendered as base64. Values that are empty, have leading or trailing
+// whitespace, contain non-printable characters or begin with a double quote
+// are rendered as quoted Go strings. Entries of keyless lists are identified
+// by the KeylessListIndexKey pseudo key.
+//
+// The format can be parsed by ytypes.UnmarshalFlat, which uses the schema of
+// each leaf to interpret its value.
+func MarshalFlat(s GoStruct) ([]byte, error) {
+	ns, err := TogNMINotifications(s, 0, GNMINotificationsConfig{
+		UsePathElem:     true,
+		KeylessListMode: KeylessListIndexKeys,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("cannot render %T to notifications: %v", s, err)
+	}
+
+	var lines []flatLine
+	for _, n := range ns {
+		for _, u := range n.GetUpdate() {
+			p := &gnmipb.Path{Elem: append(append([]*gnmipb.PathElem{}, n.GetPrefix().GetElem()...), u.GetPath().GetElem()...)}
+			ps, err := PathToString(p)
+			if err != nil {
+				return nil, fmt.Errorf("cannot render path %v: %v", u.GetPath(), err)
+			}
+			vals, err := flatValues(u.GetVal())
+			if err != nil {
+				return nil, fmt.Errorf("cannot render value of %s: %v", ps, err)
+			}
+			for _, v := range vals {
+				lines = append(lines, flatLine{path: p, str: ps, value: v})
+			}
+		}
+	}
+	// The sort is stable such that leaf-list elements retain their order.
+	sort.SliceStable(lines, func(i, j int) bool { return ComparePaths(lines[i].path, lines[j].path) < 0 })
+
+	var buf bytes.Buffer
+	for _, l := range lines {
+		buf.WriteString(l.str)
+		buf.WriteString(FlatSeparator)
+		buf.WriteString(l.value)
+		buf.WriteByte('\n')
+	}
+	return buf.Bytes(), nil
+}
+
+// flatValues returns the rendered values within the TypedValue tv, which
+// contains a single value unless tv is a leaf-list.
+func flatValues(tv *gnmipb.TypedValue) ([]string, error) {
+	if ll, ok := tv.GetValue().(*gnmipb.TypedValue_LeaflistVal); ok {
+		var vals []string
+		for _, e := range ll.LeaflistVal.GetElement() {
+			v, err := flatScalar(e)
+			if err != nil {
+				return nil, err
+			}
+			vals = append(vals, v)
+		}
+		return vals, nil
+	}
+	v, err := flatScalar(tv)
+	if err != nil {
+		return nil, err
+	}
+	return []string{v}, nil
+}
+
+// flatScalar returns the rendered value of the scalar TypedValue tv.
+func flatScalar(tv *gnmipb.TypedValue) (string, error) {
+	switch v := tv.GetValue().(type) {
+	case *gnmipb.TypedValue_StringVal:
+		return quoteFlatValue(v.StringVal), nil
+	case *gnmipb.TypedValue_IntVal:
+		return strconv.FormatInt(v.IntVal, 10), nil
+	case *gnmipb.TypedValue_UintVal:
+		return strconv.FormatUint(v.UintVal, 10), nil
+	case *gnmipb.TypedValue_BoolVal:
+		return strconv.FormatBool(v.BoolVal), nil
+	case *gnmipb.TypedValue_BytesVal:
+		return base64.StdEncoding.EncodeToString(v.BytesVal), nil
+	case *gnmipb.TypedValue_FloatVal:
+		return strconv.FormatFloat(float64(v.FloatVal), 'f', -1, 32), nil
+	case *gnmipb.TypedValue_DecimalVal:
+		prec := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(v.DecimalVal.GetPrecision())), nil)
+		return new(big.Rat).SetFrac(big.NewInt(v.DecimalVal.GetDigits()), prec).FloatString(int(v.DecimalVal.GetPrecision())), nil
+	}
+	return "", fmt.Errorf("unsupported value type %T", tv.GetValue())
+}
+
+// quoteFlatValue returns the string s as it is rendered as a value within
+// the flat text format. s is returned unchanged unless it is empty, has
+// leading or trailing whitespace, contains non-printable characters or
+// begins with a double quote, in which case it is returned as a quoted Go
+// string.
+func quoteFlatValue(s string) string {
+	needsQuote := s == "" || strings.HasPrefix(s, `"`) || strings.TrimSpace(s) != s
+	for _, r := range s {
+		if !unicode.IsPrint(r) {
+			needsQuote = true
+			break
+		}
+	}
+	if needsQuote {
+		return strconv.Quote(s)
+	}
+	return s
+}
@@ -0,0 +1,146 @@
+// Copyright 2021 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ygot
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/openconfig/gnmi/errdiff"
+	"github.com/openconfig/ygot/testutil"
+)
+
+func TestMarshalFlat(t *testing.T) {
+	tests := []struct {
+		name             string
+		in               GoStruct
+		want             string
+		wantErrSubstring string
+	}{{
+		name: "empty struct",
+		in:   &renderExample{},
+		want: "",
+	}, {
+		name: "scalar leaves sorted by path",
+		in: &renderExample{
+			Str:       String("hello world"),
+			IntVal:    Int32(-42),
+			Int64Val:  Int64(9007199254740993),
+			FloatVal:  Float32(1.5),
+			EnumField: EnumTestVALTWO,
+			Binary:    Binary{1, 2, 3},
+			Ch:        &renderExampleChild{Val: Uint64(42)},
+		},
+		want: `/binary = AQID
+/ch/val = 42
+/enum = VAL_TWO
+/floatval = 1.5
+/int-val = -42
+/int64-val = 9007199254740993
+/str = hello world
+`,
+	}, {
+		name: "quoted strings",
+		in: &renderExample{
+			Str:      String(""),
+			LeafList: []string{" leading", "trailing ", "new\nline", `"quote`, "in = side"},
+		},
+		want: `/leaf-list = " leading"
+/leaf-list = "trailing "
+/leaf-list = "new\nline"
+/leaf-list = "\"quote"
+/leaf-list = in = side
+/str = ""
+`,
+	}, {
+		name: "unions",
+		in: &renderExample{
+			UnionVal:            &renderExampleUnionString{"a"},
+			UnionValSimple:      testutil.UnionInt64(42),
+			UnionLeafListSimple: []exampleUnion{testutil.UnionString("b"), testutil.UnionBool(true)},
+		},
+		want: `/union-list-simple = b
+/union-list-simple = true
+/union-val = a
+/union-val-simple = 42
+`,
+	}, {
+		name: "keyed and keyless lists",
+		in: &renderExample{
+			List: map[uint32]*renderExampleList{
+				2: {Val: String("two")},
+				1: {Val: String("a=b]")},
+			},
+			KeylessList: []*renderExampleList{{Val: String("x")}, {Val: String("y")}},
+		},
+		want: `/keyless-list[index=0]/state/val = x
+/keyless-list[index=0]/val = x
+/keyless-list[index=1]/state/val = y
+/keyless-list[index=1]/val = y
+/list[val=a\=b\]]/state/val = a=b]
+/list[val=a\=b\]]/val = a=b]
+/list[val=two]/state/val = two
+/list[val=two]/val = two
+`,
+	}, {
+		name: "keyless list entries sorted by index",
+		in: &renderExample{
+			KeylessList: []*renderExampleList{
+				{Val: String("a")}, {Val: String("b")}, {Val: String("c")}, {Val: String("d")},
+				{Val: String("e")}, {Val: String("f")}, {Val: String("g")}, {Val: String("h")},
+				{Val: String("i")}, {Val: String("j")}, {Val: String("k")},
+			},
+		},
+		want: `/keyless-list[index=0]/state/val = a
+/keyless-list[index=0]/val = a
+/keyless-list[index=1]/state/val = b
+/keyless-list[index=1]/val = b
+/keyless-list[index=2]/state/val = c
+/keyless-list[index=2]/val = c
+/keyless-list[index=3]/state/val = d
+/keyless-list[index=3]/val = d
+/keyless-list[index=4]/state/val = e
+/keyless-list[index=4]/val = e
+/keyless-list[index=5]/state/val = f
+/keyless-list[index=5]/val = f
+/keyless-list[index=6]/state/val = g
+/keyless-list[index=6]/val = g
+/keyless-list[index=7]/state/val = h
+/keyless-list[index=7]/val = h
+/keyless-list[index=8]/state/val = i
+/keyless-list[index=8]/val = i
+/keyless-list[index=9]/state/val = j
+/keyless-list[index=9]/val = j
+/keyless-list[index=10]/state/val = k
+/keyless-list[index=10]/val = k
+`,
+	}, {
+		name:             "invalid GoStruct",
+		in:               &renderExample{InvalidPtr: &invalidGoStruct{Value: String("invalid")}},
+		wantErrSubstring: "cannot render",
+	}}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := MarshalFlat(tt.in)
+			if diff := errdiff.Substring(err, tt.wantErrSubstring); diff != "" {
+				t.Fatalf("did not get expected error, %s", diff)
+			}
+			if diff := cmp.Diff(tt.want, string(got)); diff != "" {
+				t.Errorf("did not get expected output, diff(-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
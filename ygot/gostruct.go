@@ -1,10 +1,14 @@
 package ygot
 
 import (
+	"fmt"
 	"reflect"
+	"strings"
 
 	"github.com/openconfig/goyang/pkg/yang"
 	"github.com/openconfig/ygot/util"
+
+	gnmipb "github.com/openconfig/gnmi/proto/gnmi"
 )
 
 const (
@@ -66,3 +70,178 @@ func PruneConfigFalse(schema *yang.Entry, s GoStruct) error {
 	}
 	return nil
 }
+
+// PruneTo in-place removes all nodes from the GoStruct root, whose schema is
+// supplied, that are not within a subtree matched by one of the supplied
+// paths, which are relative to root and may contain wildcard names or keys
+// specified as "*". Where a GoStruct field maps to more than one path, it is
+// retained if any of its paths are matched. Containers that do not contain any
+// data after pruning are removed.
+func PruneTo(root GoStruct, schema *yang.Entry, paths []*gnmipb.Path) error {
+	return prunePaths(root, schema, paths, true)
+}
+
+// PruneExcept in-place removes all subtrees from the GoStruct root, whose
+// schema is supplied, that are matched by one of the supplied paths, which are
+// relative to root and may contain wildcard names or keys specified as "*".
+// Where a GoStruct field maps to more than one path, it is removed if any of
+// its paths are matched. Containers that do not contain any data after pruning
+// are removed.
+func PruneExcept(root GoStruct, schema *yang.Entry, paths []*gnmipb.Path) error {
+	return prunePaths(root, schema, paths, false)
+}
+
+// Extract returns a copy of the GoStruct root, whose schema is supplied,
+// containing only the subtrees that are matched by one of the supplied paths,
+// as per PruneTo. The supplied root is not modified.
+func Extract(root GoStruct, schema *yang.Entry, paths []*gnmipb.Path) (GoStruct, error) {
+	c, err := DeepCopy(root)
+	if err != nil {
+		return nil, fmt.Errorf("cannot copy GoStruct: %v", err)
+	}
+	if err := PruneTo(c, schema, paths); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// pruneNodeID identifies a node within a GoStruct data tree independently of
+// the path that it was visited at.
+type pruneNodeID struct {
+	// parent is the GoStruct that contains the node.
+	parent interface{}
+	// field is the name of the field within parent that stores the node.
+	field string
+	// keys is the string representation of the keys of the node, if it is
+	// a list entry.
+	keys string
+}
+
+// prunePaths removes nodes from the GoStruct root, whose schema is supplied,
+// based on whether they are within a subtree matched by one of the supplied
+// paths. If keepMatched is true, the nodes that are not within a matched
+// subtree are removed, otherwise matched subtrees are removed.
+func prunePaths(root GoStruct, schema *yang.Entry, paths []*gnmipb.Path, keepMatched bool) error {
+	for _, p := range paths {
+		if p == nil || len(p.GetElement()) != 0 {
+			return fmt.Errorf("invalid path %v, paths must use PathElem", p)
+		}
+	}
+
+	// Removal of nodes is deferred until the walk is complete, such that a
+	// field that maps to more than one path can be retained by any of them.
+	var remove []*WalkNode
+	kept := map[pruneNodeID]bool{}
+	nodeID := func(n *WalkNode) pruneNodeID {
+		var keys string
+		if l := len(n.Path.GetElem()); l != 0 {
+			keys = fmt.Sprint(n.Path.GetElem()[l-1].GetKey())
+		}
+		return pruneNodeID{parent: n.Parent.Value, field: n.field, keys: keys}
+	}
+
+	// Nodes that may be ancestors of matched nodes are only retained if one
+	// of their descendants is matched.
+	ancestors := map[*WalkNode]bool{}
+	hasMatch := map[*WalkNode]bool{}
+
+	err := Walk(root, schema, VisitorFuncs{
+		Pre: func(n *WalkNode) error {
+			matched, ancestor := matchPruneQueries(n.Path, paths)
+			switch {
+			case n.Parent == nil:
+				// The root of the walk cannot be removed, so its children
+				// are always examined unless it is to be entirely kept.
+				if keepMatched && matched {
+					return SkipSubtree
+				}
+				return nil
+			case !keepMatched && matched:
+				remove = append(remove, n)
+				return SkipSubtree
+			case !keepMatched:
+				return nil
+			case matched:
+				kept[nodeID(n)] = true
+				for p := n.Parent; p != nil; p = p.Parent {
+					hasMatch[p] = true
+				}
+				return SkipSubtree
+			case isListKeyLeaf(n):
+				// The keys of list entries that are retained are always
+				// retained, such that the list entry remains valid.
+				kept[nodeID(n)] = true
+				return nil
+			case ancestor:
+				ancestors[n] = true
+				return nil
+			default:
+				remove = append(remove, n)
+				return SkipSubtree
+			}
+		},
+		Post: func(n *WalkNode) error {
+			if !ancestors[n] {
+				return nil
+			}
+			if hasMatch[n] {
+				kept[nodeID(n)] = true
+				return nil
+			}
+			remove = append(remove, n)
+			return nil
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	// Nodes are removed in the reverse of the order in which they were
+	// visited, such that removing an entry of a keyless list does not shift
+	// the index of entries that are yet to be removed.
+	for i := len(remove) - 1; i >= 0; i-- {
+		n := remove[i]
+		if keepMatched && kept[nodeID(n)] {
+			continue
+		}
+		if err := n.Replace(nil); err != nil {
+			return err
+		}
+	}
+	PruneEmptyBranches(root)
+	return nil
+}
+
+// matchPruneQueries determines whether the data tree path p is matched by one
+// of the supplied queries, which may contain wildcards, such that it is within
+// the subtree selected by the query. It returns a second value indicating
+// whether the path may be an ancestor of a node matched by one of the queries.
+func matchPruneQueries(p *gnmipb.Path, queries []*gnmipb.Path) (bool, bool) {
+	var ancestor bool
+	for _, q := range queries {
+		if util.PathMatchesQuery(p, q) {
+			return true, false
+		}
+		if len(p.GetElem()) < len(q.GetElem()) {
+			ancestor = ancestor || util.PathMatchesQuery(p, &gnmipb.Path{
+				Origin: q.GetOrigin(),
+				Elem:   q.GetElem()[:len(p.GetElem())],
+			})
+		}
+	}
+	return false, ancestor
+}
+
+// isListKeyLeaf returns true if the node n is a key leaf of the list entry
+// within which it is directly contained.
+func isListKeyLeaf(n *WalkNode) bool {
+	if n.Parent == nil || !n.Parent.Schema.IsList() || !n.Schema.IsLeaf() {
+		return false
+	}
+	for _, k := range strings.Fields(n.Parent.Schema.Key) {
+		if k == n.Schema.Name {
+			return true
+		}
+	}
+	return false
+}
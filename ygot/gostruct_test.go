@@ -4,7 +4,10 @@ import (
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/openconfig/gnmi/errdiff"
 	"github.com/openconfig/goyang/pkg/yang"
+
+	gnmipb "github.com/openconfig/gnmi/proto/gnmi"
 )
 
 // addParents adds parent pointers for a schema tree.
@@ -726,3 +729,198 @@ func TestPruneConfigFalse(t *testing.T) {
 		})
 	}
 }
+
+// mustPath returns the string s as a gNMI Path, panicking if it cannot be
+// parsed.
+func mustPath(s string) *gnmipb.Path {
+	p, err := StringToStructuredPath(s)
+	if err != nil {
+		panic(err)
+	}
+	return p
+}
+
+// pruneTestStruct returns a populated walkRoot GoStruct for testing pruning.
+func pruneTestStruct() *walkRoot {
+	return &walkRoot{
+		Name:  String("foo"),
+		Child: &walkContainer{Val: String("bar")},
+		List: map[string]*walkListEntry{
+			"one": {Key: String("one"), Value: Uint32(1)},
+			"two": {Key: String("two"), Value: Uint32(2)},
+		},
+		LeafList: []string{"a", "b"},
+	}
+}
+
+func TestPruneTo(t *testing.T) {
+	tests := []struct {
+		name             string
+		inPaths          []*gnmipb.Path
+		want             GoStruct
+		wantErrSubstring string
+	}{{
+		name:    "single leaf",
+		inPaths: []*gnmipb.Path{mustPath("/name")},
+		want:    &walkRoot{Name: String("foo")},
+	}, {
+		name:    "multiple paths",
+		inPaths: []*gnmipb.Path{mustPath("/name"), mustPath("/leaf-list")},
+		want:    &walkRoot{Name: String("foo"), LeafList: []string{"a", "b"}},
+	}, {
+		name:    "container",
+		inPaths: []*gnmipb.Path{mustPath("/child")},
+		want:    &walkRoot{Child: &walkContainer{Val: String("bar")}},
+	}, {
+		name:    "field with more than one path",
+		inPaths: []*gnmipb.Path{mustPath("/child/state/val")},
+		want:    &walkRoot{Child: &walkContainer{Val: String("bar")}},
+	}, {
+		name:    "single list entry",
+		inPaths: []*gnmipb.Path{mustPath("/list[key=two]")},
+		want: &walkRoot{
+			List: map[string]*walkListEntry{
+				"two": {Key: String("two"), Value: Uint32(2)},
+			},
+		},
+	}, {
+		name:    "wildcard list key retains list keys",
+		inPaths: []*gnmipb.Path{mustPath("/list[key=*]/value")},
+		want: &walkRoot{
+			List: map[string]*walkListEntry{
+				"one": {Key: String("one"), Value: Uint32(1)},
+				"two": {Key: String("two"), Value: Uint32(2)},
+			},
+		},
+	}, {
+		name:    "wildcard name",
+		inPaths: []*gnmipb.Path{mustPath("/*/val")},
+		want:    &walkRoot{Child: &walkContainer{Val: String("bar")}},
+	}, {
+		name:    "root path",
+		inPaths: []*gnmipb.Path{{}},
+		want:    pruneTestStruct(),
+	}, {
+		name:    "no matching paths",
+		inPaths: []*gnmipb.Path{mustPath("/child/non-existent")},
+		want:    &walkRoot{},
+	}, {
+		name: "string slice path",
+		inPaths: []*gnmipb.Path{{
+			Element: []string{"name"},
+		}},
+		wantErrSubstring: "paths must use PathElem",
+	}}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := pruneTestStruct()
+			err := PruneTo(got, walkSchema(), tt.inPaths)
+			if diff := errdiff.Substring(err, tt.wantErrSubstring); diff != "" {
+				t.Fatalf("PruneTo: did not get expected error, %s", diff)
+			}
+			if err != nil {
+				return
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("PruneTo: (-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestPruneExcept(t *testing.T) {
+	tests := []struct {
+		name string
+		// inStruct is the GoStruct that is pruned, pruneTestStruct() is
+		// used if it is nil.
+		inStruct *walkRoot
+		inPaths  []*gnmipb.Path
+		want     GoStruct
+	}{{
+		name:    "single leaf",
+		inPaths: []*gnmipb.Path{mustPath("/name")},
+		want: &walkRoot{
+			Child: &walkContainer{Val: String("bar")},
+			List: map[string]*walkListEntry{
+				"one": {Key: String("one"), Value: Uint32(1)},
+				"two": {Key: String("two"), Value: Uint32(2)},
+			},
+			LeafList: []string{"a", "b"},
+		},
+	}, {
+		name:    "list entry and leaf within container",
+		inPaths: []*gnmipb.Path{mustPath("/list[key=one]"), mustPath("/child/val")},
+		want: &walkRoot{
+			Name: String("foo"),
+			List: map[string]*walkListEntry{
+				"two": {Key: String("two"), Value: Uint32(2)},
+			},
+			LeafList: []string{"a", "b"},
+		},
+	}, {
+		name:    "wildcard",
+		inPaths: []*gnmipb.Path{mustPath("/*")},
+		want:    &walkRoot{},
+	}, {
+		name:    "root path",
+		inPaths: []*gnmipb.Path{{}},
+		want:    &walkRoot{},
+	}, {
+		name:    "no matching paths",
+		inPaths: []*gnmipb.Path{mustPath("/child/non-existent")},
+		want:    pruneTestStruct(),
+	}, {
+		name: "keyless list entries",
+		inStruct: &walkRoot{
+			Log: []*walkListEntry{{Key: String("a")}, {Key: String("b")}, {Key: String("c")}, {Key: String("d")}},
+		},
+		inPaths: []*gnmipb.Path{mustPath("/log[index=0]"), mustPath("/log[index=2]")},
+		want: &walkRoot{
+			Log: []*walkListEntry{{Key: String("b")}, {Key: String("d")}},
+		},
+	}, {
+		name: "all keyless list entries",
+		inStruct: &walkRoot{
+			Name: String("foo"),
+			Log:  []*walkListEntry{{Key: String("a")}, {Key: String("b")}},
+		},
+		inPaths: []*gnmipb.Path{mustPath("/log[index=*]")},
+		want:    &walkRoot{Name: String("foo")},
+	}}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.inStruct
+			if got == nil {
+				got = pruneTestStruct()
+			}
+			if err := PruneExcept(got, walkSchema(), tt.inPaths); err != nil {
+				t.Fatalf("PruneExcept: got unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("PruneExcept: (-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestExtract(t *testing.T) {
+	in := pruneTestStruct()
+	got, err := Extract(in, walkSchema(), []*gnmipb.Path{mustPath("/list[key=one]")})
+	if err != nil {
+		t.Fatalf("Extract: got unexpected error: %v", err)
+	}
+
+	want := &walkRoot{
+		List: map[string]*walkListEntry{
+			"one": {Key: String("one"), Value: Uint32(1)},
+		},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("Extract: (-want, +got):\n%s", diff)
+	}
+	if diff := cmp.Diff(pruneTestStruct(), in); diff != "" {
+		t.Errorf("Extract: input GoStruct was modified, (-want, +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,612 @@
+// Copyright 2021 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ygot
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/openconfig/goyang/pkg/yang"
+	"github.com/openconfig/ygot/util"
+
+	gnmipb "github.com/openconfig/gnmi/proto/gnmi"
+)
+
+const (
+	// JSONPatchAdd is the RFC6902 JSON Patch operation that adds a value.
+	JSONPatchAdd string = "add"
+	// JSONPatchRemove is the RFC6902 JSON Patch operation that removes a
+	// value.
+	JSONPatchRemove string = "remove"
+	// JSONPatchReplace is the RFC6902 JSON Patch operation that replaces a
+	// value.
+	JSONPatchReplace string = "replace"
+	// JSONPatchMove is the RFC6902 JSON Patch operation that moves a value.
+	JSONPatchMove string = "move"
+	// JSONPatchCopy is the RFC6902 JSON Patch operation that copies a value.
+	JSONPatchCopy string = "copy"
+	// JSONPatchTest is the RFC6902 JSON Patch operation that tests whether
+	// a value is present.
+	JSONPatchTest string = "test"
+)
+
+// JSONPatchOperation is an operation of an RFC6902 JSON Patch. The Path and
+// From fields are JSON Pointers (RFC6901) to values within the document that
+// the patch is applied to.
+type JSONPatchOperation struct {
+	Op    string      `json:"op"`
+	Path  string      `json:"path"`
+	From  string      `json:"from,omitempty"`
+	Value interface{} `json:"value,omitempty"`
+}
+
+const (
+	// YANGPatchCreate is the RFC8072 YANG Patch operation that creates a
+	// data resource that does not already exist.
+	YANGPatchCreate string = "create"
+	// YANGPatchDelete is the RFC8072 YANG Patch operation that deletes a
+	// data resource that exists.
+	YANGPatchDelete string = "delete"
+	// YANGPatchInsert is the RFC8072 YANG Patch operation that inserts an
+	// entry into a user-ordered list or leaf-list.
+	YANGPatchInsert string = "insert"
+	// YANGPatchMerge is the RFC8072 YANG Patch operation that merges the
+	// edit value with the target data resource.
+	YANGPatchMerge string = "merge"
+	// YANGPatchMove is the RFC8072 YANG Patch operation that moves an entry
+	// within a user-ordered list or leaf-list.
+	YANGPatchMove string = "move"
+	// YANGPatchReplace is the RFC8072 YANG Patch operation that replaces
+	// the target data resource with the edit value.
+	YANGPatchReplace string = "replace"
+	// YANGPatchRemove is the RFC8072 YANG Patch operation that removes a
+	// data resource, if it exists.
+	YANGPatchRemove string = "remove"
+)
+
+// YANGPatch is an RFC8072 YANG Patch. It is marshalled to, and unmarshalled
+// from, the JSON encoding of the ietf-yang-patch:yang-patch container.
+type YANGPatch struct {
+	PatchID string           `json:"patch-id"`
+	Comment string           `json:"comment,omitempty"`
+	Edit    []*YANGPatchEdit `json:"edit,omitempty"`
+}
+
+// YANGPatchEdit is an edit within an RFC8072 YANG Patch. The Target is a
+// RESTCONF (RFC8040) data resource identifier relative to the resource that
+// the patch is applied to, and the Value, if specified, is an RFC7951 JSON
+// object whose only member is the target data node.
+type YANGPatchEdit struct {
+	EditID    string                 `json:"edit-id"`
+	Operation string                 `json:"operation"`
+	Target    string                 `json:"target"`
+	Value     map[string]interface{} `json:"value,omitempty"`
+}
+
+// yangPatchDocument is the JSON document that an RFC8072 YANG Patch is
+// encoded within.
+type yangPatchDocument struct {
+	Patch *yangPatchJSON `json:"ietf-yang-patch:yang-patch"`
+}
+
+// yangPatchJSON has the same fields as YANGPatch, without its JSON
+// methods, such that it can be encoded using encoding/json.
+type yangPatchJSON YANGPatch
+
+// MarshalJSON marshals the YANGPatch to the JSON encoding of the
+// ietf-yang-patch:yang-patch container.
+func (p *YANGPatch) MarshalJSON() ([]byte, error) {
+	return json.Marshal(yangPatchDocument{Patch: (*yangPatchJSON)(p)})
+}
+
+// UnmarshalJSON unmarshals the JSON encoding of the
+// ietf-yang-patch:yang-patch container into the YANGPatch.
+func (p *YANGPatch) UnmarshalJSON(b []byte) error {
+	var d yangPatchDocument
+	if err := json.Unmarshal(b, &d); err != nil {
+		return err
+	}
+	if d.Patch == nil {
+		return fmt.Errorf("JSON does not contain an ietf-yang-patch:yang-patch container: %s", b)
+	}
+	*p = YANGPatch(*d.Patch)
+	return nil
+}
+
+// DiffToJSONPatch takes an original and modified GoStruct, which must be of the
+// same type, and returns an RFC6902 JSON Patch that, when applied to the RFC7951
+// JSON representation of original, results in that of modified. The RFC7951
+// JSON is rendered with module names prepended to elements. The supplied
+// DiffOpts are used when the structs are compared using Diff.
+//
+// Entries of YANG lists are identified by their index within the JSON array
+// that represents the list, which is ordered as it is by ConstructIETFJSON. The
+// operations within the patch must therefore be applied in the order that they
+// are returned.
+func DiffToJSONPatch(original, modified GoStruct, opts ...DiffOpt) ([]*JSONPatchOperation, error) {
+	edits, err := diffToPatchEdits(original, modified, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	ops := []*JSONPatchOperation{}
+	for _, e := range edits {
+		path := e.path
+		value := e.value
+		if e.newList {
+			// The list did not exist, and hence is created with a single
+			// entry.
+			last := path[len(path)-1]
+			path = append(append([]*patchSegment{}, path[:len(path)-1]...), &patchSegment{name: last.name, index: -1})
+			value = []interface{}{value}
+		}
+
+		var toks []string
+		for _, s := range path {
+			toks = append(toks, s.name)
+			if s.index != -1 {
+				toks = append(toks, strconv.Itoa(s.index))
+			}
+		}
+
+		op := &JSONPatchOperation{Path: util.JSONPointer(toks)}
+		switch e.op {
+		case patchAdd:
+			op.Op, op.Value = JSONPatchAdd, value
+		case patchReplace:
+			op.Op, op.Value = JSONPatchReplace, value
+		case patchRemove:
+			op.Op = JSONPatchRemove
+		}
+		ops = append(ops, op)
+	}
+	return ops, nil
+}
+
+// DiffToYANGPatch takes an original and modified GoStruct, which must be of the
+// same type, and returns an RFC8072 YANG Patch with the supplied ID that, when
+// applied to original, results in modified. The schema supplied must be the
+// schema of the GoStructs, and is used to determine the order of the keys of
+// the YANG lists that are identified within the targets of the patch. The
+// supplied DiffOpts are used when the structs are compared using Diff.
+//
+// The target of each edit is a RESTCONF data resource identifier, relative to
+// the GoStructs being compared. Where an entire YANG list or leaf-list is
+// removed, or a leaf-list is changed, the target identifies the list or
+// leaf-list itself rather than its individual entries.
+func DiffToYANGPatch(original, modified GoStruct, schema *yang.Entry, patchID string, opts ...DiffOpt) (*YANGPatch, error) {
+	if schema == nil {
+		return nil, fmt.Errorf("nil schema supplied to DiffToYANGPatch for %T", original)
+	}
+
+	edits, err := diffToPatchEdits(original, modified, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &YANGPatch{PatchID: patchID}
+	for i, e := range edits {
+		target, err := restconfTarget(e.path, schema)
+		if err != nil {
+			return nil, err
+		}
+
+		ed := &YANGPatchEdit{
+			EditID: fmt.Sprintf("edit%d", i+1),
+			Target: target,
+		}
+		switch e.op {
+		case patchAdd:
+			ed.Operation = YANGPatchCreate
+		case patchReplace:
+			ed.Operation = YANGPatchReplace
+		case patchRemove:
+			ed.Operation = YANGPatchDelete
+		}
+
+		if e.op != patchRemove {
+			last := e.path[len(e.path)-1]
+			name := util.StripModulePrefix(last.name)
+			if last.module != "" {
+				name = fmt.Sprintf("%s:%s", last.module, name)
+			}
+			value := e.value
+			if last.index != -1 {
+				value = []interface{}{value}
+			}
+			ed.Value = map[string]interface{}{name: value}
+		}
+		p.Edit = append(p.Edit, ed)
+	}
+	return p, nil
+}
+
+// patchOp is the type of an edit to the RFC7951 JSON representation of a
+// GoStruct.
+type patchOp int64
+
+const (
+	// patchAdd indicates that a node that did not exist is added.
+	patchAdd patchOp = iota
+	// patchReplace indicates that the value of an existing node is
+	// replaced.
+	patchReplace
+	// patchRemove indicates that an existing node is removed.
+	patchRemove
+)
+
+// patchEdit is an edit to the RFC7951 JSON representation of a GoStruct.
+type patchEdit struct {
+	// op is the type of the edit.
+	op patchOp
+	// path is the path to the node that is edited.
+	path []*patchSegment
+	// value is the RFC7951 JSON value of the node after the edit.
+	value interface{}
+	// newList indicates that the node added is the first entry of a list
+	// that did not previously exist.
+	newList bool
+}
+
+// patchSegment is an element of the path to a node within an RFC7951 JSON
+// tree, corresponding to a single gNMI PathElem.
+type patchSegment struct {
+	// name is the name of the JSON object member that stores the node,
+	// which may be prefixed with the name of a module.
+	name string
+	// module is the name of the module that the node is defined within,
+	// as determined from the prefixes of the members of the tree. It is
+	// empty if no prefix has been found.
+	module string
+	// index is the index of the list entry within the JSON array that
+	// stores the list, or -1 if the node is not a list entry.
+	index int
+	// entry is the JSON object of the list entry, if the node is a list
+	// entry.
+	entry map[string]interface{}
+}
+
+// diffToPatchEdits returns the edits to the RFC7951 JSON representation of
+// the GoStruct original that result in that of modified, determined from the
+// Diff between them. The edits are ordered such that the paths of each edit
+// are valid once the previous edits have been applied.
+func diffToPatchEdits(original, modified GoStruct, opts []DiffOpt) ([]*patchEdit, error) {
+	n, err := Diff(original, modified, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	cfg := &RFC7951JSONConfig{AppendModuleName: true}
+	orig, err := ConstructIETFJSON(original, cfg)
+	if err != nil {
+		return nil, fmt.Errorf("cannot construct RFC7951 JSON for original struct: %v", err)
+	}
+	mod, err := ConstructIETFJSON(modified, cfg)
+	if err != nil {
+		return nil, fmt.Errorf("cannot construct RFC7951 JSON for modified struct: %v", err)
+	}
+
+	var indexKeys bool
+	if po := hasDiffPathOpt(opts); po != nil {
+		indexKeys = po.KeylessListMode == KeylessListIndexKeys
+	}
+	resolve := func(t map[string]interface{}, elems []*gnmipb.PathElem) ([]*patchSegment, interface{}, bool, error) {
+		return resolveJSONPath(t, elems, indexKeys)
+	}
+
+	// Entries of keyless lists are removed in descending order of their
+	// index, such that removing an entry does not change the index of an
+	// entry that is subsequently removed, and the paths of the deletes
+	// continue to identify the entries of the original list. Entries are
+	// added in ascending order of their index, such that each is appended
+	// to the list.
+	deletes := sortedPaths(n.GetDelete(), true)
+	updates := make([]*gnmipb.Path, 0, len(n.GetUpdate()))
+	for _, u := range n.GetUpdate() {
+		updates = append(updates, u.GetPath())
+	}
+	updates = sortedPaths(updates, false)
+
+	var edits []*patchEdit
+	// The least specific node that does not exist within modified is
+	// removed for each deleted leaf, such that containers and lists that
+	// are removed entirely are removed with a single edit.
+	for _, p := range deletes {
+		for i := 1; i <= len(p.GetElem()); i++ {
+			_, _, ok, err := resolve(mod, p.GetElem()[:i])
+			switch {
+			case err != nil:
+				return nil, err
+			case ok:
+				continue
+			}
+
+			segs, _, ok, err := resolve(orig, p.GetElem()[:i])
+			if err != nil {
+				return nil, err
+			}
+			if ok && segs[len(segs)-1].index != -1 {
+				// If the list does not exist within modified, then it
+				// is removed rather than its entry.
+				psegs, _, _, err := resolve(mod, p.GetElem()[:i-1])
+				if err != nil {
+					return nil, err
+				}
+				if _, ok := jsonArrayMember(mod, psegs, segs[len(segs)-1].name); !ok {
+					last := segs[len(segs)-1]
+					segs[len(segs)-1] = &patchSegment{name: last.name, module: last.module, index: -1}
+				}
+			}
+			if ok {
+				if err := removeJSONPath(orig, segs); err != nil {
+					return nil, err
+				}
+				edits = append(edits, &patchEdit{op: patchRemove, path: segs})
+			}
+			break
+		}
+	}
+
+	// The least specific node that does not exist within original is added
+	// for each updated leaf, with the value that it has within modified.
+	for _, p := range updates {
+		for i := 1; i <= len(p.GetElem()); i++ {
+			segs, v, ok, err := resolve(orig, p.GetElem()[:i])
+			if err != nil {
+				return nil, err
+			}
+			if ok && i < len(p.GetElem()) {
+				continue
+			}
+
+			msegs, mv, mok, err := resolve(mod, p.GetElem()[:i])
+			switch {
+			case err != nil:
+				return nil, err
+			case !mok:
+				return nil, fmt.Errorf("cannot find updated path %v within modified struct", p)
+			}
+
+			e := &patchEdit{op: patchReplace, path: segs, value: mv}
+			if !ok {
+				// The parent of the node exists, since the node is the least
+				// specific node that does not exist.
+				psegs, _, _, err := resolve(orig, p.GetElem()[:i-1])
+				if err != nil {
+					return nil, err
+				}
+				ms := msegs[len(msegs)-1]
+				ns := &patchSegment{name: ms.name, module: ms.module, index: -1, entry: ms.entry}
+				if ms.index != -1 {
+					ns.index = 0
+					if l, ok := jsonArrayMember(orig, psegs, ms.name); ok {
+						ns.index = len(l)
+					} else {
+						e.newList = true
+					}
+				}
+				e.op, e.path = patchAdd, append(psegs, ns)
+			}
+
+			if e.op == patchReplace && reflect.DeepEqual(v, mv) {
+				// The node was added as part of a previous edit.
+				break
+			}
+			if err := setJSONPath(orig, e.path, copyJSON(mv), e.newList); err != nil {
+				return nil, err
+			}
+			edits = append(edits, e)
+			break
+		}
+	}
+	return edits, nil
+}
+
+// sortedPaths returns the supplied paths sorted according to comparePaths,
+// such that the edits of a patch are deterministic. If descIndex is true, the
+// entries of keyless lists are sorted in descending order of their index.
+func sortedPaths(paths []*gnmipb.Path, descIndex bool) []*gnmipb.Path {
+	out := append([]*gnmipb.Path{}, paths...)
+	sort.SliceStable(out, func(i, j int) bool { return comparePaths(out[i], out[j], descIndex) < 0 })
+	return out
+}
+
+// resolveJSONPath returns the path segments, and value, of the node
+// identified by the supplied PathElems within the RFC7951 JSON tree t. It
+// returns false if the node does not exist in t. If indexKeys is true, then
+// PathElems with only the KeylessListIndexKey key identify entries of keyless
+// lists by their index.
+func resolveJSONPath(t map[string]interface{}, elems []*gnmipb.PathElem, indexKeys bool) ([]*patchSegment, interface{}, bool, error) {
+	var segs []*patchSegment
+	var cur interface{} = t
+	var module string
+	for _, e := range elems {
+		obj, ok := cur.(map[string]interface{})
+		if !ok {
+			return nil, nil, false, fmt.Errorf("cannot resolve %s, parent is not a JSON object: %v", e.GetName(), cur)
+		}
+		name, v, ok := util.JSONMember(obj, e.GetName())
+		if !ok {
+			return nil, nil, false, nil
+		}
+		if i := strings.Index(name, ":"); i != -1 {
+			module = name[:i]
+		}
+
+		s := &patchSegment{name: name, module: module, index: -1}
+		segs = append(segs, s)
+		cur = v
+		if len(e.GetKey()) == 0 {
+			continue
+		}
+
+		l, ok := v.([]interface{})
+		if !ok {
+			return nil, nil, false, fmt.Errorf("cannot resolve %s, value is not a JSON array: %v", e.GetName(), v)
+		}
+		idx, err := findJSONListEntry(l, e.GetKey(), indexKeys)
+		if err != nil {
+			return nil, nil, false, err
+		}
+		if idx == -1 {
+			return nil, nil, false, nil
+		}
+		s.index, s.entry = idx, l[idx].(map[string]interface{})
+		cur = l[idx]
+	}
+	return segs, cur, true, nil
+}
+
+// findJSONListEntry returns the index of the entry of the JSON array l that
+// has the supplied keys, or -1 if there is no such entry. If indexKeys is
+// true, then the KeylessListIndexKey key specifies the index of the entry.
+func findJSONListEntry(l []interface{}, keys map[string]string, indexKeys bool) (int, error) {
+	if idx, ok := keys[KeylessListIndexKey]; ok && indexKeys && len(keys) == 1 {
+		i, err := strconv.Atoi(idx)
+		if err != nil {
+			return -1, fmt.Errorf("invalid keyless list index %s: %v", idx, err)
+		}
+		if i < 0 || i >= len(l) {
+			return -1, nil
+		}
+		return i, nil
+	}
+	return util.FindJSONListEntry(l, keys)
+}
+
+// jsonArrayMember returns the JSON array stored in the member with the
+// supplied name of the node at the path segs within the RFC7951 JSON tree t.
+func jsonArrayMember(t map[string]interface{}, segs []*patchSegment, name string) ([]interface{}, bool) {
+	obj, err := jsonNode(t, segs)
+	if err != nil {
+		return nil, false
+	}
+	l, ok := obj[name].([]interface{})
+	return l, ok
+}
+
+// jsonNode returns the JSON object of the node at the path segs within the
+// RFC7951 JSON tree t.
+func jsonNode(t map[string]interface{}, segs []*patchSegment) (map[string]interface{}, error) {
+	cur := t
+	for _, s := range segs {
+		v := cur[s.name]
+		if s.index != -1 {
+			l, ok := v.([]interface{})
+			if !ok || s.index >= len(l) {
+				return nil, fmt.Errorf("invalid list %s", s.name)
+			}
+			v = l[s.index]
+		}
+		obj, ok := v.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("invalid container %s", s.name)
+		}
+		cur = obj
+	}
+	return cur, nil
+}
+
+// setJSONPath sets the node at the path segs within the RFC7951 JSON tree t
+// to v. If newList is true, the list containing the node is created.
+func setJSONPath(t map[string]interface{}, segs []*patchSegment, v interface{}, newList bool) error {
+	parent, err := jsonNode(t, segs[:len(segs)-1])
+	if err != nil {
+		return err
+	}
+	s := segs[len(segs)-1]
+	switch {
+	case newList:
+		parent[s.name] = []interface{}{v}
+	case s.index == -1:
+		parent[s.name] = v
+	default:
+		l, _ := parent[s.name].([]interface{})
+		if s.index == len(l) {
+			parent[s.name] = append(l, v)
+			return nil
+		}
+		l[s.index] = v
+	}
+	return nil
+}
+
+// removeJSONPath removes the node at the path segs within the RFC7951 JSON
+// tree t.
+func removeJSONPath(t map[string]interface{}, segs []*patchSegment) error {
+	parent, err := jsonNode(t, segs[:len(segs)-1])
+	if err != nil {
+		return err
+	}
+	s := segs[len(segs)-1]
+	if s.index == -1 {
+		delete(parent, s.name)
+		return nil
+	}
+	l, _ := parent[s.name].([]interface{})
+	parent[s.name] = append(l[:s.index:s.index], l[s.index+1:]...)
+	return nil
+}
+
+// copyJSON returns a deep copy of the JSON tree v, such that the JSON objects
+// and arrays that it contains are not shared with v.
+func copyJSON(v interface{}) interface{} {
+	switch t := v.(type) {
+	case map[string]interface{}:
+		m := make(map[string]interface{}, len(t))
+		for k, cv := range t {
+			m[k] = copyJSON(cv)
+		}
+		return m
+	case []interface{}:
+		l := make([]interface{}, 0, len(t))
+		for _, cv := range t {
+			l = append(l, copyJSON(cv))
+		}
+		return l
+	}
+	return v
+}
+
+// restconfTarget returns the RESTCONF data resource identifier for the node
+// at the path segs, where the supplied schema is the schema of the root of the
+// path. The values of list keys are ordered according to the schema.
+func restconfTarget(segs []*patchSegment, schema *yang.Entry) (string, error) {
+	var elems []*restconfElem
+	for _, s := range segs {
+		if schema = util.FirstChild(schema, []string{util.StripModulePrefix(s.name)}); schema == nil {
+			return "", fmt.Errorf("cannot find schema for %s", s.name)
+		}
+		e := &restconfElem{name: s.name}
+		if s.index != -1 {
+			for _, k := range strings.Fields(schema.Key) {
+				_, v, ok := util.JSONMember(s.entry, k)
+				if !ok {
+					return "", fmt.Errorf("list entry of %s does not contain key %s", s.name, k)
+				}
+				e.keys = append(e.keys, util.JSONKeyString(v))
+			}
+			if len(e.keys) == 0 {
+				return "", fmt.Errorf("cannot identify entry of keyless list %s", s.name)
+			}
+		}
+		elems = append(elems, e)
+	}
+	return restconfString(elems), nil
+}
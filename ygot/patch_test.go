@@ -0,0 +1,357 @@
+// Copyright 2021 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ygot
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/openconfig/gnmi/errdiff"
+	"github.com/openconfig/goyang/pkg/yang"
+)
+
+// patchRoot is a GoStruct whose fields are defined within YANG modules,
+// used for testing patches.
+type patchRoot struct {
+	Name  *string                       `path:"name" module:"pmod"`
+	Child *patchChild                   `path:"child" module:"pmod"`
+	Multi map[patchMultiKey]*patchMulti `path:"multi" module:"pmod"`
+}
+
+func (*patchRoot) IsYANGGoStruct()                         {}
+func (*patchRoot) ΛValidate(...ValidationOption) error     { return nil }
+func (*patchRoot) ΛEnumTypeMap() map[string][]reflect.Type { return nil }
+func (*patchRoot) ΛBelongingModule() string                { return "" }
+
+// patchChild is a container within patchRoot, with a leaf that is
+// augmented from another module.
+type patchChild struct {
+	Val *string `path:"val" module:"pmod"`
+	Aug *string `path:"aug" module:"amod"`
+}
+
+func (*patchChild) IsYANGGoStruct()                         {}
+func (*patchChild) ΛValidate(...ValidationOption) error     { return nil }
+func (*patchChild) ΛEnumTypeMap() map[string][]reflect.Type { return nil }
+func (*patchChild) ΛBelongingModule() string                { return "pmod" }
+
+// patchMultiKey is the key of the multi list within patchRoot.
+type patchMultiKey struct {
+	B string `path:"b"`
+	A uint32 `path:"a"`
+}
+
+// patchMulti is an entry of the multi list within patchRoot.
+type patchMulti struct {
+	B   *string `path:"b" module:"pmod"`
+	A   *uint32 `path:"a" module:"pmod"`
+	Val *string `path:"val" module:"pmod"`
+}
+
+func (*patchMulti) IsYANGGoStruct()                         {}
+func (*patchMulti) ΛValidate(...ValidationOption) error     { return nil }
+func (*patchMulti) ΛEnumTypeMap() map[string][]reflect.Type { return nil }
+func (*patchMulti) ΛBelongingModule() string                { return "pmod" }
+
+func (e *patchMulti) ΛListKeyMap() (map[string]interface{}, error) {
+	return map[string]interface{}{"b": *e.B, "a": *e.A}, nil
+}
+
+// patchSchema returns the schema corresponding to the patchRoot GoStruct.
+func patchSchema() *yang.Entry {
+	leaf := func(name string, k yang.TypeKind) *yang.Entry {
+		return &yang.Entry{Name: name, Kind: yang.LeafEntry, Type: &yang.YangType{Kind: k}}
+	}
+	return &yang.Entry{
+		Name: "root",
+		Kind: yang.DirectoryEntry,
+		Dir: map[string]*yang.Entry{
+			"name": leaf("name", yang.Ystring),
+			"child": {
+				Name: "child",
+				Kind: yang.DirectoryEntry,
+				Dir: map[string]*yang.Entry{
+					"val": leaf("val", yang.Ystring),
+					"aug": leaf("aug", yang.Ystring),
+				},
+			},
+			"multi": {
+				Name:     "multi",
+				Kind:     yang.DirectoryEntry,
+				ListAttr: yang.NewDefaultListAttr(),
+				Key:      "b a",
+				Dir: map[string]*yang.Entry{
+					"a":   leaf("a", yang.Yuint32),
+					"b":   leaf("b", yang.Ystring),
+					"val": leaf("val", yang.Ystring),
+				},
+			},
+		},
+	}
+}
+
+func TestDiffToJSONPatch(t *testing.T) {
+	tests := []struct {
+		name             string
+		inOrig           GoStruct
+		inMod            GoStruct
+		inOpts           []DiffOpt
+		want             []*JSONPatchOperation
+		wantErrSubstring string
+	}{{
+		name:   "no changes",
+		inOrig: &walkRoot{Name: String("foo")},
+		inMod:  &walkRoot{Name: String("foo")},
+		want:   []*JSONPatchOperation{},
+	}, {
+		name:   "leaf replaced",
+		inOrig: &walkRoot{Name: String("foo")},
+		inMod:  &walkRoot{Name: String("bar")},
+		want: []*JSONPatchOperation{
+			{Op: "replace", Path: "/name", Value: "bar"},
+		},
+	}, {
+		name:   "leaf added and removed",
+		inOrig: &walkRoot{Name: String("foo")},
+		inMod:  &walkRoot{LeafList: []string{"a", "b"}},
+		want: []*JSONPatchOperation{
+			{Op: "remove", Path: "/name"},
+			{Op: "add", Path: "/leaf-list", Value: []interface{}{"a", "b"}},
+		},
+	}, {
+		name:   "container added with multiple leaves",
+		inOrig: &walkRoot{},
+		inMod:  &walkRoot{Child: &walkContainer{Val: String("foo")}},
+		want: []*JSONPatchOperation{
+			{Op: "add", Path: "/child", Value: map[string]interface{}{
+				"val":   "foo",
+				"state": map[string]interface{}{"val": "foo"},
+			}},
+		},
+	}, {
+		name:   "container removed",
+		inOrig: &walkRoot{Name: String("foo"), Child: &walkContainer{Val: String("foo")}},
+		inMod:  &walkRoot{Name: String("foo")},
+		want: []*JSONPatchOperation{
+			{Op: "remove", Path: "/child"},
+		},
+	}, {
+		name:   "list created",
+		inOrig: &walkRoot{},
+		inMod: &walkRoot{List: map[string]*walkListEntry{
+			"one": {Key: String("one"), Value: Uint32(1)},
+		}},
+		want: []*JSONPatchOperation{
+			{Op: "add", Path: "/list", Value: []interface{}{
+				map[string]interface{}{"key": "one", "value": uint32(1)},
+			}},
+		},
+	}, {
+		name: "list entries added, removed and updated",
+		inOrig: &walkRoot{List: map[string]*walkListEntry{
+			"a": {Key: String("a"), Value: Uint32(1)},
+			"b": {Key: String("b"), Value: Uint32(2)},
+			"c": {Key: String("c"), Value: Uint32(3)},
+		}},
+		inMod: &walkRoot{List: map[string]*walkListEntry{
+			"b": {Key: String("b"), Value: Uint32(2)},
+			"c": {Key: String("c"), Value: Uint32(42)},
+			"d": {Key: String("d")},
+		}},
+		want: []*JSONPatchOperation{
+			{Op: "remove", Path: "/list/0"},
+			{Op: "replace", Path: "/list/1/value", Value: uint32(42)},
+			{Op: "add", Path: "/list/2", Value: map[string]interface{}{"key": "d"}},
+		},
+	}, {
+		name:   "last list entry removed",
+		inOrig: &walkRoot{Name: String("foo"), List: map[string]*walkListEntry{"a": {Key: String("a")}}},
+		inMod:  &walkRoot{Name: String("foo")},
+		want: []*JSONPatchOperation{
+			{Op: "remove", Path: "/list"},
+		},
+	}, {
+		name: "keyless list entries removed in descending order",
+		inOrig: &walkRoot{Log: []*walkListEntry{
+			{Key: String("a")}, {Key: String("b")}, {Key: String("c")},
+		}},
+		inMod:  &walkRoot{Log: []*walkListEntry{{Key: String("a")}}},
+		inOpts: []DiffOpt{&DiffPathOpt{KeylessListMode: KeylessListIndexKeys}},
+		want: []*JSONPatchOperation{
+			{Op: "remove", Path: "/log/2"},
+			{Op: "remove", Path: "/log/1"},
+		},
+	}, {
+		name:   "module names prepended",
+		inOrig: &patchRoot{Name: String("foo"), Child: &patchChild{Val: String("v")}},
+		inMod:  &patchRoot{Name: String("bar"), Child: &patchChild{Val: String("v"), Aug: String("a")}},
+		want: []*JSONPatchOperation{
+			{Op: "add", Path: "/pmod:child/amod:aug", Value: "a"},
+			{Op: "replace", Path: "/pmod:name", Value: "bar"},
+		},
+	}, {
+		name:             "different types",
+		inOrig:           &walkRoot{},
+		inMod:            &patchRoot{},
+		wantErrSubstring: "cannot diff structs of different types",
+	}}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := DiffToJSONPatch(tt.inOrig, tt.inMod, tt.inOpts...)
+			if diff := errdiff.Substring(err, tt.wantErrSubstring); diff != "" {
+				t.Fatalf("DiffToJSONPatch: did not get expected error, %s", diff)
+			}
+			if err != nil {
+				return
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("DiffToJSONPatch: did not get expected patch, diff(-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDiffToYANGPatch(t *testing.T) {
+	tests := []struct {
+		name             string
+		inOrig           GoStruct
+		inMod            GoStruct
+		inSchema         *yang.Entry
+		want             *YANGPatch
+		wantErrSubstring string
+	}{{
+		name:     "no changes",
+		inOrig:   &patchRoot{Name: String("foo")},
+		inMod:    &patchRoot{Name: String("foo")},
+		inSchema: patchSchema(),
+		want:     &YANGPatch{PatchID: "p"},
+	}, {
+		name:     "leaves added, replaced and deleted",
+		inOrig:   &patchRoot{Name: String("foo"), Child: &patchChild{Val: String("v")}},
+		inMod:    &patchRoot{Child: &patchChild{Val: String("w"), Aug: String("a")}},
+		inSchema: patchSchema(),
+		want: &YANGPatch{
+			PatchID: "p",
+			Edit: []*YANGPatchEdit{{
+				EditID:    "edit1",
+				Operation: "delete",
+				Target:    "/pmod:name",
+			}, {
+				EditID:    "edit2",
+				Operation: "create",
+				Target:    "/pmod:child/amod:aug",
+				Value:     map[string]interface{}{"amod:aug": "a"},
+			}, {
+				EditID:    "edit3",
+				Operation: "replace",
+				Target:    "/pmod:child/val",
+				Value:     map[string]interface{}{"pmod:val": "w"},
+			}},
+		},
+	}, {
+		name: "multi-key list entries",
+		inOrig: &patchRoot{Multi: map[patchMultiKey]*patchMulti{
+			{B: "x/y", A: 1}: {B: String("x/y"), A: Uint32(1), Val: String("one")},
+			{B: "z", A: 2}:   {B: String("z"), A: Uint32(2)},
+		}},
+		inMod: &patchRoot{Multi: map[patchMultiKey]*patchMulti{
+			{B: "x/y", A: 1}: {B: String("x/y"), A: Uint32(1), Val: String("two")},
+			{B: "z", A: 3}:   {B: String("z"), A: Uint32(3)},
+		}},
+		inSchema: patchSchema(),
+		want: &YANGPatch{
+			PatchID: "p",
+			Edit: []*YANGPatchEdit{{
+				EditID:    "edit1",
+				Operation: "delete",
+				Target:    "/pmod:multi=z,2",
+			}, {
+				EditID:    "edit2",
+				Operation: "replace",
+				Target:    "/pmod:multi=x%2Fy,1/val",
+				Value:     map[string]interface{}{"pmod:val": "two"},
+			}, {
+				EditID:    "edit3",
+				Operation: "create",
+				Target:    "/pmod:multi=z,3",
+				Value: map[string]interface{}{"pmod:multi": []interface{}{
+					map[string]interface{}{"a": uint32(3), "b": "z"},
+				}},
+			}},
+		},
+	}, {
+		name:             "nil schema",
+		inOrig:           &patchRoot{},
+		inMod:            &patchRoot{},
+		wantErrSubstring: "nil schema",
+	}, {
+		name:             "missing schema",
+		inOrig:           &patchRoot{},
+		inMod:            &patchRoot{Name: String("foo")},
+		inSchema:         &yang.Entry{Name: "root", Kind: yang.DirectoryEntry, Dir: map[string]*yang.Entry{}},
+		wantErrSubstring: "cannot find schema for pmod:name",
+	}}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := DiffToYANGPatch(tt.inOrig, tt.inMod, tt.inSchema, "p")
+			if diff := errdiff.Substring(err, tt.wantErrSubstring); diff != "" {
+				t.Fatalf("DiffToYANGPatch: did not get expected error, %s", diff)
+			}
+			if err != nil {
+				return
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("DiffToYANGPatch: did not get expected patch, diff(-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestYANGPatchJSON(t *testing.T) {
+	p := &YANGPatch{
+		PatchID: "p",
+		Edit: []*YANGPatchEdit{{
+			EditID:    "edit1",
+			Operation: "replace",
+			Target:    "/pmod:name",
+			Value:     map[string]interface{}{"pmod:name": "foo"},
+		}},
+	}
+
+	b, err := json.Marshal(p)
+	if err != nil {
+		t.Fatalf("json.Marshal: got unexpected error: %v", err)
+	}
+	want := `{"ietf-yang-patch:yang-patch":{"patch-id":"p","edit":[{"edit-id":"edit1","operation":"replace","target":"/pmod:name","value":{"pmod:name":"foo"}}]}}`
+	if string(b) != want {
+		t.Errorf("json.Marshal: got %s, want %s", b, want)
+	}
+
+	got := &YANGPatch{}
+	if err := json.Unmarshal(b, got); err != nil {
+		t.Fatalf("json.Unmarshal: got unexpected error: %v", err)
+	}
+	if diff := cmp.Diff(p, got); diff != "" {
+		t.Errorf("json.Unmarshal: did not get expected patch, diff(-want, +got):\n%s", diff)
+	}
+
+	if err := json.Unmarshal([]byte(`{"patch-id":"p"}`), &YANGPatch{}); err == nil {
+		t.Errorf("json.Unmarshal: did not get expected error for JSON without a yang-patch container")
+	}
+}
@@ -126,6 +126,17 @@ func ModifyKey(n *NodePath, name string, value interface{}) {
 	n.keys[name] = value
 }
 
+// PathParent returns the parent PathStruct of n, or nil if n is the root.
+func PathParent(n PathStruct) PathStruct {
+	return n.parent()
+}
+
+// PathKey returns the value of the key with the given name of a NodePath,
+// or nil if the key is not set.
+func PathKey(n *NodePath, name string) interface{} {
+	return n.keys[name]
+}
+
 // relPath converts the information stored in NodePath into the partial
 // []*gpb.PathElem representing the node's relative path.
 func (n *NodePath) relPath() ([]*gpb.PathElem, []error) {
@@ -169,3 +169,30 @@ func TestResolveRelPath(t *testing.T) {
 		})
 	}
 }
+
+func TestPathParentAndKey(t *testing.T) {
+	root := NewDeviceRootBase("dev")
+	list := NewNodePath([]string{"values", "value"}, map[string]interface{}{"ID": 5}, root)
+	leaf := NewNodePath([]string{"name"}, map[string]interface{}{}, list)
+
+	if got := PathParent(leaf); got != list {
+		t.Errorf("PathParent(leaf): got %v, want %v", got, list)
+	}
+	if got := PathParent(list); got != root {
+		t.Errorf("PathParent(list): got %v, want %v", got, root)
+	}
+	if got := PathParent(root); got != nil {
+		t.Errorf("PathParent(root): got %v, want nil", got)
+	}
+
+	if got, want := PathKey(list, "ID"), interface{}(5); got != want {
+		t.Errorf("PathKey(list, \"ID\"): got %v, want %v", got, want)
+	}
+	if got := PathKey(list, "name"); got != nil {
+		t.Errorf("PathKey(list, \"name\"): got %v, want nil", got)
+	}
+	ModifyKey(list, "ID", 6)
+	if got, want := PathKey(list, "ID"), interface{}(6); got != want {
+		t.Errorf("PathKey(list, \"ID\") after ModifyKey: got %v, want %v", got, want)
+	}
+}
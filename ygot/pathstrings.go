@@ -18,10 +18,13 @@ import (
 	"bytes"
 	"errors"
 	"fmt"
+	"net/url"
 	stdpath "path"
 	"sort"
+	"strconv"
 	"strings"
 
+	"github.com/openconfig/goyang/pkg/yang"
 	"github.com/openconfig/ygot/util"
 
 	gnmipb "github.com/openconfig/gnmi/proto/gnmi"
@@ -315,3 +318,425 @@ func addKey(keys map[string]string, e, k, v string) error {
 	keys[k] = v
 	return nil
 }
+
+// qualifiedElem is an element of a gNMI path that has been resolved against
+// a schema.
+type qualifiedElem struct {
+	// name is the name of the element, prefixed with the name of the
+	// module that defines it where it is the first element of the path, or
+	// is defined in a different module than its parent.
+	name string
+	// keys is the set of key names of the list that the element identifies
+	// an entry of, ordered according to the YANG key statement.
+	keys []string
+	// elem is the PathElem that was resolved.
+	elem *gnmipb.PathElem
+}
+
+// schemaModule returns the name of the module that instantiates the schema
+// node e. It returns an empty string if the schema does not contain module
+// information, as is the case for schemas that are unmarshalled from JSON.
+func schemaModule(e *yang.Entry) (string, error) {
+	root := e
+	for root.Parent != nil {
+		root = root.Parent
+	}
+	if m, ok := root.Node.(*yang.Module); !ok || m.Modules == nil {
+		return "", nil
+	}
+	return e.InstantiatingModule()
+}
+
+// qualifyPath resolves each element of the gNMI path p against the schema,
+// which is the schema of the node that p is relative to. The keys specified
+// for each element must match those of the list, if any, that it identifies.
+func qualifyPath(p *gnmipb.Path, schema *yang.Entry) ([]*qualifiedElem, error) {
+	switch {
+	case p == nil:
+		return nil, errors.New("nil path supplied")
+	case schema == nil:
+		return nil, errors.New("nil schema supplied")
+	//lint:ignore SA1019 Specifically handling deprecated gNMI Element fields.
+	case len(p.GetElement()) != 0:
+		return nil, fmt.Errorf("paths using Element are not supported: %v", p)
+	}
+
+	parentMod, err := schemaModule(schema)
+	if err != nil {
+		return nil, err
+	}
+	if util.IsFakeRoot(schema) {
+		// The fake root is not defined within a module, hence the first
+		// element is always prefixed with its module.
+		parentMod = ""
+	}
+
+	var out []*qualifiedElem
+	for _, e := range p.GetElem() {
+		if schema = util.FirstChild(schema, []string{e.GetName()}); schema == nil {
+			return nil, fmt.Errorf("cannot find schema for %s within path %v", e.GetName(), p)
+		}
+		mod, err := schemaModule(schema)
+		if err != nil {
+			return nil, err
+		}
+
+		qe := &qualifiedElem{name: e.GetName(), elem: e}
+		if mod != parentMod {
+			qe.name = fmt.Sprintf("%s:%s", mod, e.GetName())
+		}
+		parentMod = mod
+
+		if len(e.GetKey()) != 0 {
+			if !util.IsKeyedList(schema) {
+				return nil, fmt.Errorf("keys specified for %s, which is not a keyed list", e.GetName())
+			}
+			qe.keys = strings.Fields(schema.Key)
+			if len(qe.keys) != len(e.GetKey()) {
+				return nil, fmt.Errorf("invalid keys %v for %s, which has keys %v", e.GetKey(), e.GetName(), qe.keys)
+			}
+			for _, k := range qe.keys {
+				if _, ok := e.GetKey()[k]; !ok {
+					return nil, fmt.Errorf("missing key %s for %s", k, e.GetName())
+				}
+			}
+		}
+		out = append(out, qe)
+	}
+	return out, nil
+}
+
+// PathToRESTCONF returns the RESTCONF data resource identifier (RFC8040 section
+// 3.5.3) for the gNMI path p, which must use PathElem messages. The schema
+// supplied is the schema of the node that p is relative to, typically the root
+// of the schema tree. The returned identifier is relative to the same node.
+//
+// The name of each element is prefixed with the name of its module if it is the
+// first element, or is defined in a different module than its parent. If the
+// schema does not contain module information, as is the case for schemas that
+// are unmarshalled from JSON, names are not prefixed. The values of list keys
+// are ordered according to the YANG key statement of the list, and are
+// percent-encoded.
+func PathToRESTCONF(p *gnmipb.Path, schema *yang.Entry) (string, error) {
+	elems, err := qualifyPath(p, schema)
+	if err != nil {
+		return "", err
+	}
+
+	var relems []*restconfElem
+	for _, e := range elems {
+		re := &restconfElem{name: e.name}
+		for _, k := range e.keys {
+			re.keys = append(re.keys, e.elem.GetKey()[k])
+		}
+		relems = append(relems, re)
+	}
+	return restconfString(relems), nil
+}
+
+// restconfElem is an element of a RESTCONF data resource identifier.
+type restconfElem struct {
+	// name is the name of the data node, which may be prefixed with the
+	// name of a module.
+	name string
+	// keys is the set of key values, in the order of the YANG key
+	// statement, identifying an entry of a list. It is nil if the element
+	// does not identify a list entry.
+	keys []string
+}
+
+// restconfString returns the RESTCONF data resource identifier consisting of
+// the supplied elements, with the values of list keys percent-encoded.
+func restconfString(elems []*restconfElem) string {
+	var b strings.Builder
+	for _, e := range elems {
+		b.WriteString("/" + e.name)
+		if len(e.keys) == 0 {
+			continue
+		}
+		var vals []string
+		for _, k := range e.keys {
+			vals = append(vals, url.PathEscape(k))
+		}
+		b.WriteString("=" + strings.Join(vals, ","))
+	}
+	return b.String()
+}
+
+// parseRESTCONF returns the elements of the RESTCONF data resource identifier
+// uri, with percent-encoded names and key values decoded.
+func parseRESTCONF(uri string) ([]*restconfElem, error) {
+	if !strings.HasPrefix(uri, "/") {
+		return nil, fmt.Errorf("invalid RESTCONF identifier %q, must begin with /", uri)
+	}
+	if strings.ContainsAny(uri, "?#") {
+		return nil, fmt.Errorf("invalid RESTCONF identifier %q, query and fragment components are not supported", uri)
+	}
+	if uri == "/" {
+		return nil, nil
+	}
+
+	var elems []*restconfElem
+	for _, seg := range strings.Split(uri[1:], "/") {
+		e := &restconfElem{name: seg}
+		if i := strings.Index(seg, "="); i != -1 {
+			e.name = seg[:i]
+			for _, v := range strings.Split(seg[i+1:], ",") {
+				uv, err := url.PathUnescape(v)
+				if err != nil {
+					return nil, fmt.Errorf("invalid key value %q in RESTCONF identifier %q: %v", v, uri, err)
+				}
+				e.keys = append(e.keys, uv)
+			}
+		}
+		name, err := url.PathUnescape(e.name)
+		if err != nil {
+			return nil, fmt.Errorf("invalid name %q in RESTCONF identifier %q: %v", e.name, uri, err)
+		}
+		if util.StripModulePrefix(name) == "" {
+			return nil, fmt.Errorf("invalid RESTCONF identifier %q, empty element name", uri)
+		}
+		e.name = name
+		elems = append(elems, e)
+	}
+	return elems, nil
+}
+
+// RESTCONFToPath returns the gNMI path for the RESTCONF data resource identifier
+// (RFC8040 section 3.5.3) uri, which is relative to the node whose schema is
+// supplied. Module prefixes are removed from the names of the elements of the
+// path, and are checked against the schema where it contains module
+// information. The key values of list entries are named according to the YANG
+// key statement of the list.
+func RESTCONFToPath(uri string, schema *yang.Entry) (*gnmipb.Path, error) {
+	if schema == nil {
+		return nil, errors.New("nil schema supplied")
+	}
+	elems, err := parseRESTCONF(uri)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &gnmipb.Path{}
+	for _, re := range elems {
+		name, vals := re.name, re.keys
+		mod, n := "", name
+		if i := strings.Index(name, ":"); i != -1 {
+			mod, n = name[:i], name[i+1:]
+		}
+		if schema = util.FirstChild(schema, []string{n}); schema == nil {
+			return nil, fmt.Errorf("cannot find schema for %s within RESTCONF identifier %q", name, uri)
+		}
+		if mod != "" {
+			sm, err := schemaModule(schema)
+			if err != nil {
+				return nil, err
+			}
+			if sm != "" && sm != mod {
+				return nil, fmt.Errorf("invalid module %s for %s, which is defined in module %s", mod, n, sm)
+			}
+		}
+
+		e := &gnmipb.PathElem{Name: n}
+		if vals != nil {
+			if !util.IsKeyedList(schema) {
+				return nil, fmt.Errorf("keys specified for %s, which is not a keyed list", n)
+			}
+			keys := strings.Fields(schema.Key)
+			if len(keys) != len(vals) {
+				return nil, fmt.Errorf("invalid keys %v for %s, which has keys %v", vals, n, keys)
+			}
+			e.Key = map[string]string{}
+			for i, k := range keys {
+				e.Key[k] = vals[i]
+			}
+		}
+		p.Elem = append(p.Elem, e)
+	}
+	return p, nil
+}
+
+// PathToInstanceIdentifier returns the RFC7951 instance-identifier (RFC7951
+// section 6.11) for the gNMI path p, which must use PathElem messages. The
+// schema supplied is the schema of the node that p is relative to, typically
+// the root of the schema tree. Names are prefixed with module names as they
+// are by PathToRESTCONF, and the keys of each list entry are output as
+// predicates in the order of the YANG key statement of the list.
+func PathToInstanceIdentifier(p *gnmipb.Path, schema *yang.Entry) (string, error) {
+	elems, err := qualifyPath(p, schema)
+	if err != nil {
+		return "", err
+	}
+
+	var b strings.Builder
+	for _, e := range elems {
+		b.WriteString("/" + e.name)
+		for _, k := range e.keys {
+			v := e.elem.GetKey()[k]
+			q := "'"
+			if strings.Contains(v, "'") {
+				if strings.Contains(v, `"`) {
+					return "", fmt.Errorf("cannot quote value %q of key %s for %s", v, k, e.name)
+				}
+				q = `"`
+			}
+			fmt.Fprintf(&b, "[%s=%s%s%s]", k, q, v, q)
+		}
+	}
+	return b.String(), nil
+}
+
+// InstanceIdentifierToPath returns the gNMI path for the RFC7951
+// instance-identifier (RFC7951 section 6.11) s. Module prefixes are removed
+// from the names of elements and keys. Predicates that identify leaf-list
+// entries, or list entries by their position, cannot be represented within
+// a gNMI path and result in an error.
+func InstanceIdentifierToPath(s string) (*gnmipb.Path, error) {
+	if !strings.HasPrefix(s, "/") {
+		return nil, fmt.Errorf("invalid instance-identifier %q, must begin with /", s)
+	}
+
+	p := &gnmipb.Path{}
+	for i := 1; i < len(s); {
+		j := i
+		for j < len(s) && s[j] != '/' && s[j] != '[' {
+			j++
+		}
+		name := strings.TrimSpace(s[i:j])
+		if name == "" {
+			return nil, fmt.Errorf("invalid instance-identifier %q, empty element name at position %d", s, i)
+		}
+		e := &gnmipb.PathElem{Name: util.StripModulePrefix(name)}
+
+		for i = j; i < len(s) && s[i] == '['; {
+			k, v, n, err := parseInstanceIdentifierPredicate(s[i:])
+			if err != nil {
+				return nil, fmt.Errorf("invalid instance-identifier %q: %v", s, err)
+			}
+			if e.Key == nil {
+				e.Key = map[string]string{}
+			}
+			e.Key[util.StripModulePrefix(k)] = v
+			i += n
+		}
+		p.Elem = append(p.Elem, e)
+
+		if i < len(s) {
+			if s[i] != '/' || i == len(s)-1 {
+				return nil, fmt.Errorf("invalid instance-identifier %q, unexpected character at position %d", s, i)
+			}
+			i++
+		}
+	}
+	return p, nil
+}
+
+// parseInstanceIdentifierPredicate parses the predicate at the start of s,
+// which must be of the form [key='value'] or [key="value"], returning the key
+// name, value, and the length of the predicate.
+func parseInstanceIdentifierPredicate(s string) (string, string, int, error) {
+	eq := strings.IndexAny(s, "=]")
+	if eq == -1 || s[eq] != '=' {
+		return "", "", 0, fmt.Errorf("unsupported predicate in %q, only key predicates are supported", s)
+	}
+	k := strings.TrimSpace(s[1:eq])
+	switch k {
+	case "":
+		return "", "", 0, fmt.Errorf("empty key name in predicate %q", s)
+	case ".":
+		return "", "", 0, fmt.Errorf("unsupported leaf-list predicate in %q", s)
+	}
+
+	i := eq + 1
+	for i < len(s) && s[i] == ' ' {
+		i++
+	}
+	if i == len(s) || s[i] != '\'' && s[i] != '"' {
+		return "", "", 0, fmt.Errorf("unquoted value for key %s in %q", k, s)
+	}
+	end := strings.IndexByte(s[i+1:], s[i])
+	if end == -1 {
+		return "", "", 0, fmt.Errorf("unterminated value for key %s in %q", k, s)
+	}
+	v := s[i+1 : i+1+end]
+
+	i += end + 2
+	for i < len(s) && s[i] == ' ' {
+		i++
+	}
+	if i == len(s) || s[i] != ']' {
+		return "", "", 0, fmt.Errorf("unterminated predicate for key %s in %q", k, s)
+	}
+	return k, v, i + 1, nil
+}
+
+// ComparePaths compares the paths a and b element by element, returning a
+// negative value if a is ordered before b, zero if they are equal, and a
+// positive value otherwise. Elements are compared by their names, and then by
+// their keys, where the values of KeylessListIndexKey keys are compared as
+// integers, such that the entry of a keyless list with index 2 is ordered
+// before that with index 10. A path is ordered before the paths that it is a
+// prefix of.
+func ComparePaths(a, b *gnmipb.Path) int {
+	return comparePaths(a, b, false)
+}
+
+// comparePaths compares the paths a and b element by element, returning a
+// negative value if a is ordered before b, zero if they are equal, and a
+// positive value otherwise. Elements are compared by their names, and then by
+// their keys, where the values of KeylessListIndexKey keys are compared as
+// integers, in descending order if descIndex is true. A path is ordered
+// before the paths that it is a prefix of.
+func comparePaths(a, b *gnmipb.Path, descIndex bool) int {
+	for i := 0; i < len(a.GetElem()) && i < len(b.GetElem()); i++ {
+		ea, eb := a.GetElem()[i], b.GetElem()[i]
+		if c := strings.Compare(ea.GetName(), eb.GetName()); c != 0 {
+			return c
+		}
+		ia, aok := keylessIndex(ea)
+		ib, bok := keylessIndex(eb)
+		if aok && bok {
+			c := ia - ib
+			if descIndex {
+				c = -c
+			}
+			if c != 0 {
+				return c
+			}
+			continue
+		}
+		if c := strings.Compare(keyString(ea.GetKey()), keyString(eb.GetKey())); c != 0 {
+			return c
+		}
+	}
+	return len(a.GetElem()) - len(b.GetElem())
+}
+
+// keylessIndex returns the index of the entry of a keyless list identified by
+// the PathElem e, and true if e identifies a keyless list entry by its index.
+func keylessIndex(e *gnmipb.PathElem) (int, bool) {
+	v, ok := e.GetKey()[KeylessListIndexKey]
+	if !ok || len(e.GetKey()) != 1 {
+		return 0, false
+	}
+	i, err := strconv.Atoi(v)
+	if err != nil {
+		return 0, false
+	}
+	return i, true
+}
+
+// keyString returns a string representation of the keys of a PathElem,
+// ordered by key name.
+func keyString(keys map[string]string) string {
+	names := make([]string, 0, len(keys))
+	for k := range keys {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+	var b strings.Builder
+	for _, k := range names {
+		fmt.Fprintf(&b, "[%s=%s]", k, keys[k])
+	}
+	return b.String()
+}
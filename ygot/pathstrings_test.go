@@ -21,6 +21,7 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/openconfig/gnmi/errdiff"
 	gnmipb "github.com/openconfig/gnmi/proto/gnmi"
+	"github.com/openconfig/goyang/pkg/yang"
 	"google.golang.org/protobuf/encoding/prototext"
 	"google.golang.org/protobuf/proto"
 )
@@ -545,3 +546,376 @@ func TestPathToSchemaPath(t *testing.T) {
 		}
 	}
 }
+
+// instanceIDTestModules are the YANG modules used to test conversion between
+// gNMI paths and RESTCONF and instance-identifier strings.
+var instanceIDTestModules = map[string]string{
+	"base": `
+module base {
+  prefix "b";
+  namespace "urn:base";
+
+  container interfaces {
+    list interface {
+      key "name unit";
+      leaf name { type string; }
+      leaf unit { type uint32; }
+      leaf description { type string; }
+    }
+  }
+}`,
+	"ext": `
+module ext {
+  prefix "e";
+  namespace "urn:ext";
+
+  import base { prefix b; }
+
+  augment "/b:interfaces/b:interface" {
+    container counters {
+      leaf in-pkts { type uint64; }
+    }
+  }
+}`,
+}
+
+// instanceIDTestSchema returns a fake root schema whose children are the
+// top-level nodes of instanceIDTestModules.
+func instanceIDTestSchema(t *testing.T) *yang.Entry {
+	t.Helper()
+	ms := yang.NewModules()
+	for n, src := range instanceIDTestModules {
+		if err := ms.Parse(src, n+".yang"); err != nil {
+			t.Fatalf("cannot parse module %s: %v", n, err)
+		}
+	}
+	if errs := ms.Process(); len(errs) != 0 {
+		t.Fatalf("cannot process modules: %v", errs)
+	}
+	m, err := ms.GetModule("base")
+	if err != nil {
+		t.Fatalf("cannot get module base: %v", err)
+	}
+	root := &yang.Entry{
+		Name: "device",
+		Kind: yang.DirectoryEntry,
+		Annotation: map[string]interface{}{
+			"isFakeRoot": true,
+		},
+		Dir: map[string]*yang.Entry{},
+	}
+	for n, e := range m.Dir {
+		root.Dir[n] = e
+	}
+	return root
+}
+
+// TestPathToRESTCONF tests conversion of gNMI paths to RESTCONF data resource
+// identifiers and back.
+func TestPathToRESTCONF(t *testing.T) {
+	schema := instanceIDTestSchema(t)
+
+	tests := []struct {
+		name             string
+		in               *gnmipb.Path
+		want             string
+		wantErrSubstring string
+	}{{
+		name: "empty path",
+		in:   &gnmipb.Path{},
+		want: "",
+	}, {
+		name: "container",
+		in:   mustPath("/interfaces"),
+		want: "/base:interfaces",
+	}, {
+		name: "list entry with keys in schema order",
+		in:   mustPath("/interfaces/interface[unit=0][name=eth0]/description"),
+		want: "/base:interfaces/interface=eth0,0/description",
+	}, {
+		name: "percent-encoded key values",
+		in:   mustPath("/interfaces/interface[name=eth0/1,a b][unit=1]"),
+		want: "/base:interfaces/interface=eth0%2F1%2Ca%20b,1",
+	}, {
+		name: "augmented node is module qualified",
+		in:   mustPath("/interfaces/interface[name=eth0][unit=0]/counters/in-pkts"),
+		want: "/base:interfaces/interface=eth0,0/ext:counters/in-pkts",
+	}, {
+		name:             "missing key",
+		in:               mustPath("/interfaces/interface[name=eth0]"),
+		wantErrSubstring: "invalid keys",
+	}, {
+		name:             "keys on a container",
+		in:               mustPath("/interfaces[name=eth0]"),
+		wantErrSubstring: "not a keyed list",
+	}, {
+		name:             "unknown element",
+		in:               mustPath("/interfaces/bogus"),
+		wantErrSubstring: "cannot find schema for bogus",
+	}, {
+		name:             "nil path",
+		wantErrSubstring: "nil path",
+	}}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := PathToRESTCONF(tt.in, schema)
+			if diff := errdiff.Substring(err, tt.wantErrSubstring); diff != "" {
+				t.Fatalf("PathToRESTCONF(%v): did not get expected error, %s", tt.in, diff)
+			}
+			if err != nil {
+				return
+			}
+			if got != tt.want {
+				t.Errorf("PathToRESTCONF(%v): did not get expected identifier, got: %q, want: %q", tt.in, got, tt.want)
+			}
+
+			if got == "" {
+				return
+			}
+			rt, err := RESTCONFToPath(got, schema)
+			if err != nil {
+				t.Fatalf("RESTCONFToPath(%q): got unexpected error: %v", got, err)
+			}
+			if !proto.Equal(rt, tt.in) {
+				t.Errorf("RESTCONFToPath(%q): did not get expected path, got: %v, want: %v", got, rt, tt.in)
+			}
+		})
+	}
+}
+
+// TestRESTCONFToPath tests parsing of RESTCONF data resource identifiers.
+func TestRESTCONFToPath(t *testing.T) {
+	schema := instanceIDTestSchema(t)
+
+	tests := []struct {
+		name             string
+		in               string
+		want             *gnmipb.Path
+		wantErrSubstring string
+	}{{
+		name: "root",
+		in:   "/",
+		want: &gnmipb.Path{},
+	}, {
+		name: "unprefixed names",
+		in:   "/interfaces/interface=eth0,1/counters",
+		want: mustPath("/interfaces/interface[name=eth0][unit=1]/counters"),
+	}, {
+		name: "empty key value",
+		in:   "/base:interfaces/interface=,1",
+		want: &gnmipb.Path{Elem: []*gnmipb.PathElem{
+			{Name: "interfaces"},
+			{Name: "interface", Key: map[string]string{"name": "", "unit": "1"}},
+		}},
+	}, {
+		name:             "wrong module",
+		in:               "/ext:interfaces",
+		wantErrSubstring: "invalid module ext",
+	}, {
+		name:             "wrong number of keys",
+		in:               "/interfaces/interface=eth0",
+		wantErrSubstring: "invalid keys",
+	}, {
+		name:             "relative identifier",
+		in:               "interfaces",
+		wantErrSubstring: "must begin with /",
+	}, {
+		name:             "query",
+		in:               "/interfaces?depth=1",
+		wantErrSubstring: "query and fragment",
+	}, {
+		name:             "invalid escape",
+		in:               "/interfaces/interface=eth%zz,1",
+		wantErrSubstring: "invalid key value",
+	}, {
+		name:             "empty element",
+		in:               "/interfaces//interface",
+		wantErrSubstring: "empty element name",
+	}}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := RESTCONFToPath(tt.in, schema)
+			if diff := errdiff.Substring(err, tt.wantErrSubstring); diff != "" {
+				t.Fatalf("RESTCONFToPath(%q): did not get expected error, %s", tt.in, diff)
+			}
+			if !proto.Equal(got, tt.want) {
+				t.Errorf("RESTCONFToPath(%q): did not get expected path, got: %v, want: %v", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+// TestPathToInstanceIdentifier tests conversion of gNMI paths to RFC7951
+// instance-identifiers and back.
+func TestPathToInstanceIdentifier(t *testing.T) {
+	schema := instanceIDTestSchema(t)
+
+	tests := []struct {
+		name             string
+		in               *gnmipb.Path
+		want             string
+		wantErrSubstring string
+	}{{
+		name: "leaf within list entry",
+		in:   mustPath("/interfaces/interface[unit=0][name=eth0]/description"),
+		want: "/base:interfaces/interface[name='eth0'][unit='0']/description",
+	}, {
+		name: "augmented node",
+		in:   mustPath("/interfaces/interface[name=eth0][unit=0]/counters"),
+		want: "/base:interfaces/interface[name='eth0'][unit='0']/ext:counters",
+	}, {
+		name: "value containing single quote",
+		in: &gnmipb.Path{Elem: []*gnmipb.PathElem{
+			{Name: "interfaces"},
+			{Name: "interface", Key: map[string]string{"name": "it's", "unit": "1"}},
+		}},
+		want: `/base:interfaces/interface[name="it's"][unit='1']`,
+	}, {
+		name: "value containing both quotes",
+		in: &gnmipb.Path{Elem: []*gnmipb.PathElem{
+			{Name: "interfaces"},
+			{Name: "interface", Key: map[string]string{"name": `it's "x"`, "unit": "1"}},
+		}},
+		wantErrSubstring: "cannot quote value",
+	}, {
+		name:             "missing key",
+		in:               mustPath("/interfaces/interface[unit=0]"),
+		wantErrSubstring: "invalid keys",
+	}}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := PathToInstanceIdentifier(tt.in, schema)
+			if diff := errdiff.Substring(err, tt.wantErrSubstring); diff != "" {
+				t.Fatalf("PathToInstanceIdentifier(%v): did not get expected error, %s", tt.in, diff)
+			}
+			if err != nil {
+				return
+			}
+			if got != tt.want {
+				t.Errorf("PathToInstanceIdentifier(%v): did not get expected identifier, got: %q, want: %q", tt.in, got, tt.want)
+			}
+
+			rt, err := InstanceIdentifierToPath(got)
+			if err != nil {
+				t.Fatalf("InstanceIdentifierToPath(%q): got unexpected error: %v", got, err)
+			}
+			if !proto.Equal(rt, tt.in) {
+				t.Errorf("InstanceIdentifierToPath(%q): did not get expected path, got: %v, want: %v", got, rt, tt.in)
+			}
+		})
+	}
+}
+
+// TestInstanceIdentifierToPath tests parsing of RFC7951 instance-identifiers.
+func TestInstanceIdentifierToPath(t *testing.T) {
+	tests := []struct {
+		name             string
+		in               string
+		want             *gnmipb.Path
+		wantErrSubstring string
+	}{{
+		name: "prefixed keys and double quotes",
+		in:   `/base:interfaces/base:interface[base:name="eth0"][unit = '0']/ext:counters`,
+		want: mustPath("/interfaces/interface[name=eth0][unit=0]/counters"),
+	}, {
+		name: "value containing special characters",
+		in:   `/a/b[k='x/y[z]=w']/c`,
+		want: &gnmipb.Path{Elem: []*gnmipb.PathElem{
+			{Name: "a"},
+			{Name: "b", Key: map[string]string{"k": "x/y[z]=w"}},
+			{Name: "c"},
+		}},
+	}, {
+		name:             "positional predicate",
+		in:               "/a/b[1]",
+		wantErrSubstring: "only key predicates are supported",
+	}, {
+		name:             "leaf-list predicate",
+		in:               "/a/b[.='x']",
+		wantErrSubstring: "unsupported leaf-list predicate",
+	}, {
+		name:             "unquoted value",
+		in:               "/a/b[k=x]",
+		wantErrSubstring: "unquoted value",
+	}, {
+		name:             "unterminated value",
+		in:               "/a/b[k='x]",
+		wantErrSubstring: "unterminated value",
+	}, {
+		name:             "unterminated predicate",
+		in:               "/a/b[k='x'",
+		wantErrSubstring: "unterminated predicate",
+	}, {
+		name:             "trailing slash",
+		in:               "/a/",
+		wantErrSubstring: "unexpected character",
+	}, {
+		name:             "relative",
+		in:               "a/b",
+		wantErrSubstring: "must begin with /",
+	}}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := InstanceIdentifierToPath(tt.in)
+			if diff := errdiff.Substring(err, tt.wantErrSubstring); diff != "" {
+				t.Fatalf("InstanceIdentifierToPath(%q): did not get expected error, %s", tt.in, diff)
+			}
+			if !proto.Equal(got, tt.want) {
+				t.Errorf("InstanceIdentifierToPath(%q): did not get expected path, got: %v, want: %v", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestComparePaths(t *testing.T) {
+	tests := []struct {
+		desc string
+		inA  string
+		inB  string
+		want int
+	}{{
+		desc: "equal paths",
+		inA:  "/a/b[name=x]/c",
+		inB:  "/a/b[name=x]/c",
+		want: 0,
+	}, {
+		desc: "different names",
+		inA:  "/a/b",
+		inB:  "/a/c",
+		want: -1,
+	}, {
+		desc: "prefix",
+		inA:  "/a/b",
+		inB:  "/a",
+		want: 1,
+	}, {
+		desc: "keyless list indices compared as integers",
+		inA:  "/log[index=2]/name",
+		inB:  "/log[index=10]/name",
+		want: -1,
+	}, {
+		desc: "keys compared as strings",
+		inA:  "/list[name=eth2]",
+		inB:  "/list[name=eth10]",
+		want: 1,
+	}}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			got := ComparePaths(mustPath(tt.inA), mustPath(tt.inB))
+			switch {
+			case got < 0:
+				got = -1
+			case got > 0:
+				got = 1
+			}
+			if got != tt.want {
+				t.Errorf("ComparePaths(%s, %s): got %d, want %d", tt.inA, tt.inB, got, tt.want)
+			}
+		})
+	}
+}
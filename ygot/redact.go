@@ -0,0 +1,115 @@
+// Copyright 2021 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ygot
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/openconfig/goyang/pkg/yang"
+	"github.com/openconfig/ygot/util"
+)
+
+// RedactionMode specifies how sensitive data is redacted when a GoStruct
+// is rendered.
+type RedactionMode int64
+
+const (
+	// RedactMask specifies that the value of sensitive data is replaced
+	// with util.RedactedValue.
+	RedactMask RedactionMode = iota
+	// RedactOmit specifies that sensitive data is omitted from the output.
+	RedactOmit
+)
+
+// RedactConfig specifies that sensitive data should be redacted when a
+// GoStruct is rendered. Data is considered to be sensitive if the GoStruct
+// field that stores it, or the field storing any of its ancestors, is tagged
+// with ygotSensitive, or if the schema of any of these nodes has one of the
+// specified extensions.
+type RedactConfig struct {
+	// Mode specifies how sensitive data is redacted.
+	Mode RedactionMode
+	// Schema is the schema of the GoStruct being rendered. If it is nil,
+	// only the struct tags of the GoStruct are used to determine whether
+	// data is sensitive.
+	Schema *yang.Entry
+	// Extensions is the set of YANG extensions that mark a schema node as
+	// sensitive, e.g., "oc-ext:sensitive" or "nacm:default-deny-all". An
+	// extension that is not qualified with a prefix matches the extension
+	// with any prefix.
+	Extensions []string
+}
+
+// IsMarshal7951Arg marks RedactConfig as a valid argument to the Marshal7951
+// function.
+func (*RedactConfig) IsMarshal7951Arg() {}
+
+// dataField identifies a field of a particular GoStruct within a data tree.
+type dataField struct {
+	// parent is the GoStruct that contains the field.
+	parent interface{}
+	// name is the name of the field.
+	name string
+}
+
+// redactor determines whether fields of GoStructs within a data tree are
+// sensitive, and should be redacted when rendered.
+type redactor struct {
+	// mode is the mode used to redact sensitive fields.
+	mode RedactionMode
+	// fields is the set of fields that have schema nodes with a sensitive
+	// extension.
+	fields map[dataField]bool
+}
+
+// newRedactor returns a redactor for the data tree d, according to the
+// supplied RedactConfig. It returns nil if cfg is nil, such that no data is
+// redacted.
+func newRedactor(d interface{}, cfg *RedactConfig) (*redactor, error) {
+	if cfg == nil {
+		return nil, nil
+	}
+
+	r := &redactor{mode: cfg.Mode, fields: map[dataField]bool{}}
+	gs, ok := d.(GoStruct)
+	if cfg.Schema == nil || len(cfg.Extensions) == 0 || !ok || util.IsValueNil(gs) {
+		return r, nil
+	}
+
+	if err := Walk(gs, cfg.Schema, VisitorFuncs{
+		Pre: func(n *WalkNode) error {
+			if n.Parent == nil || !util.HasExtension(n.Schema, cfg.Extensions) {
+				return nil
+			}
+			// The entire field, including all of its descendants, is
+			// redacted.
+			r.fields[dataField{parent: n.Parent.Value, name: n.field}] = true
+			return SkipSubtree
+		},
+	}); err != nil {
+		return nil, fmt.Errorf("cannot determine sensitive fields: %v", err)
+	}
+	return r, nil
+}
+
+// isSensitive returns true if the field f of the GoStruct s should be
+// redacted.
+func (r *redactor) isSensitive(s GoStruct, f reflect.StructField) bool {
+	if r == nil {
+		return false
+	}
+	return util.IsSensitive(f) || r.fields[dataField{parent: s, name: f.Name}]
+}
@@ -0,0 +1,150 @@
+// Copyright 2021 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ygot
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/openconfig/gnmi/errdiff"
+	"github.com/openconfig/goyang/pkg/yang"
+)
+
+// redactTagged is a GoStruct with a field that is tagged as sensitive.
+type redactTagged struct {
+	Name     *string `path:"name"`
+	Password *string `path:"password" ygotSensitive:"true"`
+}
+
+func (*redactTagged) IsYANGGoStruct()                         {}
+func (*redactTagged) ΛValidate(...ValidationOption) error     { return nil }
+func (*redactTagged) ΛEnumTypeMap() map[string][]reflect.Type { return nil }
+func (*redactTagged) ΛBelongingModule() string                { return "" }
+
+// redactSchema returns the schema for the walkRoot GoStruct, with the nodes
+// at the supplied names within the root marked as sensitive.
+func redactSchema(sensitive ...string) *yang.Entry {
+	s := walkSchema()
+	for _, n := range sensitive {
+		s.Dir[n].Exts = []*yang.Statement{{Keyword: "oc-ext:sensitive"}}
+	}
+	return s
+}
+
+func TestMarshal7951Redact(t *testing.T) {
+	tests := []struct {
+		name             string
+		in               interface{}
+		inArgs           []Marshal7951Arg
+		want             string
+		wantErrSubstring string
+	}{{
+		name: "struct tag, masked",
+		in:   &redactTagged{Name: String("foo"), Password: String("hunter2")},
+		inArgs: []Marshal7951Arg{
+			&RedactConfig{},
+		},
+		want: `{"name":"foo","password":"REDACTED"}`,
+	}, {
+		name: "struct tag, omitted",
+		in:   &redactTagged{Name: String("foo"), Password: String("hunter2")},
+		inArgs: []Marshal7951Arg{
+			&RedactConfig{Mode: RedactOmit},
+		},
+		want: `{"name":"foo"}`,
+	}, {
+		name: "struct tag, not redacted",
+		in:   &redactTagged{Name: String("foo"), Password: String("hunter2")},
+		want: `{"name":"foo","password":"hunter2"}`,
+	}, {
+		name: "schema extension on leaf",
+		in:   &walkRoot{Name: String("foo"), LeafList: []string{"a"}},
+		inArgs: []Marshal7951Arg{
+			&RedactConfig{Schema: redactSchema("name"), Extensions: []string{"oc-ext:sensitive"}},
+		},
+		want: `{"leaf-list":["a"],"name":"REDACTED"}`,
+	}, {
+		name: "schema extension on container",
+		in:   &walkRoot{Name: String("foo"), Child: &walkContainer{Val: String("bar")}},
+		inArgs: []Marshal7951Arg{
+			&RedactConfig{Schema: redactSchema("child"), Extensions: []string{"sensitive"}},
+		},
+		want: `{"child":"REDACTED","name":"foo"}`,
+	}, {
+		name: "schema extension on list, omitted",
+		in: &walkRoot{
+			Name: String("foo"),
+			List: map[string]*walkListEntry{"one": {Key: String("one")}},
+		},
+		inArgs: []Marshal7951Arg{
+			&RedactConfig{Mode: RedactOmit, Schema: redactSchema("list"), Extensions: []string{"sensitive"}},
+		},
+		want: `{"name":"foo"}`,
+	}, {
+		name: "schema extension not configured",
+		in:   &walkRoot{Name: String("foo")},
+		inArgs: []Marshal7951Arg{
+			&RedactConfig{Schema: redactSchema("name"), Extensions: []string{"nacm:default-deny-all"}},
+		},
+		want: `{"name":"foo"}`,
+	}, {
+		name: "invalid schema",
+		in:   &walkRoot{Name: String("foo")},
+		inArgs: []Marshal7951Arg{
+			&RedactConfig{Schema: &yang.Entry{Name: "root", Dir: map[string]*yang.Entry{}}, Extensions: []string{"sensitive"}},
+		},
+		wantErrSubstring: "cannot determine sensitive fields",
+	}}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Marshal7951(tt.in, tt.inArgs...)
+			if diff := errdiff.Substring(err, tt.wantErrSubstring); diff != "" {
+				t.Fatalf("Marshal7951: did not get expected error, %s", diff)
+			}
+			if err != nil {
+				return
+			}
+			if string(got) != tt.want {
+				t.Errorf("Marshal7951: got %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEmitJSONRedact(t *testing.T) {
+	in := &walkRoot{
+		Name:  String("foo"),
+		Child: &walkContainer{Val: String("bar")},
+	}
+	got, err := EmitJSON(in, &EmitJSONConfig{
+		Indent: " ",
+		Redact: &RedactConfig{
+			Schema:     redactSchema("child"),
+			Extensions: []string{"sensitive"},
+		},
+	})
+	if err != nil {
+		t.Fatalf("EmitJSON: got unexpected error: %v", err)
+	}
+
+	want := `{
+ "child": "REDACTED",
+ "name": "foo"
+}`
+	if got != want {
+		t.Errorf("EmitJSON: got:\n%s\nwant:\n%s", got, want)
+	}
+}
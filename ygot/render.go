@@ -20,12 +20,14 @@ import (
 	"fmt"
 	"reflect"
 	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/openconfig/gnmi/errlist"
 	"github.com/openconfig/gnmi/value"
 	"github.com/openconfig/ygot/util"
 	"google.golang.org/protobuf/encoding/prototext"
+	"google.golang.org/protobuf/encoding/protowire"
 	"google.golang.org/protobuf/proto"
 
 	gnmipb "github.com/openconfig/gnmi/proto/gnmi"
@@ -38,6 +40,30 @@ const (
 	// EmptyTypeName is the name of the type that is used for YANG
 	// empty fields in the output structs.
 	EmptyTypeName string = "YANGEmpty"
+	// KeylessListIndexKey is the name of the pseudo key that is used to
+	// identify an entry within a YANG list that does not have any keys when
+	// the KeylessListIndexKeys mode is used. The value of the key is the
+	// zero-based index of the entry within the list.
+	KeylessListIndexKey string = "index"
+)
+
+// KeylessListMode specifies how YANG lists that do not have keys, which are
+// represented as slices of GoStruct pointers in the generated code, are mapped
+// to gNMI paths.
+type KeylessListMode int64
+
+const (
+	// KeylessListUnsupported indicates that keyless lists cannot be mapped
+	// to gNMI paths. It is the default.
+	KeylessListUnsupported KeylessListMode = iota
+	// KeylessListAsJSONIETF indicates that a keyless list is mapped to a
+	// single value at the path of the list itself, containing the entire
+	// list encoded as RFC7951 JSON.
+	KeylessListAsJSONIETF
+	// KeylessListIndexKeys indicates that each entry within a keyless list is
+	// mapped to a path that is keyed by the KeylessListIndexKey pseudo key,
+	// whose value is the index of the entry within the list.
+	KeylessListIndexKeys
 )
 
 var (
@@ -269,6 +295,28 @@ func (g *gnmiPath) isSameType(p *gnmiPath) bool {
 	return g.isStringSlicePath() == p.isStringSlicePath()
 }
 
+// isEqual returns true if the path supplied is of the same type, and has the
+// same elements as the receiver.
+func (g *gnmiPath) isEqual(p *gnmiPath) bool {
+	if !g.isSameType(p) || g.Len() != p.Len() {
+		return false
+	}
+	if g.isStringSlicePath() {
+		for i, e := range g.stringSlicePath {
+			if p.stringSlicePath[i] != e {
+				return false
+			}
+		}
+		return true
+	}
+	for i, e := range g.pathElemPath {
+		if !util.PathElemsEqual(p.pathElemPath[i], e) {
+			return false
+		}
+	}
+	return true
+}
+
 // StripPrefix removes the prefix pfx from the supplied path, and returns the more
 // specific path elements of the path. It returns an error if the paths are invalid,
 // their types are different, or the prefix does not match the path.
@@ -315,28 +363,64 @@ type GNMINotificationsConfig struct {
 	// of PathElem messages. This path format is used by gNMI 0.4.0 and
 	// above. Used if PathElem is set.
 	PathElemPrefix []*gnmipb.PathElem
+	// KeylessListMode specifies how YANG lists without keys are rendered.
+	// By default, an error is returned if such a list is populated.
+	KeylessListMode KeylessListMode
+	// MaxUpdatesPerNotification specifies the maximum number of updates
+	// that are included within a single Notification message. If set to
+	// zero, the number of updates is unbounded.
+	MaxUpdatesPerNotification int
+	// MaxNotificationSize specifies the maximum size, in bytes, of the
+	// wire encoding of each Notification message. If set to zero, the
+	// size of a Notification is unbounded. A single update that exceeds
+	// the size on its own is output in a Notification containing only that
+	// update.
+	MaxNotificationSize int
+	// ListEntryPrefixes specifies that the updates corresponding to each
+	// YANG list entry are output in separate Notification messages, with
+	// the path of the list entry used as the prefix. It can only be used
+	// when UsePathElem is set.
+	ListEntryPrefixes bool
+	// WithDefaults specifies how leaves that have their default value are
+	// output. If it is nil, each leaf that is set is output.
+	WithDefaults *WithDefaultsConfig
+}
+
+// isFragmented returns true if the GNMINotificationsConfig specifies that
+// updates should be split across multiple Notification messages.
+func (c GNMINotificationsConfig) isFragmented() bool {
+	return c.MaxUpdatesPerNotification != 0 || c.MaxNotificationSize != 0 || c.ListEntryPrefixes
 }
 
 // TogNMINotifications takes an input GoStruct and renders it to slice of
 // Notification messages, marked with the specified timestamp. The configuration
-// provided determines the path format utilised, and the prefix to be included
-// in the message if relevant.
+// provided determines the path format utilised, the prefix to be included
+// in the message if relevant, and how updates are split across messages.
 //
 // TODO(robjs): When we have deprecated the string slice paths, then this function
 // can be simplified to remove support for them - including removing the gnmiPath
 // abstraction. It can also be refactored to simply use the findSetleaves function
 // which has a cleaner implementation using the reworked iterfunction util.
 func TogNMINotifications(s GoStruct, ts int64, cfg GNMINotificationsConfig) ([]*gnmipb.Notification, error) {
+	if cfg.isFragmented() {
+		var msgs []*gnmipb.Notification
+		if err := StreamgNMINotifications(s, ts, cfg, func(n *gnmipb.Notification) error {
+			msgs = append(msgs, n)
+			return nil
+		}); err != nil {
+			return nil, err
+		}
+		return msgs, nil
+	}
 
-	var pfx *gnmiPath
-	if cfg.UsePathElem {
-		pfx = newPathElemGNMIPath(cfg.PathElemPrefix)
-	} else {
-		pfx = newStringSliceGNMIPath(cfg.StringSlicePrefix)
+	pfx := notificationsConfigPrefix(cfg)
+	df, err := newDefaulter(s, cfg.WithDefaults)
+	if err != nil {
+		return nil, err
 	}
 
 	leaves := map[*path]interface{}{}
-	if err := findUpdatedLeaves(leaves, s, pfx); err != nil {
+	if err := findUpdatedLeaves(leaves, s, pfx, cfg.KeylessListMode, df); err != nil {
 		return nil, err
 	}
 
@@ -348,14 +432,233 @@ func TogNMINotifications(s GoStruct, ts int64, cfg GNMINotificationsConfig) ([]*
 	return msgs, nil
 }
 
+// StreamgNMINotifications takes an input GoStruct and renders it to a series
+// of Notification messages, marked with the specified timestamp, each of which
+// is handed to the supplied function as soon as it is complete. Unlike
+// TogNMINotifications, the set of updates for the entire GoStruct is never
+// held in memory, such that large GoStructs can be rendered to Notifications
+// that are within the size limits specified by the supplied configuration.
+// Where list entry prefixes are used, the Notification containing the leaves
+// of a list entry, or of the root, is completed only once all of its nested
+// list entries have been output, such that leaves that follow a nested list
+// are included within it. If the supplied function returns an error, the
+// GoStruct is not rendered any further, and the error is returned.
+func StreamgNMINotifications(s GoStruct, ts int64, cfg GNMINotificationsConfig, fn func(*gnmipb.Notification) error) error {
+	switch {
+	case cfg.ListEntryPrefixes && !cfg.UsePathElem:
+		return errors.New("list entry prefixes can only be used with PathElem paths")
+	case cfg.MaxUpdatesPerNotification < 0:
+		return fmt.Errorf("invalid maximum number of updates per notification %d", cfg.MaxUpdatesPerNotification)
+	case cfg.MaxNotificationSize < 0:
+		return fmt.Errorf("invalid maximum notification size %d", cfg.MaxNotificationSize)
+	}
+	df, err := newDefaulter(s, cfg.WithDefaults)
+	if err != nil {
+		return err
+	}
+
+	b := &notificationBuilder{
+		cfg: cfg,
+		ts:  ts,
+		pfx: notificationsConfigPrefix(cfg),
+		fn:  fn,
+	}
+
+	var errs errlist.List
+	if err := forEachUpdatedLeaf(s, b.pfx, cfg.KeylessListMode, df, b.add); err != errStopWalk {
+		errs.Add(err)
+	}
+	errs.Add(b.flush(0))
+	if b.sendErr != nil {
+		return b.sendErr
+	}
+	return errs.Err()
+}
+
+// errStopWalk is returned by the function called for each leaf by
+// forEachUpdatedLeaf to stop the walk, after which forEachUpdatedLeaf returns
+// it.
+var errStopWalk = errors.New("walk stopped")
+
+// notificationsConfigPrefix returns the prefix specified by the supplied
+// GNMINotificationsConfig as a gnmiPath.
+func notificationsConfigPrefix(cfg GNMINotificationsConfig) *gnmiPath {
+	if cfg.UsePathElem {
+		return newPathElemGNMIPath(cfg.PathElemPrefix)
+	}
+	return newStringSliceGNMIPath(cfg.StringSlicePrefix)
+}
+
+// notificationBuilder accumulates updates into Notification messages, handing
+// each to fn when it is complete, according to the limits specified in cfg.
+type notificationBuilder struct {
+	// cfg is the configuration that determines how updates are split.
+	cfg GNMINotificationsConfig
+	// ts is the timestamp used for each Notification.
+	ts int64
+	// pfx is the prefix of all paths that are added to the builder.
+	pfx *gnmiPath
+	// fn is called with each completed Notification.
+	fn func(*gnmipb.Notification) error
+
+	// open is the stack of Notifications that are currently being
+	// populated. The prefix of each is a prefix of the prefix of those
+	// above it, such that the Notification of a list entry remains open
+	// whilst the entries of the lists nested within it are output.
+	open []*openNotification
+	// sendErr stores the error returned by fn, after which no further
+	// Notifications are sent.
+	sendErr error
+}
+
+// openNotification is a Notification that is being populated by a
+// notificationBuilder.
+type openNotification struct {
+	// n is the Notification.
+	n *gnmipb.Notification
+	// pfx is the prefix of the Notification.
+	pfx *gnmiPath
+	// size is the size of the wire encoding of n.
+	size int
+}
+
+// add adds the leaf at path p with value v to the builder, completing the
+// current Notification if the update cannot be included within it. It
+// returns errStopWalk if a Notification could not be sent.
+func (b *notificationBuilder) add(p *gnmiPath, v interface{}) error {
+	if b.sendErr != nil {
+		return errStopWalk
+	}
+
+	pfx := b.pfx
+	if b.cfg.ListEntryPrefixes {
+		pfx = listEntryPrefix(p, b.pfx)
+	}
+
+	// The Notifications of list entries that do not contain the leaf are
+	// complete, since the entries of a list are walked one at a time.
+	depth := len(b.open)
+	for depth > 0 && !hasPathPrefix(pfx, b.open[depth-1].pfx) {
+		depth--
+	}
+	if err := b.flush(depth); err != nil {
+		return errStopWalk
+	}
+
+	rel, err := p.StripPrefix(pfx)
+	if err != nil {
+		return err
+	}
+	rp, err := rel.ToProto()
+	if err != nil {
+		return err
+	}
+	val, err := EncodeTypedValue(v, gnmipb.Encoding_JSON)
+	if err != nil {
+		return err
+	}
+	u := &gnmipb.Update{Path: rp, Val: val}
+	// The update is encoded as field 4 of the Notification message.
+	uSize := protowire.SizeTag(4) + protowire.SizeBytes(proto.Size(u))
+
+	if depth > 0 {
+		if cur := b.open[depth-1]; cur.pfx.isEqual(pfx) {
+			switch {
+			case b.cfg.MaxUpdatesPerNotification != 0 && len(cur.n.Update) >= b.cfg.MaxUpdatesPerNotification,
+				b.cfg.MaxNotificationSize != 0 && cur.size+uSize > b.cfg.MaxNotificationSize:
+				if err := b.flush(depth - 1); err != nil {
+					return errStopWalk
+				}
+			}
+		}
+	}
+
+	if len(b.open) == 0 || !b.open[len(b.open)-1].pfx.isEqual(pfx) {
+		pp, err := pfx.ToProto()
+		if err != nil {
+			return err
+		}
+		n := &gnmipb.Notification{Timestamp: b.ts, Prefix: pp}
+		b.open = append(b.open, &openNotification{n: n, pfx: pfx, size: proto.Size(n)})
+	}
+	cur := b.open[len(b.open)-1]
+	cur.n.Update = append(cur.n.Update, u)
+	cur.size += uSize
+	return nil
+}
+
+// flush hands the open Notifications above the supplied depth of the stack to
+// the builder's function, starting with the most specific.
+func (b *notificationBuilder) flush(depth int) error {
+	for len(b.open) > depth {
+		n := b.open[len(b.open)-1].n
+		b.open = b.open[:len(b.open)-1]
+		if b.sendErr != nil {
+			continue
+		}
+		if err := b.fn(n); err != nil {
+			b.sendErr = err
+		}
+	}
+	return b.sendErr
+}
+
+// hasPathPrefix returns true if pfx is a prefix of, or is equal to, the path
+// p.
+func hasPathPrefix(p, pfx *gnmiPath) bool {
+	if pfx.Len() > p.Len() {
+		return false
+	}
+	_, err := p.StripPrefix(pfx)
+	return err == nil
+}
+
+// listEntryPrefix returns the path of the most specific YANG list entry that
+// contains the supplied path p, which must be a PathElem path. If p is not
+// within a list entry, or the list entry is not more specific than pfx, pfx
+// is returned.
+func listEntryPrefix(p, pfx *gnmiPath) *gnmiPath {
+	// The leaf itself is never used as a prefix.
+	for i := p.Len() - 2; i >= pfx.Len(); i-- {
+		if len(p.pathElemPath[i].GetKey()) != 0 {
+			return newPathElemGNMIPath(p.pathElemPath[:i+1])
+		}
+	}
+	return pfx
+}
+
 // findUpdatedLeaves appends the valid leaves that are within the supplied
 // GoStruct (assumed to the rooted at parentPath) to the supplied leaves map.
 // If errors are encountered they are appended to the errlist.List supplied. If
 // the GoStruct contains fields that are themselves structured objects (YANG
 // lists, or containers - represented as maps or struct pointers), the function
-// is called recursively on them.
-func findUpdatedLeaves(leaves map[*path]interface{}, s GoStruct, parent *gnmiPath) error {
+// is called recursively on them. The keylessMode determines how lists without
+// keys are mapped to paths, and the defaulter d determines how leaves that
+// have their default value are output.
+func findUpdatedLeaves(leaves map[*path]interface{}, s GoStruct, parent *gnmiPath, keylessMode KeylessListMode, d *defaulter) error {
+	return forEachUpdatedLeaf(s, parent, keylessMode, d, func(p *gnmiPath, v interface{}) error {
+		leaves[&path{p}] = v
+		return nil
+	})
+}
+
+// forEachUpdatedLeaf calls fn for each of the valid leaves that are within
+// the supplied GoStruct (assumed to be rooted at parent), with the path and
+// value of the leaf. Errors returned by fn, or encountered during the walk,
+// are accumulated and returned. YANG lists and containers within the GoStruct
+// are walked recursively. If the defaulter d is non-nil, it determines how
+// leaves that have their default value are output. If fn returns
+// errStopWalk, the walk is stopped and errStopWalk is returned.
+func forEachUpdatedLeaf(s GoStruct, parent *gnmiPath, keylessMode KeylessListMode, d *defaulter, fn func(*gnmiPath, interface{}) error) error {
 	var errs errlist.List
+	var stopped bool
+	add := func(err error) {
+		if err == errStopWalk {
+			stopped = true
+			return
+		}
+		errs.Add(err)
+	}
 
 	if !parent.isValid() {
 		return fmt.Errorf("invalid parent specified: %v", parent)
@@ -366,13 +669,27 @@ func findUpdatedLeaves(leaves map[*path]interface{}, s GoStruct, parent *gnmiPat
 		errs.Add(fmt.Errorf("input struct for %v was not valid", parent))
 		return errs.Err()
 	}
-	sval = sval.Elem()
 
+	// The generated methods only write the fields that are set, so cannot
+	// be used when unset leaves may be output with their default value.
+	if m, ok := s.(NotificationsMarshaler); ok && d == nil {
+		w := &NotificationWriter{parent: parent, keylessMode: keylessMode, fn: fn}
+		m.ToNotifications(w)
+		if w.stopped {
+			return errStopWalk
+		}
+		return w.errs.Err()
+	}
+
+	sval = sval.Elem()
 	stype := sval.Type()
 
-	for i := 0; i < sval.NumField(); i++ {
-		fval := sval.Field(i)
+	for i := 0; i < sval.NumField() && !stopped; i++ {
 		ftype := stype.Field(i)
+		fval, _ := d.field(s, ftype.Name, sval.Field(i))
+		if !fval.IsValid() {
+			continue
+		}
 
 		// Handle nil values, and enumerations specifically.
 		switch fval.Kind() {
@@ -392,6 +709,9 @@ func findUpdatedLeaves(leaves map[*path]interface{}, s GoStruct, parent *gnmiPat
 		case reflect.Map:
 			// We need to map each child along with its key value.
 			for _, k := range fval.MapKeys() {
+				if stopped {
+					break
+				}
 				childPath, err := mapValuePath(k, fval.MapIndex(k), mapPaths[0])
 				if err != nil {
 					errs.Add(err)
@@ -403,7 +723,7 @@ func findUpdatedLeaves(leaves map[*path]interface{}, s GoStruct, parent *gnmiPat
 					errs.Add(fmt.Errorf("%v: was not a valid GoStruct", mapPaths[0]))
 					continue
 				}
-				errs.Add(findUpdatedLeaves(leaves, goStruct, childPath))
+				add(forEachUpdatedLeaf(goStruct, childPath, keylessMode, d, fn))
 			}
 		case reflect.Ptr:
 			// Determine whether this is a pointer to a struct (another YANG container), or a leaf.
@@ -414,22 +734,45 @@ func findUpdatedLeaves(leaves map[*path]interface{}, s GoStruct, parent *gnmiPat
 					errs.Add(fmt.Errorf("%v: was not a valid GoStruct", mapPaths[0]))
 					continue
 				}
-				errs.Add(findUpdatedLeaves(leaves, goStruct, mapPaths[0]))
+				add(forEachUpdatedLeaf(goStruct, mapPaths[0], keylessMode, d, fn))
 			default:
 				for _, p := range mapPaths {
-					leaves[&path{p}] = fval.Interface()
+					add(fn(p, fval.Interface()))
 				}
 			}
 		case reflect.Slice:
 			if fval.Type().Elem().Kind() == reflect.Ptr {
-				// This is a keyless list - currently unsupported for mapping since there is
-				// not an explicit path that can be used.
-				errs.Add(fmt.Errorf("unimplemented: keyless list cannot be output: %v", mapPaths[0]))
+				// This is a keyless list - since there is not an explicit path that
+				// can be used for each entry, it is mapped according to the mode
+				// requested by the caller.
+				switch keylessMode {
+				case KeylessListAsJSONIETF:
+					for _, p := range mapPaths {
+						add(fn(p, fval.Interface()))
+					}
+				case KeylessListIndexKeys:
+					for i := 0; i < fval.Len() && !stopped; i++ {
+						childPath, err := keylessListEntryPath(i, mapPaths[0])
+						if err != nil {
+							errs.Add(err)
+							continue
+						}
+
+						goStruct, ok := fval.Index(i).Interface().(GoStruct)
+						if !ok {
+							errs.Add(fmt.Errorf("%v: was not a valid GoStruct", mapPaths[0]))
+							continue
+						}
+						add(forEachUpdatedLeaf(goStruct, childPath, keylessMode, d, fn))
+					}
+				default:
+					errs.Add(fmt.Errorf("unimplemented: keyless list cannot be output: %v", mapPaths[0]))
+				}
 				continue
 			}
 			// This is a leaf-list, so add it as though it were a leaf.
 			for _, p := range mapPaths {
-				leaves[&path{p}] = fval.Interface()
+				add(fn(p, fval.Interface()))
 			}
 		case reflect.Int64:
 			name, set, err := enumFieldToString(fval, false)
@@ -444,17 +787,20 @@ func findUpdatedLeaves(leaves map[*path]interface{}, s GoStruct, parent *gnmiPat
 			}
 
 			for _, p := range mapPaths {
-				leaves[&path{p}] = name
+				add(fn(p, name))
 			}
 			continue
 		case reflect.Interface:
 			// This is a union value.
 			for _, p := range mapPaths {
-				leaves[&path{p}] = fval.Interface()
+				add(fn(p, fval.Interface()))
 			}
 			continue
 		}
 	}
+	if stopped {
+		return errStopWalk
+	}
 	return errs.Err()
 }
 
@@ -487,6 +833,41 @@ func mapValuePath(key, value reflect.Value, parentPath *gnmiPath) (*gnmiPath, er
 	return appendgNMIPathElemKey(value, childPath)
 }
 
+// keylessListEntryPath calculates the gNMI Path of the entry at index i of a
+// keyless list whose path is parentPath. For PathElem paths, the index is
+// specified using the KeylessListIndexKey pseudo key, whilst it is appended
+// as an additional element for string slice paths.
+func keylessListEntryPath(i int, parentPath *gnmiPath) (*gnmiPath, error) {
+	if parentPath == nil || !parentPath.isValid() {
+		return nil, fmt.Errorf("invalid path supplied for keyless list entry %d: %v", i, parentPath)
+	}
+
+	idx := strconv.Itoa(i)
+	if parentPath.isStringSlicePath() {
+		childPath := newStringSliceGNMIPath(append([]string{}, parentPath.stringSlicePath...))
+		if err := childPath.AppendName(idx); err != nil {
+			return nil, err
+		}
+		return childPath, nil
+	}
+
+	if parentPath.Len() == 0 {
+		return nil, fmt.Errorf("invalid path element path length, can't append index to 0 length path: %v", parentPath.pathElemPath)
+	}
+
+	childPath := parentPath.Copy()
+	e, err := childPath.LastPathElem()
+	if err != nil {
+		return nil, err
+	}
+	newElem := proto.Clone(e).(*gnmipb.PathElem)
+	newElem.Key = map[string]string{KeylessListIndexKey: idx}
+	if err := childPath.SetIndex(childPath.Len()-1, newElem); err != nil {
+		return nil, err
+	}
+	return childPath, nil
+}
+
 // appendgNMIPathElemKey takes an input reflect.Value which must implement KeyHelperGoStruct
 // and appends the keys from it to the last entry in the supplied mapPath, which must be a
 // gNMI PathElem message.
@@ -625,10 +1006,8 @@ func sliceToScalarArray(v []interface{}) (*gnmipb.ScalarArray, error) {
 // leavesToNotifications takes an input map of leaves, and outputs a slice of
 // notifications that corresponds to the leaf update, the supplied timestamp is
 // used in the set of notifications. If an error is encountered it is returned.
-// TODO(robjs): Currently, we return only a single Notification, but this is
-// likely to be suboptimal since it results in very large Notifications for particular
-// structs. There should be some fragmentation of Updates across Notification messages
-// in a future implementation. We return a slice to keep the API stable.
+// A single Notification is returned; where updates should be fragmented across
+// multiple Notification messages the notificationBuilder is used instead.
 func leavesToNotifications(leaves map[*path]interface{}, ts int64, pfx *gnmiPath) ([]*gnmipb.Notification, error) {
 	n := &gnmipb.Notification{
 		Timestamp: ts,
@@ -666,7 +1045,9 @@ func leavesToNotifications(leaves map[*path]interface{}, ts int64, pfx *gnmiPath
 }
 
 // EncodeTypedValue encodes val into a gNMI TypedValue message, using the specified encoding
-// type if the value is a struct.
+// type if the value is a struct. Keyless lists, which are represented as slices of
+// GoStructs, are always encoded as RFC7951 JSON, since this is the only standard
+// representation of such lists.
 func EncodeTypedValue(val interface{}, enc gnmipb.Encoding) (*gnmipb.TypedValue, error) {
 	switch v := val.(type) {
 	case GoStruct:
@@ -691,6 +1072,8 @@ func EncodeTypedValue(val interface{}, enc gnmipb.Encoding) (*gnmipb.TypedValue,
 		return &gnmipb.TypedValue{Value: &gnmipb.TypedValue_BytesVal{vv.Bytes()}}, nil
 	case vv.Type().Name() == EmptyTypeName:
 		return &gnmipb.TypedValue{Value: &gnmipb.TypedValue_BoolVal{vv.Bool()}}, nil
+	case vv.Kind() == reflect.Slice && util.IsTypeStructPtr(vv.Type().Elem()):
+		return marshalKeylessList(vv)
 	case vv.Kind() == reflect.Slice:
 		sval, err := leaflistToSlice(vv, false)
 		if err != nil {
@@ -770,6 +1153,27 @@ func marshalStruct(s GoStruct, enc gnmipb.Encoding) (*gnmipb.TypedValue, error)
 	return encfn(string(js)), nil
 }
 
+// marshalKeylessList encodes the supplied keyless list, which must be a slice
+// of GoStruct pointers, as an RFC7951 JSON array. It is returned as a TypedValue
+// gNMI message.
+func marshalKeylessList(v reflect.Value) (*gnmipb.TypedValue, error) {
+	j, err := jsonSlice(v, "", jsonOutputConfig{
+		jType: RFC7951,
+		// We always prepend the module name when marshalling within a Notification.
+		rfc7951Config: &RFC7951JSONConfig{AppendModuleName: true},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	js, err := json.MarshalIndent(j, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("cannot encode JSON, %v", err)
+	}
+
+	return &gnmipb.TypedValue{Value: &gnmipb.TypedValue_JsonIetfVal{js}}, nil
+}
+
 // leaflistToSlice takes a reflect.Value that represents a leaf list in the YANG schema
 // (GoStruct) and outputs a slice of interface{} that corresponds to its contents that
 // should be used within a Notification. If prependModuleNameIref is set to true, then
@@ -940,6 +1344,18 @@ type RFC7951JSONConfig struct {
 	// is to be rewritten FROM, and the value of the map is the name of the module
 	// it is to be rewritten TO.
 	RewriteModuleNames map[string]string
+	// WithDefaults specifies how leaves that have their default value are
+	// rendered. If it is nil, each leaf that is set is rendered.
+	WithDefaults *WithDefaultsConfig
+}
+
+// withDefaultsConfig returns the WithDefaultsConfig specified by c, or nil if
+// c is nil.
+func (c *RFC7951JSONConfig) withDefaultsConfig() *WithDefaultsConfig {
+	if c == nil {
+		return nil
+	}
+	return c.WithDefaults
 }
 
 // IsMarshal7951Arg marks the RFC7951JSONConfig struct as a valid argument to
@@ -951,9 +1367,14 @@ func (*RFC7951JSONConfig) IsMarshal7951Arg() {}
 // to JSON described by RFC7951. The supplied args control options corresponding
 // to the method by which JSON is marshalled.
 func ConstructIETFJSON(s GoStruct, args *RFC7951JSONConfig) (map[string]interface{}, error) {
+	df, err := newDefaulter(s, args.withDefaultsConfig())
+	if err != nil {
+		return nil, err
+	}
 	return structJSON(s, "", jsonOutputConfig{
 		jType:         RFC7951,
 		rfc7951Config: args,
+		defaulter:     df,
 	})
 }
 
@@ -989,20 +1410,33 @@ func (JSONIndent) IsMarshal7951Arg() {}
 // The rendered JSON is returned as a byte slice - in common with json.Marshal.
 func Marshal7951(d interface{}, args ...Marshal7951Arg) ([]byte, error) {
 	var (
-		rfcCfg *RFC7951JSONConfig
-		indent string
+		rfcCfg    *RFC7951JSONConfig
+		redactCfg *RedactConfig
+		indent    string
 	)
 	for _, a := range args {
 		switch v := a.(type) {
 		case *RFC7951JSONConfig:
 			rfcCfg = v
+		case *RedactConfig:
+			redactCfg = v
 		case JSONIndent:
 			indent = string(v)
 		}
 	}
+	r, err := newRedactor(d, redactCfg)
+	if err != nil {
+		return nil, err
+	}
+	df, err := newDefaulter(d, rfcCfg.withDefaultsConfig())
+	if err != nil {
+		return nil, err
+	}
 	j, err := jsonValue(reflect.ValueOf(d), "", jsonOutputConfig{
 		jType:         RFC7951,
 		rfc7951Config: rfcCfg,
+		redactor:      r,
+		defaulter:     df,
 	})
 
 	if err != nil {
@@ -1033,6 +1467,12 @@ type jsonOutputConfig struct {
 	// rfc7951Config stores the configuration to be used when outputting RFC7951
 	// JSON.
 	rfc7951Config *RFC7951JSONConfig
+	// redactor determines the fields that are to be redacted in the output
+	// JSON. If nil, no fields are redacted.
+	redactor *redactor
+	// defaulter determines how leaves that have their default value are
+	// output. If nil, each leaf that is set is output.
+	defaulter *defaulter
 }
 
 // rewriteModName rewrites the module mod according to the specified rewrite rules.
@@ -1058,13 +1498,21 @@ func rewriteModName(mod string, rules map[string]string) string {
 // there are modules to be prepended, it also returns the module to which the
 // field belongs. It will also return an error if it encounters one.
 func prependmodsJSON(fType reflect.StructField, parentMod string, args jsonOutputConfig) ([][]string, string, error) {
-	var prependmods [][]string
-	var chMod string
-
 	mapModules, err := structTagToLibModules(fType, args.rfc7951Config.PreferShadowPath)
 	if err != nil {
 		return nil, "", fmt.Errorf("%s: %v", fType.Name, err)
 	}
+	return modulesToPrepend(fType.Name, mapModules, parentMod, args)
+}
+
+// modulesToPrepend determines the module names to prepend to each path element
+// of the field named fieldName, given the modules that were parsed from its
+// struct tags and the module of its parent. The return values are as per
+// prependmodsJSON.
+func modulesToPrepend(fieldName string, mapModules []*gnmiPath, parentMod string, args jsonOutputConfig) ([][]string, string, error) {
+	var prependmods [][]string
+	var chMod string
+
 	if len(mapModules) == 0 {
 		return nil, "", nil
 	}
@@ -1089,7 +1537,7 @@ func prependmodsJSON(fType reflect.StructField, parentMod string, args jsonOutpu
 			prependmod = append(prependmod, mod)
 		}
 		if chMod != "" && prevMod != chMod {
-			return nil, "", fmt.Errorf("%s: child modules between all paths are not equal: %v", fType.Name, mapModules)
+			return nil, "", fmt.Errorf("%s: child modules between all paths are not equal: %v", fieldName, mapModules)
 		}
 		prependmods = append(prependmods, prependmod)
 		chMod = prevMod
@@ -1105,6 +1553,17 @@ func prependmodsJSON(fType reflect.StructField, parentMod string, args jsonOutpu
 // supplied jsonOutputConfig. Returns an error if the GoStruct cannot be rendered
 // to JSON.
 func structJSON(s GoStruct, parentMod string, args jsonOutputConfig) (map[string]interface{}, error) {
+	// The generated methods only write the fields that are set, so cannot
+	// be used when unset leaves may be output with their default value.
+	if m, ok := s.(RFC7951Marshaler); ok && args.jType == RFC7951 && args.defaulter == nil {
+		w := &RFC7951Writer{s: s, parentMod: parentMod, args: args, out: map[string]interface{}{}}
+		m.MarshalRFC7951(w)
+		if err := w.errs.Err(); err != nil {
+			return nil, err
+		}
+		return w.out, nil
+	}
+
 	var errs errlist.List
 
 	sval := reflect.ValueOf(s).Elem()
@@ -1115,8 +1574,11 @@ func structJSON(s GoStruct, parentMod string, args jsonOutputConfig) (map[string
 	jsonout := map[string]interface{}{}
 
 	for i := 0; i < sval.NumField(); i++ {
-		field := sval.Field(i)
 		fType := stype.Field(i)
+		field, isDefault := args.defaulter.field(s, fType.Name, sval.Field(i))
+		if !field.IsValid() {
+			continue
+		}
 
 		// Module names to prepend to the path in RFC7951 output mode.
 		var prependmods [][]string
@@ -1148,71 +1610,90 @@ func structJSON(s GoStruct, parentMod string, args jsonOutputConfig) (map[string
 			continue
 		}
 
-		if value == nil {
-			continue
-		}
+		setJSONField(jsonout, s, fType, value, isDefault, isFakeRoot, mapPaths, prependmods, args, &errs)
+	}
 
-		if mp, ok := value.(map[string]interface{}); ok && len(mp) == 0 && !util.IsYangPresence(fType) {
-			continue
-		}
+	if errs.Err() != nil {
+		return nil, errs.Err()
+	}
 
-		if isFakeRoot {
-			if v, ok := value.(map[string]interface{}); ok {
-				for mk, mv := range v {
-					jsonout[mk] = mv
-				}
-			} else {
-				errs.Add(fmt.Errorf("empty path specified for non-root entity"))
-			}
-			continue
-		}
+	return jsonout, nil
+}
 
-		if prependmods != nil && len(mapPaths) != len(prependmods) {
-			errs.Add(fmt.Errorf("%s: number of paths and modules in struct tag not the same: (paths: %v, modules: %v)", fType.Name, len(mapPaths), len(prependmods)))
-			continue
+// setJSONField sets the JSON value of the field fType of the GoStruct s
+// within jsonout, at each of the paths specified by mapPaths. If prependmods
+// is non-nil, it specifies the module names that are to be prepended to each
+// path element. isDefault specifies whether the value is the default value of
+// the field. Errors are accumulated in errs.
+func setJSONField(jsonout map[string]interface{}, s GoStruct, fType reflect.StructField, value interface{}, isDefault, isFakeRoot bool, mapPaths []*gnmiPath, prependmods [][]string, args jsonOutputConfig, errs *errlist.List) {
+	if value == nil {
+		return
+	}
+
+	if mp, ok := value.(map[string]interface{}); ok && len(mp) == 0 && !util.IsYangPresence(fType) {
+		return
+	}
+
+	if !isFakeRoot && args.redactor.isSensitive(s, fType) {
+		if args.redactor.mode == RedactOmit {
+			return
 		}
+		value = util.RedactedValue
+	}
 
-		for i, p := range mapPaths {
-			if prependmods != nil && p.Len() != len(prependmods[i]) {
-				errs.Add(fmt.Errorf("number of paths and modules elements not the same: (paths: %v, modules: %v)", p, prependmods[i]))
-				continue
+	if isFakeRoot {
+		if v, ok := value.(map[string]interface{}); ok {
+			for mk, mv := range v {
+				jsonout[mk] = mv
 			}
+		} else {
+			errs.Add(fmt.Errorf("empty path specified for non-root entity"))
+		}
+		return
+	}
 
-			parent := jsonout
-			j := 0
-			for ; j != p.Len()-1; j++ {
-				k, err := p.StringElemAt(j)
-				if err != nil {
-					errs.Add(err)
-					continue
-				}
+	if prependmods != nil && len(mapPaths) != len(prependmods) {
+		errs.Add(fmt.Errorf("%s: number of paths and modules in struct tag not the same: (paths: %v, modules: %v)", fType.Name, len(mapPaths), len(prependmods)))
+		return
+	}
 
-				if prependmods != nil && prependmods[i][j] != "" {
-					k = fmt.Sprintf("%s:%s", prependmods[i][j], k)
-				}
+	for i, p := range mapPaths {
+		if prependmods != nil && p.Len() != len(prependmods[i]) {
+			errs.Add(fmt.Errorf("number of paths and modules elements not the same: (paths: %v, modules: %v)", p, prependmods[i]))
+			continue
+		}
 
-				if _, ok := parent[k]; !ok {
-					parent[k] = map[string]interface{}{}
-				}
-				parent = parent[k].(map[string]interface{})
-			}
-			k, err := p.LastStringElem()
+		parent := jsonout
+		j := 0
+		for ; j != p.Len()-1; j++ {
+			k, err := p.StringElemAt(j)
 			if err != nil {
 				errs.Add(err)
 				continue
 			}
+
 			if prependmods != nil && prependmods[i][j] != "" {
 				k = fmt.Sprintf("%s:%s", prependmods[i][j], k)
 			}
-			parent[k] = value
-		}
-	}
 
-	if errs.Err() != nil {
-		return nil, errs.Err()
+			if _, ok := parent[k]; !ok {
+				parent[k] = map[string]interface{}{}
+			}
+			parent = parent[k].(map[string]interface{})
+		}
+		k, err := p.LastStringElem()
+		if err != nil {
+			errs.Add(err)
+			continue
+		}
+		if prependmods != nil && prependmods[i][j] != "" {
+			k = fmt.Sprintf("%s:%s", prependmods[i][j], k)
+		}
+		parent[k] = value
+		if isDefault && args.defaulter.mode == WithDefaultsReportAllTagged {
+			parent["@"+k] = defaultMetadata(value)
+		}
 	}
-
-	return jsonout, nil
 }
 
 // writeIETFScalarJSON takes an input scalar value, and returns it in the format
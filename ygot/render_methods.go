@@ -0,0 +1,450 @@
+// Copyright 2021 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ygot
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+
+	"github.com/openconfig/gnmi/errlist"
+)
+
+// This file contains the types that are used by generated code to render a
+// GoStruct without needing to reflect over its fields or parse its struct
+// tags at runtime. Generated GoStructs that implement the RFC7951Marshaler
+// or NotificationsMarshaler interfaces are detected by the reflection-based
+// rendering functions, which then call the generated methods rather than
+// walking the struct. The output produced is identical in both cases.
+
+// RFC7951Marshaler is an interface implemented by GoStructs that have generated
+// code to render themselves to RFC7951 JSON. MarshalRFC7951 should call the
+// method of the supplied RFC7951Writer that corresponds to each field of the
+// GoStruct.
+type RFC7951Marshaler interface {
+	MarshalRFC7951(w *RFC7951Writer)
+}
+
+// NotificationsMarshaler is an interface implemented by GoStructs that have
+// generated code to output the leaves that they contain. ToNotifications
+// should call the method of the supplied NotificationWriter that corresponds
+// to each populated field of the GoStruct.
+type NotificationsMarshaler interface {
+	ToNotifications(w *NotificationWriter)
+}
+
+// StructField describes a field of a generated GoStruct. The paths and modules
+// that are specified in the struct tags of the field are parsed when the
+// StructField is created, such that they do not need to be parsed each time
+// the field is rendered.
+type StructField struct {
+	reflect.StructField
+
+	// paths and modules store the relative paths and module names specified
+	// in the field's struct tags, indexed by whether the shadow-path and
+	// shadow-module tags are preferred. pathErrs and moduleErrs store the
+	// errors encountered whilst parsing the corresponding tags.
+	paths      [2][]*gnmiPath
+	pathErrs   [2]error
+	modules    [2][]*gnmiPath
+	moduleErrs [2]error
+}
+
+// NewStructField returns a StructField describing the field with the
+// specified name and struct tag.
+func NewStructField(name string, tag reflect.StructTag) *StructField {
+	f := &StructField{StructField: reflect.StructField{Name: name, Tag: tag}}
+	for i, shadow := range []bool{false, true} {
+		f.paths[i], f.pathErrs[i] = structTagToLibPaths(f.StructField, newStringSliceGNMIPath([]string{}), shadow)
+		f.modules[i], f.moduleErrs[i] = structTagToLibModules(f.StructField, shadow)
+	}
+	return f
+}
+
+// shadowIndex returns the index of the paths and modules of a StructField
+// that should be used based on whether shadow paths are preferred.
+func shadowIndex(preferShadowPath bool) int {
+	if preferShadowPath {
+		return 1
+	}
+	return 0
+}
+
+// childPaths returns the paths of the field when it is a child of parent. It
+// is equivalent to structTagToLibPaths for the field with shadow paths not
+// preferred.
+func (f *StructField) childPaths(parent *gnmiPath) ([]*gnmiPath, error) {
+	if !parent.isValid() {
+		return nil, fmt.Errorf("invalid path format in parentPath (%v, %v)", parent.stringSlicePath == nil, parent.pathElemPath == nil)
+	}
+	if f.pathErrs[0] != nil {
+		return nil, f.pathErrs[0]
+	}
+
+	mapPaths := make([]*gnmiPath, 0, len(f.paths[0]))
+	for _, p := range f.paths[0] {
+		ePath := parent.Copy()
+		for _, e := range p.stringSlicePath {
+			ePath.AppendName(e)
+		}
+		ePath.isAbsolute = p.isAbsolute
+		mapPaths = append(mapPaths, ePath)
+	}
+	return mapPaths, nil
+}
+
+// ListEntry is an entry of a keyed YANG list within a GoStruct, along with
+// the key that it is stored under in the map representing the list.
+type ListEntry struct {
+	// Key is the key of the entry.
+	Key interface{}
+	// Value is the GoStruct representing the entry.
+	Value GoStruct
+}
+
+// RFC7951Writer is used by the generated MarshalRFC7951 method of a GoStruct
+// to render each of its fields to RFC7951 JSON. Errors encountered whilst
+// rendering a field are accumulated, and returned by the caller of
+// MarshalRFC7951.
+type RFC7951Writer struct {
+	// s is the GoStruct being rendered.
+	s GoStruct
+	// parentMod is the module within which s is defined.
+	parentMod string
+	// args is the configuration for the JSON being output.
+	args jsonOutputConfig
+	// out is the JSON output for s.
+	out map[string]interface{}
+	// errs is the set of errors encountered whilst rendering s.
+	errs errlist.List
+}
+
+// field renders the field f of the GoStruct being written. The value function
+// is called with the module that the field belongs to, and returns the JSON
+// value of the field. It is equivalent to the handling of each field in
+// structJSON.
+func (w *RFC7951Writer) field(f *StructField, value func(chMod string) (interface{}, error)) {
+	var prependmods [][]string
+	var chMod string
+	if w.args.rfc7951Config != nil && w.args.rfc7951Config.AppendModuleName {
+		i := shadowIndex(w.args.rfc7951Config.PreferShadowPath)
+		if err := f.moduleErrs[i]; err != nil {
+			w.errs.Add(fmt.Errorf("%s: %v", f.Name, err))
+			return
+		}
+		var err error
+		if prependmods, chMod, err = modulesToPrepend(f.Name, f.modules[i], w.parentMod, w.args); err != nil {
+			w.errs.Add(err)
+			return
+		}
+	}
+
+	i := shadowIndex(w.args.rfc7951Config != nil && w.args.rfc7951Config.PreferShadowPath)
+	if err := f.pathErrs[i]; err != nil {
+		w.errs.Add(fmt.Errorf("%s: %v", f.Name, err))
+		return
+	}
+	mapPaths := f.paths[i]
+
+	isFakeRoot := len(mapPaths) == 1 && mapPaths[0].Len() == 0
+	if isFakeRoot {
+		chMod = w.parentMod
+	}
+
+	v, err := value(chMod)
+	if err != nil {
+		w.errs.Add(err)
+		return
+	}
+	setJSONField(w.out, w.s, f.StructField, v, false, isFakeRoot, mapPaths, prependmods, w.args, &w.errs)
+}
+
+// prependModuleNameIref returns whether identityref values should have the
+// name of their defining module prepended.
+func (w *RFC7951Writer) prependModuleNameIref() bool {
+	return w.args.rfc7951Config != nil && (w.args.rfc7951Config.AppendModuleName || w.args.rfc7951Config.PrependModuleNameIdentityref)
+}
+
+// Leaf writes the field f, which is a leaf with the scalar value v. v must
+// be the value that the field points to, rather than the pointer itself.
+func (w *RFC7951Writer) Leaf(f *StructField, v interface{}) {
+	w.field(f, func(string) (interface{}, error) {
+		switch v := v.(type) {
+		case int64:
+			return strconv.FormatInt(v, 10), nil
+		case uint64:
+			return strconv.FormatUint(v, 10), nil
+		case float64:
+			return strconv.FormatFloat(v, 'g', -1, 64), nil
+		}
+		return writeIETFScalarJSON(v), nil
+	})
+}
+
+// Enum writes the field f, which is an enumerated leaf with the value v. The
+// field is omitted if v is unset.
+func (w *RFC7951Writer) Enum(f *StructField, v GoEnum) {
+	w.field(f, func(string) (interface{}, error) {
+		name, set, err := enumFieldToString(reflect.ValueOf(v), w.prependModuleNameIref())
+		if err != nil || !set {
+			return nil, err
+		}
+		return name, nil
+	})
+}
+
+// Union writes the field f, which is a non-nil union leaf with the value v.
+func (w *RFC7951Writer) Union(f *StructField, v interface{}) {
+	w.field(f, func(chMod string) (interface{}, error) {
+		// The value is handed to jsonValue as an interface, in common with
+		// the union field itself.
+		return jsonValue(reflect.ValueOf(&v).Elem(), chMod, w.args)
+	})
+}
+
+// Empty writes the field f, which is a YANG empty leaf. The field is omitted
+// if v is false.
+func (w *RFC7951Writer) Empty(f *StructField, v bool) {
+	w.field(f, func(string) (interface{}, error) {
+		if !v {
+			return nil, nil
+		}
+		return []interface{}{nil}, nil
+	})
+}
+
+// Binary writes the field f, which is a non-nil binary leaf with the value v.
+func (w *RFC7951Writer) Binary(f *StructField, v []byte) {
+	w.field(f, func(string) (interface{}, error) {
+		return binaryBase64(v), nil
+	})
+}
+
+// LeafList writes the field f, which is a non-nil leaf-list whose value is
+// the slice v.
+func (w *RFC7951Writer) LeafList(f *StructField, v interface{}) {
+	w.field(f, func(chMod string) (interface{}, error) {
+		return jsonSlice(reflect.ValueOf(v), chMod, w.args)
+	})
+}
+
+// Annotations writes the field f, which is a non-nil annotation field with
+// the value v.
+func (w *RFC7951Writer) Annotations(f *StructField, v []Annotation) {
+	w.field(f, func(string) (interface{}, error) {
+		return jsonAnnotationSlice(reflect.ValueOf(v))
+	})
+}
+
+// Container writes the field f, which is a non-nil YANG container whose
+// value is the GoStruct v.
+func (w *RFC7951Writer) Container(f *StructField, v GoStruct) {
+	w.field(f, func(chMod string) (interface{}, error) {
+		return structJSON(v, chMod, w.args)
+	})
+}
+
+// List writes the field f, which is a non-nil keyed YANG list, whose entries
+// are specified by l.
+func (w *RFC7951Writer) List(f *StructField, l []ListEntry) {
+	w.field(f, func(chMod string) (interface{}, error) {
+		return listJSON(l, chMod, w.args)
+	})
+}
+
+// KeylessList writes the field f, which is a non-nil keyless YANG list whose
+// value is the slice v.
+func (w *RFC7951Writer) KeylessList(f *StructField, v interface{}) {
+	w.field(f, func(chMod string) (interface{}, error) {
+		return jsonSlice(reflect.ValueOf(v), chMod, w.args)
+	})
+}
+
+// listJSON returns the RFC7951 JSON representation of the keyed YANG list
+// with the entries l. It is equivalent to mapJSON for RFC7951 output.
+func listJSON(l []ListEntry, parentMod string, args jsonOutputConfig) (interface{}, error) {
+	var errs errlist.List
+	entries := map[string]GoStruct{}
+	var keys []string
+	for _, e := range l {
+		keyval, err := keyValue(reflect.ValueOf(e.Key), false)
+		if err != nil {
+			errs.Add(fmt.Errorf("invalid enumerated key: %v", err))
+			continue
+		}
+		kn := fmt.Sprintf("%v", keyval)
+		keys = append(keys, kn)
+		entries[kn] = e.Value
+	}
+	sort.Strings(keys)
+
+	vals := []interface{}{}
+	if len(keys) == 0 {
+		return vals, nil
+	}
+
+	for _, kn := range keys {
+		val, err := structJSON(entries[kn], parentMod, args)
+		if err != nil {
+			errs.Add(err)
+			continue
+		}
+		vals = append(vals, val)
+	}
+
+	if errs.Err() != nil {
+		return nil, errs.Err()
+	}
+	return vals, nil
+}
+
+// NotificationWriter is used by the generated ToNotifications method of a
+// GoStruct to output the leaves that it contains. Errors encountered whilst
+// writing a field are accumulated, and returned by the caller of
+// ToNotifications.
+type NotificationWriter struct {
+	// parent is the path of the GoStruct being written.
+	parent *gnmiPath
+	// keylessMode specifies how keyless lists are to be output.
+	keylessMode KeylessListMode
+	// fn is called for each leaf that is written.
+	fn func(*gnmiPath, interface{}) error
+	// errs is the set of errors encountered whilst writing the GoStruct.
+	errs errlist.List
+	// stopped is set when fn returns errStopWalk, after which no further
+	// fields are written.
+	stopped bool
+}
+
+// add records the error err returned whilst writing a field.
+func (w *NotificationWriter) add(err error) {
+	if err == errStopWalk {
+		w.stopped = true
+		return
+	}
+	w.errs.Add(err)
+}
+
+// paths returns the paths of the field f, or nil if they cannot be determined.
+func (w *NotificationWriter) paths(f *StructField) []*gnmiPath {
+	if w.stopped {
+		return nil
+	}
+	mapPaths, err := f.childPaths(w.parent)
+	if err != nil {
+		w.errs.Add(fmt.Errorf("%v->%s: %v", w.parent, f.Name, err))
+		return nil
+	}
+	return mapPaths
+}
+
+// Leaf writes the field f, which is a non-nil leaf or leaf-list with the
+// value v. v must be the value of the field itself, i.e., a pointer for
+// scalar leaves.
+func (w *NotificationWriter) Leaf(f *StructField, v interface{}) {
+	for _, p := range w.paths(f) {
+		w.add(w.fn(p, v))
+	}
+}
+
+// Enum writes the field f, which is an enumerated leaf with the value v. The
+// field is omitted if v is unset.
+func (w *NotificationWriter) Enum(f *StructField, v GoEnum) {
+	mapPaths := w.paths(f)
+	if mapPaths == nil {
+		return
+	}
+
+	name, set, err := enumFieldToString(reflect.ValueOf(v), false)
+	if err != nil {
+		w.errs.Add(err)
+		return
+	}
+
+	// Skip if the enum has not been explicitly set in the schema.
+	if !set {
+		return
+	}
+
+	for _, p := range mapPaths {
+		w.add(w.fn(p, name))
+	}
+}
+
+// Container writes the leaves of the field f, which is a non-nil YANG
+// container whose value is the GoStruct v.
+func (w *NotificationWriter) Container(f *StructField, v GoStruct) {
+	mapPaths := w.paths(f)
+	if mapPaths == nil {
+		return
+	}
+	w.add(forEachUpdatedLeaf(v, mapPaths[0], w.keylessMode, nil, w.fn))
+}
+
+// List writes the leaves of the field f, which is a non-nil keyed YANG list
+// whose entries are specified by l.
+func (w *NotificationWriter) List(f *StructField, l []ListEntry) {
+	mapPaths := w.paths(f)
+	if mapPaths == nil {
+		return
+	}
+
+	for _, e := range l {
+		if w.stopped {
+			return
+		}
+		childPath, err := mapValuePath(reflect.ValueOf(e.Key), reflect.ValueOf(e.Value), mapPaths[0])
+		if err != nil {
+			w.errs.Add(err)
+			continue
+		}
+		w.add(forEachUpdatedLeaf(e.Value, childPath, w.keylessMode, nil, w.fn))
+	}
+}
+
+// KeylessList writes the field f, which is a non-nil keyless YANG list whose
+// value is the slice v, according to the keyless list mode of the writer.
+func (w *NotificationWriter) KeylessList(f *StructField, v interface{}) {
+	mapPaths := w.paths(f)
+	if mapPaths == nil {
+		return
+	}
+
+	switch w.keylessMode {
+	case KeylessListAsJSONIETF:
+		for _, p := range mapPaths {
+			w.add(w.fn(p, v))
+		}
+	case KeylessListIndexKeys:
+		fval := reflect.ValueOf(v)
+		for i := 0; i < fval.Len() && !w.stopped; i++ {
+			childPath, err := keylessListEntryPath(i, mapPaths[0])
+			if err != nil {
+				w.errs.Add(err)
+				continue
+			}
+
+			goStruct, ok := fval.Index(i).Interface().(GoStruct)
+			if !ok {
+				w.errs.Add(fmt.Errorf("%v: was not a valid GoStruct", mapPaths[0]))
+				continue
+			}
+			w.add(forEachUpdatedLeaf(goStruct, childPath, w.keylessMode, nil, w.fn))
+		}
+	default:
+		w.errs.Add(fmt.Errorf("unimplemented: keyless list cannot be output: %v", mapPaths[0]))
+	}
+}
@@ -1389,6 +1389,61 @@ func TestTogNMINotifications(t *testing.T) {
 			},
 		},
 		wantErr: true, //unimplemented.
+	}, {
+		name:        "keyless list as JSON_IETF",
+		inTimestamp: 42,
+		inStruct: &renderExample{
+			KeylessList: []*renderExampleList{
+				{String("trillian")},
+			},
+		},
+		inConfig: GNMINotificationsConfig{
+			UsePathElem:     true,
+			KeylessListMode: KeylessListAsJSONIETF,
+		},
+		want: []*gnmipb.Notification{{
+			Timestamp: 42,
+			Update: []*gnmipb.Update{{
+				Path: &gnmipb.Path{Elem: []*gnmipb.PathElem{{Name: "keyless-list"}}},
+				Val: &gnmipb.TypedValue{Value: &gnmipb.TypedValue_JsonIetfVal{[]byte(`[
+  {
+    "state": {
+      "val": "trillian"
+    },
+    "val": "trillian"
+  }
+]`)}},
+			}},
+		}},
+	}, {
+		name:        "keyless list with index keys",
+		inTimestamp: 42,
+		inStruct: &renderExample{
+			KeylessList: []*renderExampleList{
+				{String("trillian")},
+				{String("arthur")},
+			},
+		},
+		inConfig: GNMINotificationsConfig{
+			UsePathElem:     true,
+			KeylessListMode: KeylessListIndexKeys,
+		},
+		want: []*gnmipb.Notification{{
+			Timestamp: 42,
+			Update: []*gnmipb.Update{{
+				Path: &gnmipb.Path{Elem: mustPathElem("keyless-list[index=0]/val")},
+				Val:  &gnmipb.TypedValue{Value: &gnmipb.TypedValue_StringVal{"trillian"}},
+			}, {
+				Path: &gnmipb.Path{Elem: mustPathElem("keyless-list[index=0]/state/val")},
+				Val:  &gnmipb.TypedValue{Value: &gnmipb.TypedValue_StringVal{"trillian"}},
+			}, {
+				Path: &gnmipb.Path{Elem: mustPathElem("keyless-list[index=1]/val")},
+				Val:  &gnmipb.TypedValue{Value: &gnmipb.TypedValue_StringVal{"arthur"}},
+			}, {
+				Path: &gnmipb.Path{Elem: mustPathElem("keyless-list[index=1]/state/val")},
+				Val:  &gnmipb.TypedValue{Value: &gnmipb.TypedValue_StringVal{"arthur"}},
+			}},
+		}},
 	}, {
 		name:        "invalid element in leaf-list",
 		inTimestamp: 42,
@@ -1552,6 +1607,321 @@ func TestTogNMINotifications(t *testing.T) {
 	}
 }
 
+func TestTogNMINotificationsFragmented(t *testing.T) {
+	tests := []struct {
+		name        string
+		inTimestamp int64
+		inStruct    GoStruct
+		inConfig    GNMINotificationsConfig
+		want        []*gnmipb.Notification
+		wantErr     bool
+	}{{
+		name:        "maximum number of updates",
+		inTimestamp: 42,
+		inStruct: &renderExample{
+			Str:      String("hello"),
+			IntVal:   Int32(42),
+			FloatVal: Float32(42.0),
+		},
+		inConfig: GNMINotificationsConfig{
+			UsePathElem:               true,
+			MaxUpdatesPerNotification: 2,
+		},
+		want: []*gnmipb.Notification{{
+			Timestamp: 42,
+			Update: []*gnmipb.Update{{
+				Path: &gnmipb.Path{Elem: []*gnmipb.PathElem{{Name: "str"}}},
+				Val:  &gnmipb.TypedValue{Value: &gnmipb.TypedValue_StringVal{"hello"}},
+			}, {
+				Path: &gnmipb.Path{Elem: []*gnmipb.PathElem{{Name: "int-val"}}},
+				Val:  &gnmipb.TypedValue{Value: &gnmipb.TypedValue_IntVal{42}},
+			}},
+		}, {
+			Timestamp: 42,
+			Update: []*gnmipb.Update{{
+				Path: &gnmipb.Path{Elem: []*gnmipb.PathElem{{Name: "floatval"}}},
+				Val:  &gnmipb.TypedValue{Value: &gnmipb.TypedValue_FloatVal{42.0}},
+			}},
+		}},
+	}, {
+		name:        "maximum size of notification",
+		inTimestamp: 42,
+		inStruct: &renderExample{
+			Str:      String("hello"),
+			IntVal:   Int32(42),
+			FloatVal: Float32(42.0),
+		},
+		inConfig: GNMINotificationsConfig{
+			UsePathElem:         true,
+			MaxNotificationSize: 45,
+		},
+		want: []*gnmipb.Notification{{
+			Timestamp: 42,
+			Update: []*gnmipb.Update{{
+				Path: &gnmipb.Path{Elem: []*gnmipb.PathElem{{Name: "str"}}},
+				Val:  &gnmipb.TypedValue{Value: &gnmipb.TypedValue_StringVal{"hello"}},
+			}, {
+				Path: &gnmipb.Path{Elem: []*gnmipb.PathElem{{Name: "int-val"}}},
+				Val:  &gnmipb.TypedValue{Value: &gnmipb.TypedValue_IntVal{42}},
+			}},
+		}, {
+			Timestamp: 42,
+			Update: []*gnmipb.Update{{
+				Path: &gnmipb.Path{Elem: []*gnmipb.PathElem{{Name: "floatval"}}},
+				Val:  &gnmipb.TypedValue{Value: &gnmipb.TypedValue_FloatVal{42.0}},
+			}},
+		}},
+	}, {
+		name:        "update larger than maximum size of notification",
+		inTimestamp: 42,
+		inStruct: &renderExample{
+			Str:    String("hello"),
+			IntVal: Int32(42),
+		},
+		inConfig: GNMINotificationsConfig{
+			MaxNotificationSize: 1,
+		},
+		want: []*gnmipb.Notification{{
+			Timestamp: 42,
+			Update: []*gnmipb.Update{{
+				Path: &gnmipb.Path{Element: []string{"str"}},
+				Val:  &gnmipb.TypedValue{Value: &gnmipb.TypedValue_StringVal{"hello"}},
+			}},
+		}, {
+			Timestamp: 42,
+			Update: []*gnmipb.Update{{
+				Path: &gnmipb.Path{Element: []string{"int-val"}},
+				Val:  &gnmipb.TypedValue{Value: &gnmipb.TypedValue_IntVal{42}},
+			}},
+		}},
+	}, {
+		name:        "list entry prefixes",
+		inTimestamp: 42,
+		inStruct: &renderExample{
+			Str: String("hello"),
+			List: map[uint32]*renderExampleList{
+				1: {Val: String("one")},
+				2: {Val: String("two")},
+			},
+		},
+		inConfig: GNMINotificationsConfig{
+			UsePathElem:       true,
+			PathElemPrefix:    []*gnmipb.PathElem{{Name: "root"}},
+			ListEntryPrefixes: true,
+		},
+		want: []*gnmipb.Notification{{
+			Timestamp: 42,
+			Prefix:    &gnmipb.Path{Elem: []*gnmipb.PathElem{{Name: "root"}}},
+			Update: []*gnmipb.Update{{
+				Path: &gnmipb.Path{Elem: []*gnmipb.PathElem{{Name: "str"}}},
+				Val:  &gnmipb.TypedValue{Value: &gnmipb.TypedValue_StringVal{"hello"}},
+			}},
+		}, {
+			Timestamp: 42,
+			Prefix: &gnmipb.Path{Elem: []*gnmipb.PathElem{{Name: "root"}, {
+				Name: "list",
+				Key:  map[string]string{"val": "one"},
+			}}},
+			Update: []*gnmipb.Update{{
+				Path: &gnmipb.Path{Elem: []*gnmipb.PathElem{{Name: "val"}}},
+				Val:  &gnmipb.TypedValue{Value: &gnmipb.TypedValue_StringVal{"one"}},
+			}, {
+				Path: &gnmipb.Path{Elem: []*gnmipb.PathElem{{Name: "state"}, {Name: "val"}}},
+				Val:  &gnmipb.TypedValue{Value: &gnmipb.TypedValue_StringVal{"one"}},
+			}},
+		}, {
+			Timestamp: 42,
+			Prefix: &gnmipb.Path{Elem: []*gnmipb.PathElem{{Name: "root"}, {
+				Name: "list",
+				Key:  map[string]string{"val": "two"},
+			}}},
+			Update: []*gnmipb.Update{{
+				Path: &gnmipb.Path{Elem: []*gnmipb.PathElem{{Name: "val"}}},
+				Val:  &gnmipb.TypedValue{Value: &gnmipb.TypedValue_StringVal{"two"}},
+			}, {
+				Path: &gnmipb.Path{Elem: []*gnmipb.PathElem{{Name: "state"}, {Name: "val"}}},
+				Val:  &gnmipb.TypedValue{Value: &gnmipb.TypedValue_StringVal{"two"}},
+			}},
+		}},
+	}, {
+		name:        "list entry prefixes with maximum number of updates",
+		inTimestamp: 42,
+		inStruct: &renderExample{
+			List: map[uint32]*renderExampleList{
+				1: {Val: String("one")},
+			},
+		},
+		inConfig: GNMINotificationsConfig{
+			UsePathElem:               true,
+			ListEntryPrefixes:         true,
+			MaxUpdatesPerNotification: 1,
+		},
+		want: []*gnmipb.Notification{{
+			Timestamp: 42,
+			Prefix: &gnmipb.Path{Elem: []*gnmipb.PathElem{{
+				Name: "list",
+				Key:  map[string]string{"val": "one"},
+			}}},
+			Update: []*gnmipb.Update{{
+				Path: &gnmipb.Path{Elem: []*gnmipb.PathElem{{Name: "val"}}},
+				Val:  &gnmipb.TypedValue{Value: &gnmipb.TypedValue_StringVal{"one"}},
+			}},
+		}, {
+			Timestamp: 42,
+			Prefix: &gnmipb.Path{Elem: []*gnmipb.PathElem{{
+				Name: "list",
+				Key:  map[string]string{"val": "one"},
+			}}},
+			Update: []*gnmipb.Update{{
+				Path: &gnmipb.Path{Elem: []*gnmipb.PathElem{{Name: "state"}, {Name: "val"}}},
+				Val:  &gnmipb.TypedValue{Value: &gnmipb.TypedValue_StringVal{"one"}},
+			}},
+		}},
+	}, {
+		name:        "list entry prefixes with leaves following a nested list",
+		inTimestamp: 42,
+		inStruct: &renderExample{
+			Str: String("hello"),
+			List: map[uint32]*renderExampleList{
+				1: {Val: String("one")},
+			},
+			Binary: Binary{42},
+		},
+		inConfig: GNMINotificationsConfig{
+			UsePathElem:       true,
+			ListEntryPrefixes: true,
+		},
+		want: []*gnmipb.Notification{{
+			Timestamp: 42,
+			Update: []*gnmipb.Update{{
+				Path: &gnmipb.Path{Elem: []*gnmipb.PathElem{{Name: "str"}}},
+				Val:  &gnmipb.TypedValue{Value: &gnmipb.TypedValue_StringVal{"hello"}},
+			}, {
+				Path: &gnmipb.Path{Elem: []*gnmipb.PathElem{{Name: "binary"}}},
+				Val:  &gnmipb.TypedValue{Value: &gnmipb.TypedValue_BytesVal{[]byte{42}}},
+			}},
+		}, {
+			Timestamp: 42,
+			Prefix: &gnmipb.Path{Elem: []*gnmipb.PathElem{{
+				Name: "list",
+				Key:  map[string]string{"val": "one"},
+			}}},
+			Update: []*gnmipb.Update{{
+				Path: &gnmipb.Path{Elem: []*gnmipb.PathElem{{Name: "val"}}},
+				Val:  &gnmipb.TypedValue{Value: &gnmipb.TypedValue_StringVal{"one"}},
+			}, {
+				Path: &gnmipb.Path{Elem: []*gnmipb.PathElem{{Name: "state"}, {Name: "val"}}},
+				Val:  &gnmipb.TypedValue{Value: &gnmipb.TypedValue_StringVal{"one"}},
+			}},
+		}},
+	}, {
+		name:        "negative maximum number of updates",
+		inTimestamp: 42,
+		inStruct:    &renderExample{Str: String("hello")},
+		inConfig: GNMINotificationsConfig{
+			MaxUpdatesPerNotification: -1,
+		},
+		wantErr: true,
+	}, {
+		name:        "negative maximum size of notification",
+		inTimestamp: 42,
+		inStruct:    &renderExample{Str: String("hello")},
+		inConfig: GNMINotificationsConfig{
+			MaxNotificationSize: -1,
+		},
+		wantErr: true,
+	}, {
+		name:        "list entry prefixes with string slice paths",
+		inTimestamp: 42,
+		inStruct:    &renderExample{Str: String("hello")},
+		inConfig: GNMINotificationsConfig{
+			ListEntryPrefixes: true,
+		},
+		wantErr: true,
+	}, {
+		name:        "invalid GoStruct map",
+		inTimestamp: 42,
+		inStruct: &renderExample{
+			InvalidMap: map[string]*invalidGoStruct{
+				"test": {Value: String("test")},
+			},
+		},
+		inConfig: GNMINotificationsConfig{
+			MaxUpdatesPerNotification: 1,
+		},
+		wantErr: true,
+	}}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := TogNMINotifications(tt.inStruct, tt.inTimestamp, tt.inConfig)
+			if err != nil {
+				if !tt.wantErr {
+					t.Errorf("TogNMINotifications(%v, %v, %v): got unexpected error: %v", tt.inStruct, tt.inTimestamp, tt.inConfig, err)
+				}
+				return
+			}
+			if tt.wantErr {
+				t.Fatalf("TogNMINotifications(%v, %v, %v): did not get expected error", tt.inStruct, tt.inTimestamp, tt.inConfig)
+			}
+
+			if len(got) != len(tt.want) || !testutil.NotificationSetEqual(got, tt.want) {
+				diff := cmp.Diff(got, tt.want, protocmp.Transform())
+				t.Errorf("TogNMINotifications(%v, %v, %v): did not get expected Notifications, diff(-got,+want):%s\n", tt.inStruct, tt.inTimestamp, tt.inConfig, diff)
+			}
+
+			for _, n := range got {
+				if max := tt.inConfig.MaxNotificationSize; max != 0 && len(n.Update) > 1 && proto.Size(n) > max {
+					t.Errorf("TogNMINotifications(%v, %v, %v): got Notification of size %d, want <= %d", tt.inStruct, tt.inTimestamp, tt.inConfig, proto.Size(n), max)
+				}
+			}
+		})
+	}
+}
+
+func TestStreamgNMINotificationsError(t *testing.T) {
+	in := &renderExample{
+		Str:    String("hello"),
+		IntVal: Int32(42),
+	}
+	cfg := GNMINotificationsConfig{MaxUpdatesPerNotification: 1}
+
+	var calls int
+	err := StreamgNMINotifications(in, 42, cfg, func(*gnmipb.Notification) error {
+		calls++
+		return fmt.Errorf("stream closed")
+	})
+	if diff := errdiff.Substring(err, "stream closed"); diff != "" {
+		t.Errorf("StreamgNMINotifications(%v, 42, %v): did not get expected error, %s", in, cfg, diff)
+	}
+	if calls != 1 {
+		t.Errorf("StreamgNMINotifications(%v, 42, %v): function called %d times after returning an error, want 1", in, cfg, calls)
+	}
+}
+
+func TestForEachUpdatedLeafStop(t *testing.T) {
+	in := &renderExample{
+		Str:    String("hello"),
+		IntVal: Int32(42),
+		List: map[uint32]*renderExampleList{
+			1: {Val: String("one")},
+			2: {Val: String("two")},
+		},
+	}
+
+	var calls int
+	err := forEachUpdatedLeaf(in, newPathElemGNMIPath(nil), KeylessListUnsupported, nil, func(*gnmiPath, interface{}) error {
+		calls++
+		return errStopWalk
+	})
+	if err != errStopWalk {
+		t.Errorf("forEachUpdatedLeaf: got error %v, want %v", err, errStopWalk)
+	}
+	if calls != 1 {
+		t.Errorf("forEachUpdatedLeaf: function called %d times, want 1", calls)
+	}
+}
+
 // exampleDevice and the following structs are a set of structs used for more
 // complex testing in TestConstructIETFJSON
 type exampleDevice struct {
@@ -3937,6 +4307,24 @@ func TestEncodeTypedValue(t *testing.T) {
 		inVal: Int64(42),
 		inEnc: gnmipb.Encoding_JSON_IETF,
 		want:  &gnmipb.TypedValue{Value: &gnmipb.TypedValue_IntVal{42}},
+	}, {
+		name:  "keyless list",
+		inVal: []*renderExampleList{{Val: String("one")}, {Val: String("two")}},
+		inEnc: gnmipb.Encoding_PROTO,
+		want: &gnmipb.TypedValue{Value: &gnmipb.TypedValue_JsonIetfVal{[]byte(`[
+  {
+    "state": {
+      "val": "one"
+    },
+    "val": "one"
+  },
+  {
+    "state": {
+      "val": "two"
+    },
+    "val": "two"
+  }
+]`)}},
 	}}
 
 	for _, tt := range tests {
@@ -3966,6 +4354,7 @@ func TestFindUpdatedLeaves(t *testing.T) {
 		name             string
 		in               GoStruct
 		inParent         *gnmiPath
+		inKeylessMode    KeylessListMode
 		wantLeaves       map[*path]interface{}
 		wantErrSubstring string
 	}{{
@@ -4031,6 +4420,61 @@ func TestFindUpdatedLeaves(t *testing.T) {
 		},
 		inParent:         &gnmiPath{pathElemPath: []*gnmipb.PathElem{}},
 		wantErrSubstring: "keyless list cannot be output",
+	}, {
+		name: "keyless list as JSON_IETF",
+		in: &renderExample{
+			KeylessList: []*renderExampleList{
+				{Val: String("one")},
+			},
+		},
+		inParent:      &gnmiPath{pathElemPath: []*gnmipb.PathElem{}},
+		inKeylessMode: KeylessListAsJSONIETF,
+		wantLeaves: map[*path]interface{}{
+			{p: &gnmiPath{
+				pathElemPath: mustPathElem("keyless-list"),
+			}}: []*renderExampleList{{Val: String("one")}},
+		},
+	}, {
+		name: "keyless list with index keys",
+		in: &renderExample{
+			KeylessList: []*renderExampleList{
+				{Val: String("one")},
+				{Val: String("two")},
+			},
+		},
+		inParent:      &gnmiPath{pathElemPath: []*gnmipb.PathElem{}},
+		inKeylessMode: KeylessListIndexKeys,
+		wantLeaves: map[*path]interface{}{
+			{p: &gnmiPath{
+				pathElemPath: mustPathElem("keyless-list[index=0]/val"),
+			}}: String("one"),
+			{p: &gnmiPath{
+				pathElemPath: mustPathElem("keyless-list[index=0]/state/val"),
+			}}: String("one"),
+			{p: &gnmiPath{
+				pathElemPath: mustPathElem("keyless-list[index=1]/val"),
+			}}: String("two"),
+			{p: &gnmiPath{
+				pathElemPath: mustPathElem("keyless-list[index=1]/state/val"),
+			}}: String("two"),
+		},
+	}, {
+		name: "keyless list with index keys, string slice path",
+		in: &renderExample{
+			KeylessList: []*renderExampleList{
+				{Val: String("one")},
+			},
+		},
+		inParent:      &gnmiPath{stringSlicePath: []string{}},
+		inKeylessMode: KeylessListIndexKeys,
+		wantLeaves: map[*path]interface{}{
+			{p: &gnmiPath{
+				stringSlicePath: []string{"keyless-list", "0", "val"},
+			}}: String("one"),
+			{p: &gnmiPath{
+				stringSlicePath: []string{"keyless-list", "0", "state", "val"},
+			}}: String("one"),
+		},
 	}, {
 		name: "union",
 		in: &renderExample{
@@ -4075,7 +4519,7 @@ func TestFindUpdatedLeaves(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			gotLeaves := map[*path]interface{}{}
-			if err := findUpdatedLeaves(gotLeaves, tt.in, tt.inParent); err != nil {
+			if err := findUpdatedLeaves(gotLeaves, tt.in, tt.inParent, tt.inKeylessMode, nil); err != nil {
 				if diff := errdiff.Substring(err, tt.wantErrSubstring); diff != "" {
 					t.Fatalf("did not get expected error, %v", err)
 				}
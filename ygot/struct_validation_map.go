@@ -396,6 +396,9 @@ type EmitJSONConfig struct {
 	// validation rules in the case that a partially populated data instance is
 	// to be emitted.
 	ValidationOpts []ValidationOption
+	// Redact specifies how sensitive data within the GoStruct is redacted
+	// in the output JSON. If it is nil, no data is redacted.
+	Redact *RedactConfig
 }
 
 // EmitJSON takes an input GoStruct (produced by ygen with validation enabled)
@@ -453,15 +456,20 @@ func EmitJSON(gs GoStruct, opts *EmitJSONConfig) (string, error) {
 // JSON format specified. By default makeJSON returns internal format JSON.
 func makeJSON(s GoStruct, opts *EmitJSONConfig) (map[string]interface{}, error) {
 	f := Internal
+	var r *redactor
 	if opts != nil {
 		f = opts.Format
+		var err error
+		if r, err = newRedactor(s, opts.Redact); err != nil {
+			return nil, err
+		}
 	}
 
 	var v map[string]interface{}
 	var err error
 	switch f {
 	case Internal:
-		if v, err = ConstructInternalJSON(s); err != nil {
+		if v, err = structJSON(s, "", jsonOutputConfig{jType: Internal, redactor: r}); err != nil {
 			return nil, fmt.Errorf("ConstructInternalJSON error: %v", err)
 		}
 	case RFC7951:
@@ -469,7 +477,11 @@ func makeJSON(s GoStruct, opts *EmitJSONConfig) (map[string]interface{}, error)
 		if opts != nil {
 			c = opts.RFC7951Config
 		}
-		if v, err = ConstructIETFJSON(s, c); err != nil {
+		df, err := newDefaulter(s, c.withDefaultsConfig())
+		if err != nil {
+			return nil, err
+		}
+		if v, err = structJSON(s, "", jsonOutputConfig{jType: RFC7951, rfc7951Config: c, redactor: r, defaulter: df}); err != nil {
 			return nil, fmt.Errorf("ConstructIETFJSON error: %v", err)
 		}
 	}
@@ -0,0 +1,310 @@
+// Copyright 2021 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ygot
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/openconfig/goyang/pkg/yang"
+	"github.com/openconfig/ygot/util"
+
+	gnmipb "github.com/openconfig/gnmi/proto/gnmi"
+)
+
+// SkipSubtree can be returned by the PreVisit method of a Visitor to indicate
+// that the descendants of the node being visited should not be walked. It is
+// never returned as an error by Walk.
+var SkipSubtree = errors.New("skip subtree")
+
+// WalkNode describes a node of a GoStruct data tree that is visited by Walk.
+type WalkNode struct {
+	// Path is the data tree path of the node, relative to the GoStruct
+	// that the walk was started from.
+	Path *gnmipb.Path
+	// Schema is the schema entry that describes the node. For list entries,
+	// it is the schema of the list.
+	Schema *yang.Entry
+	// Value is the Go value of the node. For containers and list entries it
+	// is the GoStruct representing the node, for leaves and leaf-lists it is
+	// the value of the corresponding GoStruct field.
+	Value interface{}
+	// Parent is the node within which this node is contained. It is nil for
+	// the GoStruct that the walk was started from.
+	Parent *WalkNode
+
+	// field is the name of the GoStruct field that stores the node within
+	// its parent.
+	field string
+	// typ is the type of the Go value that stores the node within its parent.
+	typ reflect.Type
+	// set replaces the Go value that stores the node within its parent.
+	set func(reflect.Value)
+}
+
+// Replace replaces the value of the node within the data tree with v, which
+// must be assignable to the type of the GoStruct field, or list, that stores
+// the node. If v is nil, the node is removed from the data tree, with a list
+// that has no remaining entries being set to nil. Removing an entry of a
+// keyless list shifts the index of the entries that follow it. When called
+// from the PreVisit method of a Visitor, the descendants of v, rather than the
+// original value, are walked.
+func (n *WalkNode) Replace(v interface{}) error {
+	if n.set == nil {
+		return errors.New("cannot replace the root of a walk")
+	}
+
+	nv := reflect.Zero(n.typ)
+	if v != nil {
+		nv = reflect.ValueOf(v)
+		if !nv.Type().AssignableTo(n.typ) {
+			return fmt.Errorf("cannot replace value at %v, type %T is not assignable to %v", n.Path, v, n.typ)
+		}
+	}
+	n.set(nv)
+	n.Value = nv.Interface()
+	return nil
+}
+
+// Visitor is implemented by types that are called for each node of a
+// GoStruct data tree by Walk.
+type Visitor interface {
+	// PreVisit is called for a node before its descendants are walked. If
+	// it returns SkipSubtree, the descendants of the node are not walked,
+	// any other error stops the walk.
+	PreVisit(n *WalkNode) error
+	// PostVisit is called for a node after its descendants are walked. If
+	// it returns an error, the walk is stopped.
+	PostVisit(n *WalkNode) error
+}
+
+// VisitorFuncs is a Visitor which calls the Pre function for PreVisit, and
+// the Post function for PostVisit. Either function may be nil.
+type VisitorFuncs struct {
+	Pre  func(n *WalkNode) error
+	Post func(n *WalkNode) error
+}
+
+// PreVisit implements the Visitor interface.
+func (f VisitorFuncs) PreVisit(n *WalkNode) error {
+	if f.Pre == nil {
+		return nil
+	}
+	return f.Pre(n)
+}
+
+// PostVisit implements the Visitor interface.
+func (f VisitorFuncs) PostVisit(n *WalkNode) error {
+	if f.Post == nil {
+		return nil
+	}
+	return f.Post(n)
+}
+
+// Walk walks the data tree of the GoStruct root, whose schema is supplied,
+// calling the visitor for each node that is populated - including root
+// itself, containers, list entries, leaves and leaf-lists. Leaf-lists are
+// visited as a single node. Where a GoStruct field maps to more than one data
+// tree path, the node is visited once for each path. The entries of keyed
+// lists are visited in an unspecified order.
+//
+// Walk returns the first error returned by the visitor, or encountered
+// whilst walking the data tree.
+func Walk(root GoStruct, schema *yang.Entry, v Visitor) error {
+	if util.IsValueNil(root) {
+		return errors.New("nil GoStruct supplied to Walk")
+	}
+	if schema == nil {
+		return fmt.Errorf("nil schema supplied to Walk for %T", root)
+	}
+
+	return walkNode(&WalkNode{
+		Path:   &gnmipb.Path{},
+		Schema: schema,
+		Value:  root,
+	}, newPathElemGNMIPath(nil), v)
+}
+
+// walkNode visits the node n, whose data tree path is p, using the visitor v,
+// walking its descendants if it is a container or list entry.
+func walkNode(n *WalkNode, p *gnmiPath, v Visitor) error {
+	switch err := v.PreVisit(n); {
+	case err == SkipSubtree:
+	case err != nil:
+		return err
+	case !util.IsValueNil(n.Value) && util.IsValueStructPtr(reflect.ValueOf(n.Value)):
+		if err := walkStruct(n, p, v); err != nil {
+			return err
+		}
+	}
+	return v.PostVisit(n)
+}
+
+// walkStruct walks each populated field of the GoStruct stored in the node
+// n, whose data tree path is p, using the visitor v.
+func walkStruct(n *WalkNode, p *gnmiPath, v Visitor) error {
+	sv := reflect.ValueOf(n.Value).Elem()
+	st := sv.Type()
+
+	for i := 0; i < sv.NumField(); i++ {
+		fv, ft := sv.Field(i), st.Field(i)
+		if util.IsYgotAnnotation(ft) {
+			continue
+		}
+
+		schPaths, err := util.SchemaPaths(ft)
+		if err != nil {
+			return fmt.Errorf("%v->%s: %v", p, ft.Name, err)
+		}
+		dataPaths, err := structTagToLibPaths(ft, p, false)
+		if err != nil {
+			return fmt.Errorf("%v->%s: %v", p, ft.Name, err)
+		}
+
+		for j, sp := range schPaths {
+			// The field is checked for each path, since it may have been
+			// removed when visiting a previous path.
+			if !isPopulatedField(fv) {
+				break
+			}
+
+			cs := util.FirstChild(n.Schema, sp)
+			if cs == nil {
+				return fmt.Errorf("could not find schema for field %s with path %v from schema %s", ft.Name, sp, n.Schema.Name)
+			}
+
+			switch {
+			case fv.Kind() == reflect.Map:
+				for _, k := range fv.MapKeys() {
+					ev := fv.MapIndex(k)
+					if !ev.IsValid() || ev.IsNil() {
+						continue
+					}
+					cp, err := mapValuePath(k, ev, dataPaths[j])
+					if err != nil {
+						return err
+					}
+					k := k
+					if err := walkChild(n, ft.Name, cp, cs, ev, func(nv reflect.Value) {
+						if nv.IsNil() {
+							if fv.IsNil() {
+								return
+							}
+							fv.SetMapIndex(k, reflect.Value{})
+							// A list with no entries is not populated.
+							if fv.Len() == 0 {
+								fv.Set(reflect.Zero(fv.Type()))
+							}
+							return
+						}
+						fv.SetMapIndex(k, nv)
+					}, v); err != nil {
+						return err
+					}
+				}
+			case fv.Kind() == reflect.Slice && util.IsTypeStructPtr(fv.Type().Elem()):
+				for i := 0; i < fv.Len(); {
+					ev := fv.Index(i)
+					if ev.IsNil() {
+						i++
+						continue
+					}
+					cp, err := keylessListEntryPath(i, dataPaths[j])
+					if err != nil {
+						return err
+					}
+					idx, removed := i, false
+					if err := walkChild(n, ft.Name, cp, cs, ev, func(nv reflect.Value) {
+						switch {
+						case nv.IsNil() && !removed:
+							// The entry is spliced out of the list, such
+							// that the following entries are shifted down.
+							removed = true
+							fv.Set(removeSliceIndex(fv, idx))
+						case !nv.IsNil() && removed:
+							removed = false
+							fv.Set(insertSliceIndex(fv, idx, nv))
+						case !nv.IsNil():
+							fv.Index(idx).Set(nv)
+						}
+					}, v); err != nil {
+						return err
+					}
+					// The next entry has the index of a removed entry.
+					if !removed {
+						i++
+					}
+				}
+			default:
+				if err := walkChild(n, ft.Name, dataPaths[j], cs, fv, fv.Set, v); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// walkChild visits the child of the node parent, stored in the GoStruct field
+// named field, whose data tree path is p, schema is s, and value is cv. The set
+// function is used to replace the value of the child.
+func walkChild(parent *WalkNode, field string, p *gnmiPath, s *yang.Entry, cv reflect.Value, set func(reflect.Value), v Visitor) error {
+	pp, err := p.ToProto()
+	if err != nil {
+		return err
+	}
+	return walkNode(&WalkNode{
+		Path:   pp,
+		Schema: s,
+		Value:  cv.Interface(),
+		Parent: parent,
+		field:  field,
+		typ:    cv.Type(),
+		set:    set,
+	}, p, v)
+}
+
+// removeSliceIndex returns a copy of the slice l with the element at index i
+// removed. A nil slice is returned if no elements remain.
+func removeSliceIndex(l reflect.Value, i int) reflect.Value {
+	if l.Len() == 1 {
+		return reflect.Zero(l.Type())
+	}
+	nl := reflect.MakeSlice(l.Type(), 0, l.Len()-1)
+	nl = reflect.AppendSlice(nl, l.Slice(0, i))
+	return reflect.AppendSlice(nl, l.Slice(i+1, l.Len()))
+}
+
+// insertSliceIndex returns a copy of the slice l with v inserted at index i.
+func insertSliceIndex(l reflect.Value, i int, v reflect.Value) reflect.Value {
+	nl := reflect.MakeSlice(l.Type(), 0, l.Len()+1)
+	nl = reflect.AppendSlice(nl, l.Slice(0, i))
+	nl = reflect.Append(nl, v)
+	return reflect.AppendSlice(nl, l.Slice(i, l.Len()))
+}
+
+// isPopulatedField returns true if the GoStruct field value v is populated
+// in the data tree. Enumerated values that are not explicitly set, and YANG
+// empty leaves that are false, are not considered to be populated.
+func isPopulatedField(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Map, reflect.Slice, reflect.Ptr, reflect.Interface:
+		return !v.IsNil()
+	case reflect.Int64, reflect.Bool:
+		return !v.IsZero()
+	}
+	return true
+}
@@ -0,0 +1,492 @@
+// Copyright 2021 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ygot
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/openconfig/gnmi/errdiff"
+	"github.com/openconfig/goyang/pkg/yang"
+)
+
+// walkRoot is the root GoStruct used for testing Walk.
+type walkRoot struct {
+	Name     *string                   `path:"name"`
+	Child    *walkContainer            `path:"child"`
+	List     map[string]*walkListEntry `path:"list"`
+	LeafList []string                  `path:"leaf-list"`
+	Enum     EnumTest                  `path:"enum"`
+	Log      []*walkListEntry          `path:"log"`
+}
+
+func (*walkRoot) IsYANGGoStruct()                         {}
+func (*walkRoot) ΛValidate(...ValidationOption) error     { return nil }
+func (*walkRoot) ΛEnumTypeMap() map[string][]reflect.Type { return nil }
+func (*walkRoot) ΛBelongingModule() string                { return "" }
+
+// walkContainer is a container within walkRoot.
+type walkContainer struct {
+	Val *string `path:"val|state/val"`
+}
+
+func (*walkContainer) IsYANGGoStruct()                         {}
+func (*walkContainer) ΛValidate(...ValidationOption) error     { return nil }
+func (*walkContainer) ΛEnumTypeMap() map[string][]reflect.Type { return nil }
+func (*walkContainer) ΛBelongingModule() string                { return "" }
+
+// walkListEntry is an entry of the list within walkRoot.
+type walkListEntry struct {
+	Key   *string `path:"key"`
+	Value *uint32 `path:"value"`
+}
+
+func (*walkListEntry) IsYANGGoStruct()                         {}
+func (*walkListEntry) ΛValidate(...ValidationOption) error     { return nil }
+func (*walkListEntry) ΛEnumTypeMap() map[string][]reflect.Type { return nil }
+func (*walkListEntry) ΛBelongingModule() string                { return "" }
+
+func (e *walkListEntry) ΛListKeyMap() (map[string]interface{}, error) {
+	return map[string]interface{}{"key": *e.Key}, nil
+}
+
+// walkSchema returns the schema corresponding to the walkRoot GoStruct.
+func walkSchema() *yang.Entry {
+	root := &yang.Entry{
+		Name: "root",
+		Kind: yang.DirectoryEntry,
+		Dir: map[string]*yang.Entry{
+			"name": {
+				Name: "name",
+				Kind: yang.LeafEntry,
+				Type: &yang.YangType{Kind: yang.Ystring},
+			},
+			"child": {
+				Name: "child",
+				Kind: yang.DirectoryEntry,
+				Dir: map[string]*yang.Entry{
+					"val": {
+						Name: "val",
+						Kind: yang.LeafEntry,
+						Type: &yang.YangType{Kind: yang.Ystring},
+					},
+					"state": {
+						Name: "state",
+						Kind: yang.DirectoryEntry,
+						Dir: map[string]*yang.Entry{
+							"val": {
+								Name: "val",
+								Kind: yang.LeafEntry,
+								Type: &yang.YangType{Kind: yang.Ystring},
+							},
+						},
+					},
+				},
+			},
+			"list": {
+				Name:     "list",
+				Kind:     yang.DirectoryEntry,
+				ListAttr: yang.NewDefaultListAttr(),
+				Key:      "key",
+				Dir: map[string]*yang.Entry{
+					"key": {
+						Name: "key",
+						Kind: yang.LeafEntry,
+						Type: &yang.YangType{Kind: yang.Ystring},
+					},
+					"value": {
+						Name: "value",
+						Kind: yang.LeafEntry,
+						Type: &yang.YangType{Kind: yang.Yuint32},
+					},
+				},
+			},
+			"leaf-list": {
+				Name:     "leaf-list",
+				Kind:     yang.LeafEntry,
+				ListAttr: yang.NewDefaultListAttr(),
+				Type:     &yang.YangType{Kind: yang.Ystring},
+			},
+			"enum": {
+				Name: "enum",
+				Kind: yang.LeafEntry,
+				Type: &yang.YangType{Kind: yang.Yenum},
+			},
+			"log": {
+				Name:     "log",
+				Kind:     yang.DirectoryEntry,
+				ListAttr: yang.NewDefaultListAttr(),
+				Dir: map[string]*yang.Entry{
+					"key": {
+						Name: "key",
+						Kind: yang.LeafEntry,
+						Type: &yang.YangType{Kind: yang.Ystring},
+					},
+				},
+			},
+		},
+	}
+	for _, e := range root.Dir {
+		e.Parent = root
+	}
+	return root
+}
+
+func TestWalk(t *testing.T) {
+	tests := []struct {
+		name string
+		// inStruct is the GoStruct to be walked.
+		inStruct GoStruct
+		// inSchema is the schema of inStruct.
+		inSchema *yang.Entry
+		// inPre is called by the pre-order callback of the visitor.
+		inPre func(n *WalkNode) error
+		// inPost is called by the post-order callback of the visitor.
+		inPost func(n *WalkNode) error
+		// wantVisits is the sequence of callbacks that is expected, along
+		// with the path and schema name of each node.
+		wantVisits []string
+		// wantStruct is the expected contents of inStruct after the walk.
+		wantStruct GoStruct
+		// wantErrSubstring is a substring of the expected error.
+		wantErrSubstring string
+	}{{
+		name: "walk all nodes",
+		inStruct: &walkRoot{
+			Name:     String("foo"),
+			Child:    &walkContainer{Val: String("bar")},
+			List:     map[string]*walkListEntry{"one": {Key: String("one"), Value: Uint32(1)}},
+			LeafList: []string{"a", "b"},
+			Enum:     EnumTestVALONE,
+		},
+		inSchema: walkSchema(),
+		wantVisits: []string{
+			"pre / root",
+			"pre /name name",
+			"post /name name",
+			"pre /child child",
+			"pre /child/val val",
+			"post /child/val val",
+			"pre /child/state/val val",
+			"post /child/state/val val",
+			"post /child child",
+			"pre /list[key=one] list",
+			"pre /list[key=one]/key key",
+			"post /list[key=one]/key key",
+			"pre /list[key=one]/value value",
+			"post /list[key=one]/value value",
+			"post /list[key=one] list",
+			"pre /leaf-list leaf-list",
+			"post /leaf-list leaf-list",
+			"pre /enum enum",
+			"post /enum enum",
+			"post / root",
+		},
+	}, {
+		name: "unset fields are not visited",
+		inStruct: &walkRoot{
+			Child: &walkContainer{},
+		},
+		inSchema: walkSchema(),
+		wantVisits: []string{
+			"pre / root",
+			"pre /child child",
+			"post /child child",
+			"post / root",
+		},
+	}, {
+		name: "skip subtree",
+		inStruct: &walkRoot{
+			Name:  String("foo"),
+			Child: &walkContainer{Val: String("bar")},
+		},
+		inSchema: walkSchema(),
+		inPre: func(n *WalkNode) error {
+			if n.Schema.Name == "child" {
+				return SkipSubtree
+			}
+			return nil
+		},
+		wantVisits: []string{
+			"pre / root",
+			"pre /name name",
+			"post /name name",
+			"pre /child child",
+			"post /child child",
+			"post / root",
+		},
+	}, {
+		name: "replace leaf value",
+		inStruct: &walkRoot{
+			Name: String("secret"),
+		},
+		inSchema: walkSchema(),
+		inPre: func(n *WalkNode) error {
+			if n.Schema.Name == "name" {
+				return n.Replace(String("REDACTED"))
+			}
+			return nil
+		},
+		wantVisits: []string{
+			"pre / root",
+			"pre /name name",
+			"post /name name",
+			"post / root",
+		},
+		wantStruct: &walkRoot{
+			Name: String("REDACTED"),
+		},
+	}, {
+		name: "replace container walks new value",
+		inStruct: &walkRoot{
+			Child: &walkContainer{},
+		},
+		inSchema: walkSchema(),
+		inPre: func(n *WalkNode) error {
+			if n.Schema.Name == "child" {
+				return n.Replace(&walkContainer{Val: String("new")})
+			}
+			return nil
+		},
+		wantVisits: []string{
+			"pre / root",
+			"pre /child child",
+			"pre /child/val val",
+			"post /child/val val",
+			"pre /child/state/val val",
+			"post /child/state/val val",
+			"post /child child",
+			"post / root",
+		},
+		wantStruct: &walkRoot{
+			Child: &walkContainer{Val: String("new")},
+		},
+	}, {
+		name: "remove list entry",
+		inStruct: &walkRoot{
+			List: map[string]*walkListEntry{"one": {Key: String("one")}},
+		},
+		inSchema: walkSchema(),
+		inPre: func(n *WalkNode) error {
+			if n.Schema.Name == "list" {
+				return n.Replace(nil)
+			}
+			return nil
+		},
+		wantVisits: []string{
+			"pre / root",
+			"pre /list[key=one] list",
+			"post /list[key=one] list",
+			"post / root",
+		},
+		wantStruct: &walkRoot{},
+	}, {
+		name: "remove keyless list entries",
+		inStruct: &walkRoot{
+			Log: []*walkListEntry{{Key: String("a")}, {Key: String("b")}, {Key: String("c")}},
+		},
+		inSchema: walkSchema(),
+		inPre: func(n *WalkNode) error {
+			if e, ok := n.Value.(*walkListEntry); ok && *e.Key != "c" {
+				return n.Replace(nil)
+			}
+			return nil
+		},
+		// Following a removal, the next entry is visited at the index of
+		// the removed entry.
+		wantVisits: []string{
+			"pre / root",
+			"pre /log[index=0] log",
+			"post /log[index=0] log",
+			"pre /log[index=0] log",
+			"post /log[index=0] log",
+			"pre /log[index=0] log",
+			"pre /log[index=0]/key key",
+			"post /log[index=0]/key key",
+			"post /log[index=0] log",
+			"post / root",
+		},
+		wantStruct: &walkRoot{
+			Log: []*walkListEntry{{Key: String("c")}},
+		},
+	}, {
+		name: "remove all keyless list entries",
+		inStruct: &walkRoot{
+			Log: []*walkListEntry{{Key: String("a")}, {Key: String("b")}},
+		},
+		inSchema: walkSchema(),
+		inPost: func(n *WalkNode) error {
+			if n.Schema.Name == "log" {
+				return n.Replace(nil)
+			}
+			return nil
+		},
+		wantVisits: []string{
+			"pre / root",
+			"pre /log[index=0] log",
+			"pre /log[index=0]/key key",
+			"post /log[index=0]/key key",
+			"post /log[index=0] log",
+			"pre /log[index=0] log",
+			"pre /log[index=0]/key key",
+			"post /log[index=0]/key key",
+			"post /log[index=0] log",
+			"post / root",
+		},
+		wantStruct: &walkRoot{},
+	}, {
+		name: "remove leaf in post-order",
+		inStruct: &walkRoot{
+			Child: &walkContainer{Val: String("bar")},
+		},
+		inSchema: walkSchema(),
+		inPost: func(n *WalkNode) error {
+			if n.Schema.Name == "val" {
+				return n.Replace(nil)
+			}
+			return nil
+		},
+		// The second path of the field is not visited since the field was
+		// removed when visiting the first.
+		wantVisits: []string{
+			"pre / root",
+			"pre /child child",
+			"pre /child/val val",
+			"post /child/val val",
+			"post /child child",
+			"post / root",
+		},
+		wantStruct: &walkRoot{
+			Child: &walkContainer{},
+		},
+	}, {
+		name: "replace with invalid type",
+		inStruct: &walkRoot{
+			Name: String("foo"),
+		},
+		inSchema: walkSchema(),
+		inPre: func(n *WalkNode) error {
+			if n.Schema.Name == "name" {
+				return n.Replace(42)
+			}
+			return nil
+		},
+		wantVisits: []string{
+			"pre / root",
+			"pre /name name",
+		},
+		wantErrSubstring: "type int is not assignable to *string",
+	}, {
+		name: "replace root",
+		inStruct: &walkRoot{
+			Name: String("foo"),
+		},
+		inSchema: walkSchema(),
+		inPre: func(n *WalkNode) error {
+			return n.Replace(&walkRoot{})
+		},
+		wantVisits: []string{
+			"pre / root",
+		},
+		wantErrSubstring: "cannot replace the root of a walk",
+	}, {
+		name: "error from visitor stops walk",
+		inStruct: &walkRoot{
+			Name:  String("foo"),
+			Child: &walkContainer{Val: String("bar")},
+		},
+		inSchema: walkSchema(),
+		inPost: func(n *WalkNode) error {
+			if n.Schema.Name == "name" {
+				return fmt.Errorf("invalid name")
+			}
+			return nil
+		},
+		wantVisits: []string{
+			"pre / root",
+			"pre /name name",
+			"post /name name",
+		},
+		wantErrSubstring: "invalid name",
+	}, {
+		name: "missing schema",
+		inStruct: &walkRoot{
+			Name: String("foo"),
+		},
+		inSchema: &yang.Entry{
+			Name: "root",
+			Kind: yang.DirectoryEntry,
+			Dir:  map[string]*yang.Entry{},
+		},
+		wantVisits: []string{
+			"pre / root",
+		},
+		wantErrSubstring: "could not find schema for field Name",
+	}, {
+		name:             "nil schema",
+		inStruct:         &walkRoot{},
+		wantErrSubstring: "nil schema supplied",
+	}, {
+		name:             "nil GoStruct",
+		inStruct:         (*walkRoot)(nil),
+		inSchema:         walkSchema(),
+		wantErrSubstring: "nil GoStruct supplied",
+	}}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			record := func(order string, n *WalkNode) {
+				p, err := PathToString(n.Path)
+				if err != nil {
+					t.Fatalf("cannot convert path %v to string: %v", n.Path, err)
+				}
+				got = append(got, fmt.Sprintf("%s %s %s", order, p, n.Schema.Name))
+			}
+
+			err := Walk(tt.inStruct, tt.inSchema, VisitorFuncs{
+				Pre: func(n *WalkNode) error {
+					record("pre", n)
+					if tt.inPre != nil {
+						return tt.inPre(n)
+					}
+					return nil
+				},
+				Post: func(n *WalkNode) error {
+					record("post", n)
+					if tt.inPost != nil {
+						return tt.inPost(n)
+					}
+					return nil
+				},
+			})
+			if diff := errdiff.Substring(err, tt.wantErrSubstring); diff != "" {
+				t.Fatalf("Walk: did not get expected error, %s", diff)
+			}
+
+			if diff := cmp.Diff(tt.wantVisits, got); diff != "" {
+				t.Errorf("Walk: did not get expected visits, (-want, +got):\n%s", diff)
+			}
+
+			if tt.wantStruct != nil {
+				if diff := cmp.Diff(tt.wantStruct, tt.inStruct); diff != "" {
+					t.Errorf("Walk: did not get expected GoStruct after walk, (-want, +got):\n%s", diff)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,347 @@
+// Copyright 2021 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ygot
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/openconfig/goyang/pkg/yang"
+	"github.com/openconfig/ygot/util"
+)
+
+// WithDefaultsMode specifies how leaves that have the default value specified
+// by their schema are reported when a GoStruct is rendered or diffed. The
+// modes correspond to the with-defaults retrieval modes defined in RFC6243.
+type WithDefaultsMode int64
+
+const (
+	// WithDefaultsExplicit specifies that leaves are reported if they are
+	// set within the GoStruct, regardless of their value. Since a GoStruct
+	// does not record whether a leaf was set explicitly, or was populated
+	// with its default value by a generated PopulateDefaults method, this
+	// is equivalent to not specifying a with-defaults mode.
+	WithDefaultsExplicit WithDefaultsMode = iota
+	// WithDefaultsReportAll specifies that all leaves are reported,
+	// including leaves that are unset within a container or list entry
+	// that is present in the GoStruct, and have a default value. Such
+	// leaves are reported with their default value. When diffing
+	// GoStructs, an unset leaf is considered to be equal to a leaf that
+	// has its default value, and a leaf that is unset in the modified
+	// GoStruct is reported as an update to its default value rather than
+	// a deletion.
+	WithDefaultsReportAll
+	// WithDefaultsReportAllTagged specifies that leaves are reported as
+	// per WithDefaultsReportAll, with each leaf that has its default value
+	// tagged with the ietf-netconf-with-defaults:default annotation in
+	// RFC7951 JSON. Since gNMI Notifications cannot carry annotations, and
+	// Diff does not report leaves that are unchanged, it is equivalent to
+	// WithDefaultsReportAll for these outputs.
+	WithDefaultsReportAllTagged
+	// WithDefaultsTrim specifies that leaves that have their default value
+	// are not reported. When diffing GoStructs, an unset leaf is considered
+	// to be equal to a leaf that has its default value, such that a leaf
+	// that is changed to its default value is reported as a deletion.
+	WithDefaultsTrim
+)
+
+// withDefaultsAnnotation is the name of the RFC7952 annotation that is used
+// to tag leaves that have their default value in WithDefaultsReportAllTagged
+// mode, as defined in RFC6243.
+const withDefaultsAnnotation = "ietf-netconf-with-defaults:default"
+
+// WithDefaultsConfig specifies that leaves that have their default value are
+// reported according to a with-defaults mode when a GoStruct is rendered, or
+// diffed. The default value of a leaf is specified by the default statement
+// of the leaf within the schema, or that of its typedef. Leaves whose GoStruct
+// field is a union are always considered not to have their default value.
+type WithDefaultsConfig struct {
+	// Mode specifies how leaves that have their default value are
+	// reported.
+	Mode WithDefaultsMode
+	// Schema is the schema of the GoStruct being rendered or diffed. It
+	// must be specified unless Mode is WithDefaultsExplicit.
+	Schema *yang.Entry
+}
+
+// IsDiffOpt marks WithDefaultsConfig as a diff option.
+func (*WithDefaultsConfig) IsDiffOpt() {}
+
+// hasWithDefaults returns the first WithDefaultsConfig from an opts slice, or
+// nil if there isn't one.
+func hasWithDefaults(opts []DiffOpt) *WithDefaultsConfig {
+	for _, o := range opts {
+		switch v := o.(type) {
+		case *WithDefaultsConfig:
+			return v
+		}
+	}
+	return nil
+}
+
+// defaulter determines the default values of the leaves of GoStructs within
+// a data tree, and whether they should be reported.
+type defaulter struct {
+	// mode is the with-defaults mode used to report leaves.
+	mode WithDefaultsMode
+	// defaults stores the default value of each leaf and leaf-list field
+	// within the data tree that has one.
+	defaults map[dataField]reflect.Value
+}
+
+// newDefaulter returns a defaulter for the data tree d, according to the
+// supplied WithDefaultsConfig. It returns nil if cfg is nil, or specifies
+// WithDefaultsExplicit mode, such that all leaves that are set are reported.
+// Default values are only determined when d is a GoStruct.
+func newDefaulter(d interface{}, cfg *WithDefaultsConfig) (*defaulter, error) {
+	if cfg == nil || cfg.Mode == WithDefaultsExplicit {
+		return nil, nil
+	}
+	if cfg.Schema == nil {
+		return nil, errors.New("a schema must be specified to determine default values")
+	}
+
+	df := &defaulter{mode: cfg.Mode, defaults: map[dataField]reflect.Value{}}
+	gs, ok := d.(GoStruct)
+	if !ok || util.IsValueNil(gs) {
+		return df, nil
+	}
+
+	if err := Walk(gs, cfg.Schema, VisitorFuncs{
+		Pre: func(n *WalkNode) error {
+			if !util.IsValueStructPtr(reflect.ValueOf(n.Value)) {
+				return nil
+			}
+			return df.addFields(n)
+		},
+	}); err != nil {
+		return nil, fmt.Errorf("cannot determine default values: %v", err)
+	}
+	return df, nil
+}
+
+// addFields stores the default value of each leaf and leaf-list field of the
+// GoStruct stored in the node n, including those fields that are unset.
+func (d *defaulter) addFields(n *WalkNode) error {
+	sv := reflect.ValueOf(n.Value).Elem()
+	st := sv.Type()
+	for i := 0; i < st.NumField(); i++ {
+		ft := st.Field(i)
+		if util.IsYgotAnnotation(ft) {
+			continue
+		}
+
+		sp, err := util.SchemaPaths(ft)
+		if err != nil {
+			return fmt.Errorf("%v->%s: %v", n.Path, ft.Name, err)
+		}
+		// Where a field maps to more than one path, each of the paths
+		// refers to a leaf with the same default value.
+		cs := util.FirstChild(n.Schema, sp[0])
+		if cs == nil {
+			return fmt.Errorf("could not find schema for field %s with path %v from schema %s", ft.Name, sp[0], n.Schema.Name)
+		}
+		if !cs.IsLeaf() && !cs.IsLeafList() {
+			continue
+		}
+		defs := schemaDefaults(cs)
+		if len(defs) == 0 {
+			continue
+		}
+
+		v, ok, err := defaultValue(ft.Type, defs)
+		if err != nil {
+			return fmt.Errorf("invalid default value %v for field %s: %v", defs, ft.Name, err)
+		}
+		if ok {
+			d.defaults[dataField{parent: n.Value, name: ft.Name}] = v
+		}
+	}
+	return nil
+}
+
+// value returns the default value of the field named name of the GoStruct s,
+// and whether the field has a default value.
+func (d *defaulter) value(s interface{}, name string) (reflect.Value, bool) {
+	if d == nil {
+		return reflect.Value{}, false
+	}
+	v, ok := d.defaults[dataField{parent: s, name: name}]
+	return v, ok
+}
+
+// isDefault returns true if the field named name of the GoStruct s, whose
+// value is v, is set to its default value.
+func (d *defaulter) isDefault(s interface{}, name string, v reflect.Value) bool {
+	def, ok := d.value(s, name)
+	return ok && isPopulatedField(v) && reflect.DeepEqual(v.Interface(), def.Interface())
+}
+
+// field returns the value that should be reported for the field named name of
+// the GoStruct s, whose value is v, according to the with-defaults mode, along
+// with whether the returned value is the field's default value. An invalid
+// value is returned if the field should not be reported.
+func (d *defaulter) field(s interface{}, name string, v reflect.Value) (reflect.Value, bool) {
+	def, ok := d.value(s, name)
+	switch {
+	case !ok:
+		return v, false
+	case !isPopulatedField(v):
+		if d.mode == WithDefaultsTrim {
+			return v, false
+		}
+		return def, true
+	case d.isDefault(s, name, v):
+		if d.mode == WithDefaultsTrim {
+			return reflect.Value{}, true
+		}
+		return v, true
+	}
+	return v, false
+}
+
+// defaultMetadata returns the RFC7952 metadata that tags the RFC7951 JSON
+// value v of a leaf, or leaf-list, as having its default value.
+func defaultMetadata(v interface{}) interface{} {
+	md := map[string]interface{}{withDefaultsAnnotation: true}
+	l, ok := v.([]interface{})
+	if !ok {
+		return md
+	}
+	// The metadata of a leaf-list is specified for each of its elements.
+	mds := make([]interface{}, 0, len(l))
+	for range l {
+		mds = append(mds, md)
+	}
+	return mds
+}
+
+// schemaDefaults returns the default values of the leaf or leaf-list e, which
+// are specified by its default statements, or failing that, the default
+// statement of its typedef. It is equivalent to the DefaultValues method of
+// e, but also handles entries that have been unmarshalled from JSON, which do
+// not store the YANG statements from which they were created.
+func schemaDefaults(e *yang.Entry) []string {
+	if d := e.DefaultValues(); d != nil || e.Node != nil {
+		return d
+	}
+	if e.Type == nil || !e.Type.HasDefault || e.Mandatory == yang.TSTrue || (e.IsLeafList() && e.ListAttr.MinElements > 0) {
+		return nil
+	}
+	return []string{e.Type.Default}
+}
+
+// defaultValue returns the value of the GoStruct field type t that
+// corresponds to the default values defs of the leaf, or leaf-list, that the
+// field stores. It returns false if the value of a field of type t cannot be
+// determined from its string representation, as is the case for unions.
+func defaultValue(t reflect.Type, defs []string) (reflect.Value, bool, error) {
+	switch {
+	case t.Kind() == reflect.Slice && t.Name() != BinaryTypeName:
+		// A leaf-list, whose default value contains each of the
+		// values.
+		l := reflect.MakeSlice(t, 0, len(defs))
+		for _, s := range defs {
+			v, ok, err := scalarDefaultValue(t.Elem(), s)
+			if !ok || err != nil {
+				return reflect.Value{}, ok, err
+			}
+			l = reflect.Append(l, v)
+		}
+		return l, true, nil
+	case len(defs) != 1:
+		return reflect.Value{}, false, fmt.Errorf("leaf has %d default values", len(defs))
+	case t.Kind() == reflect.Ptr:
+		v, ok, err := scalarDefaultValue(t.Elem(), defs[0])
+		if !ok || err != nil {
+			return reflect.Value{}, ok, err
+		}
+		p := reflect.New(t.Elem())
+		p.Elem().Set(v)
+		return p, true, nil
+	}
+	return scalarDefaultValue(t, defs[0])
+}
+
+// scalarDefaultValue returns the value of type t corresponding to the string
+// representation s of a YANG value, along with whether it can be determined.
+func scalarDefaultValue(t reflect.Type, s string) (reflect.Value, bool, error) {
+	v := reflect.New(t).Elem()
+	if e, ok := v.Interface().(GoEnum); ok {
+		// Identityref values are qualified with the prefix of the module
+		// within which the identity is defined.
+		name := s
+		if i := strings.LastIndex(s, ":"); i != -1 {
+			name = s[i+1:]
+		}
+		for n, def := range e.ΛMap()[t.Name()] {
+			if def.Name == name {
+				v.SetInt(n)
+				return v, true, nil
+			}
+		}
+		return reflect.Value{}, false, fmt.Errorf("%q is not a valid value of enumerated type %s", s, t.Name())
+	}
+
+	// Integer values may be specified in decimal, hexadecimal or octal
+	// notation, as per RFC6020 Section 9.2.1, hence the base is inferred
+	// from the prefix of s.
+	switch t.Kind() {
+	case reflect.String:
+		v.SetString(s)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return reflect.Value{}, false, err
+		}
+		v.SetBool(b)
+	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		i, err := strconv.ParseInt(s, 0, t.Bits())
+		if err != nil {
+			return reflect.Value{}, false, err
+		}
+		v.SetInt(i)
+	case reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		u, err := strconv.ParseUint(s, 0, t.Bits())
+		if err != nil {
+			return reflect.Value{}, false, err
+		}
+		v.SetUint(u)
+	case reflect.Float64:
+		f, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			return reflect.Value{}, false, err
+		}
+		v.SetFloat(f)
+	case reflect.Slice:
+		if t.Name() != BinaryTypeName {
+			return reflect.Value{}, false, nil
+		}
+		b, err := base64.StdEncoding.DecodeString(s)
+		if err != nil {
+			return reflect.Value{}, false, err
+		}
+		v.SetBytes(b)
+	default:
+		// Union values cannot be determined without knowing the
+		// types that are valid within the union.
+		return reflect.Value{}, false, nil
+	}
+	return v, true, nil
+}
@@ -0,0 +1,372 @@
+// Copyright 2021 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ygot
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/openconfig/gnmi/errdiff"
+	"github.com/openconfig/goyang/pkg/yang"
+	"github.com/openconfig/ygot/testutil"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	gnmipb "github.com/openconfig/gnmi/proto/gnmi"
+)
+
+// withDefaultsSchema returns the schema for the walkRoot GoStruct, with
+// default values specified for each of its leaves other than the list key.
+// The default of the name leaf is specified by its typedef.
+func withDefaultsSchema() *yang.Entry {
+	s := walkSchema()
+	s.Dir["name"].Type = &yang.YangType{Kind: yang.Ystring, Default: "foo", HasDefault: true}
+	s.Dir["child"].Dir["val"].Default = []string{"bar"}
+	s.Dir["child"].Dir["state"].Dir["val"].Default = []string{"bar"}
+	s.Dir["list"].Dir["value"].Default = []string{"10"}
+	s.Dir["leaf-list"].Default = []string{"a", "b"}
+	s.Dir["enum"].Default = []string{"VAL_ONE"}
+	return s
+}
+
+// withDefaultsRoot returns a walkRoot in which the name leaf, and the value
+// leaf of the list entry "one", are set to their default values.
+func withDefaultsRoot() *walkRoot {
+	return &walkRoot{
+		Name:  String("foo"),
+		Child: &walkContainer{},
+		List: map[string]*walkListEntry{
+			"one": {Key: String("one"), Value: Uint32(10)},
+			"two": {Key: String("two"), Value: Uint32(20)},
+		},
+		Enum: EnumTestVALTWO,
+	}
+}
+
+func TestMarshal7951WithDefaults(t *testing.T) {
+	tests := []struct {
+		name             string
+		in               interface{}
+		inConfig         *WithDefaultsConfig
+		want             string
+		wantErrSubstring string
+	}{{
+		name: "no with-defaults mode",
+		in:   withDefaultsRoot(),
+		want: `{"enum":"VAL_TWO","list":[{"key":"one","value":10},{"key":"two","value":20}],"name":"foo"}`,
+	}, {
+		name:     "explicit",
+		in:       withDefaultsRoot(),
+		inConfig: &WithDefaultsConfig{Mode: WithDefaultsExplicit},
+		want:     `{"enum":"VAL_TWO","list":[{"key":"one","value":10},{"key":"two","value":20}],"name":"foo"}`,
+	}, {
+		name:     "trim",
+		in:       withDefaultsRoot(),
+		inConfig: &WithDefaultsConfig{Mode: WithDefaultsTrim, Schema: withDefaultsSchema()},
+		want:     `{"enum":"VAL_TWO","list":[{"key":"one"},{"key":"two","value":20}]}`,
+	}, {
+		name:     "report-all",
+		in:       withDefaultsRoot(),
+		inConfig: &WithDefaultsConfig{Mode: WithDefaultsReportAll, Schema: withDefaultsSchema()},
+		want:     `{"child":{"state":{"val":"bar"},"val":"bar"},"enum":"VAL_TWO","leaf-list":["a","b"],"list":[{"key":"one","value":10},{"key":"two","value":20}],"name":"foo"}`,
+	}, {
+		name:     "report-all with unset enumeration",
+		in:       &walkRoot{},
+		inConfig: &WithDefaultsConfig{Mode: WithDefaultsReportAll, Schema: withDefaultsSchema()},
+		want:     `{"enum":"VAL_ONE","leaf-list":["a","b"],"name":"foo"}`,
+	}, {
+		name:     "report-all-tagged",
+		in:       withDefaultsRoot(),
+		inConfig: &WithDefaultsConfig{Mode: WithDefaultsReportAllTagged, Schema: withDefaultsSchema()},
+		want: `{"@leaf-list":[{"ietf-netconf-with-defaults:default":true},{"ietf-netconf-with-defaults:default":true}],` +
+			`"@name":{"ietf-netconf-with-defaults:default":true},` +
+			`"child":{"@val":{"ietf-netconf-with-defaults:default":true},"state":{"@val":{"ietf-netconf-with-defaults:default":true},"val":"bar"},"val":"bar"},` +
+			`"enum":"VAL_TWO","leaf-list":["a","b"],` +
+			`"list":[{"@value":{"ietf-netconf-with-defaults:default":true},"key":"one","value":10},{"key":"two","value":20}],"name":"foo"}`,
+	}, {
+		name:     "field of a GoStruct",
+		in:       String("foo"),
+		inConfig: &WithDefaultsConfig{Mode: WithDefaultsTrim, Schema: withDefaultsSchema()},
+		want:     `"foo"`,
+	}, {
+		name:             "no schema",
+		in:               withDefaultsRoot(),
+		inConfig:         &WithDefaultsConfig{Mode: WithDefaultsTrim},
+		wantErrSubstring: "a schema must be specified",
+	}, {
+		name: "invalid default value",
+		in:   withDefaultsRoot(),
+		inConfig: func() *WithDefaultsConfig {
+			s := withDefaultsSchema()
+			s.Dir["enum"].Default = []string{"VAL_NINE"}
+			return &WithDefaultsConfig{Mode: WithDefaultsTrim, Schema: s}
+		}(),
+		wantErrSubstring: `"VAL_NINE" is not a valid value of enumerated type EnumTest`,
+	}}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Marshal7951(tt.in, &RFC7951JSONConfig{WithDefaults: tt.inConfig})
+			if diff := errdiff.Substring(err, tt.wantErrSubstring); diff != "" {
+				t.Fatalf("Marshal7951: did not get expected error, %s", diff)
+			}
+			if err != nil {
+				return
+			}
+			if string(got) != tt.want {
+				t.Errorf("Marshal7951: got %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConstructIETFJSONWithDefaults(t *testing.T) {
+	got, err := ConstructIETFJSON(withDefaultsRoot(), &RFC7951JSONConfig{
+		WithDefaults: &WithDefaultsConfig{Mode: WithDefaultsTrim, Schema: withDefaultsSchema()},
+	})
+	if err != nil {
+		t.Fatalf("ConstructIETFJSON: got unexpected error: %v", err)
+	}
+	gotJSON, err := json.Marshal(got)
+	if err != nil {
+		t.Fatalf("cannot marshal JSON: %v", err)
+	}
+	if want := `{"enum":"VAL_TWO","list":[{"key":"one"},{"key":"two","value":20}]}`; string(gotJSON) != want {
+		t.Errorf("ConstructIETFJSON: got %s, want %s", gotJSON, want)
+	}
+}
+
+// withDefaultsUpdate returns a gNMI Update of the string path p to the string
+// value v.
+func withDefaultsUpdate(p, v string) *gnmipb.Update {
+	return &gnmipb.Update{
+		Path: mustPath(p),
+		Val:  &gnmipb.TypedValue{Value: &gnmipb.TypedValue_StringVal{v}},
+	}
+}
+
+func TestTogNMINotificationsWithDefaults(t *testing.T) {
+	in := &walkRoot{
+		Name:  String("foo"),
+		Child: &walkContainer{Val: String("baz")},
+		Enum:  EnumTestVALONE,
+	}
+
+	tests := []struct {
+		name     string
+		inConfig *WithDefaultsConfig
+		want     []*gnmipb.Update
+	}{{
+		name: "no with-defaults mode",
+		want: []*gnmipb.Update{
+			withDefaultsUpdate("/name", "foo"),
+			withDefaultsUpdate("/child/val", "baz"),
+			withDefaultsUpdate("/child/state/val", "baz"),
+			withDefaultsUpdate("/enum", "VAL_ONE"),
+		},
+	}, {
+		name:     "trim",
+		inConfig: &WithDefaultsConfig{Mode: WithDefaultsTrim, Schema: withDefaultsSchema()},
+		want: []*gnmipb.Update{
+			withDefaultsUpdate("/child/val", "baz"),
+			withDefaultsUpdate("/child/state/val", "baz"),
+		},
+	}, {
+		name:     "report-all-tagged",
+		inConfig: &WithDefaultsConfig{Mode: WithDefaultsReportAllTagged, Schema: withDefaultsSchema()},
+		want: []*gnmipb.Update{
+			withDefaultsUpdate("/name", "foo"),
+			withDefaultsUpdate("/child/val", "baz"),
+			withDefaultsUpdate("/child/state/val", "baz"),
+			withDefaultsUpdate("/enum", "VAL_ONE"),
+			{
+				Path: mustPath("/leaf-list"),
+				Val: &gnmipb.TypedValue{Value: &gnmipb.TypedValue_LeaflistVal{&gnmipb.ScalarArray{
+					Element: []*gnmipb.TypedValue{
+						{Value: &gnmipb.TypedValue_StringVal{"a"}},
+						{Value: &gnmipb.TypedValue_StringVal{"b"}},
+					},
+				}}},
+			},
+		},
+	}}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := GNMINotificationsConfig{UsePathElem: true, WithDefaults: tt.inConfig}
+			got, err := TogNMINotifications(in, 42, cfg)
+			if err != nil {
+				t.Fatalf("TogNMINotifications: got unexpected error: %v", err)
+			}
+			want := []*gnmipb.Notification{{Timestamp: 42, Update: tt.want}}
+			if !testutil.NotificationSetEqual(got, want) {
+				t.Errorf("TogNMINotifications: did not get expected Notifications, diff(-got,+want):\n%s", cmp.Diff(got, want, protocmp.Transform()))
+			}
+
+			// Streamed Notifications must contain the same updates.
+			cfg.MaxUpdatesPerNotification = 1
+			var streamed []*gnmipb.Update
+			if err := StreamgNMINotifications(in, 42, cfg, func(n *gnmipb.Notification) error {
+				streamed = append(streamed, n.Update...)
+				return nil
+			}); err != nil {
+				t.Fatalf("StreamgNMINotifications: got unexpected error: %v", err)
+			}
+			got = []*gnmipb.Notification{{Timestamp: 42, Update: streamed}}
+			if !testutil.NotificationSetEqual(got, want) {
+				t.Errorf("StreamgNMINotifications: did not get expected updates, diff(-got,+want):\n%s", cmp.Diff(got, want, protocmp.Transform()))
+			}
+		})
+	}
+}
+
+func TestDiffWithDefaults(t *testing.T) {
+	tests := []struct {
+		desc   string
+		inOrig *walkRoot
+		inMod  *walkRoot
+		inMode WithDefaultsMode
+		want   *gnmipb.Notification
+	}{{
+		desc:   "explicit, leaf set to default",
+		inOrig: &walkRoot{},
+		inMod:  &walkRoot{Name: String("foo")},
+		inMode: WithDefaultsExplicit,
+		want:   &gnmipb.Notification{Update: []*gnmipb.Update{withDefaultsUpdate("/name", "foo")}},
+	}, {
+		desc:   "trim, leaf set to default",
+		inOrig: &walkRoot{},
+		inMod:  &walkRoot{Name: String("foo")},
+		inMode: WithDefaultsTrim,
+		want:   &gnmipb.Notification{},
+	}, {
+		desc:   "trim, leaf changed to default",
+		inOrig: &walkRoot{Name: String("bar")},
+		inMod:  &walkRoot{Name: String("foo")},
+		inMode: WithDefaultsTrim,
+		want:   &gnmipb.Notification{Delete: []*gnmipb.Path{mustPath("/name")}},
+	}, {
+		desc:   "trim, leaf changed from default",
+		inOrig: &walkRoot{Name: String("foo")},
+		inMod:  &walkRoot{Name: String("bar")},
+		inMode: WithDefaultsTrim,
+		want:   &gnmipb.Notification{Update: []*gnmipb.Update{withDefaultsUpdate("/name", "bar")}},
+	}, {
+		desc:   "report-all, leaf with default unset",
+		inOrig: &walkRoot{Name: String("foo"), Enum: EnumTestVALONE},
+		inMod:  &walkRoot{},
+		inMode: WithDefaultsReportAll,
+		want:   &gnmipb.Notification{},
+	}, {
+		desc:   "report-all, leaf unset",
+		inOrig: &walkRoot{Name: String("bar"), Enum: EnumTestVALTWO},
+		inMod:  &walkRoot{},
+		inMode: WithDefaultsReportAll,
+		want: &gnmipb.Notification{Update: []*gnmipb.Update{
+			withDefaultsUpdate("/name", "foo"),
+			withDefaultsUpdate("/enum", "VAL_ONE"),
+		}},
+	}, {
+		desc: "report-all-tagged, leaf within list entry unset",
+		inOrig: &walkRoot{List: map[string]*walkListEntry{
+			"one": {Key: String("one"), Value: Uint32(20)},
+		}},
+		inMod: &walkRoot{List: map[string]*walkListEntry{
+			"one": {Key: String("one")},
+		}},
+		inMode: WithDefaultsReportAllTagged,
+		want: &gnmipb.Notification{Update: []*gnmipb.Update{{
+			Path: mustPath("/list[key=one]/value"),
+			Val:  &gnmipb.TypedValue{Value: &gnmipb.TypedValue_UintVal{10}},
+		}}},
+	}, {
+		desc:   "report-all, leaf without default unset",
+		inOrig: &walkRoot{List: map[string]*walkListEntry{"one": {Key: String("one")}}},
+		inMod:  &walkRoot{},
+		inMode: WithDefaultsReportAll,
+		want:   &gnmipb.Notification{Delete: []*gnmipb.Path{mustPath("/list[key=one]/key")}},
+	}}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			got, err := Diff(tt.inOrig, tt.inMod, &WithDefaultsConfig{Mode: tt.inMode, Schema: withDefaultsSchema()})
+			if err != nil {
+				t.Fatalf("Diff: got unexpected error: %v", err)
+			}
+			if !testutil.NotificationSetEqual([]*gnmipb.Notification{got}, []*gnmipb.Notification{tt.want}) {
+				t.Errorf("Diff: did not get expected Notification, diff(-got,+want):\n%s", cmp.Diff(got, tt.want, protocmp.Transform()))
+			}
+		})
+	}
+}
+
+func TestScalarDefaultValue(t *testing.T) {
+	tests := []struct {
+		desc             string
+		inType           reflect.Type
+		inDefault        string
+		want             interface{}
+		wantErrSubstring string
+	}{{
+		desc:      "decimal int8",
+		inType:    reflect.TypeOf(int8(0)),
+		inDefault: "-42",
+		want:      int8(-42),
+	}, {
+		desc:      "hexadecimal uint32",
+		inType:    reflect.TypeOf(uint32(0)),
+		inDefault: "0x10",
+		want:      uint32(16),
+	}, {
+		desc:      "octal uint16",
+		inType:    reflect.TypeOf(uint16(0)),
+		inDefault: "017",
+		want:      uint16(15),
+	}, {
+		desc:      "negative hexadecimal int64",
+		inType:    reflect.TypeOf(int64(0)),
+		inDefault: "-0x10",
+		want:      int64(-16),
+	}, {
+		desc:             "out of range uint8",
+		inType:           reflect.TypeOf(uint8(0)),
+		inDefault:        "0x100",
+		wantErrSubstring: "value out of range",
+	}, {
+		desc:             "invalid int32",
+		inType:           reflect.TypeOf(int32(0)),
+		inDefault:        "ten",
+		wantErrSubstring: "invalid syntax",
+	}}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			got, ok, err := scalarDefaultValue(tt.inType, tt.inDefault)
+			if diff := errdiff.Substring(err, tt.wantErrSubstring); diff != "" {
+				t.Fatalf("scalarDefaultValue(%v, %q): %s", tt.inType, tt.inDefault, diff)
+			}
+			if err != nil {
+				return
+			}
+			if !ok {
+				t.Fatalf("scalarDefaultValue(%v, %q): value could not be determined", tt.inType, tt.inDefault)
+			}
+			if diff := cmp.Diff(tt.want, got.Interface()); diff != "" {
+				t.Errorf("scalarDefaultValue(%v, %q): did not get expected value, (-want, +got):\n%s", tt.inType, tt.inDefault, diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,97 @@
+// Copyright 2021 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ygot
+
+import (
+	"fmt"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// defaultYAMLIndent is the number of spaces used for indentation within YAML
+// output by default.
+const defaultYAMLIndent = 2
+
+// EmitYAMLConfig specifies how YAML should be created by the EmitYAML
+// function.
+type EmitYAMLConfig struct {
+	// RFC7951Config specifies the configuration options for the RFC7951
+	// JSON representation of the GoStruct, from which the YAML is created.
+	RFC7951Config *RFC7951JSONConfig
+	// Indent is the number of spaces used for indentation within the YAML
+	// output. The default value is two.
+	Indent int
+	// SkipValidation specifies whether the GoStruct supplied to EmitYAML
+	// should be validated before emitting its content. Validation is skipped
+	// when it is set to true.
+	SkipValidation bool
+	// ValidationOpts is the set of options that should be used to determine
+	// how the schema should be validated.
+	ValidationOpts []ValidationOption
+	// Redact specifies how sensitive data within the GoStruct is redacted
+	// in the output YAML. If it is nil, no data is redacted.
+	Redact *RedactConfig
+}
+
+// EmitYAML takes an input GoStruct (produced by ygen with validation enabled)
+// and serialises it to a YAML string. The YAML is equivalent to the RFC7951
+// JSON output by EmitJSON, such that containers are YAML mappings, whose
+// keys are the names of fields, qualified by their module names as specified
+// by the RFC7951JSONConfig, and lists and leaf-lists are YAML sequences.
+// Values that are strings in RFC7951 JSON, such as 64-bit integers, are
+// quoted where they would otherwise be interpreted as another YAML type.
+// Mapping keys are sorted.
+func EmitYAML(gs GoStruct, opts *EmitYAMLConfig) (string, error) {
+	if opts == nil {
+		opts = &EmitYAMLConfig{}
+	}
+
+	s, ok := gs.(validatedGoStruct)
+	if !ok {
+		return "", fmt.Errorf("input GoStruct does not have ΛValidate() method")
+	}
+
+	if !opts.SkipValidation {
+		if err := s.ΛValidate(opts.ValidationOpts...); err != nil {
+			return "", fmt.Errorf("validation err: %v", err)
+		}
+	}
+
+	v, err := makeJSON(s, &EmitJSONConfig{
+		Format:        RFC7951,
+		RFC7951Config: opts.RFC7951Config,
+		Redact:        opts.Redact,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	sb := &strings.Builder{}
+	enc := yaml.NewEncoder(sb)
+	indent := defaultYAMLIndent
+	if opts.Indent != 0 {
+		indent = opts.Indent
+	}
+	enc.SetIndent(indent)
+
+	if err := enc.Encode(v); err != nil {
+		return "", fmt.Errorf("YAML marshalling error: %v", err)
+	}
+	if err := enc.Close(); err != nil {
+		return "", fmt.Errorf("YAML marshalling error: %v", err)
+	}
+	return sb.String(), nil
+}
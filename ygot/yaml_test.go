@@ -0,0 +1,99 @@
+// Copyright 2021 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ygot
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/openconfig/gnmi/errdiff"
+	"github.com/openconfig/ygot/testutil"
+)
+
+func TestEmitYAML(t *testing.T) {
+	tests := []struct {
+		name             string
+		in               GoStruct
+		inConfig         *EmitYAMLConfig
+		want             string
+		wantErrSubstring string
+	}{{
+		name: "empty struct",
+		in:   &renderExample{},
+		want: "{}\n",
+	}, {
+		name: "scalar leaves",
+		in: &renderExample{
+			Str:       String("yes"),
+			IntVal:    Int32(-42),
+			Int64Val:  Int64(9007199254740993),
+			FloatVal:  Float32(1.5),
+			EnumField: EnumTestVALTWO,
+			Binary:    Binary{1, 2, 3},
+			Ch:        &renderExampleChild{Val: Uint64(42)},
+		},
+		want: `binary: AQID
+ch:
+  val: "42"
+enum: VAL_TWO
+floatval: 1.5
+int-val: -42
+int64-val: "9007199254740993"
+str: "yes"
+`,
+	}, {
+		name: "lists and leaf-lists as sequences",
+		in: &renderExample{
+			LeafList:            []string{"b", "a"},
+			UnionLeafListSimple: []exampleUnion{testutil.UnionString("x"), testutil.UnionBool(true)},
+			List: map[uint32]*renderExampleList{
+				2: {Val: String("two")},
+				1: {Val: String("one")},
+			},
+		},
+		inConfig: &EmitYAMLConfig{Indent: 4},
+		want: `leaf-list:
+    - b
+    - a
+list:
+    - state:
+        val: one
+      val: one
+    - state:
+        val: two
+      val: two
+union-list-simple:
+    - x
+    - true
+`,
+	}, {
+		name:             "invalid GoStruct",
+		in:               &renderExample{InvalidPtr: &invalidGoStruct{Value: String("invalid")}},
+		inConfig:         &EmitYAMLConfig{SkipValidation: true},
+		wantErrSubstring: "invalid",
+	}}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := EmitYAML(tt.in, tt.inConfig)
+			if diff := errdiff.Substring(err, tt.wantErrSubstring); diff != "" {
+				t.Fatalf("did not get expected error, %s", diff)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("did not get expected output, diff(-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
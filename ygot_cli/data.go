@@ -0,0 +1,283 @@
+// Copyright 2021 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+
+	"github.com/openconfig/goyang/pkg/yang"
+	"github.com/openconfig/ygot/protomap"
+	"github.com/openconfig/ygot/util"
+	"github.com/openconfig/ygot/ygot"
+	"github.com/openconfig/ygot/ytypes"
+	"google.golang.org/protobuf/encoding/prototext"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+
+	gpb "github.com/openconfig/gnmi/proto/gnmi"
+)
+
+// The formats in which data can be read and written.
+const (
+	// formatRFC7951 is RFC7951 JSON.
+	formatRFC7951 = "rfc7951"
+	// formatInternal is the internal JSON format used by ygot.
+	formatInternal = "internal"
+	// formatNotification is a gNMI Notification in protobuf text format.
+	formatNotification = "notification"
+	// formatProto is a protobuf generated by proto_generator, in protobuf
+	// text format.
+	formatProto = "proto"
+)
+
+// fakeRootName is the name of the schema entry that is the root of the
+// data tree, whose children are the top-level data nodes of the modules.
+const fakeRootName = "device"
+
+// loadSchema parses and processes the supplied YANG files, searching the
+// supplied paths for any imported or included modules, and returns a schema
+// entry whose children are the top-level data nodes of the modules.
+func loadSchema(yangFiles, includePaths []string, options yang.Options) (*yang.Entry, error) {
+	if len(yangFiles) == 0 {
+		return nil, fmt.Errorf("no input modules specified")
+	}
+	ms := yang.NewModules()
+	ms.ParseOptions = options
+	for _, p := range includePaths {
+		ms.AddPath(p)
+	}
+
+	var errs util.Errors
+	for _, name := range yangFiles {
+		errs = util.AppendErr(errs, ms.Read(name))
+	}
+	if errs != nil {
+		return nil, errs
+	}
+	if errs := ms.Process(); errs != nil {
+		return nil, util.Errors(errs)
+	}
+
+	root := &yang.Entry{
+		Name: fakeRootName,
+		Kind: yang.DirectoryEntry,
+		Dir:  map[string]*yang.Entry{},
+		Annotation: map[string]interface{}{
+			"isFakeRoot": true,
+		},
+	}
+	seen := map[*yang.Module]bool{}
+	for _, m := range ms.Modules {
+		if seen[m] {
+			continue
+		}
+		seen[m] = true
+		for name, e := range yang.ToEntry(m).Dir {
+			if e.RPC != nil || e.Kind == yang.NotificationEntry {
+				continue
+			}
+			if _, ok := root.Dir[name]; ok {
+				return nil, fmt.Errorf("top-level node %s is defined by more than one module", name)
+			}
+			root.Dir[name] = e
+		}
+	}
+	return root, nil
+}
+
+// protoConfig specifies the protobuf message that is used for the proto
+// format.
+type protoConfig struct {
+	// descriptorFile is the path to a serialised FileDescriptorSet that
+	// contains the message and all of its dependencies.
+	descriptorFile string
+	// message is the full name of the message.
+	message string
+}
+
+// newMessage returns an empty message of the type specified by c. Since the
+// message is built at runtime from its descriptor, fields of the ywrapper
+// Decimal64Value type cannot be mapped to or from it.
+func (c *protoConfig) newMessage() (proto.Message, error) {
+	if c.descriptorFile == "" || c.message == "" {
+		return nil, fmt.Errorf("a descriptor set and message name must be specified for the %s format", formatProto)
+	}
+	b, err := ioutil.ReadFile(c.descriptorFile)
+	if err != nil {
+		return nil, fmt.Errorf("cannot read descriptor set: %v", err)
+	}
+	fds := &descriptorpb.FileDescriptorSet{}
+	if err := proto.Unmarshal(b, fds); err != nil {
+		return nil, fmt.Errorf("cannot unmarshal descriptor set %s: %v", c.descriptorFile, err)
+	}
+	files, err := protodesc.NewFiles(fds)
+	if err != nil {
+		return nil, fmt.Errorf("invalid descriptor set %s: %v", c.descriptorFile, err)
+	}
+	d, err := files.FindDescriptorByName(protoreflect.FullName(c.message))
+	if err != nil {
+		return nil, fmt.Errorf("cannot find message %s: %v", c.message, err)
+	}
+	md, ok := d.(protoreflect.MessageDescriptor)
+	if !ok {
+		return nil, fmt.Errorf("%s is not a message", c.message)
+	}
+	return dynamicpb.NewMessage(md), nil
+}
+
+// readFile returns the contents of the named file, or of stdin if the name
+// is "-".
+func readFile(name string) ([]byte, error) {
+	if name == "-" {
+		return ioutil.ReadAll(os.Stdin)
+	}
+	return ioutil.ReadFile(name)
+}
+
+// readTree returns a data tree with the supplied schema that contains the
+// data in the named file, which is in the supplied format.
+func readTree(schema *yang.Entry, name, format string, pc *protoConfig, opts []ytypes.UnmarshalOpt) (*ytypes.DynamicNode, error) {
+	if name == "" {
+		return nil, fmt.Errorf("no data file specified")
+	}
+	b, err := readFile(name)
+	if err != nil {
+		return nil, fmt.Errorf("cannot read data file: %v", err)
+	}
+	tree, err := ytypes.NewDynamicTree(schema)
+	if err != nil {
+		return nil, err
+	}
+
+	switch format {
+	case formatRFC7951:
+		err = tree.Unmarshal7951(b, opts...)
+	case formatInternal:
+		err = tree.UnmarshalInternalJSON(b, opts...)
+	case formatNotification:
+		n := &gpb.Notification{}
+		if err := prototext.Unmarshal(b, n); err != nil {
+			return nil, fmt.Errorf("cannot unmarshal notification in %s: %v", name, err)
+		}
+		err = applyNotification(tree, n)
+	case formatProto:
+		m, err := pc.newMessage()
+		if err != nil {
+			return nil, err
+		}
+		if err := prototext.Unmarshal(b, m); err != nil {
+			return nil, fmt.Errorf("cannot unmarshal %s in %s: %v", pc.message, name, err)
+		}
+		ns, err := protomap.NotificationsFromProto(m, 0)
+		if err != nil {
+			return nil, fmt.Errorf("cannot map %s to notifications: %v", pc.message, err)
+		}
+		for _, n := range ns {
+			if err := applyNotification(tree, n); err != nil {
+				return nil, err
+			}
+		}
+	default:
+		return nil, fmt.Errorf("unknown format %q", format)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("cannot read %s: %v", name, err)
+	}
+	return tree, nil
+}
+
+// applyNotification applies the deletes, followed by the updates, within
+// the notification n to tree.
+func applyNotification(tree *ytypes.DynamicNode, n *gpb.Notification) error {
+	for _, d := range n.GetDelete() {
+		if err := tree.DeleteNode(joinPaths(n.GetPrefix(), d)); err != nil {
+			return fmt.Errorf("cannot delete %v: %v", d, err)
+		}
+	}
+	for _, u := range n.GetUpdate() {
+		if err := tree.SetNode(joinPaths(n.GetPrefix(), u.GetPath()), u.GetVal(), &ytypes.InitMissingElements{}); err != nil {
+			return fmt.Errorf("cannot apply update to %v: %v", u.GetPath(), err)
+		}
+	}
+	return nil
+}
+
+// joinPaths returns the path p relative to the root, given that it is
+// relative to prefix. The origin and target of prefix are retained; the
+// origin of p is used if prefix does not specify one.
+func joinPaths(prefix, p *gpb.Path) *gpb.Path {
+	origin := prefix.GetOrigin()
+	if origin == "" {
+		origin = p.GetOrigin()
+	}
+	return &gpb.Path{
+		Origin: origin,
+		Target: prefix.GetTarget(),
+		Elem:   append(append([]*gpb.PathElem{}, prefix.GetElem()...), p.GetElem()...),
+	}
+}
+
+// writeTree writes the data within tree to w in the supplied format.
+func writeTree(w io.Writer, tree *ytypes.DynamicNode, format string, pc *protoConfig) error {
+	var out []byte
+	switch format {
+	case formatRFC7951:
+		js, err := tree.Marshal7951(&ygot.RFC7951JSONConfig{AppendModuleName: true}, ygot.JSONIndent("  "))
+		if err != nil {
+			return err
+		}
+		out = js
+	case formatInternal:
+		j, err := tree.ConstructInternalJSON()
+		if err != nil {
+			return err
+		}
+		if out, err = json.MarshalIndent(j, "", "  "); err != nil {
+			return fmt.Errorf("could not marshal JSON, %v", err)
+		}
+	case formatNotification:
+		ns, err := tree.TogNMINotifications(0)
+		if err != nil {
+			return err
+		}
+		out = []byte(prototext.MarshalOptions{Multiline: true}.Format(ns[0]))
+	case formatProto:
+		m, err := pc.newMessage()
+		if err != nil {
+			return err
+		}
+		ns, err := tree.TogNMINotifications(0)
+		if err != nil {
+			return err
+		}
+		if err := protomap.ProtoFromNotifications(m, ns); err != nil {
+			return fmt.Errorf("cannot map data to %s: %v", pc.message, err)
+		}
+		out = []byte(prototext.MarshalOptions{Multiline: true}.Format(m))
+	default:
+		return fmt.Errorf("unknown format %q", format)
+	}
+	if _, err := fmt.Fprintf(w, "%s\n", out); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,256 @@
+// Copyright 2021 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Binary ygot_cli validates and converts data that is described by a set of
+// YANG modules. The modules are parsed at runtime using goyang, such that no
+// generated code is required. Data is held in a ytypes.DynamicNode tree,
+// and can be read and written as RFC7951 JSON, internal JSON, gNMI
+// Notification textproto, or textproto of a protobuf generated by
+// proto_generator.
+//
+// Usage:
+//
+//	ygot_cli <command> -yang=<modules> [flags] <args>
+//
+// The supported commands are:
+//
+//	validate <file>             validate the data in file against the schema.
+//	convert <file>              convert the data in file to another format.
+//	diff <orig> <mod>           output the gNMI updates and deletes that
+//	                            transform orig into mod.
+//	get <file> <path>           output the value at path within file.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/openconfig/goyang/pkg/yang"
+	"github.com/openconfig/ygot/ygot"
+	"github.com/openconfig/ygot/ytypes"
+	"google.golang.org/protobuf/encoding/prototext"
+)
+
+// commands maps the name of each command to the function that runs it.
+var commands = map[string]func(c *config, args []string, w io.Writer) error{
+	"validate": runValidate,
+	"convert":  runConvert,
+	"diff":     runDiff,
+	"get":      runGet,
+}
+
+// config holds the flags that are common to all commands.
+type config struct {
+	// yangFiles are the YANG modules that describe the data.
+	yangFiles []string
+	// includePaths are the directories that are recursively searched for
+	// imported and included modules.
+	includePaths []string
+	// ignoreCircDeps specifies whether circular dependencies between
+	// submodules are ignored.
+	ignoreCircDeps bool
+	// inFormat is the format of input data files.
+	inFormat string
+	// outFormat is the format of output data.
+	outFormat string
+	// ignoreExtra specifies whether fields of input JSON that are not
+	// within the schema are ignored.
+	ignoreExtra bool
+	// proto specifies the message used for the proto format.
+	proto protoConfig
+}
+
+// parseFlags parses the flags for command cmd from args, and returns the
+// resulting configuration along with the remaining positional arguments.
+func parseFlags(cmd string, args []string) (*config, []string, error) {
+	c := &config{}
+	fs := flag.NewFlagSet(cmd, flag.ContinueOnError)
+	yangFiles := fs.String("yang", "", "Comma separated list of the YANG modules that describe the data.")
+	yangPaths := fs.String("path", "", "Comma separated list of paths to be recursively searched for included modules or submodules within the defined YANG modules.")
+	fs.BoolVar(&c.ignoreCircDeps, "ignore_circdeps", false, "If set to true, circular dependencies between submodules are ignored.")
+	fs.StringVar(&c.inFormat, "in_format", formatRFC7951, fmt.Sprintf("The format of input data; one of %s, %s, %s or %s.", formatRFC7951, formatInternal, formatNotification, formatProto))
+	fs.StringVar(&c.outFormat, "out_format", formatRFC7951, "The format of output data, for the convert and get commands; as for in_format.")
+	fs.BoolVar(&c.ignoreExtra, "ignore_extra", false, "If set to true, fields of input JSON that are not defined in the schema are ignored.")
+	fs.StringVar(&c.proto.descriptorFile, "proto_descriptor", "", "The path to a serialised FileDescriptorSet that describes the message used for the proto format.")
+	fs.StringVar(&c.proto.message, "proto_message", "", "The full name of the message used for the proto format.")
+	if err := fs.Parse(args); err != nil {
+		return nil, nil, err
+	}
+
+	if *yangFiles != "" {
+		c.yangFiles = strings.Split(*yangFiles, ",")
+	}
+	// Append "..." to each path to ensure that the directory is
+	// recursively searched.
+	if *yangPaths != "" {
+		for _, p := range strings.Split(*yangPaths, ",") {
+			c.includePaths = append(c.includePaths, filepath.Join(p, "..."))
+		}
+	}
+	return c, fs.Args(), nil
+}
+
+// schema returns the schema described by the YANG modules of c.
+func (c *config) schema() (*yang.Entry, error) {
+	return loadSchema(c.yangFiles, c.includePaths, yang.Options{
+		IgnoreSubmoduleCircularDependencies: c.ignoreCircDeps,
+	})
+}
+
+// readTrees returns a data tree for each of the named files, which are in
+// the input format of c.
+func (c *config) readTrees(names ...string) ([]*ytypes.DynamicNode, error) {
+	schema, err := c.schema()
+	if err != nil {
+		return nil, fmt.Errorf("cannot load schema: %v", err)
+	}
+	var opts []ytypes.UnmarshalOpt
+	if c.ignoreExtra {
+		opts = append(opts, &ytypes.IgnoreExtraFields{})
+	}
+	var trees []*ytypes.DynamicNode
+	for _, name := range names {
+		t, err := readTree(schema, name, c.inFormat, &c.proto, opts)
+		if err != nil {
+			return nil, err
+		}
+		trees = append(trees, t)
+	}
+	return trees, nil
+}
+
+// checkArgs returns an error if args does not contain exactly the named
+// positional arguments of cmd.
+func checkArgs(cmd string, args []string, names ...string) error {
+	if len(args) != len(names) {
+		return fmt.Errorf("%s expects arguments %s, got %d arguments", cmd, strings.Join(names, " "), len(args))
+	}
+	return nil
+}
+
+// runValidate validates the data in the file named by args against the
+// schema, and reports whether it is valid.
+func runValidate(c *config, args []string, w io.Writer) error {
+	if err := checkArgs("validate", args, "<file>"); err != nil {
+		return err
+	}
+	trees, err := c.readTrees(args[0])
+	if err != nil {
+		return err
+	}
+	if errs := trees[0].Validate(); errs != nil {
+		return fmt.Errorf("%s is invalid: %v", args[0], errs)
+	}
+	_, err = fmt.Fprintf(w, "%s is valid\n", args[0])
+	return err
+}
+
+// runConvert writes the data in the file named by args in the output format.
+func runConvert(c *config, args []string, w io.Writer) error {
+	if err := checkArgs("convert", args, "<file>"); err != nil {
+		return err
+	}
+	trees, err := c.readTrees(args[0])
+	if err != nil {
+		return err
+	}
+	return writeTree(w, trees[0], c.outFormat, &c.proto)
+}
+
+// runDiff writes a gNMI Notification, in protobuf text format, containing
+// the updates and deletes that transform the data in the first file named
+// by args into that in the second.
+func runDiff(c *config, args []string, w io.Writer) error {
+	if err := checkArgs("diff", args, "<orig>", "<mod>"); err != nil {
+		return err
+	}
+	trees, err := c.readTrees(args[0], args[1])
+	if err != nil {
+		return err
+	}
+	n, err := ytypes.DiffDynamic(trees[0], trees[1])
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(w, "%s\n", prototext.MarshalOptions{Multiline: true}.Format(n))
+	return err
+}
+
+// runGet writes the nodes of the data in the file named by args that match
+// the supplied path, which may contain wildcards, in the output format.
+func runGet(c *config, args []string, w io.Writer) error {
+	if err := checkArgs("get", args, "<file>", "<path>"); err != nil {
+		return err
+	}
+	p, err := ygot.StringToStructuredPath(args[1])
+	if err != nil {
+		return fmt.Errorf("invalid path %s: %v", args[1], err)
+	}
+	trees, err := c.readTrees(args[0])
+	if err != nil {
+		return err
+	}
+	nodes, err := trees[0].GetNode(p, &ytypes.GetHandleWildcards{})
+	if err != nil {
+		return err
+	}
+	for _, n := range nodes {
+		if err := writeTree(w, n, c.outFormat, &c.proto); err != nil {
+			return fmt.Errorf("cannot write %s: %v", n, err)
+		}
+	}
+	return nil
+}
+
+// usage writes a description of the supported commands to w.
+func usage(w io.Writer) {
+	fmt.Fprintf(w, `Usage: %s <command> -yang=<modules> [flags] <args>
+
+Commands:
+  validate <file>       validate the data in file against the schema
+  convert <file>        convert the data in file to out_format
+  diff <orig> <mod>     output the gNMI updates and deletes that transform orig into mod
+  get <file> <path>     output the value at path within file
+
+Run "%s <command> -help" for the flags of a command.
+`, filepath.Base(os.Args[0]), filepath.Base(os.Args[0]))
+}
+
+// main runs the command named by the first command-line argument.
+func main() {
+	if len(os.Args) < 2 {
+		usage(os.Stderr)
+		os.Exit(2)
+	}
+	run, ok := commands[os.Args[1]]
+	if !ok {
+		usage(os.Stderr)
+		os.Exit(2)
+	}
+	c, args, err := parseFlags(os.Args[1], os.Args[2:])
+	if err != nil {
+		if err == flag.ErrHelp {
+			os.Exit(0)
+		}
+		os.Exit(2)
+	}
+	if err := run(c, args, os.Stdout); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+}
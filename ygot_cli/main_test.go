@@ -0,0 +1,317 @@
+// Copyright 2021 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/openconfig/gnmi/errdiff"
+	"github.com/openconfig/goyang/pkg/yang"
+	"google.golang.org/protobuf/encoding/prototext"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	gpb "github.com/openconfig/gnmi/proto/gnmi"
+)
+
+const testYANG = "testdata/cli.yang"
+
+func TestCommands(t *testing.T) {
+	tests := []struct {
+		name             string
+		inCommand        string
+		inArgs           []string
+		wantJSON         string
+		wantOut          string
+		wantErrSubstring string
+	}{{
+		name:      "validate valid data",
+		inCommand: "validate",
+		inArgs:    []string{"-yang=" + testYANG, "testdata/orig.json"},
+		wantOut:   "testdata/orig.json is valid\n",
+	}, {
+		name:             "validate invalid data",
+		inCommand:        "validate",
+		inArgs:           []string{"-yang=" + testYANG, "testdata/invalid.json"},
+		wantErrSubstring: "outside specified ranges",
+	}, {
+		name:             "validate with unknown fields",
+		inCommand:        "validate",
+		inArgs:           []string{"-yang=" + testYANG, "testdata/extra.json"},
+		wantErrSubstring: "unexpected field speed",
+	}, {
+		name:      "validate ignoring unknown fields",
+		inCommand: "validate",
+		inArgs:    []string{"-yang=" + testYANG, "-ignore_extra", "testdata/extra.json"},
+		wantOut:   "testdata/extra.json is valid\n",
+	}, {
+		name:             "no modules",
+		inCommand:        "validate",
+		inArgs:           []string{"testdata/orig.json"},
+		wantErrSubstring: "no input modules specified",
+	}, {
+		name:             "wrong number of arguments",
+		inCommand:        "diff",
+		inArgs:           []string{"-yang=" + testYANG, "testdata/orig.json"},
+		wantErrSubstring: "diff expects arguments <orig> <mod>",
+	}, {
+		name:      "convert to internal JSON",
+		inCommand: "convert",
+		inArgs:    []string{"-yang=" + testYANG, "-out_format=internal", "testdata/orig.json"},
+		wantJSON: `{"interfaces": {"interface": {
+			"eth0": {"name": "eth0", "mtu": 1500, "type": "ETH", "counter": 42},
+			"eth1": {"name": "eth1", "mtu": 9000}
+		}}}`,
+	}, {
+		name:      "convert from internal JSON",
+		inCommand: "convert",
+		inArgs:    []string{"-yang=" + testYANG, "-in_format=internal", "testdata/internal.json"},
+		wantJSON: `{"cli:interfaces": {"interface": [
+			{"name": "eth0", "mtu": 1500, "type": "cli:ETH", "counter": "42"},
+			{"name": "eth1", "mtu": 9000}
+		]}}`,
+	}, {
+		name:      "convert from notification",
+		inCommand: "convert",
+		inArgs:    []string{"-yang=" + testYANG, "-in_format=notification", "testdata/notification.txtpb"},
+		wantJSON: `{"cli:interfaces": {"interface": [
+			{"name": "eth0", "mtu": 1500}
+		]}}`,
+	}, {
+		name:             "unknown format",
+		inCommand:        "convert",
+		inArgs:           []string{"-yang=" + testYANG, "-out_format=xml", "testdata/orig.json"},
+		wantErrSubstring: `unknown format "xml"`,
+	}, {
+		name:             "proto format without descriptor",
+		inCommand:        "convert",
+		inArgs:           []string{"-yang=" + testYANG, "-out_format=proto", "testdata/orig.json"},
+		wantErrSubstring: "a descriptor set and message name must be specified",
+	}, {
+		name:      "get leaf with wildcard",
+		inCommand: "get",
+		inArgs:    []string{"-yang=" + testYANG, "testdata/orig.json", "/interfaces/interface[name=*]/mtu"},
+		wantOut:   "{\n  \"cli:mtu\": 1500\n}\n{\n  \"cli:mtu\": 9000\n}\n",
+	}, {
+		name:      "get list entry",
+		inCommand: "get",
+		inArgs:    []string{"-yang=" + testYANG, "-out_format=internal", "testdata/orig.json", "/interfaces/interface[name=eth1]"},
+		wantJSON:  `{"name": "eth1", "mtu": 9000}`,
+	}, {
+		name:             "get missing path",
+		inCommand:        "get",
+		inArgs:           []string{"-yang=" + testYANG, "testdata/orig.json", "/interfaces/interface[name=eth2]"},
+		wantErrSubstring: "no nodes match path",
+	}}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c, args, err := parseFlags(tt.inCommand, tt.inArgs)
+			if err != nil {
+				t.Fatalf("cannot parse flags: %v", err)
+			}
+			var out bytes.Buffer
+			err = commands[tt.inCommand](c, args, &out)
+			if diff := errdiff.Substring(err, tt.wantErrSubstring); diff != "" {
+				t.Fatalf("did not get expected error, %s", diff)
+			}
+			if err != nil {
+				return
+			}
+
+			if tt.wantJSON != "" {
+				var got, want interface{}
+				if err := json.Unmarshal(out.Bytes(), &got); err != nil {
+					t.Fatalf("cannot unmarshal output %s: %v", out.String(), err)
+				}
+				if err := json.Unmarshal([]byte(tt.wantJSON), &want); err != nil {
+					t.Fatalf("cannot unmarshal want JSON: %v", err)
+				}
+				if diff := cmp.Diff(want, got); diff != "" {
+					t.Errorf("did not get expected JSON, diff(-want, +got):\n%s", diff)
+				}
+				return
+			}
+			if got := out.String(); got != tt.wantOut {
+				t.Errorf("did not get expected output, got: %s, want: %s", got, tt.wantOut)
+			}
+		})
+	}
+}
+
+func TestDiff(t *testing.T) {
+	c, args, err := parseFlags("diff", []string{"-yang=" + testYANG, "testdata/orig.json", "testdata/mod.json"})
+	if err != nil {
+		t.Fatalf("cannot parse flags: %v", err)
+	}
+	var out bytes.Buffer
+	if err := runDiff(c, args, &out); err != nil {
+		t.Fatalf("runDiff: unexpected error: %v", err)
+	}
+	got := &gpb.Notification{}
+	if err := prototext.Unmarshal(out.Bytes(), got); err != nil {
+		t.Fatalf("cannot unmarshal output %s: %v", out.String(), err)
+	}
+	want := &gpb.Notification{}
+	b, err := ioutil.ReadFile(filepath.Join("testdata", "diff.txtpb"))
+	if err != nil {
+		t.Fatalf("cannot read want notification: %v", err)
+	}
+	if err := prototext.Unmarshal(b, want); err != nil {
+		t.Fatalf("cannot unmarshal want notification: %v", err)
+	}
+	if diff := cmp.Diff(want, got, protocmp.Transform()); diff != "" {
+		t.Errorf("runDiff: did not get expected notification, diff(-want, +got):\n%s", diff)
+	}
+}
+
+func TestLoadSchema(t *testing.T) {
+	root, err := loadSchema([]string{testYANG}, nil, yang.Options{})
+	if err != nil {
+		t.Fatalf("loadSchema: unexpected error: %v", err)
+	}
+	var names []string
+	for name := range root.Dir {
+		names = append(names, name)
+	}
+	if got, want := strings.Join(names, ","), "interfaces"; got != want {
+		t.Errorf("loadSchema: got top-level nodes %s, want %s", got, want)
+	}
+	if root.Name != fakeRootName {
+		t.Errorf("loadSchema: got root name %s, want %s", root.Name, fakeRootName)
+	}
+}
+
+// testProto specifies the message used to test the proto format. The
+// descriptor set contains the protobufs in testdata/proto, which were
+// generated from testYANG by proto_generator with the -generate_fakeroot
+// flag, along with their dependencies.
+var testProto = &protoConfig{
+	descriptorFile: "testdata/cli.fds",
+	message:        "cli.Device",
+}
+
+func TestReadTreeProto(t *testing.T) {
+	schema, err := loadSchema([]string{testYANG}, nil, yang.Options{})
+	if err != nil {
+		t.Fatalf("loadSchema: unexpected error: %v", err)
+	}
+	want, err := readTree(schema, "testdata/orig.json", formatRFC7951, nil, nil)
+	if err != nil {
+		t.Fatalf("readTree: cannot read want tree: %v", err)
+	}
+	got, err := readTree(schema, "testdata/orig.txtpb", formatProto, testProto, nil)
+	if err != nil {
+		t.Fatalf("readTree: unexpected error: %v", err)
+	}
+
+	gotJSON, err := got.Marshal7951()
+	if err != nil {
+		t.Fatalf("Marshal7951: unexpected error: %v", err)
+	}
+	wantJSON, err := want.Marshal7951()
+	if err != nil {
+		t.Fatalf("Marshal7951: cannot marshal want tree: %v", err)
+	}
+	if !bytes.Equal(gotJSON, wantJSON) {
+		t.Errorf("readTree: did not get expected tree, got: %s, want: %s", gotJSON, wantJSON)
+	}
+}
+
+func TestWriteTreeProto(t *testing.T) {
+	schema, err := loadSchema([]string{testYANG}, nil, yang.Options{})
+	if err != nil {
+		t.Fatalf("loadSchema: unexpected error: %v", err)
+	}
+	tree, err := readTree(schema, "testdata/orig.json", formatRFC7951, nil, nil)
+	if err != nil {
+		t.Fatalf("readTree: unexpected error: %v", err)
+	}
+	var out bytes.Buffer
+	if err := writeTree(&out, tree, formatProto, testProto); err != nil {
+		t.Fatalf("writeTree: unexpected error: %v", err)
+	}
+
+	got, err := testProto.newMessage()
+	if err != nil {
+		t.Fatalf("newMessage: unexpected error: %v", err)
+	}
+	if err := prototext.Unmarshal(out.Bytes(), got); err != nil {
+		t.Fatalf("cannot unmarshal output %s: %v", out.String(), err)
+	}
+	want, err := testProto.newMessage()
+	if err != nil {
+		t.Fatalf("newMessage: unexpected error: %v", err)
+	}
+	b, err := ioutil.ReadFile(filepath.Join("testdata", "orig.txtpb"))
+	if err != nil {
+		t.Fatalf("cannot read want message: %v", err)
+	}
+	if err := prototext.Unmarshal(b, want); err != nil {
+		t.Fatalf("cannot unmarshal want message: %v", err)
+	}
+	if diff := cmp.Diff(want, got, protocmp.Transform()); diff != "" {
+		t.Errorf("writeTree: did not get expected message, diff(-want, +got):\n%s", diff)
+	}
+}
+
+func TestJoinPaths(t *testing.T) {
+	tests := []struct {
+		name     string
+		inPrefix *gpb.Path
+		inPath   *gpb.Path
+		want     *gpb.Path
+	}{{
+		name:     "nil prefix",
+		inPrefix: nil,
+		inPath:   &gpb.Path{Elem: []*gpb.PathElem{{Name: "interfaces"}}},
+		want:     &gpb.Path{Elem: []*gpb.PathElem{{Name: "interfaces"}}},
+	}, {
+		name: "prefix with origin and target",
+		inPrefix: &gpb.Path{
+			Origin: "openconfig",
+			Target: "dut",
+			Elem:   []*gpb.PathElem{{Name: "interfaces"}},
+		},
+		inPath: &gpb.Path{Elem: []*gpb.PathElem{{Name: "interface", Key: map[string]string{"name": "eth0"}}}},
+		want: &gpb.Path{
+			Origin: "openconfig",
+			Target: "dut",
+			Elem:   []*gpb.PathElem{{Name: "interfaces"}, {Name: "interface", Key: map[string]string{"name": "eth0"}}},
+		},
+	}, {
+		name:     "origin within path",
+		inPrefix: &gpb.Path{Target: "dut", Elem: []*gpb.PathElem{{Name: "interfaces"}}},
+		inPath:   &gpb.Path{Origin: "openconfig", Elem: []*gpb.PathElem{{Name: "interface"}}},
+		want: &gpb.Path{
+			Origin: "openconfig",
+			Target: "dut",
+			Elem:   []*gpb.PathElem{{Name: "interfaces"}, {Name: "interface"}},
+		},
+	}}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if diff := cmp.Diff(tt.want, joinPaths(tt.inPrefix, tt.inPath), protocmp.Transform()); diff != "" {
+				t.Errorf("joinPaths: did not get expected path, diff(-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -190,6 +190,20 @@ type GenConfig struct {
 	BaseImportPath string
 	// PackageString is the string to apppend to the generated Go package names.
 	PackageSuffix string
+	// GenerateKeylessListPaths generates path-building methods for YANG
+	// lists that do not have keys. Entries within such lists are identified
+	// by their index, using the ygot.KeylessListIndexKey pseudo key, such
+	// that the paths match those output by ygot when the
+	// ygot.KeylessListIndexKeys mode is used. By default, the subtrees of
+	// keyless lists are not reachable in the generated API.
+	GenerateKeylessListPaths bool
+	// GenerateAccessors generates Get and Set methods for each
+	// non-wildcard path struct, which read and write the corresponding
+	// node within a tree of ygen-generated GoStructs rooted at the
+	// fakeroot GoStruct. The accessors traverse the GoStruct fields and
+	// list keys directly, rather than resolving the path using
+	// reflection. Nodes within keyless lists do not have accessors.
+	GenerateAccessors bool
 }
 
 // GoImports contains package import options.
@@ -284,6 +298,11 @@ func (cg *GenConfig) GeneratePathCode(yangFiles, includePaths []string) (map[str
 		errs = util.AppendErrs(errs, es)
 	}
 
+	var accessorParents map[string]accessorParent
+	if cg.GenerateAccessors {
+		accessorParents = getAccessorParents(ir.Directories)
+	}
+
 	// Generate struct code.
 	var structSnippets []GoPathStructCodeSnippet
 	for _, directoryPath := range ir.OrderedDirectoryPathsByName() {
@@ -294,10 +313,17 @@ func (cg *GenConfig) GeneratePathCode(yangFiles, includePaths []string) (map[str
 			listBuilderKeyThreshold = cg.ListBuilderKeyThreshold
 		}
 
-		structSnippet, es := generateDirectorySnippet(directory, ir.Directories, schemaStructPkgAccessor, cg.PathStructSuffix, listBuilderKeyThreshold, cg.GenerateWildcardPaths, cg.SimplifyWildcardPaths, cg.SplitByModule, cg.PackageName, cg.PackageSuffix, cg.TrimPackagePrefix)
+		structSnippet, es := generateDirectorySnippet(directory, ir.Directories, schemaStructPkgAccessor, cg.PathStructSuffix, listBuilderKeyThreshold, cg.GenerateWildcardPaths, cg.SimplifyWildcardPaths, cg.GenerateKeylessListPaths, cg.SplitByModule, cg.PackageName, cg.PackageSuffix, cg.TrimPackagePrefix)
 		if es != nil {
 			errs = util.AppendErrs(errs, es)
 		}
+		if cg.GenerateAccessors && len(structSnippet) > 0 {
+			accessors, es := generateAccessors(directory, accessorParents, ir.Directories, schemaStructPkgAccessor, cg.PathStructSuffix, cg.FakeRootName)
+			if es != nil {
+				errs = util.AppendErrs(errs, es)
+			}
+			structSnippet[0].Accessors = accessors
+		}
 		structSnippets = append(structSnippets, structSnippet...)
 	}
 
@@ -433,6 +459,10 @@ type GoPathStructCodeSnippet struct {
 	// ChildConstructors contains the method code snippets with the input struct as a
 	// receiver, that is used to get the child path struct.
 	ChildConstructors string
+	// Accessors contains the Get and Set method code snippets of the input
+	// struct and its leaves, which access the corresponding node within a
+	// GoStruct data tree. It is only populated when accessors are generated.
+	Accessors string
 	// Package is the name of the package that this snippet belongs to.
 	Package string
 	// Deps are any packages that this snippet depends on.
@@ -443,7 +473,7 @@ type GoPathStructCodeSnippet struct {
 // simply writing out all of its generated code.
 func (g GoPathStructCodeSnippet) String() string {
 	var b strings.Builder
-	for _, method := range []string{g.StructBase, g.ChildConstructors} {
+	for _, method := range []string{g.StructBase, g.ChildConstructors, g.Accessors} {
 		genutil.WriteIfNotEmpty(&b, method)
 	}
 	return b.String()
@@ -525,6 +555,9 @@ Imported modules were sourced from:
 package {{ .PackageName }}
 
 import (
+	{{- if .ImportFmt }}
+	"fmt"
+	{{- end }}
 	{{- if .SchemaStructPkgPath }}
 	{{ .SchemaStructPkgAlias }} "{{ .SchemaStructPkgPath }}"
 	{{- end }}
@@ -609,6 +642,175 @@ func (n *{{ .TypeName }}) {{ .MethodName }}({{ .KeyParamName }} {{ .KeyParamType
 	ygot.ModifyKey(n.NodePath, "{{ .KeySchemaName }}", {{ .KeyParamName }})
 	return n
 }
+`)
+
+	// goPathParentStructTemplate generates the method that returns the
+	// GoStruct containing the node of a path struct within a GoStruct data
+	// tree. It is used by the Get and Set accessors of the path struct.
+	goPathParentStructTemplate = mustTemplate("parentStruct", `
+// parentStruct returns the GoStruct containing the {{ .YANGPath }} {{ .YANGNodeType }}
+// within the data tree rooted at root, or nil if it is not present. If create
+// is set, the GoStruct and any of its ancestors that are not present are created.
+func (n *{{ .TypeName }}) parentStruct(root *{{ .RootType }}, create bool) (*{{ .ParentStructType }}, error) {
+{{- if .ParentTypeName }}
+	p, ok := ygot.PathParent(n).(*{{ .ParentTypeName }})
+	if !ok {
+		return nil, fmt.Errorf("unexpected parent %T of {{ .TypeName }}", ygot.PathParent(n))
+	}
+	if create {
+		return p.getOrCreate(root)
+	}
+	s, _ := p.Get(root)
+	return s, nil
+{{- else }}
+	if root == nil {
+		return nil, fmt.Errorf("nil root for {{ .YANGPath }}")
+	}
+	return root, nil
+{{- end }}
+}
+`)
+
+	// goPathDirectoryAccessorsTemplate generates the Get and Set accessors
+	// of the path struct of a container or list, which read and write the
+	// corresponding GoStruct within a GoStruct data tree.
+	goPathDirectoryAccessorsTemplate = mustTemplate("directoryAccessors", `
+{{- if .Keys }}
+// key returns the key of the {{ .YANGPath }} list entry identified by n, and
+// whether all of its key values are set to values of the expected types.
+func (n *{{ .TypeName }}) key() ({{ .MapKeyType }}, bool) {
+{{- if .KeyStructType }}
+	var k {{ .KeyStructType }}
+	var ok bool
+{{- range $key := .Keys }}
+	if k.{{ $key.KeyField }}, ok = ygot.PathKey(n.NodePath, "{{ $key.Name }}").({{ $key.TypeName }}); !ok {
+		return k, false
+	}
+{{- end }}
+	return k, true
+{{- else }}
+{{- with index .Keys 0 }}
+	k, ok := ygot.PathKey(n.NodePath, "{{ .Name }}").({{ .TypeName }})
+{{- end }}
+	return k, ok
+{{- end }}
+}
+{{ end }}
+// Get returns the {{ .YANGPath }} {{ .YANGNodeType }} within the data tree rooted
+// at root, and whether it is present.
+func (n *{{ .TypeName }}) Get(root *{{ .RootType }}) (*{{ .GoType }}, bool) {
+	s, err := n.parentStruct(root, false)
+	if err != nil || s == nil {
+		return nil, false
+	}
+{{- if .Keys }}
+	k, ok := n.key()
+	if !ok {
+		return nil, false
+	}
+	v, ok := s.{{ .FieldName }}[k]
+	return v, ok
+{{- else }}
+	return s.{{ .FieldName }}, s.{{ .FieldName }} != nil
+{{- end }}
+}
+
+// Set sets the {{ .YANGPath }} {{ .YANGNodeType }} within the data tree rooted
+// at root to v, creating any of its ancestors that are not present.
+{{- if .Keys }} The keys of v
+// must be equal to those of n.
+{{- end }}
+func (n *{{ .TypeName }}) Set(root *{{ .RootType }}, v *{{ .GoType }}) error {
+{{- if .Keys }}
+	if v == nil {
+		return fmt.Errorf("nil value for {{ .YANGPath }}")
+	}
+	k, ok := n.key()
+	if !ok {
+		return fmt.Errorf("invalid key for {{ .YANGPath }}")
+	}
+{{- range $key := .Keys }}
+	if {{ if $key.IsScalarField }}v.{{ $key.ListField }} == nil || *{{ end }}v.{{ $key.ListField }} != k{{ if $.KeyStructType }}.{{ $key.KeyField }}{{ end }} {
+		return fmt.Errorf("key {{ $key.Name }} of value does not match the path of {{ $.YANGPath }}")
+	}
+{{- end }}
+{{- end }}
+	s, err := n.parentStruct(root, true)
+	if err != nil {
+		return err
+	}
+{{- if .Keys }}
+	if s.{{ .FieldName }} == nil {
+		s.{{ .FieldName }} = map[{{ .MapKeyType }}]*{{ .GoType }}{}
+	}
+	s.{{ .FieldName }}[k] = v
+{{- else }}
+	s.{{ .FieldName }} = v
+{{- end }}
+	return nil
+}
+
+// getOrCreate returns the {{ .YANGPath }} {{ .YANGNodeType }} within the data
+// tree rooted at root, creating it and any of its ancestors that are not present.
+func (n *{{ .TypeName }}) getOrCreate(root *{{ .RootType }}) (*{{ .GoType }}, error) {
+	s, err := n.parentStruct(root, true)
+	if err != nil {
+		return nil, err
+	}
+{{- if .Keys }}
+	k, ok := n.key()
+	if !ok {
+		return nil, fmt.Errorf("invalid key for {{ .YANGPath }}")
+	}
+	if v, ok := s.{{ .FieldName }}[k]; ok {
+		return v, nil
+	}
+	v := &{{ .GoType }}{
+{{- range $key := .Keys }}
+		{{ $key.ListField }}: {{ if $key.IsScalarField }}&{{ end }}k{{ if $.KeyStructType }}.{{ $key.KeyField }}{{ end }},
+{{- end }}
+	}
+	if s.{{ .FieldName }} == nil {
+		s.{{ .FieldName }} = map[{{ .MapKeyType }}]*{{ .GoType }}{}
+	}
+	s.{{ .FieldName }}[k] = v
+	return v, nil
+{{- else }}
+	if s.{{ .FieldName }} == nil {
+		s.{{ .FieldName }} = &{{ .GoType }}{}
+	}
+	return s.{{ .FieldName }}, nil
+{{- end }}
+}
+`)
+
+	// goPathLeafAccessorsTemplate generates the Get and Set accessors of the
+	// path struct of a leaf or leaf-list, which read and write the
+	// corresponding field of its parent GoStruct within a GoStruct data tree.
+	goPathLeafAccessorsTemplate = mustTemplate("leafAccessors", `
+// Get returns the value of the {{ .YANGPath }} {{ .YANGNodeType }} within the
+// data tree rooted at root, and whether it is set.
+func (n *{{ .TypeName }}) Get(root *{{ .RootType }}) ({{ .GoType }}, bool) {
+	var v {{ .GoType }}
+	s, err := n.parentStruct(root, false)
+	if err != nil || s == nil || {{ .AbsentCond }} {
+		return v, false
+	}
+	return {{ if .IsScalarField }}*{{ end }}s.{{ .FieldName }}, true
+}
+{{- if not .IsListKey }}
+
+// Set sets the value of the {{ .YANGPath }} {{ .YANGNodeType }} within the data
+// tree rooted at root to v, creating any of its ancestors that are not present.
+func (n *{{ .TypeName }}) Set(root *{{ .RootType }}, v {{ .GoType }}) error {
+	s, err := n.parentStruct(root, true)
+	if err != nil {
+		return err
+	}
+	s.{{ .FieldName }} = {{ if .IsScalarField }}&{{ end }}v
+	return nil
+}
+{{- end }}
 `)
 )
 
@@ -663,19 +865,9 @@ func getNodeDataMap(ir *ygen.IR, fakeRootName, schemaStructPkgAccessor, pathStru
 			case !isLeaf:
 				goTypeName = "*" + schemaStructPkgAccessor + subsumingGoStructName
 				localGoTypeName = "*" + subsumingGoStructName
-			case field.Type == ygen.LeafListNode && ygen.IsYgenDefinedGoType(mType):
-				goTypeName = "[]" + schemaStructPkgAccessor + mType.NativeType
-				localGoTypeName = "[]" + mType.NativeType
-			case ygen.IsYgenDefinedGoType(mType):
-				goTypeName = schemaStructPkgAccessor + mType.NativeType
-				localGoTypeName = mType.NativeType
-			case field.Type == ygen.LeafListNode:
-				goTypeName = "[]" + mType.NativeType
 			default:
-				goTypeName = mType.NativeType
-			}
-			if localGoTypeName == "" {
-				localGoTypeName = goTypeName
+				goTypeName = leafGoTypeName(field, schemaStructPkgAccessor)
+				localGoTypeName = leafGoTypeName(field, "")
 			}
 
 			var yangTypeName string
@@ -703,6 +895,24 @@ func getNodeDataMap(ir *ygen.IR, fakeRootName, schemaStructPkgAccessor, pathStru
 	return nodeDataMap, nil
 }
 
+// leafGoTypeName returns the Go type of the given leaf or leaf-list field
+// within its ygen-generated GoStruct, with any pointer of a scalar field
+// removed. schemaStructPkgAccessor qualifies the types that are defined by
+// ygen.
+func leafGoTypeName(field *ygen.NodeDetails, schemaStructPkgAccessor string) string {
+	mType := field.LangType
+	switch {
+	case field.Type == ygen.LeafListNode && ygen.IsYgenDefinedGoType(mType):
+		return "[]" + schemaStructPkgAccessor + mType.NativeType
+	case ygen.IsYgenDefinedGoType(mType):
+		return schemaStructPkgAccessor + mType.NativeType
+	case field.Type == ygen.LeafListNode:
+		return "[]" + mType.NativeType
+	default:
+		return mType.NativeType
+	}
+}
+
 // writeHeader parses the yangFiles from the includePaths, and fills the given
 // *GeneratedPathCode with the header of the generated Go path code.
 func writeHeader(yangFiles, includePaths []string, packageName string, cg *GenConfig, genCode *GeneratedPathCode) error {
@@ -719,6 +929,7 @@ func writeHeader(yangFiles, includePaths []string, packageName string, cg *GenCo
 		PathStructInterfaceName string   // PathStructInterfaceName is the name of the interface which all path structs implement.
 		FakeRootTypeName        string   // FakeRootTypeName is the type name of the fakeroot node in the generated code.
 		ExtraImports            []string // ExtraImports for path structs that are in a different package.
+		ImportFmt               bool     // ImportFmt determines whether the fmt package is used by the generated accessors.
 	}{
 		GoImports:               cg.GoImports,
 		PackageName:             packageName,
@@ -735,6 +946,11 @@ func writeHeader(yangFiles, includePaths []string, packageName string, cg *GenCo
 		s.ExtraImports = append(s.ExtraImports, fmt.Sprintf("%s/%s", cg.BaseImportPath, dep))
 	}
 	sort.Slice(s.ExtraImports, func(i, j int) bool { return s.ExtraImports[i] < s.ExtraImports[j] })
+	for _, snippet := range genCode.Structs {
+		if snippet.Accessors != "" {
+			s.ImportFmt = true
+		}
+	}
 
 	var common strings.Builder
 	if err := goPathCommonHeaderTemplate.Execute(&common, s); err != nil {
@@ -814,7 +1030,7 @@ type goPathFieldData struct {
 // node, and directories is a map from path to a parsed schema node for all
 // directory nodes in the schema.
 func generateDirectorySnippet(directory *ygen.ParsedDirectory, directories map[string]*ygen.ParsedDirectory, schemaStructPkgAccessor, pathStructSuffix string, listBuilderKeyThreshold uint,
-	generateWildcardPaths, simplifyWildcardPaths, splitByModule bool, pkgName, pkgSuffix, trimPkgPrefix string) ([]GoPathStructCodeSnippet, util.Errors) {
+	generateWildcardPaths, simplifyWildcardPaths, generateKeylessListPaths, splitByModule bool, pkgName, pkgSuffix, trimPkgPrefix string) ([]GoPathStructCodeSnippet, util.Errors) {
 
 	var errs util.Errors
 	// structBuf is used to store the code associated with the struct defined for
@@ -868,7 +1084,7 @@ func generateDirectorySnippet(directory *ygen.ParsedDirectory, directories map[s
 			}
 		}
 
-		if es := generateChildConstructors(&methodBuf, buildBuf, directory, fName, goFieldName, directories, schemaStructPkgAccessor, pathStructSuffix, listBuilderKeyThreshold, generateWildcardPaths, simplifyWildcardPaths, childPkgAccessor); es != nil {
+		if es := generateChildConstructors(&methodBuf, buildBuf, directory, fName, goFieldName, directories, schemaStructPkgAccessor, pathStructSuffix, listBuilderKeyThreshold, generateWildcardPaths, simplifyWildcardPaths, generateKeylessListPaths, childPkgAccessor); es != nil {
 			errs = util.AppendErrs(errs, es)
 		}
 
@@ -930,8 +1146,9 @@ func generateDirectorySnippet(directory *ygen.ParsedDirectory, directories map[s
 // of the directory identifying the child yang.Entry, a directory-level unique
 // field name to be used as the generated method's name and the incremental
 // type name of of the child path struct, and a map of all directories of the
-// whole schema keyed by their schema paths.
-func generateChildConstructors(methodBuf *strings.Builder, builderBuf *strings.Builder, directory *ygen.ParsedDirectory, directoryFieldName string, goFieldName string, directories map[string]*ygen.ParsedDirectory, schemaStructPkgAccessor, pathStructSuffix string, listBuilderKeyThreshold uint, generateWildcardPaths, simplifyWildcardPaths bool, childPkgAccessor string) []error {
+// whole schema keyed by their schema paths. If generateKeylessListPaths is set,
+// child constructors are also generated for lists without keys.
+func generateChildConstructors(methodBuf *strings.Builder, builderBuf *strings.Builder, directory *ygen.ParsedDirectory, directoryFieldName string, goFieldName string, directories map[string]*ygen.ParsedDirectory, schemaStructPkgAccessor, pathStructSuffix string, listBuilderKeyThreshold uint, generateWildcardPaths, simplifyWildcardPaths, generateKeylessListPaths bool, childPkgAccessor string) []error {
 	field, ok := directory.Fields[directoryFieldName]
 	if !ok {
 		return []error{fmt.Errorf("generateChildConstructors: field %s not found in directory %v", directoryFieldName, directory)}
@@ -985,11 +1202,14 @@ func generateChildConstructors(methodBuf *strings.Builder, builderBuf *strings.B
 	switch {
 	case field.Type != ygen.ListNode:
 		return generateChildConstructorsForLeafOrContainer(methodBuf, fieldData, isUnderFakeRoot, generateWildcardPaths)
+	case len(fieldDirectory.ListKeys) == 0 && generateKeylessListPaths:
+		// Keyless lists as a path are not supported by gNMI, so when requested,
+		// the entries of the list are addressed by their index using the
+		// pseudo key that ygot uses when rendering such lists.
+		return generateChildConstructorsForKeyParams(methodBuf, []keyParam{keylessListIndexParam}, fieldData, isUnderFakeRoot, generateWildcardPaths, simplifyWildcardPaths)
 	case len(fieldDirectory.ListKeys) == 0:
-		// TODO(wenbli): keyless lists as a path are not supported by gNMI, but this
-		// library is currently intended for gNMI, so need to decide on a long-term solution.
-
-		// As a short-term solution, we just need to prevent the user from accessing any node in the keyless list's subtree.
+		// Keyless lists as a path are not supported by gNMI, so by default we
+		// prevent the user from accessing any node in the keyless list's subtree.
 		// Here, we simply skip generating the child constructor, such that its subtree is unreachable.
 		return nil
 		// Erroring out, on the other hand, is impractical due to their existence in the current OpenConfig models.
@@ -1109,12 +1329,28 @@ func generateChildConstructorsForListBuilderFormat(methodBuf *strings.Builder, b
 // container (which contains a subset of the basic information required for
 // the list constructor methods).
 func generateChildConstructorsForList(methodBuf *strings.Builder, keys map[string]*ygen.ListKey, keyNames []string, fieldData goPathFieldData, isUnderFakeRoot, generateWildcardPaths, simplifyWildcardPaths bool, schemaStructPkgAccessor string) []error {
-	var errors []error
 	// List of function parameters as would appear in the method definition.
 	keyParams, err := makeKeyParams(keys, keyNames, schemaStructPkgAccessor)
 	if err != nil {
-		return append(errors, err)
+		return []error{err}
 	}
+	return generateChildConstructorsForKeyParams(methodBuf, keyParams, fieldData, isUnderFakeRoot, generateWildcardPaths, simplifyWildcardPaths)
+}
+
+// keylessListIndexParam is the key parameter used to address an entry of a
+// keyless list by its index.
+var keylessListIndexParam = keyParam{
+	name:          ygot.KeylessListIndexKey,
+	varName:       "Index",
+	typeName:      "int",
+	typeDocString: "int",
+}
+
+// generateChildConstructorsForKeyParams writes into methodBuf the child
+// constructor method snippets for a list whose entries are identified by the
+// supplied key parameters.
+func generateChildConstructorsForKeyParams(methodBuf *strings.Builder, keyParams []keyParam, fieldData goPathFieldData, isUnderFakeRoot, generateWildcardPaths, simplifyWildcardPaths bool) []error {
+	var errors []error
 	keyN := len(keyParams)
 	combos := combinations(keyN)
 
@@ -1212,6 +1448,224 @@ func generateChildConstructorsForList(methodBuf *strings.Builder, keys map[strin
 	return errors
 }
 
+// accessorParent identifies the GoStruct field within which the GoStruct of
+// a directory is stored.
+type accessorParent struct {
+	// dir is the directory of the parent GoStruct.
+	dir *ygen.ParsedDirectory
+	// fieldName is the name of the field within the parent directory.
+	fieldName string
+}
+
+// getAccessorParents returns a map, keyed by schema path, of the parents of
+// each directory for which accessors can be generated. These are the
+// directories reachable from the fakeroot without traversing a keyless list,
+// since the entries of a keyless list cannot be identified within their
+// GoStruct slice by a path struct.
+func getAccessorParents(directories map[string]*ygen.ParsedDirectory) map[string]accessorParent {
+	parents := map[string]accessorParent{}
+	var walk func(*ygen.ParsedDirectory)
+	walk = func(dir *ygen.ParsedDirectory) {
+		for fName, field := range dir.Fields {
+			if field.Type != ygen.ContainerNode && field.Type != ygen.ListNode {
+				continue
+			}
+			child, ok := directories[field.YANGDetails.Path]
+			if !ok || (field.Type == ygen.ListNode && len(child.ListKeys) == 0) {
+				continue
+			}
+			parents[field.YANGDetails.Path] = accessorParent{dir: dir, fieldName: fName}
+			walk(child)
+		}
+	}
+	for _, dir := range directories {
+		if dir.IsFakeRoot {
+			walk(dir)
+		}
+	}
+	return parents
+}
+
+// goPathAccessorData stores template information needed to generate the
+// accessors of a path struct.
+type goPathAccessorData struct {
+	TypeName         string              // TypeName is the type name of the path struct.
+	YANGPath         string              // YANGPath is the schema path of the node.
+	YANGNodeType     string              // YANGNodeType is the type of YANG node for the node (e.g. "list", "container", "leaf").
+	RootType         string              // RootType is the type name of the fakeroot GoStruct.
+	ParentTypeName   string              // ParentTypeName is the type name of the parent path struct, or empty if the parent is the fakeroot.
+	ParentStructType string              // ParentStructType is the type name of the GoStruct containing the node.
+	FieldName        string              // FieldName is the name of the field storing the node within its parent GoStruct.
+	GoType           string              // GoType is the type of the node, without any pointer of a GoStruct or a scalar leaf.
+	MapKeyType       string              // MapKeyType is the key type of the GoStruct map storing a list.
+	KeyStructType    string              // KeyStructType is the type name of the key struct of a list with multiple keys.
+	Keys             []goPathAccessorKey // Keys are the keys of a list, in schema order.
+	IsScalarField    bool                // IsScalarField indicates whether a leaf is stored as a pointer within its GoStruct.
+	IsListKey        bool                // IsListKey indicates whether a leaf is a key of its list, for which no Set accessor is generated.
+	AbsentCond       string              // AbsentCond is the condition under which a leaf is not set within its GoStruct.
+}
+
+// goPathAccessorKey stores template information about a list key needed to
+// generate the accessors of a list path struct.
+type goPathAccessorKey struct {
+	Name          string // Name is the schema name of the key.
+	TypeName      string // TypeName is the type of the key value within the path struct.
+	KeyField      string // KeyField is the name of the key within the key struct of a multi-keyed list.
+	ListField     string // ListField is the name of the key within the list GoStruct.
+	IsScalarField bool   // IsScalarField indicates whether the key is stored as a pointer within the list GoStruct.
+}
+
+// generateAccessors generates the Get and Set accessors of the path struct of
+// a directory and of each of its leaves, which access the corresponding node
+// within a GoStruct data tree. Only a Get accessor is generated for the key
+// leaves of a list, which are set along with their list entry. parents is the
+// map returned by getAccessorParents; no accessors are generated for a
+// directory that is not within it, other than the fakeroot, which only has
+// accessors generated for its leaves.
+func generateAccessors(directory *ygen.ParsedDirectory, parents map[string]accessorParent, directories map[string]*ygen.ParsedDirectory, schemaStructPkgAccessor, pathStructSuffix, fakeRootName string) (string, util.Errors) {
+	var parent accessorParent
+	if !directory.IsFakeRoot {
+		var ok bool
+		if parent, ok = parents[directory.Path]; !ok {
+			return "", nil
+		}
+	}
+
+	var errs util.Errors
+	var buf strings.Builder
+	rootType := schemaStructPkgAccessor + yang.CamelCase(fakeRootName)
+	goFieldNameMap := ygen.GoFieldNameMap(directory)
+	if !directory.IsFakeRoot {
+		// The accessor methods would collide with the child constructors of
+		// children of the same name.
+		for _, name := range []string{"Get", "Set"} {
+			for fName, goFieldName := range goFieldNameMap {
+				if goFieldName == name {
+					return "", util.NewErrs(fmt.Errorf("generateAccessors: cannot generate %s accessor of %s, which has a child %s of the same name", name, directory.Path, fName))
+				}
+			}
+		}
+
+		data := goPathAccessorData{
+			TypeName:         directory.Name + pathStructSuffix,
+			YANGPath:         directory.Path,
+			YANGNodeType:     "container",
+			RootType:         rootType,
+			ParentStructType: schemaStructPkgAccessor + parent.dir.Name,
+			FieldName:        ygen.GoFieldNameMap(parent.dir)[parent.fieldName],
+			GoType:           schemaStructPkgAccessor + directory.Name,
+		}
+		if !parent.dir.IsFakeRoot {
+			data.ParentTypeName = parent.dir.Name + pathStructSuffix
+		}
+		if directory.Type == ygen.List {
+			data.YANGNodeType = "list"
+			if err := populateAccessorKeys(&data, directory, parent, directories, schemaStructPkgAccessor); err != nil {
+				return "", util.NewErrs(err)
+			}
+		}
+		if err := goPathParentStructTemplate.Execute(&buf, data); err != nil {
+			return "", util.NewErrs(err)
+		}
+		if err := goPathDirectoryAccessorsTemplate.Execute(&buf, data); err != nil {
+			return "", util.NewErrs(err)
+		}
+	}
+
+	for _, fName := range directory.OrderedFieldNames() {
+		field := directory.Fields[fName]
+		if field.Type != ygen.LeafNode && field.Type != ygen.LeafListNode {
+			continue
+		}
+		goFieldName := goFieldNameMap[fName]
+		leafTypeName, err := getFieldTypeName(directory, fName, goFieldName, directories, pathStructSuffix)
+		if err != nil {
+			errs = util.AppendErr(errs, err)
+			continue
+		}
+		data := goPathAccessorData{
+			TypeName:         leafTypeName,
+			YANGPath:         field.YANGDetails.Path,
+			YANGNodeType:     "leaf",
+			RootType:         rootType,
+			ParentStructType: schemaStructPkgAccessor + directory.Name,
+			FieldName:        goFieldName,
+			GoType:           leafGoTypeName(field, schemaStructPkgAccessor),
+			IsScalarField:    gogen.IsScalarField(field),
+			AbsentCond:       fmt.Sprintf("s.%s == nil", goFieldName),
+		}
+		if directory.Type == ygen.List {
+			_, data.IsListKey = directory.ListKeys[fName]
+		}
+		if !directory.IsFakeRoot {
+			data.ParentTypeName = directory.Name + pathStructSuffix
+		}
+		switch {
+		case field.Type == ygen.LeafListNode:
+			data.YANGNodeType = "leaf-list"
+		case field.LangType.IsEnumeratedValue:
+			data.AbsentCond = fmt.Sprintf("s.%s == 0", goFieldName)
+		case field.LangType.NativeType == ygot.EmptyTypeName:
+			data.AbsentCond = fmt.Sprintf("!s.%s", goFieldName)
+		}
+		if err := goPathParentStructTemplate.Execute(&buf, data); err != nil {
+			errs = util.AppendErr(errs, err)
+		}
+		if err := goPathLeafAccessorsTemplate.Execute(&buf, data); err != nil {
+			errs = util.AppendErr(errs, err)
+		}
+	}
+
+	if len(errs) != 0 {
+		return "", errs
+	}
+	return buf.String(), nil
+}
+
+// populateAccessorKeys populates the key information of the accessor
+// template data of a list directory. The key struct type of a multi-keyed
+// list is named in the same way as it is by ygen's Go code generation.
+func populateAccessorKeys(data *goPathAccessorData, directory *ygen.ParsedDirectory, parent accessorParent, directories map[string]*ygen.ParsedDirectory, schemaStructPkgAccessor string) error {
+	keyParams, err := makeKeyParams(directory.ListKeys, directory.ListKeyYANGNames, schemaStructPkgAccessor)
+	if err != nil {
+		return err
+	}
+	listFieldNameMap := ygen.GoFieldNameMap(directory)
+	for _, kp := range keyParams {
+		keyField, ok := directory.Fields[kp.name]
+		if !ok {
+			return fmt.Errorf("populateAccessorKeys: key %q not found in fields of list %s", kp.name, directory.Path)
+		}
+		data.Keys = append(data.Keys, goPathAccessorKey{
+			Name:          kp.name,
+			TypeName:      kp.typeName,
+			KeyField:      kp.varName,
+			ListField:     listFieldNameMap[kp.name],
+			IsScalarField: gogen.IsScalarField(keyField),
+		})
+	}
+
+	if len(data.Keys) == 1 {
+		mType := directory.ListKeys[directory.ListKeyYANGNames[0]].LangType
+		data.MapKeyType = mType.NativeType
+		if ygen.IsYgenDefinedGoType(mType) {
+			data.MapKeyType = schemaStructPkgAccessor + mType.NativeType
+		}
+		return nil
+	}
+
+	keyStructName := directory.Name + "_Key"
+	for _, d := range directories {
+		if d.Name == keyStructName {
+			keyStructName = fmt.Sprintf("%s_%s_YANGListKey", parent.dir.Name, ygen.GoFieldNameMap(parent.dir)[parent.fieldName])
+			break
+		}
+	}
+	data.KeyStructType = schemaStructPkgAccessor + keyStructName
+	data.MapKeyType = data.KeyStructType
+	return nil
+}
+
 // getFieldTypeName returns the type name for a field node of a directory -
 // handling the case where the field supplied is a leaf or directory. The input
 // directories is a map from paths to directory entries, and goFieldName is the
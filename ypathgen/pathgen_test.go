@@ -62,6 +62,8 @@ func TestGeneratePathCode(t *testing.T) {
 		inSchemaStructPkgPath   string
 		inPathStructSuffix      string
 		inSimplifyWildcardPaths bool
+		// inGenerateAccessors determines whether Get and Set accessors are generated.
+		inGenerateAccessors bool
 		// checkYANGPath says whether to check for the YANG path in the NodeDataMap.
 		checkYANGPath bool
 		// wantStructsCodeFile is the path of the generated Go code that the output of the test should be compared to.
@@ -427,6 +429,28 @@ func TestGeneratePathCode(t *testing.T) {
 		inSchemaStructPkgPath:                  "",
 		inPathStructSuffix:                     "Path",
 		wantStructsCodeFile:                    filepath.Join(TestRoot, "testdata/structs/openconfig-withlist.builder.path-txt"),
+	}, {
+		name:                                   "simple openconfig test with list in separate package with accessors",
+		inFiles:                                []string{filepath.Join(datapath, "openconfig-withlist.yang")},
+		inPreferOperationalState:               true,
+		inShortenEnumLeafNames:                 true,
+		inUseDefiningModuleForTypedefEnumNames: true,
+		inGenerateWildcardPaths:                false,
+		inSchemaStructPkgPath:                  "github.com/openconfig/ygot/ypathgen/testdata/exampleoc",
+		inPathStructSuffix:                     "Path",
+		inGenerateAccessors:                    true,
+		wantStructsCodeFile:                    filepath.Join(TestRoot, "testdata/structs/openconfig-withlist.accessors.path-txt"),
+	}, {
+		name:                                   "simple openconfig test with union & typedef & identity & enum with accessors",
+		inFiles:                                []string{filepath.Join(datapath, "openconfig-unione.yang")},
+		inPreferOperationalState:               true,
+		inShortenEnumLeafNames:                 true,
+		inUseDefiningModuleForTypedefEnumNames: true,
+		inGenerateWildcardPaths:                false,
+		inSchemaStructPkgPath:                  "",
+		inPathStructSuffix:                     "Path",
+		inGenerateAccessors:                    true,
+		wantStructsCodeFile:                    filepath.Join(TestRoot, "testdata/structs/openconfig-unione.accessors.path-txt"),
 	}, {
 		name:                                   "simple openconfig test with union & typedef & identity & enum",
 		inFiles:                                []string{filepath.Join(datapath, "openconfig-unione.yang")},
@@ -952,6 +976,7 @@ func TestGeneratePathCode(t *testing.T) {
 				cg.UseDefiningModuleForTypedefEnumNames = tt.inUseDefiningModuleForTypedefEnumNames
 				cg.GenerateWildcardPaths = tt.inGenerateWildcardPaths
 				cg.SimplifyWildcardPaths = tt.inSimplifyWildcardPaths
+				cg.GenerateAccessors = tt.inGenerateAccessors
 				cg.PackageName = "ocstructs"
 
 				gotCode, gotNodeDataMap, err := cg.GeneratePathCode(tt.inFiles, tt.inIncludePaths)
@@ -2725,7 +2750,7 @@ func (n *ListWithStatePathAny) WithKey(Key float64) *ListWithStatePathAny {
 	for _, tt := range tests {
 		if tt.want != nil {
 			t.Run(tt.name, func(t *testing.T) {
-				got, gotErr := generateDirectorySnippet(tt.inDirectory, directories, "oc.", tt.inPathStructSuffix, tt.inListBuilderKeyThreshold, true, false, tt.inSplitByModule, tt.inPackageName, tt.inPackageSuffix, "")
+				got, gotErr := generateDirectorySnippet(tt.inDirectory, directories, "oc.", tt.inPathStructSuffix, tt.inListBuilderKeyThreshold, true, false, false, tt.inSplitByModule, tt.inPackageName, tt.inPackageSuffix, "")
 				if gotErr != nil {
 					t.Fatalf("func generateDirectorySnippet, unexpected error: %v", gotErr)
 				}
@@ -2741,7 +2766,7 @@ func (n *ListWithStatePathAny) WithKey(Key float64) *ListWithStatePathAny {
 
 		if tt.wantNoWildcard != nil {
 			t.Run(tt.name+" no wildcard", func(t *testing.T) {
-				got, gotErr := generateDirectorySnippet(tt.inDirectory, directories, "oc.", tt.inPathStructSuffix, tt.inListBuilderKeyThreshold, false, false, tt.inSplitByModule, tt.inPackageName, tt.inPackageSuffix, "")
+				got, gotErr := generateDirectorySnippet(tt.inDirectory, directories, "oc.", tt.inPathStructSuffix, tt.inListBuilderKeyThreshold, false, false, false, tt.inSplitByModule, tt.inPackageName, tt.inPackageSuffix, "")
 				if gotErr != nil {
 					t.Fatalf("func generateDirectorySnippet, unexpected error: %v", gotErr)
 				}
@@ -2761,18 +2786,19 @@ func TestGenerateChildConstructor(t *testing.T) {
 	directories := getIR().Directories
 
 	tests := []struct {
-		name                      string
-		inDirectory               *ygen.ParsedDirectory
-		inDirectories             map[string]*ygen.ParsedDirectory
-		inFieldName               string
-		inUniqueFieldName         string
-		inListBuilderKeyThreshold uint
-		inPathStructSuffix        string
-		inGenerateWildcardPaths   bool
-		inSimplifyWildcardPaths   bool
-		inChildAccessor           string
-		testMethodDocComment      bool
-		wantMethod                string
+		name                       string
+		inDirectory                *ygen.ParsedDirectory
+		inDirectories              map[string]*ygen.ParsedDirectory
+		inFieldName                string
+		inUniqueFieldName          string
+		inListBuilderKeyThreshold  uint
+		inPathStructSuffix         string
+		inGenerateWildcardPaths    bool
+		inSimplifyWildcardPaths    bool
+		inGenerateKeylessListPaths bool
+		inChildAccessor            string
+		testMethodDocComment       bool
+		wantMethod                 string
 		// testMethodDocComment determines whether the doc comments for methods are tested.
 		wantListBuilderAPI string
 	}{{
@@ -2900,6 +2926,36 @@ func (n *ContainerWithConfigPathAny) Leaflist() *ContainerWithConfig_LeaflistPat
 		inGenerateWildcardPaths: true,
 		testMethodDocComment:    true,
 		wantMethod:              ``,
+	}, {
+		name:                       "keyless list with index paths",
+		inDirectory:                directories["/root"],
+		inDirectories:              directories,
+		inFieldName:                "keyless-list",
+		inUniqueFieldName:          "KeylessList",
+		inPathStructSuffix:         "Path",
+		inGenerateWildcardPaths:    true,
+		inGenerateKeylessListPaths: true,
+		wantMethod: `
+func (n *RootPath) KeylessListAny() *KeylessListPathAny {
+	return &KeylessListPathAny{
+		NodePath: ygot.NewNodePath(
+			[]string{"keyless-list-container", "keyless-list"},
+			map[string]interface{}{"index": "*"},
+			n,
+		),
+	}
+}
+
+func (n *RootPath) KeylessList(Index int) *KeylessListPath {
+	return &KeylessListPath{
+		NodePath: ygot.NewNodePath(
+			[]string{"keyless-list-container", "keyless-list"},
+			map[string]interface{}{"index": Index},
+			n,
+		),
+	}
+}
+`,
 	}, {
 		name:                    "list with state method",
 		inDirectory:             directories["/root"],
@@ -3010,7 +3066,7 @@ func (n *ListPathAny) WithUnionKey(UnionKey oc.RootElementModule_List_UnionKey_U
 		t.Run(tt.name, func(t *testing.T) {
 			var methodBuf strings.Builder
 			var builderBuf strings.Builder
-			if errs := generateChildConstructors(&methodBuf, &builderBuf, tt.inDirectory, tt.inFieldName, tt.inUniqueFieldName, tt.inDirectories, "oc.", tt.inPathStructSuffix, tt.inListBuilderKeyThreshold, tt.inGenerateWildcardPaths, tt.inSimplifyWildcardPaths, tt.inChildAccessor); errs != nil {
+			if errs := generateChildConstructors(&methodBuf, &builderBuf, tt.inDirectory, tt.inFieldName, tt.inUniqueFieldName, tt.inDirectories, "oc.", tt.inPathStructSuffix, tt.inListBuilderKeyThreshold, tt.inGenerateWildcardPaths, tt.inSimplifyWildcardPaths, tt.inGenerateKeylessListPaths, tt.inChildAccessor); errs != nil {
 				t.Fatal(errs)
 			}
 
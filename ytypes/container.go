@@ -145,6 +145,14 @@ func unmarshalContainer(schema *yang.Entry, parent interface{}, jsonTree interfa
 //   parent is the parent struct, which must be a struct ptr.
 //   jsonTree is a JSON data tree which must be a map[string]interface{}.
 func unmarshalStruct(schema *yang.Entry, parent interface{}, jsonTree map[string]interface{}, enc Encoding, opts ...UnmarshalOpt) error {
+	if m, ok := parent.(RFC7951Unmarshaler); ok {
+		u := &StructUnmarshaler{schema: schema, parent: parent, jsonTree: jsonTree, enc: enc, opts: opts}
+		if err := m.UnmarshalRFC7951(u); err != nil {
+			return err
+		}
+		return checkStructFields(schema, parent, jsonTree, u.allSchemaPaths, opts)
+	}
+
 	destv := reflect.ValueOf(parent).Elem()
 	var allSchemaPaths [][]string
 
@@ -241,6 +249,19 @@ func unmarshalStruct(schema *yang.Entry, parent interface{}, jsonTree map[string
 		}
 	}
 
+	if err := checkStructFields(schema, parent, jsonTree, allSchemaPaths, opts); err != nil {
+		return err
+	}
+
+	util.DbgPrint("container after unmarshal:\n%s\n", pretty.Sprint(destv.Interface()))
+	return nil
+}
+
+// checkStructFields checks that each of the fields of jsonTree, which has been
+// unmarshalled into the struct parent with the supplied schema, is covered by
+// one of the data paths in allSchemaPaths. The check is skipped if the
+// IgnoreExtraFields option is specified.
+func checkStructFields(schema *yang.Entry, parent interface{}, jsonTree map[string]interface{}, allSchemaPaths [][]string, opts []UnmarshalOpt) error {
 	// Only check for missing fields if the IgnoreExtraFields option isn't specified.
 	if !hasIgnoreExtraFields(opts) {
 		// Go over all JSON fields to make sure that each one is covered
@@ -249,8 +270,6 @@ func unmarshalStruct(schema *yang.Entry, parent interface{}, jsonTree map[string
 			return fmt.Errorf("parent container %s (type %T): %s", schema.Name, parent, err)
 		}
 	}
-
-	util.DbgPrint("container after unmarshal:\n%s\n", pretty.Sprint(destv.Interface()))
 	return nil
 }
 
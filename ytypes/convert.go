@@ -0,0 +1,145 @@
+// Copyright 2021 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ytypes
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+
+	"github.com/openconfig/goyang/pkg/yang"
+	"github.com/openconfig/ygot/util"
+	"github.com/openconfig/ygot/ygot"
+
+	gpb "github.com/openconfig/gnmi/proto/gnmi"
+)
+
+// ConvertGoStruct populates the GoStruct dst, whose schema is supplied, with
+// the data contained within the GoStruct src. src and dst are expected to be
+// generated from the same set of YANG modules, but may be generated with
+// different compression behaviours - such that one is a compressed GoStruct,
+// and the other is uncompressed. Data is mapped between the two GoStructs
+// according to the path of each leaf within the YANG data tree, as described by
+// the "path" and "shadow-path" tags of their fields. Existing contents of dst
+// that are not overwritten by data in src are retained.
+//
+// The paths of the leaves within src that cannot be represented in dst are
+// returned. Such leaves are those whose path does not correspond to a field in
+// dst, or corresponds only to the "shadow-path" tag of a field, such as the
+// state leaves of an uncompressed GoStruct when converted to a compressed
+// GoStruct that prefers intended configuration. The returned paths are sorted
+// and are relative to src.
+func ConvertGoStruct(src ygot.GoStruct, schema *yang.Entry, dst ygot.GoStruct) ([]*gpb.Path, error) {
+	switch {
+	case util.IsValueNil(src):
+		return nil, fmt.Errorf("nil source GoStruct supplied")
+	case util.IsValueNil(dst):
+		return nil, fmt.Errorf("nil destination GoStruct supplied")
+	case schema == nil:
+		return nil, fmt.Errorf("nil schema supplied for %T", dst)
+	}
+
+	ns, err := ygot.TogNMINotifications(src, 0, ygot.GNMINotificationsConfig{
+		UsePathElem:     true,
+		KeylessListMode: ygot.KeylessListIndexKeys,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("cannot determine the leaves of %T: %v", src, err)
+	}
+
+	var upds []*gpb.Update
+	for _, n := range ns {
+		upds = append(upds, n.GetUpdate()...)
+	}
+	// Entries of keyless lists can only be appended in order of their index,
+	// hence updates are sorted such that list entries are created in order.
+	sort.Slice(upds, func(i, j int) bool {
+		return ygot.ComparePaths(upds[i].GetPath(), upds[j].GetPath()) < 0
+	})
+
+	var (
+		errs          util.Errors
+		unrepresented []*gpb.Path
+	)
+	dt := reflect.TypeOf(dst)
+	for _, u := range upds {
+		if !pathRepresentable(dt, u.GetPath().GetElem()) {
+			unrepresented = append(unrepresented, u.GetPath())
+			continue
+		}
+		if err := SetNode(schema, dst, u.GetPath(), u.GetVal(), &InitMissingElements{}); err != nil {
+			errs = util.AppendErr(errs, fmt.Errorf("cannot set %s in %T: %v", pathString(u.GetPath()), dst, err))
+		}
+	}
+	if errs != nil {
+		return nil, errs
+	}
+	return unrepresented, nil
+}
+
+// pathRepresentable reports whether the data tree path p, relative to a
+// GoStruct of type t, corresponds to a field of the GoStruct or one of its
+// descendants. Paths that correspond only to the "shadow-path" tag of a field
+// are not represented, since the value of the shadow path is not stored
+// within the GoStruct.
+func pathRepresentable(t reflect.Type, p []*gpb.PathElem) bool {
+	if len(p) == 0 {
+		return true
+	}
+	if !util.IsTypeStructPtr(t) {
+		return false
+	}
+	t = t.Elem()
+
+	path := &gpb.Path{Elem: p}
+	for i := 0; i < t.NumField(); i++ {
+		ft := t.Field(i)
+		if util.IsYgotAnnotation(ft) {
+			continue
+		}
+		schPaths, err := util.SchemaPaths(ft)
+		if err != nil {
+			continue
+		}
+		for _, sp := range schPaths {
+			if !util.PathMatchesPrefix(path, sp) {
+				continue
+			}
+			rest := p[len(sp):]
+			switch {
+			case util.IsTypeMap(ft.Type), isKeylessList(ft.Type):
+				// The last element of the matched path identifies
+				// the list entry, such that the remaining path is
+				// relative to the entry.
+				return pathRepresentable(ft.Type.Elem(), rest)
+			case util.IsTypeStructPtr(ft.Type):
+				return pathRepresentable(ft.Type, rest)
+			default:
+				return len(rest) == 0
+			}
+		}
+	}
+	return false
+}
+
+// pathString returns a human-readable representation of the path p for use
+// in error messages.
+func pathString(p *gpb.Path) string {
+	s, err := ygot.PathToString(p)
+	if err != nil {
+		return p.String()
+	}
+	return s
+}
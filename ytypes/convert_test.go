@@ -0,0 +1,349 @@
+// Copyright 2021 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ytypes
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/openconfig/gnmi/errdiff"
+	"github.com/openconfig/goyang/pkg/yang"
+	"github.com/openconfig/ygot/ygot"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	gpb "github.com/openconfig/gnmi/proto/gnmi"
+)
+
+// convURoot and its descendants are uncompressed GoStructs for the schema
+// returned by convSchema.
+type convURoot struct {
+	Interfaces *convUInterfaces `path:"interfaces"`
+	Logs       *convULogs       `path:"logs"`
+}
+
+func (*convURoot) IsYANGGoStruct()                          {}
+func (*convURoot) ΛValidate(...ygot.ValidationOption) error { return nil }
+func (*convURoot) ΛEnumTypeMap() map[string][]reflect.Type  { return nil }
+func (*convURoot) ΛBelongingModule() string                 { return "" }
+
+type convUInterfaces struct {
+	Interface map[string]*convUInterface `path:"interface"`
+}
+
+func (*convUInterfaces) IsYANGGoStruct()                          {}
+func (*convUInterfaces) ΛValidate(...ygot.ValidationOption) error { return nil }
+func (*convUInterfaces) ΛEnumTypeMap() map[string][]reflect.Type  { return nil }
+func (*convUInterfaces) ΛBelongingModule() string                 { return "" }
+
+type convUInterface struct {
+	Name   *string               `path:"name"`
+	Config *convUInterfaceConfig `path:"config"`
+	State  *convUInterfaceState  `path:"state"`
+}
+
+func (*convUInterface) IsYANGGoStruct()                          {}
+func (*convUInterface) ΛValidate(...ygot.ValidationOption) error { return nil }
+func (*convUInterface) ΛEnumTypeMap() map[string][]reflect.Type  { return nil }
+func (*convUInterface) ΛBelongingModule() string                 { return "" }
+
+func (i *convUInterface) ΛListKeyMap() (map[string]interface{}, error) {
+	return map[string]interface{}{"name": *i.Name}, nil
+}
+
+type convUInterfaceConfig struct {
+	Name *string `path:"name"`
+	Mtu  *uint16 `path:"mtu"`
+}
+
+func (*convUInterfaceConfig) IsYANGGoStruct()                          {}
+func (*convUInterfaceConfig) ΛValidate(...ygot.ValidationOption) error { return nil }
+func (*convUInterfaceConfig) ΛEnumTypeMap() map[string][]reflect.Type  { return nil }
+func (*convUInterfaceConfig) ΛBelongingModule() string                 { return "" }
+
+type convUInterfaceState struct {
+	Name    *string `path:"name"`
+	Mtu     *uint16 `path:"mtu"`
+	Counter *uint64 `path:"counter"`
+}
+
+func (*convUInterfaceState) IsYANGGoStruct()                          {}
+func (*convUInterfaceState) ΛValidate(...ygot.ValidationOption) error { return nil }
+func (*convUInterfaceState) ΛEnumTypeMap() map[string][]reflect.Type  { return nil }
+func (*convUInterfaceState) ΛBelongingModule() string                 { return "" }
+
+type convULogs struct {
+	Log []*convLog `path:"log"`
+}
+
+func (*convULogs) IsYANGGoStruct()                          {}
+func (*convULogs) ΛValidate(...ygot.ValidationOption) error { return nil }
+func (*convULogs) ΛEnumTypeMap() map[string][]reflect.Type  { return nil }
+func (*convULogs) ΛBelongingModule() string                 { return "" }
+
+type convLog struct {
+	Msg *string `path:"msg"`
+}
+
+func (*convLog) IsYANGGoStruct()                          {}
+func (*convLog) ΛValidate(...ygot.ValidationOption) error { return nil }
+func (*convLog) ΛEnumTypeMap() map[string][]reflect.Type  { return nil }
+func (*convLog) ΛBelongingModule() string                 { return "" }
+
+// convCRoot and its descendants are compressed GoStructs for the schema
+// returned by convSchema, which exclude state-only leaves.
+type convCRoot struct {
+	Interface map[string]*convCInterface `path:"interfaces/interface"`
+	Log       []*convLog                 `path:"logs/log"`
+}
+
+func (*convCRoot) IsYANGGoStruct()                          {}
+func (*convCRoot) ΛValidate(...ygot.ValidationOption) error { return nil }
+func (*convCRoot) ΛEnumTypeMap() map[string][]reflect.Type  { return nil }
+func (*convCRoot) ΛBelongingModule() string                 { return "" }
+
+type convCInterface struct {
+	Name *string `path:"config/name|name" shadow-path:"state/name"`
+	Mtu  *uint16 `path:"config/mtu" shadow-path:"state/mtu"`
+}
+
+func (*convCInterface) IsYANGGoStruct()                          {}
+func (*convCInterface) ΛValidate(...ygot.ValidationOption) error { return nil }
+func (*convCInterface) ΛEnumTypeMap() map[string][]reflect.Type  { return nil }
+func (*convCInterface) ΛBelongingModule() string                 { return "" }
+
+func (i *convCInterface) ΛListKeyMap() (map[string]interface{}, error) {
+	return map[string]interface{}{"name": *i.Name}, nil
+}
+
+func convSchema() *yang.Entry {
+	leaf := func(name string, k yang.TypeKind) *yang.Entry {
+		return &yang.Entry{Name: name, Kind: yang.LeafEntry, Type: &yang.YangType{Kind: k}}
+	}
+	s := &yang.Entry{
+		Name: "device",
+		Kind: yang.DirectoryEntry,
+		Annotation: map[string]interface{}{
+			"isFakeRoot": true,
+		},
+		Dir: map[string]*yang.Entry{
+			"interfaces": {
+				Name: "interfaces",
+				Kind: yang.DirectoryEntry,
+				Dir: map[string]*yang.Entry{
+					"interface": {
+						Name:     "interface",
+						Kind:     yang.DirectoryEntry,
+						ListAttr: yang.NewDefaultListAttr(),
+						Key:      "name",
+						Dir: map[string]*yang.Entry{
+							"name": leaf("name", yang.Ystring),
+							"config": {
+								Name: "config",
+								Kind: yang.DirectoryEntry,
+								Dir: map[string]*yang.Entry{
+									"name": leaf("name", yang.Ystring),
+									"mtu":  leaf("mtu", yang.Yuint16),
+								},
+							},
+							"state": {
+								Name: "state",
+								Kind: yang.DirectoryEntry,
+								Dir: map[string]*yang.Entry{
+									"name":    leaf("name", yang.Ystring),
+									"mtu":     leaf("mtu", yang.Yuint16),
+									"counter": leaf("counter", yang.Yuint64),
+								},
+							},
+						},
+					},
+				},
+			},
+			"logs": {
+				Name: "logs",
+				Kind: yang.DirectoryEntry,
+				Dir: map[string]*yang.Entry{
+					"log": {
+						Name:     "log",
+						Kind:     yang.DirectoryEntry,
+						ListAttr: yang.NewDefaultListAttr(),
+						Dir: map[string]*yang.Entry{
+							"msg": leaf("msg", yang.Ystring),
+						},
+					},
+				},
+			},
+		},
+	}
+	addParents(s)
+	return s
+}
+
+// convLogs returns a keyless list of n log entries.
+func convLogs(n int) []*convLog {
+	var l []*convLog
+	for i := 0; i < n; i++ {
+		l = append(l, &convLog{Msg: ygot.String(fmt.Sprintf("msg%d", i))})
+	}
+	return l
+}
+
+func TestConvertGoStruct(t *testing.T) {
+	tests := []struct {
+		name               string
+		inSrc              ygot.GoStruct
+		inDst              ygot.GoStruct
+		want               ygot.GoStruct
+		wantUnrepresented  []*gpb.Path
+		wantErrSubstring   string
+		inSchemaUnsupplied bool
+	}{{
+		name: "uncompressed to compressed",
+		inSrc: &convURoot{
+			Interfaces: &convUInterfaces{
+				Interface: map[string]*convUInterface{
+					"eth0": {
+						Name: ygot.String("eth0"),
+						Config: &convUInterfaceConfig{
+							Name: ygot.String("eth0"),
+							Mtu:  ygot.Uint16(1500),
+						},
+						State: &convUInterfaceState{
+							Name:    ygot.String("eth0"),
+							Mtu:     ygot.Uint16(9000),
+							Counter: ygot.Uint64(42),
+						},
+					},
+				},
+			},
+			Logs: &convULogs{Log: convLogs(12)},
+		},
+		inDst: &convCRoot{},
+		want: &convCRoot{
+			Interface: map[string]*convCInterface{
+				"eth0": {
+					Name: ygot.String("eth0"),
+					Mtu:  ygot.Uint16(1500),
+				},
+			},
+			Log: convLogs(12),
+		},
+		wantUnrepresented: []*gpb.Path{
+			mustPath("/interfaces/interface[name=eth0]/state/counter"),
+			mustPath("/interfaces/interface[name=eth0]/state/mtu"),
+			mustPath("/interfaces/interface[name=eth0]/state/name"),
+		},
+	}, {
+		name: "compressed to uncompressed",
+		inSrc: &convCRoot{
+			Interface: map[string]*convCInterface{
+				"eth0": {
+					Name: ygot.String("eth0"),
+					Mtu:  ygot.Uint16(1500),
+				},
+			},
+			Log: convLogs(2),
+		},
+		inDst: &convURoot{},
+		want: &convURoot{
+			Interfaces: &convUInterfaces{
+				Interface: map[string]*convUInterface{
+					"eth0": {
+						Name: ygot.String("eth0"),
+						Config: &convUInterfaceConfig{
+							Name: ygot.String("eth0"),
+							Mtu:  ygot.Uint16(1500),
+						},
+					},
+				},
+			},
+			Logs: &convULogs{Log: convLogs(2)},
+		},
+	}, {
+		name: "existing contents of destination are retained",
+		inSrc: &convURoot{
+			Interfaces: &convUInterfaces{
+				Interface: map[string]*convUInterface{
+					"eth0": {
+						Name: ygot.String("eth0"),
+						Config: &convUInterfaceConfig{
+							Mtu: ygot.Uint16(1500),
+						},
+					},
+				},
+			},
+		},
+		inDst: &convCRoot{
+			Interface: map[string]*convCInterface{
+				"eth0": {
+					Name: ygot.String("eth0"),
+					Mtu:  ygot.Uint16(9000),
+				},
+				"eth1": {
+					Name: ygot.String("eth1"),
+				},
+			},
+		},
+		want: &convCRoot{
+			Interface: map[string]*convCInterface{
+				"eth0": {
+					Name: ygot.String("eth0"),
+					Mtu:  ygot.Uint16(1500),
+				},
+				"eth1": {
+					Name: ygot.String("eth1"),
+				},
+			},
+		},
+	}, {
+		name:             "nil source",
+		inSrc:            (*convURoot)(nil),
+		inDst:            &convCRoot{},
+		wantErrSubstring: "nil source GoStruct",
+	}, {
+		name:             "nil destination",
+		inSrc:            &convURoot{},
+		wantErrSubstring: "nil destination GoStruct",
+	}, {
+		name:               "nil schema",
+		inSrc:              &convURoot{},
+		inDst:              &convCRoot{},
+		inSchemaUnsupplied: true,
+		wantErrSubstring:   "nil schema",
+	}}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			schema := convSchema()
+			if tt.inSchemaUnsupplied {
+				schema = nil
+			}
+			got, err := ConvertGoStruct(tt.inSrc, schema, tt.inDst)
+			if diff := errdiff.Substring(err, tt.wantErrSubstring); diff != "" {
+				t.Fatalf("ConvertGoStruct(%v, %v): did not get expected error, %s", tt.inSrc, tt.inDst, diff)
+			}
+			if err != nil {
+				return
+			}
+			if diff := cmp.Diff(tt.wantUnrepresented, got, protocmp.Transform()); diff != "" {
+				t.Errorf("ConvertGoStruct(%v, %v): did not get expected unrepresented paths, (-want, +got):\n%s", tt.inSrc, tt.inDst, diff)
+			}
+			if diff := cmp.Diff(tt.want, tt.inDst); diff != "" {
+				t.Errorf("ConvertGoStruct(%v, %v): did not get expected destination, (-want, +got):\n%s", tt.inSrc, tt.inDst, diff)
+			}
+		})
+	}
+}
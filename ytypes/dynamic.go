@@ -0,0 +1,534 @@
+// Copyright 2021 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ytypes
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/openconfig/goyang/pkg/yang"
+	"github.com/openconfig/ygot/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	gpb "github.com/openconfig/gnmi/proto/gnmi"
+)
+
+// dynamicKind is the kind of a node within a dynamic data tree.
+type dynamicKind int
+
+const (
+	// dynamicContainer is a container, or the root of the tree.
+	dynamicContainer dynamicKind = iota
+	// dynamicList is a list, whose children are its entries.
+	dynamicList
+	// dynamicListEntry is an entry within a list.
+	dynamicListEntry
+	// dynamicLeaf is a leaf.
+	dynamicLeaf
+	// dynamicLeafList is a leaf-list.
+	dynamicLeafList
+)
+
+// DynamicNode is a node within a data tree that is described by a YANG schema
+// supplied at runtime, rather than by generated GoStructs. The value of a
+// leaf is stored as the Go type that would be used for it by a generated
+// GoStruct, with the exception of enumerations, identityrefs and bits,
+// whose values are stored as their names. The names of identities do not
+// include a module prefix. The value of a union is stored as the type of the
+// member type that it matches, and the value of a leafref as the type of the
+// leaf that it references. The value of an empty leaf is true, and the value
+// of a leaf-list is a []interface{} containing the values of its elements.
+type DynamicNode struct {
+	schema *yang.Entry
+	kind   dynamicKind
+	parent *DynamicNode
+	// children stores the children of a container or a list entry, keyed
+	// by their schema name.
+	children map[string]*DynamicNode
+	// entries stores the entries of a list, in the order they were added.
+	entries []*DynamicNode
+	// value stores the value of a leaf or a leaf-list.
+	value interface{}
+}
+
+// NewDynamicTree returns an empty data tree whose root is described by the
+// supplied schema, which must be a container or the root entry of a module.
+func NewDynamicTree(schema *yang.Entry) (*DynamicNode, error) {
+	switch {
+	case schema == nil:
+		return nil, fmt.Errorf("nil schema supplied")
+	case !schema.IsDir() || schema.IsList() || util.IsChoiceOrCase(schema):
+		return nil, fmt.Errorf("schema %s is not a container", schema.Name)
+	}
+	return newDynamicNode(schema, dynamicContainer, nil), nil
+}
+
+// newDynamicNode returns a node of the supplied kind with the supplied schema
+// and parent.
+func newDynamicNode(schema *yang.Entry, kind dynamicKind, parent *DynamicNode) *DynamicNode {
+	n := &DynamicNode{schema: schema, kind: kind, parent: parent}
+	if kind == dynamicContainer || kind == dynamicListEntry {
+		n.children = map[string]*DynamicNode{}
+	}
+	return n
+}
+
+// dynamicKindOf returns the kind of the node described by the schema e when
+// it is the child of a container or list entry.
+func dynamicKindOf(e *yang.Entry) (dynamicKind, error) {
+	switch {
+	case e.IsLeaf():
+		return dynamicLeaf, nil
+	case e.IsLeafList():
+		return dynamicLeafList, nil
+	case e.IsList():
+		return dynamicList, nil
+	case e.IsContainer():
+		return dynamicContainer, nil
+	}
+	return 0, fmt.Errorf("schema %s has an unsupported kind", e.Name)
+}
+
+// Schema returns the schema of the node. The entries of a list have the
+// schema of the list.
+func (n *DynamicNode) Schema() *yang.Entry {
+	return n.schema
+}
+
+// Value returns the value of a leaf or leaf-list node, or nil for any other
+// kind of node.
+func (n *DynamicNode) Value() interface{} {
+	return n.value
+}
+
+// Parent returns the parent of the node, which is nil for the root.
+func (n *DynamicNode) Parent() *DynamicNode {
+	return n.parent
+}
+
+// Children returns the children of the node. The children of a container or
+// list entry are sorted by name, and the children of a list are its entries.
+func (n *DynamicNode) Children() []*DynamicNode {
+	if n.kind == dynamicList {
+		return append([]*DynamicNode{}, n.entries...)
+	}
+	var out []*DynamicNode
+	for _, name := range n.childNames() {
+		out = append(out, n.children[name])
+	}
+	return out
+}
+
+// childNames returns the sorted names of the children of n.
+func (n *DynamicNode) childNames() []string {
+	var names []string
+	for name := range n.children {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Path returns the gNMI path of the node relative to the root of the tree.
+// The path to a list refers to the list as a whole, and the path to a list
+// entry specifies its keys.
+func (n *DynamicNode) Path() *gpb.Path {
+	var elems []*gpb.PathElem
+	for c := n; c.parent != nil; c = c.parent {
+		switch {
+		case c.kind == dynamicListEntry:
+			elems = append([]*gpb.PathElem{{Name: c.schema.Name, Key: c.keyStrings()}}, elems...)
+		case c.kind == dynamicList && c != n:
+			// The list has been included in the element of its entry.
+		default:
+			elems = append([]*gpb.PathElem{{Name: c.schema.Name}}, elems...)
+		}
+	}
+	return &gpb.Path{Elem: elems}
+}
+
+// String returns the path of the node in the form used in error messages.
+func (n *DynamicNode) String() string {
+	return dynamicPathString(n.Path())
+}
+
+// dynamicPathString renders p as a path string, with keys sorted by name.
+func dynamicPathString(p *gpb.Path) string {
+	var b strings.Builder
+	for _, e := range p.GetElem() {
+		b.WriteString("/" + e.GetName())
+		var ks []string
+		for k := range e.GetKey() {
+			ks = append(ks, k)
+		}
+		sort.Strings(ks)
+		for _, k := range ks {
+			fmt.Fprintf(&b, "[%s=%s]", k, e.GetKey()[k])
+		}
+	}
+	if b.Len() == 0 {
+		return "/"
+	}
+	return b.String()
+}
+
+// keyNames returns the names of the keys of the list described by e.
+func keyNames(e *yang.Entry) []string {
+	return strings.Fields(e.Key)
+}
+
+// keyStrings returns the values of the keys of a list entry as strings, keyed
+// by the key name. Keys that are not set are omitted.
+func (n *DynamicNode) keyStrings() map[string]string {
+	keys := map[string]string{}
+	for _, k := range keyNames(n.schema) {
+		if c, ok := n.children[k]; ok {
+			keys[k] = dynamicValueString(c.value)
+		}
+	}
+	if len(keys) == 0 {
+		return nil
+	}
+	return keys
+}
+
+// dynamicChildSchema returns the schema of the child of e with the supplied
+// name, looking through any choice and case statements, or nil if there is
+// no such child.
+func dynamicChildSchema(e *yang.Entry, name string) *yang.Entry {
+	name = util.StripModulePrefix(name)
+	if c, ok := e.Dir[name]; ok && !util.IsChoiceOrCase(c) {
+		return c
+	}
+	for _, c := range e.Dir {
+		if !util.IsChoiceOrCase(c) {
+			continue
+		}
+		if cc := dynamicChildSchema(c, name); cc != nil {
+			return cc
+		}
+	}
+	return nil
+}
+
+// child returns the child of the container or list entry n with the supplied
+// schema, creating it if create is true and it does not exist.
+func (n *DynamicNode) child(e *yang.Entry, create bool) (*DynamicNode, error) {
+	if c, ok := n.children[e.Name]; ok {
+		return c, nil
+	}
+	if !create {
+		return nil, nil
+	}
+	kind, err := dynamicKindOf(e)
+	if err != nil {
+		return nil, err
+	}
+	c := newDynamicNode(e, kind, n)
+	n.children[e.Name] = c
+	return c, nil
+}
+
+// entry returns the entry of the list n whose keys have the supplied values,
+// creating it if create is true and it does not exist.
+func (n *DynamicNode) entry(keys map[string]interface{}, create bool) (*DynamicNode, error) {
+	for _, en := range n.entries {
+		if en.matchesKeys(keys) {
+			return en, nil
+		}
+	}
+	if !create {
+		return nil, nil
+	}
+	en := newDynamicNode(n.schema, dynamicListEntry, n)
+	for _, k := range keyNames(n.schema) {
+		v, ok := keys[k]
+		if !ok {
+			return nil, fmt.Errorf("missing key %s for list %s", k, n.schema.Name)
+		}
+		ks := dynamicChildSchema(n.schema, k)
+		if ks == nil {
+			return nil, fmt.Errorf("no schema for key %s of list %s", k, n.schema.Name)
+		}
+		kn := newDynamicNode(ks, dynamicLeaf, en)
+		kn.value = v
+		en.children[k] = kn
+	}
+	n.entries = append(n.entries, en)
+	return en, nil
+}
+
+// matchesKeys reports whether the list entry n has the supplied key values.
+func (n *DynamicNode) matchesKeys(keys map[string]interface{}) bool {
+	for k, v := range keys {
+		c, ok := n.children[k]
+		if !ok || !reflect.DeepEqual(c.value, v) {
+			return false
+		}
+	}
+	return true
+}
+
+// remove removes the node n from its parent.
+func (n *DynamicNode) remove() {
+	p := n.parent
+	if p == nil {
+		return
+	}
+	if n.kind == dynamicListEntry {
+		for i, en := range p.entries {
+			if en == n {
+				p.entries = append(p.entries[:i], p.entries[i+1:]...)
+				break
+			}
+		}
+		return
+	}
+	delete(p.children, n.schema.Name)
+}
+
+// GetNode returns the nodes within the tree rooted at n that are identified by
+// the supplied path, which is relative to n. List entries are selected by
+// the keys specified in the path; a list element that specifies no keys
+// selects all of the entries of the list. The GetHandleWildcards and
+// GetPartialKeyMatch options have the same meaning as for GetNode on a
+// GoStruct. An error with code NotFound is returned if no nodes match the
+// path.
+func (n *DynamicNode) GetNode(path *gpb.Path, opts ...GetNodeOpt) ([]*DynamicNode, error) {
+	nodes := []*DynamicNode{n}
+	for _, pe := range path.GetElem() {
+		var next []*DynamicNode
+		for _, c := range nodes {
+			matches, err := c.matchElem(pe, hasHandleWildcards(opts), hasPartialKeyMatch(opts))
+			if err != nil {
+				return nil, err
+			}
+			next = append(next, matches...)
+		}
+		nodes = next
+	}
+	if len(nodes) == 0 {
+		return nil, status.Errorf(codes.NotFound, "no nodes match path %v", path)
+	}
+	return nodes, nil
+}
+
+// matchElem returns the children of n that match the path element pe. When a
+// list is matched, its entries that match the keys of pe are returned.
+func (n *DynamicNode) matchElem(pe *gpb.PathElem, wildcards, partialKeys bool) ([]*DynamicNode, error) {
+	if n.kind != dynamicContainer && n.kind != dynamicListEntry {
+		return nil, status.Errorf(codes.InvalidArgument, "path element %s is a child of %s, which is not a container or list entry", pe.GetName(), n.schema.Name)
+	}
+	var candidates []*DynamicNode
+	switch name := util.StripModulePrefix(pe.GetName()); {
+	case wildcards && name == "*":
+		candidates = n.Children()
+	default:
+		e := dynamicChildSchema(n.schema, name)
+		if e == nil {
+			return nil, status.Errorf(codes.InvalidArgument, "no schema for path element %s within %s", name, n.schema.Name)
+		}
+		c, err := n.child(e, false)
+		if err != nil {
+			return nil, status.Errorf(codes.Unknown, "%v", err)
+		}
+		if c == nil {
+			return nil, nil
+		}
+		candidates = []*DynamicNode{c}
+	}
+
+	var out []*DynamicNode
+	for _, c := range candidates {
+		if c.kind != dynamicList {
+			if len(pe.GetKey()) != 0 {
+				return nil, status.Errorf(codes.InvalidArgument, "path element %s specifies keys, but is not a list", pe.GetName())
+			}
+			out = append(out, c)
+			continue
+		}
+		matches, err := c.matchEntries(pe.GetKey(), wildcards, partialKeys)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, matches...)
+	}
+	return out, nil
+}
+
+// matchEntries returns the entries of the list n that match the supplied
+// keys.
+func (n *DynamicNode) matchEntries(keys map[string]string, wildcards, partialKeys bool) ([]*DynamicNode, error) {
+	if len(keys) == 0 {
+		return append([]*DynamicNode{}, n.entries...), nil
+	}
+	if n.schema.Key == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "keyless list %s cannot be indexed by keys %v", n.schema.Name, keys)
+	}
+	want := map[string]interface{}{}
+	for _, k := range keyNames(n.schema) {
+		s, ok := keys[k]
+		switch {
+		case !ok && !partialKeys:
+			return nil, status.Errorf(codes.InvalidArgument, "key %s of list %s is not specified in %v", k, n.schema.Name, keys)
+		case !ok, wildcards && s == "*":
+			continue
+		}
+		v, err := n.keyValue(k, s)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+		}
+		want[k] = v
+	}
+	var out []*DynamicNode
+	for _, en := range n.entries {
+		if en.matchesKeys(want) {
+			out = append(out, en)
+		}
+	}
+	return out, nil
+}
+
+// keyValue decodes the string value s of the key k of the list n.
+func (n *DynamicNode) keyValue(k, s string) (interface{}, error) {
+	ks := dynamicChildSchema(n.schema, k)
+	if ks == nil {
+		return nil, fmt.Errorf("no schema for key %s of list %s", k, n.schema.Name)
+	}
+	v, err := decodeStringLeaf(ks, s)
+	if err != nil {
+		return nil, fmt.Errorf("invalid value %q for key %s of list %s: %v", s, k, n.schema.Name, err)
+	}
+	return v, nil
+}
+
+// SetNode sets the value of the node identified by the supplied path, which is
+// relative to n, to val. The node must be a leaf or leaf-list, or, if val is
+// JSON, a container or list entry into which the value is merged. Each list
+// element of the path must specify all of the keys of the list. If the
+// InitMissingElements option is specified, any nodes on the path that do not
+// exist are created, otherwise an error with code NotFound is returned. The
+// value of a list key cannot be changed.
+func (n *DynamicNode) SetNode(path *gpb.Path, val *gpb.TypedValue, opts ...SetNodeOpt) error {
+	if val == nil {
+		return status.Errorf(codes.InvalidArgument, "nil value supplied for path %v", path)
+	}
+	create := hasInitMissingElements(opts)
+	cur := n
+	for _, pe := range path.GetElem() {
+		next, err := cur.descend(pe, create)
+		if err != nil {
+			return err
+		}
+		if next == nil {
+			return status.Errorf(codes.NotFound, "path %v does not exist", path)
+		}
+		cur = next
+	}
+	if err := cur.setTypedValue(val); err != nil {
+		return status.Errorf(codes.InvalidArgument, "cannot set value of %s: %v", cur, err)
+	}
+	return nil
+}
+
+// descend returns the child of n identified by the path element pe, which
+// must identify exactly one node, creating it if create is true.
+func (n *DynamicNode) descend(pe *gpb.PathElem, create bool) (*DynamicNode, error) {
+	if n.kind != dynamicContainer && n.kind != dynamicListEntry {
+		return nil, status.Errorf(codes.InvalidArgument, "path element %s is a child of %s, which is not a container or list entry", pe.GetName(), n.schema.Name)
+	}
+	e := dynamicChildSchema(n.schema, pe.GetName())
+	if e == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "no schema for path element %s within %s", pe.GetName(), n.schema.Name)
+	}
+	c, err := n.child(e, create)
+	switch {
+	case err != nil:
+		return nil, status.Errorf(codes.Unknown, "%v", err)
+	case c == nil:
+		return nil, nil
+	case c.kind != dynamicList:
+		if len(pe.GetKey()) != 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "path element %s specifies keys, but is not a list", pe.GetName())
+		}
+		return c, nil
+	case e.Key == "":
+		return nil, status.Errorf(codes.InvalidArgument, "entries of keyless list %s cannot be addressed by a path", e.Name)
+	}
+
+	keys := map[string]interface{}{}
+	for _, k := range keyNames(e) {
+		s, ok := pe.GetKey()[k]
+		if !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "key %s of list %s is not specified", k, e.Name)
+		}
+		v, err := c.keyValue(k, s)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "%v", err)
+		}
+		keys[k] = v
+	}
+	en, err := c.entry(keys, create)
+	if err != nil {
+		return nil, status.Errorf(codes.Unknown, "%v", err)
+	}
+	return en, nil
+}
+
+// DeleteNode deletes the nodes identified by the supplied path, which is
+// relative to n. Deleting the entries of a list leaves the list empty. It is
+// not an error for the path not to exist.
+func (n *DynamicNode) DeleteNode(path *gpb.Path) error {
+	if len(path.GetElem()) == 0 {
+		return status.Errorf(codes.InvalidArgument, "cannot delete the root of the tree")
+	}
+	nodes, err := n.GetNode(path, &GetPartialKeyMatch{})
+	switch {
+	case status.Code(err) == codes.NotFound:
+		return nil
+	case err != nil:
+		return err
+	}
+	for _, c := range nodes {
+		c.remove()
+	}
+	return nil
+}
+
+// isKey reports whether n is a key of the list entry that is its parent.
+func (n *DynamicNode) isKey() bool {
+	if n.parent == nil || n.parent.kind != dynamicListEntry {
+		return false
+	}
+	for _, k := range keyNames(n.parent.schema) {
+		if k == n.schema.Name {
+			return true
+		}
+	}
+	return false
+}
+
+// setLeaf sets the value of the leaf or leaf-list n to v, refusing to change
+// the value of a list key.
+func (n *DynamicNode) setLeaf(v interface{}) error {
+	if n.isKey() && !reflect.DeepEqual(n.value, v) {
+		return fmt.Errorf("cannot change the value of key %s from %v to %v", n.schema.Name, n.value, v)
+	}
+	n.value = v
+	return nil
+}
@@ -0,0 +1,256 @@
+// Copyright 2021 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ytypes
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+
+	"github.com/openconfig/gnmi/value"
+	"github.com/openconfig/goyang/pkg/yang"
+
+	gpb "github.com/openconfig/gnmi/proto/gnmi"
+)
+
+// setTypedValue sets the value of n to the gNMI TypedValue tv. A container or
+// list entry can only be set to a JSON value, which is merged into it.
+func (n *DynamicNode) setTypedValue(tv *gpb.TypedValue) error {
+	switch n.kind {
+	case dynamicContainer, dynamicListEntry:
+		j, ok := typedValueJSON(tv)
+		if !ok {
+			return fmt.Errorf("got %T value for a container or list entry, expect JSON", tv.GetValue())
+		}
+		return n.Unmarshal7951(j)
+	case dynamicLeaf:
+		v, err := typedValueToDynamic(n.schema, tv)
+		if err != nil {
+			return err
+		}
+		return n.setLeaf(v)
+	case dynamicLeafList:
+		vals := []interface{}{}
+		if j, ok := typedValueJSON(tv); ok {
+			var l []interface{}
+			if err := json.Unmarshal(j, &l); err != nil {
+				return fmt.Errorf("cannot unmarshal JSON for leaf-list: %v", err)
+			}
+			for _, lv := range l {
+				v, err := decodeJSONLeaf(n.schema, lv)
+				if err != nil {
+					return err
+				}
+				vals = append(vals, v)
+			}
+			return n.setLeaf(vals)
+		}
+		l, ok := tv.GetValue().(*gpb.TypedValue_LeaflistVal)
+		if !ok {
+			return fmt.Errorf("got %T value for a leaf-list, expect a leaf-list or JSON value", tv.GetValue())
+		}
+		for _, e := range l.LeaflistVal.GetElement() {
+			v, err := typedValueToDynamic(n.schema, e)
+			if err != nil {
+				return err
+			}
+			vals = append(vals, v)
+		}
+		return n.setLeaf(vals)
+	}
+	return fmt.Errorf("cannot set the value of a list, set the value of its entries instead")
+}
+
+// typedValueJSON returns the JSON within tv, and whether tv is a JSON value.
+func typedValueJSON(tv *gpb.TypedValue) ([]byte, bool) {
+	switch v := tv.GetValue().(type) {
+	case *gpb.TypedValue_JsonIetfVal:
+		return v.JsonIetfVal, true
+	case *gpb.TypedValue_JsonVal:
+		return v.JsonVal, true
+	}
+	return nil, false
+}
+
+// typedValueToDynamic decodes the scalar or JSON gNMI TypedValue tv as a
+// value of the leaf, or leaf-list element, with schema e.
+func typedValueToDynamic(e *yang.Entry, tv *gpb.TypedValue) (interface{}, error) {
+	if j, ok := typedValueJSON(tv); ok {
+		var v interface{}
+		if err := json.Unmarshal(j, &v); err != nil {
+			return nil, fmt.Errorf("cannot unmarshal JSON value: %v", err)
+		}
+		return decodeJSONLeaf(e, v)
+	}
+
+	var s string
+	switch v := tv.GetValue().(type) {
+	case *gpb.TypedValue_StringVal:
+		s = v.StringVal
+	case *gpb.TypedValue_IntVal:
+		s = strconv.FormatInt(v.IntVal, 10)
+	case *gpb.TypedValue_UintVal:
+		s = strconv.FormatUint(v.UintVal, 10)
+	case *gpb.TypedValue_BoolVal:
+		s = strconv.FormatBool(v.BoolVal)
+	case *gpb.TypedValue_BytesVal:
+		s = base64.StdEncoding.EncodeToString(v.BytesVal)
+	case *gpb.TypedValue_FloatVal:
+		s = strconv.FormatFloat(float64(v.FloatVal), 'f', -1, 32)
+	default:
+		return nil, fmt.Errorf("unsupported value type %T for schema %s", tv.GetValue(), e.Name)
+	}
+	return decodeStringLeaf(e, s)
+}
+
+// dynamicLeafPath is a leaf or leaf-list within a dynamic tree, along with its
+// path relative to the node from which the tree was traversed.
+type dynamicLeafPath struct {
+	path *gpb.Path
+	node *DynamicNode
+}
+
+// leaves returns the leaves and leaf-lists within the tree rooted at n, along
+// with their paths relative to n. The entries of keyless lists cannot be
+// addressed by a path, and hence cause an error to be returned.
+func (n *DynamicNode) leaves() ([]*dynamicLeafPath, error) {
+	var out []*dynamicLeafPath
+	var walk func(c *DynamicNode, p *gpb.Path) error
+	walk = func(c *DynamicNode, p *gpb.Path) error {
+		switch c.kind {
+		case dynamicLeaf, dynamicLeafList:
+			out = append(out, &dynamicLeafPath{path: p, node: c})
+		case dynamicContainer, dynamicListEntry:
+			for _, cc := range c.Children() {
+				if cc.kind == dynamicList {
+					if err := walk(cc, p); err != nil {
+						return err
+					}
+					continue
+				}
+				if err := walk(cc, appendElem(p, &gpb.PathElem{Name: cc.schema.Name})); err != nil {
+					return err
+				}
+			}
+		case dynamicList:
+			if c.schema.Key == "" && len(c.entries) != 0 {
+				return fmt.Errorf("entries of keyless list %s cannot be addressed by a path", c)
+			}
+			for _, en := range c.entries {
+				if err := walk(en, appendElem(p, &gpb.PathElem{Name: c.schema.Name, Key: en.keyStrings()})); err != nil {
+					return err
+				}
+			}
+		}
+		return nil
+	}
+	if err := walk(n, &gpb.Path{}); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// typedValue returns the gNMI TypedValue of the leaf or leaf-list n.
+func (n *DynamicNode) typedValue() (*gpb.TypedValue, error) {
+	tv, err := value.FromScalar(n.value)
+	if err != nil {
+		return nil, fmt.Errorf("cannot encode value of %s: %v", n, err)
+	}
+	return tv, nil
+}
+
+// TogNMINotifications returns a gNMI Notification, with the supplied
+// timestamp, that contains an update for each leaf and leaf-list within the
+// tree rooted at n. The paths of the updates are relative to the prefix of
+// the notification, which is the path of n. The values of enumerations,
+// identityrefs and bits are encoded as strings.
+func (n *DynamicNode) TogNMINotifications(ts int64) ([]*gpb.Notification, error) {
+	leaves, err := n.leaves()
+	if err != nil {
+		return nil, err
+	}
+	notif := &gpb.Notification{Timestamp: ts}
+	if n.parent != nil {
+		notif.Prefix = n.Path()
+	}
+	for _, l := range leaves {
+		tv, err := l.node.typedValue()
+		if err != nil {
+			return nil, err
+		}
+		notif.Update = append(notif.Update, &gpb.Update{Path: l.path, Val: tv})
+	}
+	return []*gpb.Notification{notif}, nil
+}
+
+// DiffDynamic returns a gNMI Notification that describes the changes required
+// to transform the tree orig into the tree mod, which must have the same
+// schema. Leaves and leaf-lists that are set in mod with a different value
+// than in orig are updated, and those that are set only in orig are
+// deleted. Updates and deletes are sorted by path, which is relative to the
+// roots of the trees.
+func DiffDynamic(orig, mod *DynamicNode) (*gpb.Notification, error) {
+	switch {
+	case orig == nil || mod == nil:
+		return nil, fmt.Errorf("cannot diff nil trees")
+	case orig.schema != mod.schema:
+		return nil, fmt.Errorf("cannot diff trees with different schemas %s and %s", orig.schema.Name, mod.schema.Name)
+	}
+
+	origLeaves, err := orig.leaves()
+	if err != nil {
+		return nil, fmt.Errorf("cannot diff original tree: %v", err)
+	}
+	modLeaves, err := mod.leaves()
+	if err != nil {
+		return nil, fmt.Errorf("cannot diff modified tree: %v", err)
+	}
+
+	origByPath := map[string]*dynamicLeafPath{}
+	for _, l := range origLeaves {
+		origByPath[dynamicPathString(l.path)] = l
+	}
+
+	n := &gpb.Notification{}
+	modPaths := map[string]bool{}
+	for _, l := range modLeaves {
+		p := dynamicPathString(l.path)
+		modPaths[p] = true
+		if o, ok := origByPath[p]; ok && reflect.DeepEqual(o.node.value, l.node.value) {
+			continue
+		}
+		tv, err := l.node.typedValue()
+		if err != nil {
+			return nil, err
+		}
+		n.Update = append(n.Update, &gpb.Update{Path: l.path, Val: tv})
+	}
+	for _, l := range origLeaves {
+		if !modPaths[dynamicPathString(l.path)] {
+			n.Delete = append(n.Delete, l.path)
+		}
+	}
+
+	sort.Slice(n.Update, func(i, j int) bool {
+		return dynamicPathString(n.Update[i].Path) < dynamicPathString(n.Update[j].Path)
+	})
+	sort.Slice(n.Delete, func(i, j int) bool {
+		return dynamicPathString(n.Delete[i]) < dynamicPathString(n.Delete[j])
+	})
+	return n, nil
+}
@@ -0,0 +1,707 @@
+// Copyright 2021 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ytypes
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/openconfig/goyang/pkg/yang"
+	"github.com/openconfig/ygot/util"
+	"github.com/openconfig/ygot/ygot"
+)
+
+// decodeStringLeaf decodes the string representation s of a value of the leaf
+// or leaf-list with schema e, as used in gNMI path keys and string values.
+func decodeStringLeaf(e *yang.Entry, s string) (interface{}, error) {
+	target, err := util.ResolveIfLeafRef(e)
+	if err != nil {
+		return nil, err
+	}
+	return decodeStringValue(target, target.Type, s)
+}
+
+// decodeStringValue decodes s as a value of the type t, which is the type of
+// the leaf with schema e, or one of the members of its union type. Types that
+// are not represented by generated Go types within GoStructs are decoded as
+// for the keys of GoStruct lists.
+func decodeStringValue(e *yang.Entry, t *yang.YangType, s string) (interface{}, error) {
+	switch t.Kind {
+	case yang.Yempty:
+		if s != "" && s != "true" {
+			return nil, fmt.Errorf("invalid value %q for an empty leaf", s)
+		}
+		return true, nil
+	case yang.Yenum:
+		if t.Enum == nil || !t.Enum.IsDefined(s) {
+			return nil, fmt.Errorf("%q is not a valid value of enumeration %s", s, t.Name)
+		}
+		return s, nil
+	case yang.Yidentityref:
+		name := util.StripModulePrefix(s)
+		if t.IdentityBase == nil || !t.IdentityBase.IsDefined(name) {
+			return nil, fmt.Errorf("%q is not a valid value of identityref %s", s, t.Name)
+		}
+		return name, nil
+	case yang.Ybits:
+		return strings.Join(strings.Fields(s), " "), nil
+	case yang.Yunion:
+		return decodeUnion(e, t, func(m *yang.YangType) (interface{}, error) {
+			return decodeStringValue(e, m, s)
+		})
+	}
+
+	v, err := stringToKeyType(dynamicLeafEntry(e, t), nil, e.Name, s)
+	if err != nil {
+		return nil, err
+	}
+	return v.Interface(), nil
+}
+
+// dynamicLeafEntry returns a leaf entry with the name of e, and the type t,
+// which is the type of e or one of the members of its union type.
+func dynamicLeafEntry(e *yang.Entry, t *yang.YangType) *yang.Entry {
+	return &yang.Entry{Name: e.Name, Kind: yang.LeafEntry, Type: t}
+}
+
+// decodeUnion decodes a value of the union type t, which is the type of the
+// leaf with schema e, using the supplied function to decode the value as
+// each of its member types in turn. The value of the first member type that
+// the value is valid for is returned. If the value can be decoded, but is
+// not valid, for any member type, the value decoded for the first such type
+// is returned, such that validation reports the error.
+func decodeUnion(e *yang.Entry, t *yang.YangType, decode func(*yang.YangType) (interface{}, error)) (interface{}, error) {
+	var first interface{}
+	for _, m := range util.FlattenedTypes(t.Type) {
+		v, err := decode(m)
+		if err != nil {
+			continue
+		}
+		if errs := validateDynamicValue(e.Name, m, v); errs == nil {
+			return v, nil
+		}
+		if first == nil {
+			first = v
+		}
+	}
+	if first == nil {
+		return nil, fmt.Errorf("value does not match any member of union type %s for schema %s", t.Name, e.Name)
+	}
+	return first, nil
+}
+
+// decodeJSONLeaf decodes the value v of the leaf or leaf-list element with
+// schema e, as produced by json.Unmarshal from RFC7951 JSON. Numbers decoded
+// as json.Number, as used for internal JSON, are accepted for all numeric
+// types.
+func decodeJSONLeaf(e *yang.Entry, v interface{}) (interface{}, error) {
+	target, err := util.ResolveIfLeafRef(e)
+	if err != nil {
+		return nil, err
+	}
+	return decodeJSONValue(target, target.Type, v)
+}
+
+// decodeJSONValue decodes v as a value of the type t, which is the type of
+// the leaf with schema e, or one of the members of its union type. Types that
+// are not represented by generated Go types within GoStructs are decoded as
+// for GoStruct fields.
+func decodeJSONValue(e *yang.Entry, t *yang.YangType, v interface{}) (interface{}, error) {
+	switch t.Kind {
+	case yang.Yunion:
+		return decodeUnion(e, t, func(m *yang.YangType) (interface{}, error) {
+			return decodeJSONValue(e, m, v)
+		})
+	case yang.Yenum, yang.Yidentityref, yang.Ybits:
+		s, ok := v.(string)
+		if !ok {
+			return nil, fmt.Errorf("got %T type for field %s, expect string", v, e.Name)
+		}
+		return decodeStringValue(e, t, s)
+	}
+	if v == nil {
+		return nil, fmt.Errorf("got nil value for field %s", e.Name)
+	}
+
+	if num, ok := v.(json.Number); ok {
+		// Numbers are converted to their RFC7951 representation, which
+		// is a string for 64-bit integers and decimal64 values.
+		switch {
+		case t.Kind == yang.Yint64 || t.Kind == yang.Yuint64 || t.Kind == yang.Ydecimal64:
+			v = num.String()
+		case isIntegerType(t.Kind):
+			f, err := num.Float64()
+			if err != nil {
+				return nil, err
+			}
+			v = f
+		default:
+			return nil, fmt.Errorf("got number %s for field %s of type %v", num, e.Name, t.Kind)
+		}
+	}
+	return sanitizeJSON(nil, dynamicLeafEntry(e, t), e.Name, v)
+}
+
+// Unmarshal7951 merges the supplied RFC7951 JSON, which describes the
+// container or list entry n, into the tree. Entries of lists are merged
+// with existing entries that have the same keys, and the values of
+// leaf-lists replace any existing value. Metadata annotations are ignored.
+// If the IgnoreExtraFields option is specified, fields that are not within
+// the schema are ignored, otherwise they cause an error to be returned.
+func (n *DynamicNode) Unmarshal7951(data []byte, opts ...UnmarshalOpt) error {
+	if n.kind != dynamicContainer && n.kind != dynamicListEntry {
+		return fmt.Errorf("cannot unmarshal JSON into %s, which is not a container or list entry", n)
+	}
+	var j interface{}
+	if err := json.Unmarshal(data, &j); err != nil {
+		return fmt.Errorf("cannot unmarshal JSON: %v", err)
+	}
+	obj, ok := j.(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("JSON for %s is %T, expect object", n, j)
+	}
+	return n.unmarshalJSON(obj, opts)
+}
+
+// UnmarshalInternalJSON merges the supplied JSON, which describes the
+// container or list entry n in the internal JSON format used for GoStructs,
+// into the tree, as for Unmarshal7951.
+func (n *DynamicNode) UnmarshalInternalJSON(data []byte, opts ...UnmarshalOpt) error {
+	if n.kind != dynamicContainer && n.kind != dynamicListEntry {
+		return fmt.Errorf("cannot unmarshal JSON into %s, which is not a container or list entry", n)
+	}
+	d := json.NewDecoder(bytes.NewReader(data))
+	d.UseNumber()
+	var j interface{}
+	if err := d.Decode(&j); err != nil {
+		return fmt.Errorf("cannot unmarshal JSON: %v", err)
+	}
+	obj, ok := j.(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("JSON for %s is %T, expect object", n, j)
+	}
+	rfcObj, err := internalToRFC7951(n.schema, obj)
+	if err != nil {
+		return err
+	}
+	return n.unmarshalJSON(rfcObj, opts)
+}
+
+// internalToRFC7951 converts the internal JSON object obj, which describes a
+// container or list entry with schema e, to the equivalent RFC7951 JSON
+// object. Fields that are not within the schema are copied unchanged.
+func internalToRFC7951(e *yang.Entry, obj map[string]interface{}) (map[string]interface{}, error) {
+	out := map[string]interface{}{}
+	for k, v := range obj {
+		ce := dynamicChildSchema(e, k)
+		if ce == nil {
+			out[k] = v
+			continue
+		}
+		switch {
+		case ce.IsList():
+			var entries []interface{}
+			switch l := v.(type) {
+			case map[string]interface{}:
+				// Keyed lists are objects keyed by the values of the
+				// keys of their entries.
+				var ks []string
+				for lk := range l {
+					ks = append(ks, lk)
+				}
+				sort.Strings(ks)
+				for _, lk := range ks {
+					entries = append(entries, l[lk])
+				}
+			case []interface{}:
+				entries = l
+			default:
+				return nil, fmt.Errorf("JSON for list %s is %T, expect object or array", ce.Name, v)
+			}
+			var rl []interface{}
+			for _, en := range entries {
+				eo, ok := en.(map[string]interface{})
+				if !ok {
+					return nil, fmt.Errorf("JSON for entry of list %s is %T, expect object", ce.Name, en)
+				}
+				re, err := internalToRFC7951(ce, eo)
+				if err != nil {
+					return nil, err
+				}
+				rl = append(rl, re)
+			}
+			out[k] = rl
+		case ce.IsContainer():
+			co, ok := v.(map[string]interface{})
+			if !ok {
+				return nil, fmt.Errorf("JSON for container %s is %T, expect object", ce.Name, v)
+			}
+			rc, err := internalToRFC7951(ce, co)
+			if err != nil {
+				return nil, err
+			}
+			out[k] = rc
+		default:
+			// An empty leaf that is set is true in internal JSON.
+			if target, err := util.ResolveIfLeafRef(ce); err == nil && target.Type.Kind == yang.Yempty && v == true {
+				v = []interface{}{nil}
+			}
+			out[k] = v
+		}
+	}
+	return out, nil
+}
+
+// unmarshalJSON merges the JSON object obj into the container or list entry
+// n.
+func (n *DynamicNode) unmarshalJSON(obj map[string]interface{}, opts []UnmarshalOpt) error {
+	var names []string
+	for k := range obj {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+
+	for _, k := range names {
+		if strings.HasPrefix(k, "@") {
+			continue
+		}
+		e := dynamicChildSchema(n.schema, k)
+		if e == nil {
+			if hasIgnoreExtraFields(opts) {
+				continue
+			}
+			return fmt.Errorf("JSON contains unexpected field %s in %s", k, n)
+		}
+		if err := n.unmarshalJSONChild(e, obj[k], opts); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// unmarshalJSONChild merges the JSON value v into the child of n with schema
+// e.
+func (n *DynamicNode) unmarshalJSONChild(e *yang.Entry, v interface{}, opts []UnmarshalOpt) error {
+	switch {
+	case e.IsLeaf():
+		val, err := decodeJSONLeaf(e, v)
+		if err != nil {
+			return fmt.Errorf("invalid value for %s/%s: %v", n, e.Name, err)
+		}
+		c, err := n.child(e, true)
+		if err != nil {
+			return err
+		}
+		return c.setLeaf(val)
+	case e.IsLeafList():
+		l, ok := v.([]interface{})
+		if !ok {
+			return fmt.Errorf("JSON for leaf-list %s/%s is %T, expect array", n, e.Name, v)
+		}
+		vals := []interface{}{}
+		for _, lv := range l {
+			val, err := decodeJSONLeaf(e, lv)
+			if err != nil {
+				return fmt.Errorf("invalid value for %s/%s: %v", n, e.Name, err)
+			}
+			vals = append(vals, val)
+		}
+		c, err := n.child(e, true)
+		if err != nil {
+			return err
+		}
+		return c.setLeaf(vals)
+	case e.IsList():
+		l, ok := v.([]interface{})
+		if !ok {
+			return fmt.Errorf("JSON for list %s/%s is %T, expect array", n, e.Name, v)
+		}
+		c, err := n.child(e, true)
+		if err != nil {
+			return err
+		}
+		for _, lv := range l {
+			obj, ok := lv.(map[string]interface{})
+			if !ok {
+				return fmt.Errorf("JSON for entry of list %s/%s is %T, expect object", n, e.Name, lv)
+			}
+			if err := c.unmarshalJSONEntry(obj, opts); err != nil {
+				return err
+			}
+		}
+		return nil
+	case e.IsContainer():
+		obj, ok := v.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("JSON for container %s/%s is %T, expect object", n, e.Name, v)
+		}
+		c, err := n.child(e, true)
+		if err != nil {
+			return err
+		}
+		return c.unmarshalJSON(obj, opts)
+	}
+	return fmt.Errorf("cannot unmarshal JSON into %s/%s, which has an unsupported kind", n, e.Name)
+}
+
+// unmarshalJSONEntry merges the JSON object obj into the entry of the list n
+// that has the keys that it specifies, creating the entry if it does not
+// exist. An entry is always created for a keyless list.
+func (n *DynamicNode) unmarshalJSONEntry(obj map[string]interface{}, opts []UnmarshalOpt) error {
+	if n.schema.Key == "" {
+		en := newDynamicNode(n.schema, dynamicListEntry, n)
+		n.entries = append(n.entries, en)
+		return en.unmarshalJSON(obj, opts)
+	}
+
+	keys := map[string]interface{}{}
+	for _, k := range keyNames(n.schema) {
+		var raw interface{}
+		var ok bool
+		for jk, jv := range obj {
+			if util.StripModulePrefix(jk) == k {
+				raw, ok = jv, true
+				break
+			}
+		}
+		if !ok {
+			return fmt.Errorf("missing key %s for entry of list %s", k, n)
+		}
+		ks := dynamicChildSchema(n.schema, k)
+		if ks == nil {
+			return fmt.Errorf("no schema for key %s of list %s", k, n)
+		}
+		v, err := decodeJSONLeaf(ks, raw)
+		if err != nil {
+			return fmt.Errorf("invalid value for key %s of list %s: %v", k, n, err)
+		}
+		keys[k] = v
+	}
+	en, err := n.entry(keys, true)
+	if err != nil {
+		return err
+	}
+	return en.unmarshalJSON(obj, opts)
+}
+
+// ConstructIETFJSON returns the RFC7951 JSON representation of n, as a map
+// that can be rendered by json.Marshal. For a container or list entry, the
+// map contains its children, otherwise it contains a single member, named
+// after n, whose value is n. The supplied configuration controls whether
+// module names are prepended to the names of fields and identities, as for
+// GoStructs. Containers that are not presence containers and have no
+// children, and lists and leaf-lists with no entries, are omitted.
+func (n *DynamicNode) ConstructIETFJSON(cfg *ygot.RFC7951JSONConfig) (map[string]interface{}, error) {
+	if cfg == nil {
+		cfg = &ygot.RFC7951JSONConfig{}
+	}
+	return n.constructJSON(dynamicJSONConfig{format: ygot.RFC7951, rfc7951Config: cfg})
+}
+
+// ConstructInternalJSON returns the representation of n in the internal JSON
+// format used for GoStructs, as a map that can be rendered by json.Marshal.
+// In this format, module names are omitted, keyed lists are rendered as
+// objects keyed by the space-separated values of their keys, and all numeric
+// values are rendered as JSON numbers. The contents of the map are as for
+// ConstructIETFJSON.
+func (n *DynamicNode) ConstructInternalJSON() (map[string]interface{}, error) {
+	return n.constructJSON(dynamicJSONConfig{format: ygot.Internal, rfc7951Config: &ygot.RFC7951JSONConfig{}})
+}
+
+// dynamicJSONConfig specifies the format of the JSON rendered for a dynamic
+// tree.
+type dynamicJSONConfig struct {
+	// format is the JSON format to be output.
+	format ygot.JSONFormat
+	// rfc7951Config is the configuration for RFC7951 output, which is
+	// non-nil for both formats.
+	rfc7951Config *ygot.RFC7951JSONConfig
+}
+
+// constructJSON returns the JSON representation of n in the format specified
+// by cfg.
+func (n *DynamicNode) constructJSON(cfg dynamicJSONConfig) (map[string]interface{}, error) {
+	if n.kind == dynamicContainer || n.kind == dynamicListEntry {
+		return n.toJSON("", cfg)
+	}
+	out := map[string]interface{}{}
+	key, v, ok, err := n.memberJSON("", cfg)
+	if err != nil {
+		return nil, err
+	}
+	if ok {
+		out[key] = v
+	}
+	return out, nil
+}
+
+// Marshal7951 renders n as RFC7951 JSON, as described for ConstructIETFJSON.
+// The RFC7951JSONConfig and JSONIndent arguments are supported, with the
+// same meaning as for GoStructs.
+func (n *DynamicNode) Marshal7951(args ...ygot.Marshal7951Arg) ([]byte, error) {
+	var (
+		cfg    *ygot.RFC7951JSONConfig
+		indent string
+	)
+	for _, a := range args {
+		switch v := a.(type) {
+		case *ygot.RFC7951JSONConfig:
+			cfg = v
+		case ygot.JSONIndent:
+			indent = string(v)
+		}
+	}
+	j, err := n.ConstructIETFJSON(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	var js []byte
+	switch indent {
+	case "":
+		js, err = json.Marshal(j)
+	default:
+		js, err = json.MarshalIndent(j, "", indent)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("could not marshal JSON, %v", err)
+	}
+	return js, nil
+}
+
+// toJSON returns the JSON representation of the container or list entry n,
+// whose parent belongs to the module parentMod.
+func (n *DynamicNode) toJSON(parentMod string, cfg dynamicJSONConfig) (map[string]interface{}, error) {
+	out := map[string]interface{}{}
+	for _, name := range n.childNames() {
+		key, v, ok, err := n.children[name].memberJSON(parentMod, cfg)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			out[key] = v
+		}
+	}
+	return out, nil
+}
+
+// memberJSON returns the name and value of the JSON member that represents n
+// within the JSON object of its parent, which belongs to the module
+// parentMod. It returns false if the member should be omitted.
+func (n *DynamicNode) memberJSON(parentMod string, cfg dynamicJSONConfig) (string, interface{}, bool, error) {
+	mod, err := dynamicModule(n.schema)
+	if err != nil {
+		return "", nil, false, err
+	}
+	key := n.schema.Name
+	if cfg.format == ygot.RFC7951 && cfg.rfc7951Config.AppendModuleName && mod != "" && mod != parentMod {
+		key = fmt.Sprintf("%s:%s", mod, key)
+	}
+
+	switch n.kind {
+	case dynamicContainer:
+		v, err := n.toJSON(mod, cfg)
+		if err != nil {
+			return "", nil, false, err
+		}
+		return key, v, len(v) != 0 || len(n.schema.Extra["presence"]) != 0, nil
+	case dynamicList:
+		if len(n.entries) == 0 {
+			return "", nil, false, nil
+		}
+		if cfg.format == ygot.Internal && n.schema.Key != "" {
+			m := map[string]interface{}{}
+			for _, en := range n.entries {
+				v, err := en.toJSON(mod, cfg)
+				if err != nil {
+					return "", nil, false, err
+				}
+				var ks []string
+				for _, k := range keyNames(n.schema) {
+					ks = append(ks, dynamicValueString(en.children[k].value))
+				}
+				m[strings.Join(ks, " ")] = v
+			}
+			return key, m, true, nil
+		}
+		var l []interface{}
+		for _, en := range n.entries {
+			v, err := en.toJSON(mod, cfg)
+			if err != nil {
+				return "", nil, false, err
+			}
+			l = append(l, v)
+		}
+		return key, l, true, nil
+	case dynamicLeaf:
+		v, err := encodeJSONLeaf(n.schema, n.value, cfg)
+		if err != nil {
+			return "", nil, false, fmt.Errorf("cannot render %s: %v", n, err)
+		}
+		return key, v, true, nil
+	case dynamicLeafList:
+		vals, _ := n.value.([]interface{})
+		if len(vals) == 0 {
+			return "", nil, false, nil
+		}
+		var l []interface{}
+		for _, lv := range vals {
+			v, err := encodeJSONLeaf(n.schema, lv, cfg)
+			if err != nil {
+				return "", nil, false, fmt.Errorf("cannot render %s: %v", n, err)
+			}
+			l = append(l, v)
+		}
+		return key, l, true, nil
+	}
+	return "", nil, false, fmt.Errorf("cannot render %s, which has an unknown kind", n)
+}
+
+// encodeJSONLeaf returns the JSON representation, in the format specified by
+// cfg, of the value v of the leaf or leaf-list element with schema e.
+func encodeJSONLeaf(e *yang.Entry, v interface{}, cfg dynamicJSONConfig) (interface{}, error) {
+	target, err := util.ResolveIfLeafRef(e)
+	if err != nil {
+		return nil, err
+	}
+	t, err := dynamicValueType(target, v)
+	if err != nil {
+		return nil, err
+	}
+
+	if t.Kind != yang.Yidentityref {
+		return encodeJSONScalar(t.Kind, v, cfg)
+	}
+	name, ok := v.(string)
+	if !ok {
+		return nil, fmt.Errorf("got %T value for identityref, expect string", v)
+	}
+	// As for GoStructs, identities are qualified by their module whenever
+	// module names are appended to field names.
+	if cfg.format == ygot.Internal || !(cfg.rfc7951Config.AppendModuleName || cfg.rfc7951Config.PrependModuleNameIdentityref) {
+		return name, nil
+	}
+	mod, err := identityModule(t, name)
+	if err != nil {
+		return nil, err
+	}
+	return fmt.Sprintf("%s:%s", mod, name), nil
+}
+
+// encodeJSONScalar returns the JSON representation, in the format specified by
+// cfg, of the value v of a leaf of the type k, which is not an identityref.
+// The value is rendered by ygot, as the value of the equivalent GoStruct
+// field.
+func encodeJSONScalar(k yang.TypeKind, v interface{}, cfg dynamicJSONConfig) (interface{}, error) {
+	// ygot identifies the generated types of binary and empty fields by
+	// their names.
+	type Binary []byte
+	type YANGEmpty bool
+
+	var field interface{}
+	switch {
+	case k == yang.Ybinary:
+		b, ok := v.([]byte)
+		if !ok {
+			return nil, fmt.Errorf("got %T value for binary, expect []byte", v)
+		}
+		field = Binary(b)
+	case cfg.format == ygot.Internal:
+		// Internal JSON renders the values of other fields unchanged,
+		// other than empty leaves, which are rendered as true.
+		if k == yang.Yempty {
+			return true, nil
+		}
+		return v, nil
+	case k == yang.Yempty:
+		field = YANGEmpty(true)
+	default:
+		field = ygot.ToPtr(v)
+	}
+
+	js, err := ygot.Marshal7951(field)
+	if err != nil {
+		return nil, err
+	}
+	d := json.NewDecoder(bytes.NewReader(js))
+	d.UseNumber()
+	var j interface{}
+	if err := d.Decode(&j); err != nil {
+		return nil, fmt.Errorf("cannot decode rendered JSON %s: %v", js, err)
+	}
+	return j, nil
+}
+
+// dynamicValueType returns the type of the leaf with schema e that the value
+// v has. For a union, this is the first member type that v is valid for.
+func dynamicValueType(e *yang.Entry, v interface{}) (*yang.YangType, error) {
+	if e.Type.Kind != yang.Yunion {
+		return e.Type, nil
+	}
+	for _, m := range util.FlattenedTypes(e.Type.Type) {
+		if errs := validateDynamicValue(e.Name, m, v); errs == nil {
+			return m, nil
+		}
+	}
+	return nil, fmt.Errorf("value %v does not match any member of union type %s for schema %s", v, e.Type.Name, e.Name)
+}
+
+// dynamicValueString returns the string representation of the value v of a
+// leaf, as used in gNMI path keys. Values are rendered as the keys of GoStruct
+// lists, other than int64 values, which ygot reserves for enumerations.
+func dynamicValueString(v interface{}) string {
+	if s, err := ygot.KeyValueAsString(v); err == nil {
+		return s
+	}
+	return fmt.Sprintf("%v", v)
+}
+
+// dynamicModule returns the name of the module that instantiates the schema
+// node e, or the empty string if the schema is not within a module.
+func dynamicModule(e *yang.Entry) (string, error) {
+	root := e
+	for root.Parent != nil {
+		root = root.Parent
+	}
+	if m, ok := root.Node.(*yang.Module); !ok || m.Modules == nil {
+		return "", nil
+	}
+	return e.InstantiatingModule()
+}
+
+// identityModule returns the name of the module that defines the identity
+// with the supplied name, which is a value of the identityref type t.
+func identityModule(t *yang.YangType, name string) (string, error) {
+	if t.IdentityBase == nil {
+		return "", fmt.Errorf("identityref %s has no base", t.Name)
+	}
+	id := t.IdentityBase.GetValue(name)
+	if id == nil {
+		return "", fmt.Errorf("%q is not a valid value of identityref %s", name, t.Name)
+	}
+	m := yang.RootNode(id)
+	if m == nil {
+		return "", fmt.Errorf("cannot find the module defining identity %s", name)
+	}
+	if m.Kind() == "submodule" && m.BelongsTo != nil && m.Modules != nil {
+		if bm, ok := m.Modules.Modules[m.BelongsTo.Name]; ok {
+			m = bm
+		}
+	}
+	return m.Name, nil
+}
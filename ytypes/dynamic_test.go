@@ -0,0 +1,565 @@
+// Copyright 2021 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ytypes
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/openconfig/gnmi/errdiff"
+	"github.com/openconfig/goyang/pkg/yang"
+	"github.com/openconfig/ygot/ygot"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/testing/protocmp"
+
+	gpb "github.com/openconfig/gnmi/proto/gnmi"
+)
+
+// dynamicTestModule is the YANG module that describes the trees used in the
+// tests of DynamicNode.
+const dynamicTestModule = `
+module dyn {
+  prefix d;
+  namespace "urn:dyn";
+
+  identity BASE;
+  identity ID-A { base BASE; }
+
+  typedef percent {
+    type uint8 { range "0..100"; }
+  }
+
+  container top {
+    leaf name { type string { pattern "[a-z]+"; } }
+    leaf level { type percent; }
+    leaf big { type int64; }
+    leaf ratio { type decimal64 { fraction-digits 2; } }
+    leaf enabled { type boolean; }
+    leaf flag { type empty; }
+    leaf data { type binary; }
+    leaf mode {
+      type enumeration { enum UP; enum DOWN; }
+    }
+    leaf kind {
+      type identityref { base BASE; }
+    }
+    leaf addr {
+      type union { type uint16; type string; }
+    }
+    leaf-list tags {
+      type string;
+      max-elements 2;
+    }
+    leaf ref {
+      type leafref { path "../iface/name"; }
+    }
+    leaf ref-mtu {
+      type leafref { path "/d:top/d:iface[d:name = current()/../d:ref]/d:mtu"; }
+    }
+    list iface {
+      key "name";
+      leaf name { type string; }
+      leaf mtu { type uint16; }
+    }
+    list anon {
+      leaf value { type string; }
+    }
+    container opts {
+      choice c {
+        case a {
+          leaf a { type string; }
+        }
+      }
+    }
+  }
+}
+`
+
+// dynamicTestSchema returns the schema of the dyn module.
+func dynamicTestSchema(t *testing.T) *yang.Entry {
+	t.Helper()
+	ms := yang.NewModules()
+	if err := ms.Parse(dynamicTestModule, "dyn.yang"); err != nil {
+		t.Fatalf("cannot parse module: %v", err)
+	}
+	if errs := ms.Process(); len(errs) != 0 {
+		t.Fatalf("cannot process modules: %v", errs)
+	}
+	m, err := ms.GetModule("dyn")
+	if err != nil {
+		t.Fatalf("cannot get module dyn: %v", err)
+	}
+	return m
+}
+
+// dynamicTestTree returns a tree with the schema of the dyn module, into which
+// the supplied JSON has been unmarshalled.
+func dynamicTestTree(t *testing.T, schema *yang.Entry, j string) *DynamicNode {
+	t.Helper()
+	n, err := NewDynamicTree(schema)
+	if err != nil {
+		t.Fatalf("cannot create tree: %v", err)
+	}
+	if err := n.Unmarshal7951([]byte(j)); err != nil {
+		t.Fatalf("cannot unmarshal %s: %v", j, err)
+	}
+	return n
+}
+
+// jsonDiff reports the difference between the JSON documents got and want,
+// or an error if either cannot be unmarshalled.
+func jsonDiff(got []byte, want string) (string, error) {
+	var g, w interface{}
+	if err := json.Unmarshal(got, &g); err != nil {
+		return "", err
+	}
+	if err := json.Unmarshal([]byte(want), &w); err != nil {
+		return "", err
+	}
+	return cmp.Diff(w, g), nil
+}
+
+func TestDynamicUnmarshal7951(t *testing.T) {
+	schema := dynamicTestSchema(t)
+
+	tests := []struct {
+		name             string
+		in               string
+		inOpts           []UnmarshalOpt
+		inConfig         *ygot.RFC7951JSONConfig
+		want             string
+		wantErrSubstring string
+	}{{
+		name: "all types",
+		in: `{"dyn:top": {
+			"name": "eth",
+			"level": 50,
+			"big": "-9000000000",
+			"ratio": "1.25",
+			"enabled": true,
+			"flag": [null],
+			"data": "AQI=",
+			"mode": "UP",
+			"kind": "dyn:ID-A",
+			"addr": 80,
+			"tags": ["a", "b"],
+			"ref": "eth0",
+			"iface": [{"name": "eth0", "mtu": 1500}],
+			"opts": {"a": "x"}
+		}}`,
+		inConfig: &ygot.RFC7951JSONConfig{AppendModuleName: true, PrependModuleNameIdentityref: true},
+		want: `{"dyn:top": {
+			"name": "eth",
+			"level": 50,
+			"big": "-9000000000",
+			"ratio": "1.25",
+			"enabled": true,
+			"flag": [null],
+			"data": "AQI=",
+			"mode": "UP",
+			"kind": "dyn:ID-A",
+			"addr": 80,
+			"tags": ["a", "b"],
+			"ref": "eth0",
+			"iface": [{"name": "eth0", "mtu": 1500}],
+			"opts": {"a": "x"}
+		}}`,
+	}, {
+		name: "union member selected by JSON type, without module names",
+		in:   `{"top": {"addr": "eighty", "kind": "ID-A"}}`,
+		want: `{"top": {"addr": "eighty", "kind": "ID-A"}}`,
+	}, {
+		name: "list entries merged by key",
+		in: `{"top": {"iface": [
+			{"name": "eth0", "mtu": 1500},
+			{"name": "eth1"},
+			{"name": "eth0", "mtu": 9000}
+		]}}`,
+		want: `{"top": {"iface": [{"name": "eth0", "mtu": 9000}, {"name": "eth1"}]}}`,
+	}, {
+		name: "keyless list",
+		in:   `{"top": {"anon": [{"value": "a"}, {"value": "a"}]}}`,
+		want: `{"top": {"anon": [{"value": "a"}, {"value": "a"}]}}`,
+	}, {
+		name: "metadata ignored, empty container omitted",
+		in:   `{"top": {"@name": {"dyn:x": 1}, "name": "eth", "opts": {}}}`,
+		want: `{"top": {"name": "eth"}}`,
+	}, {
+		name:             "unexpected field",
+		in:               `{"top": {"bogus": 1}}`,
+		wantErrSubstring: "unexpected field bogus",
+	}, {
+		name:   "unexpected field ignored",
+		in:     `{"top": {"bogus": 1, "name": "eth"}}`,
+		inOpts: []UnmarshalOpt{&IgnoreExtraFields{}},
+		want:   `{"top": {"name": "eth"}}`,
+	}, {
+		name:             "wrong JSON type",
+		in:               `{"top": {"big": 42}}`,
+		wantErrSubstring: "got float64 type for field big, expect string",
+	}, {
+		name:             "undefined enumeration value",
+		in:               `{"top": {"mode": "SIDEWAYS"}}`,
+		wantErrSubstring: `"SIDEWAYS" is not a valid value of enumeration`,
+	}, {
+		name:             "list entry without key",
+		in:               `{"top": {"iface": [{"mtu": 1500}]}}`,
+		wantErrSubstring: "missing key name",
+	}}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			n, err := NewDynamicTree(schema)
+			if err != nil {
+				t.Fatalf("cannot create tree: %v", err)
+			}
+			err = n.Unmarshal7951([]byte(tt.in), tt.inOpts...)
+			if diff := errdiff.Substring(err, tt.wantErrSubstring); diff != "" {
+				t.Fatalf("did not get expected error, %s", diff)
+			}
+			if err != nil {
+				return
+			}
+			got, err := n.Marshal7951(tt.inConfig)
+			if err != nil {
+				t.Fatalf("cannot marshal tree: %v", err)
+			}
+			diff, err := jsonDiff(got, tt.want)
+			if err != nil {
+				t.Fatalf("cannot compare JSON: %v", err)
+			}
+			if diff != "" {
+				t.Errorf("did not get expected JSON, diff(-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDynamicInternalJSON(t *testing.T) {
+	schema := dynamicTestSchema(t)
+	n := dynamicTestTree(t, schema, `{"top": {
+		"big": "9007199254740993",
+		"flag": [null],
+		"kind": "dyn:ID-A",
+		"iface": [{"name": "eth0", "mtu": 1500}, {"name": "eth1"}],
+		"anon": [{"value": "a"}]
+	}}`)
+	want := `{"top": {
+		"big": 9007199254740993,
+		"flag": true,
+		"kind": "ID-A",
+		"iface": {"eth0": {"name": "eth0", "mtu": 1500}, "eth1": {"name": "eth1"}},
+		"anon": [{"value": "a"}]
+	}}`
+
+	j, err := n.ConstructInternalJSON()
+	if err != nil {
+		t.Fatalf("ConstructInternalJSON: unexpected error: %v", err)
+	}
+	got, err := json.Marshal(j)
+	if err != nil {
+		t.Fatalf("cannot marshal JSON: %v", err)
+	}
+	if string(got) != `{"top":{"anon":[{"value":"a"}],"big":9007199254740993,"flag":true,"iface":{"eth0":{"mtu":1500,"name":"eth0"},"eth1":{"name":"eth1"}},"kind":"ID-A"}}` {
+		t.Errorf("ConstructInternalJSON: got %s, want %s", got, want)
+	}
+
+	rt, err := NewDynamicTree(schema)
+	if err != nil {
+		t.Fatalf("cannot create tree: %v", err)
+	}
+	if err := rt.UnmarshalInternalJSON([]byte(want)); err != nil {
+		t.Fatalf("UnmarshalInternalJSON: unexpected error: %v", err)
+	}
+	orig, err := n.Marshal7951()
+	if err != nil {
+		t.Fatalf("cannot marshal original tree: %v", err)
+	}
+	rtJSON, err := rt.Marshal7951()
+	if err != nil {
+		t.Fatalf("cannot marshal unmarshalled tree: %v", err)
+	}
+	if string(orig) != string(rtJSON) {
+		t.Errorf("UnmarshalInternalJSON: got tree %s, want %s", rtJSON, orig)
+	}
+
+	leaves, err := n.GetNode(&gpb.Path{Elem: []*gpb.PathElem{{Name: "top"}, {Name: "kind"}}})
+	if err != nil {
+		t.Fatalf("GetNode: unexpected error: %v", err)
+	}
+	lj, err := leaves[0].Marshal7951(&ygot.RFC7951JSONConfig{AppendModuleName: true})
+	if err != nil {
+		t.Fatalf("Marshal7951: unexpected error: %v", err)
+	}
+	if got, want := string(lj), `{"dyn:kind":"dyn:ID-A"}`; got != want {
+		t.Errorf("Marshal7951 of leaf: got %s, want %s", got, want)
+	}
+}
+
+func TestDynamicValidate(t *testing.T) {
+	schema := dynamicTestSchema(t)
+
+	tests := []struct {
+		name             string
+		in               string
+		inOpts           []ygot.ValidationOption
+		wantErrSubstring string
+	}{{
+		name: "valid",
+		in: `{"top": {
+			"name": "eth",
+			"level": 100,
+			"tags": ["a", "b"],
+			"ref": "eth0",
+			"ref-mtu": 1500,
+			"iface": [{"name": "eth0", "mtu": 1500}, {"name": "eth1", "mtu": 9000}]
+		}}`,
+	}, {
+		name:             "pattern mismatch",
+		in:               `{"top": {"name": "ETH"}}`,
+		wantErrSubstring: `/top/name: schema "name": "ETH" does not match regular expression pattern`,
+	}, {
+		name:             "range from typedef",
+		in:               `{"top": {"level": 101}}`,
+		wantErrSubstring: "/top/level: schema \"level\": unsigned integer value 101 is outside specified ranges",
+	}, {
+		name:             "max-elements",
+		in:               `{"top": {"tags": ["a", "b", "c"]}}`,
+		wantErrSubstring: "/top/tags: list tags contains more than max allowed elements: 3 > 2",
+	}, {
+		name:             "missing leafref target",
+		in:               `{"top": {"ref": "eth1", "iface": [{"name": "eth0"}]}}`,
+		wantErrSubstring: "/top/ref: leafref value eth1 does not match any node at path ../iface/name",
+	}, {
+		name:             "leafref predicate selects a different entry",
+		in:               `{"top": {"ref": "eth0", "ref-mtu": 9000, "iface": [{"name": "eth0", "mtu": 1500}, {"name": "eth1", "mtu": 9000}]}}`,
+		wantErrSubstring: "/top/ref-mtu: leafref value 9000 does not match any node",
+	}, {
+		name:   "missing leafref target ignored",
+		in:     `{"top": {"ref": "eth1"}}`,
+		inOpts: []ygot.ValidationOption{&LeafrefOptions{IgnoreMissingData: true}},
+	}}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			n := dynamicTestTree(t, schema, tt.in)
+			var err error
+			if errs := n.Validate(tt.inOpts...); errs != nil {
+				err = errs
+			}
+			if diff := errdiff.Substring(err, tt.wantErrSubstring); diff != "" {
+				t.Errorf("did not get expected error, %s", diff)
+			}
+		})
+	}
+}
+
+func TestDynamicNodeOperations(t *testing.T) {
+	schema := dynamicTestSchema(t)
+	n := dynamicTestTree(t, schema, `{"top": {"iface": [{"name": "eth0", "mtu": 1500}, {"name": "eth1", "mtu": 9000}]}}`)
+
+	mtuPath := func(name string) *gpb.Path {
+		return &gpb.Path{Elem: []*gpb.PathElem{{Name: "top"}, {Name: "iface", Key: map[string]string{"name": name}}, {Name: "mtu"}}}
+	}
+
+	got, err := n.GetNode(mtuPath("eth1"))
+	if err != nil {
+		t.Fatalf("GetNode: cannot get eth1 mtu: %v", err)
+	}
+	if len(got) != 1 || got[0].Value() != uint16(9000) {
+		t.Errorf("GetNode: got %v, want single node with value 9000", got)
+	}
+	if diff := cmp.Diff(mtuPath("eth1"), got[0].Path(), protocmp.Transform()); diff != "" {
+		t.Errorf("Path: did not get expected path, diff(-want, +got):\n%s", diff)
+	}
+
+	wildcard := &gpb.Path{Elem: []*gpb.PathElem{{Name: "top"}, {Name: "iface", Key: map[string]string{"name": "*"}}, {Name: "mtu"}}}
+	if got, err := n.GetNode(wildcard, &GetHandleWildcards{}); err != nil || len(got) != 2 {
+		t.Errorf("GetNode: got %v, %v for wildcard path, want 2 nodes", got, err)
+	}
+
+	if _, err := n.GetNode(mtuPath("eth2")); status.Code(err) != codes.NotFound {
+		t.Errorf("GetNode: got error %v for missing entry, want NotFound", err)
+	}
+
+	if err := n.SetNode(mtuPath("eth2"), &gpb.TypedValue{Value: &gpb.TypedValue_UintVal{UintVal: 1400}}); status.Code(err) != codes.NotFound {
+		t.Errorf("SetNode: got error %v for missing entry without InitMissingElements, want NotFound", err)
+	}
+	if err := n.SetNode(mtuPath("eth2"), &gpb.TypedValue{Value: &gpb.TypedValue_UintVal{UintVal: 1400}}, &InitMissingElements{}); err != nil {
+		t.Fatalf("SetNode: cannot set eth2 mtu: %v", err)
+	}
+	if err := n.SetNode(mtuPath("eth2"), &gpb.TypedValue{Value: &gpb.TypedValue_UintVal{UintVal: 70000}}); err == nil {
+		t.Errorf("SetNode: did not get error for out of range value")
+	}
+
+	namePath := &gpb.Path{Elem: []*gpb.PathElem{{Name: "top"}, {Name: "iface", Key: map[string]string{"name": "eth2"}}, {Name: "name"}}}
+	if diff := errdiff.Substring(n.SetNode(namePath, &gpb.TypedValue{Value: &gpb.TypedValue_StringVal{StringVal: "eth3"}}), "cannot change the value of key name"); diff != "" {
+		t.Errorf("SetNode: did not get expected error, %s", diff)
+	}
+
+	tagsPath := &gpb.Path{Elem: []*gpb.PathElem{{Name: "top"}, {Name: "tags"}}}
+	tags := &gpb.TypedValue{Value: &gpb.TypedValue_LeaflistVal{LeaflistVal: &gpb.ScalarArray{Element: []*gpb.TypedValue{
+		{Value: &gpb.TypedValue_StringVal{StringVal: "x"}},
+	}}}}
+	if err := n.SetNode(tagsPath, tags, &InitMissingElements{}); err != nil {
+		t.Fatalf("SetNode: cannot set tags: %v", err)
+	}
+	optsPath := &gpb.Path{Elem: []*gpb.PathElem{{Name: "top"}, {Name: "opts"}}}
+	if err := n.SetNode(optsPath, &gpb.TypedValue{Value: &gpb.TypedValue_JsonIetfVal{JsonIetfVal: []byte(`{"a": "y"}`)}}, &InitMissingElements{}); err != nil {
+		t.Fatalf("SetNode: cannot set opts: %v", err)
+	}
+
+	if err := n.DeleteNode(&gpb.Path{Elem: []*gpb.PathElem{{Name: "top"}, {Name: "iface", Key: map[string]string{"name": "eth0"}}}}); err != nil {
+		t.Fatalf("DeleteNode: cannot delete eth0: %v", err)
+	}
+	if err := n.DeleteNode(mtuPath("eth5")); err != nil {
+		t.Errorf("DeleteNode: got error %v for missing node, want nil", err)
+	}
+
+	js, err := n.Marshal7951()
+	if err != nil {
+		t.Fatalf("cannot marshal tree: %v", err)
+	}
+	diff, err := jsonDiff(js, `{"top": {
+		"iface": [{"name": "eth1", "mtu": 9000}, {"name": "eth2", "mtu": 1400}],
+		"opts": {"a": "y"},
+		"tags": ["x"]
+	}}`)
+	if err != nil {
+		t.Fatalf("cannot compare JSON: %v", err)
+	}
+	if diff != "" {
+		t.Errorf("did not get expected tree, diff(-want, +got):\n%s", diff)
+	}
+}
+
+func TestDynamicTogNMINotifications(t *testing.T) {
+	schema := dynamicTestSchema(t)
+
+	tests := []struct {
+		name             string
+		in               string
+		inPath           *gpb.Path
+		want             []*gpb.Notification
+		wantErrSubstring string
+	}{{
+		name: "root",
+		in:   `{"top": {"name": "eth", "flag": [null], "tags": ["a"], "iface": [{"name": "eth0", "mtu": 1500}]}}`,
+		want: []*gpb.Notification{{
+			Timestamp: 42,
+			Update: []*gpb.Update{{
+				Path: &gpb.Path{Elem: []*gpb.PathElem{{Name: "top"}, {Name: "flag"}}},
+				Val:  &gpb.TypedValue{Value: &gpb.TypedValue_BoolVal{BoolVal: true}},
+			}, {
+				Path: &gpb.Path{Elem: []*gpb.PathElem{{Name: "top"}, {Name: "iface", Key: map[string]string{"name": "eth0"}}, {Name: "mtu"}}},
+				Val:  &gpb.TypedValue{Value: &gpb.TypedValue_UintVal{UintVal: 1500}},
+			}, {
+				Path: &gpb.Path{Elem: []*gpb.PathElem{{Name: "top"}, {Name: "iface", Key: map[string]string{"name": "eth0"}}, {Name: "name"}}},
+				Val:  &gpb.TypedValue{Value: &gpb.TypedValue_StringVal{StringVal: "eth0"}},
+			}, {
+				Path: &gpb.Path{Elem: []*gpb.PathElem{{Name: "top"}, {Name: "name"}}},
+				Val:  &gpb.TypedValue{Value: &gpb.TypedValue_StringVal{StringVal: "eth"}},
+			}, {
+				Path: &gpb.Path{Elem: []*gpb.PathElem{{Name: "top"}, {Name: "tags"}}},
+				Val: &gpb.TypedValue{Value: &gpb.TypedValue_LeaflistVal{LeaflistVal: &gpb.ScalarArray{Element: []*gpb.TypedValue{
+					{Value: &gpb.TypedValue_StringVal{StringVal: "a"}},
+				}}}},
+			}},
+		}},
+	}, {
+		name:   "subtree",
+		in:     `{"top": {"iface": [{"name": "eth0", "mtu": 1500}]}}`,
+		inPath: &gpb.Path{Elem: []*gpb.PathElem{{Name: "top"}, {Name: "iface", Key: map[string]string{"name": "eth0"}}}},
+		want: []*gpb.Notification{{
+			Timestamp: 42,
+			Prefix:    &gpb.Path{Elem: []*gpb.PathElem{{Name: "top"}, {Name: "iface", Key: map[string]string{"name": "eth0"}}}},
+			Update: []*gpb.Update{{
+				Path: &gpb.Path{Elem: []*gpb.PathElem{{Name: "mtu"}}},
+				Val:  &gpb.TypedValue{Value: &gpb.TypedValue_UintVal{UintVal: 1500}},
+			}, {
+				Path: &gpb.Path{Elem: []*gpb.PathElem{{Name: "name"}}},
+				Val:  &gpb.TypedValue{Value: &gpb.TypedValue_StringVal{StringVal: "eth0"}},
+			}},
+		}},
+	}, {
+		name:             "keyless list",
+		in:               `{"top": {"anon": [{"value": "a"}]}}`,
+		wantErrSubstring: "entries of keyless list /top/anon cannot be addressed by a path",
+	}}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			n := dynamicTestTree(t, schema, tt.in)
+			if tt.inPath != nil {
+				nodes, err := n.GetNode(tt.inPath)
+				if err != nil {
+					t.Fatalf("cannot get node %v: %v", tt.inPath, err)
+				}
+				n = nodes[0]
+			}
+			got, err := n.TogNMINotifications(42)
+			if diff := errdiff.Substring(err, tt.wantErrSubstring); diff != "" {
+				t.Fatalf("did not get expected error, %s", diff)
+			}
+			if diff := cmp.Diff(tt.want, got, protocmp.Transform()); diff != "" {
+				t.Errorf("did not get expected notifications, diff(-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDiffDynamic(t *testing.T) {
+	schema := dynamicTestSchema(t)
+
+	orig := dynamicTestTree(t, schema, `{"top": {"name": "eth", "tags": ["a"], "iface": [{"name": "eth0", "mtu": 1500}]}}`)
+	mod := dynamicTestTree(t, schema, `{"top": {"name": "eth", "tags": ["a", "b"], "iface": [{"name": "eth1", "mtu": 1500}]}}`)
+
+	got, err := DiffDynamic(orig, mod)
+	if err != nil {
+		t.Fatalf("DiffDynamic: unexpected error: %v", err)
+	}
+	want := &gpb.Notification{
+		Update: []*gpb.Update{{
+			Path: &gpb.Path{Elem: []*gpb.PathElem{{Name: "top"}, {Name: "iface", Key: map[string]string{"name": "eth1"}}, {Name: "mtu"}}},
+			Val:  &gpb.TypedValue{Value: &gpb.TypedValue_UintVal{UintVal: 1500}},
+		}, {
+			Path: &gpb.Path{Elem: []*gpb.PathElem{{Name: "top"}, {Name: "iface", Key: map[string]string{"name": "eth1"}}, {Name: "name"}}},
+			Val:  &gpb.TypedValue{Value: &gpb.TypedValue_StringVal{StringVal: "eth1"}},
+		}, {
+			Path: &gpb.Path{Elem: []*gpb.PathElem{{Name: "top"}, {Name: "tags"}}},
+			Val: &gpb.TypedValue{Value: &gpb.TypedValue_LeaflistVal{LeaflistVal: &gpb.ScalarArray{Element: []*gpb.TypedValue{
+				{Value: &gpb.TypedValue_StringVal{StringVal: "a"}},
+				{Value: &gpb.TypedValue_StringVal{StringVal: "b"}},
+			}}}},
+		}},
+		Delete: []*gpb.Path{
+			{Elem: []*gpb.PathElem{{Name: "top"}, {Name: "iface", Key: map[string]string{"name": "eth0"}}, {Name: "mtu"}}},
+			{Elem: []*gpb.PathElem{{Name: "top"}, {Name: "iface", Key: map[string]string{"name": "eth0"}}, {Name: "name"}}},
+		},
+	}
+	if diff := cmp.Diff(want, got, protocmp.Transform()); diff != "" {
+		t.Errorf("DiffDynamic: did not get expected notification, diff(-want, +got):\n%s", diff)
+	}
+
+	other, err := NewDynamicTree(schema.Dir["top"])
+	if err != nil {
+		t.Fatalf("cannot create tree: %v", err)
+	}
+	if _, err := DiffDynamic(orig, other); err == nil {
+		t.Errorf("DiffDynamic: did not get error for trees with different schemas")
+	}
+}
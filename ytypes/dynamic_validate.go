@@ -0,0 +1,331 @@
+// Copyright 2021 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ytypes
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/openconfig/goyang/pkg/yang"
+	"github.com/openconfig/ygot/util"
+	"github.com/openconfig/ygot/ygot"
+)
+
+// Validate validates the tree rooted at n against its schema. The values of
+// leaves are checked against their types, including any range, length and
+// pattern restrictions, the keys of list entries must be present, and lists
+// and leaf-lists must satisfy their min-elements and max-elements
+// statements. The value of each leafref must match the value of a node that
+// its path refers to within the tree, unless the IgnoreMissingData field of
+// a supplied LeafrefOptions is set. Absolute leafref paths are resolved from
+// the root of the tree.
+func (n *DynamicNode) Validate(opts ...ygot.ValidationOption) util.Errors {
+	var leafrefOpt *LeafrefOptions
+	for _, o := range opts {
+		if v, ok := o.(*LeafrefOptions); ok {
+			leafrefOpt = v
+		}
+	}
+
+	errs := n.validate()
+	if leafrefOpt != nil && leafrefOpt.IgnoreMissingData {
+		return errs
+	}
+	return util.AppendErrs(errs, n.validateLeafrefs())
+}
+
+// validate validates the tree rooted at n, other than its leafrefs.
+func (n *DynamicNode) validate() util.Errors {
+	var errs util.Errors
+	switch n.kind {
+	case dynamicContainer, dynamicListEntry:
+		if n.kind == dynamicListEntry {
+			for _, k := range keyNames(n.schema) {
+				if _, ok := n.children[k]; !ok {
+					errs = util.AppendErr(errs, fmt.Errorf("%s: missing key %s", n, k))
+				}
+			}
+		}
+		for _, c := range n.Children() {
+			errs = util.AppendErrs(errs, c.validate())
+		}
+	case dynamicList:
+		if n.schema.ListAttr != nil {
+			errs = util.AppendErrs(errs, n.wrapErrs(validateListAttr(n.schema, n.entries)))
+		}
+		for _, en := range n.entries {
+			errs = util.AppendErrs(errs, en.validate())
+		}
+	case dynamicLeaf:
+		errs = util.AppendErrs(errs, n.wrapErrs(validateDynamicLeaf(n.schema, n.value)))
+	case dynamicLeafList:
+		vals, ok := n.value.([]interface{})
+		if !ok {
+			return util.NewErrs(fmt.Errorf("%s: leaf-list value has type %T, expect []interface{}", n, n.value))
+		}
+		for _, v := range vals {
+			errs = util.AppendErrs(errs, n.wrapErrs(validateDynamicLeaf(n.schema, v)))
+		}
+		if n.schema.ListAttr != nil {
+			errs = util.AppendErrs(errs, n.wrapErrs(validateListAttr(n.schema, vals)))
+		}
+	}
+	return errs
+}
+
+// wrapErrs prefixes each of errs with the path of n.
+func (n *DynamicNode) wrapErrs(errs util.Errors) util.Errors {
+	var out util.Errors
+	for _, err := range errs {
+		out = util.AppendErr(out, fmt.Errorf("%s: %v", n, err))
+	}
+	return out
+}
+
+// validateDynamicLeaf validates the value v of the leaf, or leaf-list
+// element, with schema e.
+func validateDynamicLeaf(e *yang.Entry, v interface{}) util.Errors {
+	target, err := util.ResolveIfLeafRef(e)
+	if err != nil {
+		return util.NewErrs(err)
+	}
+	return validateDynamicValue(e.Name, target.Type, v)
+}
+
+// validateDynamicValue validates the value v, as stored in a DynamicNode,
+// against the type t of the leaf with the supplied name. Values that
+// generated GoStructs also store as Go types are validated by validateLeaf.
+func validateDynamicValue(name string, t *yang.YangType, v interface{}) util.Errors {
+	if v == nil {
+		return util.NewErrs(fmt.Errorf("nil value for schema %s", name))
+	}
+	e := &yang.Entry{Name: name, Kind: yang.LeafEntry, Type: t}
+
+	switch t.Kind {
+	case yang.Yunion:
+		for _, m := range util.FlattenedTypes(t.Type) {
+			if errs := validateDynamicValue(name, m, v); errs == nil {
+				return nil
+			}
+		}
+		return util.NewErrs(fmt.Errorf("value %v does not match any member of union type %s for schema %s", v, t.Name, name))
+	case yang.Yenum:
+		s, ok := v.(string)
+		if !ok || t.Enum == nil || !t.Enum.IsDefined(s) {
+			return util.NewErrs(fmt.Errorf("%v is not a valid value of enumeration %s for schema %s", v, t.Name, name))
+		}
+		return nil
+	case yang.Yidentityref:
+		s, ok := v.(string)
+		if !ok || t.IdentityBase == nil || !t.IdentityBase.IsDefined(s) {
+			return util.NewErrs(fmt.Errorf("%v is not a valid value of identityref %s for schema %s", v, t.Name, name))
+		}
+		return nil
+	case yang.Ybits:
+		s, ok := v.(string)
+		if !ok {
+			return util.NewErrs(fmt.Errorf("non string type %T with value %v for bits schema %s", v, v, name))
+		}
+		for _, b := range strings.Fields(s) {
+			if t.Bit == nil || !t.Bit.IsDefined(b) {
+				return util.NewErrs(fmt.Errorf("%s is not a valid bit of bits type %s for schema %s", b, t.Name, name))
+			}
+		}
+		return nil
+	case yang.Yempty, yang.Ybinary:
+		// validateLeaf expects the values of these types directly, rather
+		// than pointers to them.
+		if reflect.TypeOf(v) != reflect.TypeOf(yangBuiltinTypeToGoType(t.Kind)) {
+			return util.NewErrs(fmt.Errorf("bad value type %T for schema %s of type %v", v, name, t.Kind))
+		}
+		return validateLeaf(e, v)
+	case yang.Yleafref:
+		// A leafref within a union cannot be resolved without its
+		// containing schema, so only its target is checked.
+		return nil
+	}
+	p := reflect.New(reflect.TypeOf(v))
+	p.Elem().Set(reflect.ValueOf(v))
+	return validateLeaf(e, p.Interface())
+}
+
+// validateLeafrefs validates the values of the leafrefs within the tree
+// rooted at n.
+func (n *DynamicNode) validateLeafrefs() util.Errors {
+	var errs util.Errors
+	switch n.kind {
+	case dynamicContainer, dynamicListEntry:
+		for _, c := range n.Children() {
+			errs = util.AppendErrs(errs, c.validateLeafrefs())
+		}
+	case dynamicList:
+		for _, en := range n.entries {
+			errs = util.AppendErrs(errs, en.validateLeafrefs())
+		}
+	case dynamicLeaf, dynamicLeafList:
+		if n.schema.Type == nil || n.schema.Type.Kind != yang.Yleafref {
+			return nil
+		}
+		targets, err := n.resolveDataPath(util.StripModulePrefixesStr(n.schema.Type.Path))
+		if err != nil {
+			return util.NewErrs(fmt.Errorf("%s: cannot resolve leafref path %s: %v", n, n.schema.Type.Path, err))
+		}
+		want := dynamicValues(targets)
+		for _, v := range dynamicValues([]*DynamicNode{n}) {
+			if !containsDynamicValue(want, v) {
+				errs = util.AppendErr(errs, fmt.Errorf("%s: leafref value %v does not match any node at path %s", n, v, n.schema.Type.Path))
+			}
+		}
+	}
+	return errs
+}
+
+// dynamicValues returns the values of the leaves and the elements of the
+// leaf-lists within nodes.
+func dynamicValues(nodes []*DynamicNode) []interface{} {
+	var out []interface{}
+	for _, c := range nodes {
+		switch c.kind {
+		case dynamicLeaf:
+			out = append(out, c.value)
+		case dynamicLeafList:
+			vals, _ := c.value.([]interface{})
+			out = append(out, vals...)
+		}
+	}
+	return out
+}
+
+// containsDynamicValue reports whether v is one of vals.
+func containsDynamicValue(vals []interface{}, v interface{}) bool {
+	for _, w := range vals {
+		if reflect.DeepEqual(v, w) {
+			return true
+		}
+	}
+	return false
+}
+
+// dataParent returns the parent of n within the data tree, in which the entry
+// of a list is the child of the parent of the list.
+func (n *DynamicNode) dataParent() *DynamicNode {
+	p := n.parent
+	if p != nil && p.kind == dynamicList {
+		p = p.parent
+	}
+	return p
+}
+
+// resolveDataPath returns the nodes that the leafref path, from which module
+// prefixes have been removed, refers to, with n as the context node. Each
+// element of the path may contain a single predicate of the form
+// [key = current()/path] or [key = "literal"].
+func (n *DynamicNode) resolveDataPath(path string) ([]*DynamicNode, error) {
+	parts := util.SplitPath(path)
+	nodes := []*DynamicNode{n}
+	if strings.HasPrefix(path, "/") {
+		root := n
+		for root.parent != nil {
+			root = root.parent
+		}
+		nodes = []*DynamicNode{root}
+	}
+
+	for _, p := range parts {
+		switch p {
+		case "", ".":
+			continue
+		case "..":
+			var next []*DynamicNode
+			for _, c := range nodes {
+				if dp := c.dataParent(); dp != nil && !containsDynamicNode(next, dp) {
+					next = append(next, dp)
+				}
+			}
+			nodes = next
+			continue
+		}
+
+		name, k, v, err := extractKeyValue(p)
+		if err != nil {
+			return nil, err
+		}
+		var next []*DynamicNode
+		for _, c := range nodes {
+			if c.kind != dynamicContainer && c.kind != dynamicListEntry {
+				continue
+			}
+			e := dynamicChildSchema(c.schema, name)
+			if e == nil {
+				return nil, fmt.Errorf("no schema for path element %s within %s", name, c.schema.Name)
+			}
+			cc, ok := c.children[e.Name]
+			switch {
+			case !ok:
+				continue
+			case cc.kind != dynamicList:
+				next = append(next, cc)
+				continue
+			}
+			for _, en := range cc.entries {
+				match, err := en.matchesPredicate(n, k, v)
+				if err != nil {
+					return nil, err
+				}
+				if match {
+					next = append(next, en)
+				}
+			}
+		}
+		nodes = next
+	}
+	return nodes, nil
+}
+
+// matchesPredicate reports whether the list entry n matches the predicate
+// [k = v] of a leafref path that is resolved with the supplied context node.
+// An empty k matches all entries.
+func (n *DynamicNode) matchesPredicate(context *DynamicNode, k, v string) (bool, error) {
+	if k == "" {
+		return true, nil
+	}
+	kn, ok := n.children[k]
+	if !ok {
+		return false, nil
+	}
+	if isInQuotes(v) {
+		want, err := decodeStringLeaf(kn.schema, strings.Trim(v, `"`))
+		if err != nil {
+			return false, err
+		}
+		return reflect.DeepEqual(kn.value, want), nil
+	}
+	targets, err := context.resolveDataPath(strings.TrimPrefix(v, "current()/"))
+	if err != nil {
+		return false, err
+	}
+	return containsDynamicValue(dynamicValues(targets), kn.value), nil
+}
+
+// containsDynamicNode reports whether n is one of nodes.
+func containsDynamicNode(nodes []*DynamicNode, n *DynamicNode) bool {
+	for _, c := range nodes {
+		if c == n {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,187 @@
+// Copyright 2021 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ytypes
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/openconfig/goyang/pkg/yang"
+	"github.com/openconfig/ygot/util"
+	"github.com/openconfig/ygot/ygot"
+
+	gpb "github.com/openconfig/gnmi/proto/gnmi"
+)
+
+// flatLeaf is a leaf or leaf-list whose value is specified within the flat
+// text format.
+type flatLeaf struct {
+	// path is the path of the leaf.
+	path *gpb.Path
+	// line is the number of the first line that specifies the leaf.
+	line int
+	// values are the unquoted values specified for the leaf, in order.
+	values []string
+}
+
+// UnmarshalFlat sets the leaves of the GoStruct root, whose schema is
+// supplied, to the values specified in data, which is in the flat text
+// format rendered by ygot.MarshalFlat. Blank lines, and lines that begin
+// with ygot.FlatComment, are ignored. The value of each leaf is interpreted
+// according to its schema, such that enumerated values and identities are
+// specified by name, binary values are specified as base64, and the value
+// of a union is that of its first member type that the value is valid for.
+// A leaf-list is set to the values of all of the lines that specify its
+// path, in order. Leaves that are not specified in data are not modified.
+//
+// If the PreferShadowPath UnmarshalOpt is supplied, paths are matched
+// against the shadow paths of the GoStruct. If an error is returned, root is
+// not modified.
+func UnmarshalFlat(schema *yang.Entry, root ygot.GoStruct, data []byte, opts ...UnmarshalOpt) error {
+	if schema == nil {
+		return fmt.Errorf("nil schema supplied for %T", root)
+	}
+	if util.IsValueNil(root) {
+		return fmt.Errorf("nil GoStruct supplied")
+	}
+
+	leaves, err := parseFlat(data)
+	if err != nil {
+		return err
+	}
+
+	var (
+		gocOpts []GetOrCreateNodeOpt
+		setOpts = []SetNodeOpt{&InitMissingElements{}}
+	)
+	if hasPreferShadowPath(opts) {
+		gocOpts = append(gocOpts, &PreferShadowPath{})
+		setOpts = append(setOpts, &PreferShadowPath{})
+	}
+
+	// Entries of keyless lists can only be created in the order of their
+	// indices, which need not be the order of the input.
+	sort.SliceStable(leaves, func(i, j int) bool { return ygot.ComparePaths(leaves[i].path, leaves[j].path) < 0 })
+
+	cp, err := ygot.DeepCopy(root)
+	if err != nil {
+		return fmt.Errorf("cannot copy %T: %v", root, err)
+	}
+	for _, l := range leaves {
+		_, e, err := GetOrCreateNode(schema, cp, l.path, gocOpts...)
+		if err != nil {
+			return fmt.Errorf("line %d: %v", l.line, err)
+		}
+		j, err := flatLeafJSON(e, l.values)
+		if err != nil {
+			return fmt.Errorf("line %d: %v", l.line, err)
+		}
+		if err := SetNode(schema, cp, l.path, &gpb.TypedValue{Value: &gpb.TypedValue_JsonIetfVal{JsonIetfVal: j}}, setOpts...); err != nil {
+			return fmt.Errorf("line %d: %v", l.line, err)
+		}
+	}
+	reflect.ValueOf(root).Elem().Set(reflect.ValueOf(cp).Elem())
+	return nil
+}
+
+// parseFlat parses the flat text format data, returning the leaves that it
+// specifies in the order in which they first appear.
+func parseFlat(data []byte) ([]*flatLeaf, error) {
+	var leaves []*flatLeaf
+	byPath := map[string]*flatLeaf{}
+	for i, line := range strings.Split(string(data), "\n") {
+		line = strings.TrimSuffix(line, "\r")
+		if t := strings.TrimSpace(line); t == "" || strings.HasPrefix(t, ygot.FlatComment) {
+			continue
+		}
+		// Within paths, the = character is escaped in key values, such
+		// that the first separator ends the path.
+		idx := strings.Index(line, ygot.FlatSeparator)
+		if idx == -1 {
+			return nil, fmt.Errorf("line %d: no %q separator between path and value", i+1, ygot.FlatSeparator)
+		}
+		ps, vs := strings.TrimSpace(line[:idx]), line[idx+len(ygot.FlatSeparator):]
+		v, err := unquoteFlatValue(vs)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %v", i+1, err)
+		}
+
+		if l, ok := byPath[ps]; ok {
+			l.values = append(l.values, v)
+			continue
+		}
+		p, err := ygot.StringToStructuredPath(ps)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: invalid path %s: %v", i+1, ps, err)
+		}
+		l := &flatLeaf{path: p, line: i + 1, values: []string{v}}
+		byPath[ps] = l
+		leaves = append(leaves, l)
+	}
+	return leaves, nil
+}
+
+// unquoteFlatValue returns the value represented by s within a line of the
+// flat text format, which is a quoted Go string if it begins with a double
+// quote.
+func unquoteFlatValue(s string) (string, error) {
+	if !strings.HasPrefix(s, `"`) {
+		return s, nil
+	}
+	u, err := strconv.Unquote(s)
+	if err != nil {
+		return "", fmt.Errorf("invalid quoted value %s: %v", s, err)
+	}
+	return u, nil
+}
+
+// flatLeafJSON returns the RFC7951 JSON encoding of the supplied values of
+// the leaf or leaf-list with schema e.
+func flatLeafJSON(e *yang.Entry, values []string) ([]byte, error) {
+	if e == nil || !(e.IsLeaf() || e.IsLeafList()) {
+		return nil, fmt.Errorf("path does not refer to a leaf or leaf-list")
+	}
+	if e.IsLeaf() && len(values) != 1 {
+		return nil, fmt.Errorf("leaf %s is specified %d times", e.Name, len(values))
+	}
+
+	cfg := dynamicJSONConfig{format: ygot.RFC7951, rfc7951Config: &ygot.RFC7951JSONConfig{}}
+	var vals []interface{}
+	for _, s := range values {
+		v, err := decodeStringLeaf(e, s)
+		if err != nil {
+			return nil, fmt.Errorf("invalid value %q for %s: %v", s, e.Name, err)
+		}
+		jv, err := encodeJSONLeaf(e, v, cfg)
+		if err != nil {
+			return nil, fmt.Errorf("cannot encode value %q for %s: %v", s, e.Name, err)
+		}
+		vals = append(vals, jv)
+	}
+
+	var j interface{} = vals
+	if e.IsLeaf() {
+		j = vals[0]
+	}
+	b, err := json.Marshal(j)
+	if err != nil {
+		return nil, fmt.Errorf("cannot marshal JSON for %s: %v", e.Name, err)
+	}
+	return b, nil
+}
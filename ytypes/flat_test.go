@@ -0,0 +1,356 @@
+// Copyright 2021 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ytypes
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/openconfig/gnmi/errdiff"
+	"github.com/openconfig/goyang/pkg/yang"
+	"github.com/openconfig/ygot/testutil"
+	"github.com/openconfig/ygot/ygot"
+)
+
+type flatRoot struct {
+	Name  *string                   `path:"name" module:"fmod"`
+	Mtu   *uint16                   `path:"mtu" module:"fmod"`
+	Big   *int64                    `path:"big" module:"fmod"`
+	Ratio *float64                  `path:"ratio" module:"fmod"`
+	Kind  EnumType                  `path:"kind" module:"fmod"`
+	Ident EnumType2                 `path:"ident" module:"fmod"`
+	Data  Binary                    `path:"data" module:"fmod"`
+	Flag  YANGEmpty                 `path:"flag" module:"fmod"`
+	Value UnionLeafTypeSimple       `path:"value" module:"fmod"`
+	Tags  []string                  `path:"tags" module:"fmod"`
+	Entry map[string]*flatRootEntry `path:"entry" module:"fmod"`
+	Log   []*flatRootEntry          `path:"log" module:"fmod"`
+}
+
+func (*flatRoot) IsYANGGoStruct()                          {}
+func (*flatRoot) ΛValidate(...ygot.ValidationOption) error { return nil }
+func (*flatRoot) ΛBelongingModule() string                 { return "" }
+
+func (*flatRoot) ΛEnumTypeMap() map[string][]reflect.Type {
+	return map[string][]reflect.Type{
+		"/flat-root/value": {reflect.TypeOf(EnumType(0))},
+	}
+}
+
+func (*flatRoot) To_UnionLeafTypeSimple(i interface{}) (UnionLeafTypeSimple, error) {
+	if v, ok := i.(UnionLeafTypeSimple); ok {
+		return v, nil
+	}
+	switch v := i.(type) {
+	case string:
+		return testutil.UnionString(v), nil
+	case uint32:
+		return testutil.UnionUint32(v), nil
+	}
+	return nil, fmt.Errorf("cannot convert %v to UnionLeafTypeSimple, unknown union type, got: %T, want any of [string, uint32, EnumType]", i, i)
+}
+
+type flatRootEntry struct {
+	Name *string `path:"name" module:"fmod"`
+	Desc *string `path:"desc" module:"fmod"`
+}
+
+func (*flatRootEntry) IsYANGGoStruct()                          {}
+func (*flatRootEntry) ΛValidate(...ygot.ValidationOption) error { return nil }
+func (*flatRootEntry) ΛEnumTypeMap() map[string][]reflect.Type  { return nil }
+func (*flatRootEntry) ΛBelongingModule() string                 { return "fmod" }
+
+func (e *flatRootEntry) ΛListKeyMap() (map[string]interface{}, error) {
+	return map[string]interface{}{"name": *e.Name}, nil
+}
+
+func flatSchema(t *testing.T) *yang.Entry {
+	t.Helper()
+	enum := yang.NewEnumType()
+	if err := enum.Set("E_VALUE_FORTY_TWO", 42); err != nil {
+		t.Fatalf("cannot create enumeration: %v", err)
+	}
+	leaf := func(name string, typ *yang.YangType) *yang.Entry {
+		return &yang.Entry{Name: name, Kind: yang.LeafEntry, Type: typ}
+	}
+	s := &yang.Entry{
+		Name: "flat-root",
+		Kind: yang.DirectoryEntry,
+		Dir: map[string]*yang.Entry{
+			"name":  leaf("name", &yang.YangType{Kind: yang.Ystring}),
+			"mtu":   leaf("mtu", &yang.YangType{Kind: yang.Yuint16}),
+			"big":   leaf("big", &yang.YangType{Kind: yang.Yint64}),
+			"ratio": leaf("ratio", &yang.YangType{Kind: yang.Ydecimal64, FractionDigits: 2}),
+			"kind":  leaf("kind", &yang.YangType{Kind: yang.Yenum, Enum: enum}),
+			"ident": leaf("ident", &yang.YangType{
+				Kind: yang.Yidentityref,
+				IdentityBase: &yang.Identity{
+					Name:   "BASE",
+					Values: []*yang.Identity{{Name: "E_VALUE_FORTY_THREE"}},
+				},
+			}),
+			"data": leaf("data", &yang.YangType{Kind: yang.Ybinary}),
+			"flag": leaf("flag", &yang.YangType{Kind: yang.Yempty}),
+			"value": leaf("value", &yang.YangType{
+				Kind: yang.Yunion,
+				Type: []*yang.YangType{
+					{Kind: yang.Yuint32, Range: yang.YangRange{{Min: yang.FromInt(0), Max: yang.FromInt(100)}}},
+					{Kind: yang.Yenum, Enum: enum},
+					{Kind: yang.Ystring},
+				},
+			}),
+			"tags": {
+				Name:     "tags",
+				Kind:     yang.LeafEntry,
+				ListAttr: yang.NewDefaultListAttr(),
+				Type:     &yang.YangType{Kind: yang.Ystring},
+			},
+			"log": {
+				Name:     "log",
+				Kind:     yang.DirectoryEntry,
+				ListAttr: yang.NewDefaultListAttr(),
+				Dir: map[string]*yang.Entry{
+					"name": leaf("name", &yang.YangType{Kind: yang.Ystring}),
+					"desc": leaf("desc", &yang.YangType{Kind: yang.Ystring}),
+				},
+			},
+			"entry": {
+				Name:     "entry",
+				Kind:     yang.DirectoryEntry,
+				ListAttr: yang.NewDefaultListAttr(),
+				Key:      "name",
+				Dir: map[string]*yang.Entry{
+					"name": leaf("name", &yang.YangType{Kind: yang.Ystring}),
+					"desc": leaf("desc", &yang.YangType{Kind: yang.Ystring}),
+				},
+			},
+		},
+	}
+	addParents(s)
+	return s
+}
+
+func TestUnmarshalFlat(t *testing.T) {
+	tests := []struct {
+		name             string
+		inRoot           *flatRoot
+		in               string
+		inOpts           []UnmarshalOpt
+		want             *flatRoot
+		wantErrSubstring string
+	}{{
+		name: "scalar types",
+		in: `/big = -9000000000
+/data = AQID
+/flag = true
+/ident = E_VALUE_FORTY_THREE
+/kind = E_VALUE_FORTY_TWO
+/mtu = 1500
+/name = eth0
+/ratio = 1.25
+`,
+		want: &flatRoot{
+			Big:   ygot.Int64(-9000000000),
+			Data:  Binary{1, 2, 3},
+			Flag:  true,
+			Ident: 43,
+			Kind:  42,
+			Mtu:   ygot.Uint16(1500),
+			Name:  ygot.String("eth0"),
+			Ratio: ygot.Float64(1.25),
+		},
+	}, {
+		name: "identity with module prefix",
+		in:   "/ident = fmod:E_VALUE_FORTY_THREE\n",
+		want: &flatRoot{Ident: 43},
+	}, {
+		name: "union matching first member",
+		in:   "/value = 42\n",
+		want: &flatRoot{Value: testutil.UnionUint32(42)},
+	}, {
+		name: "union matching enumeration",
+		in:   "/value = E_VALUE_FORTY_TWO\n",
+		want: &flatRoot{Value: EnumType(42)},
+	}, {
+		name: "union value outside range of integer member",
+		in:   "/value = 420\n",
+		want: &flatRoot{Value: testutil.UnionString("420")},
+	}, {
+		name: "leaf-list and list entries",
+		in: `/entry[name=eth0]/desc = uplink
+/entry[name=eth0]/name = eth0
+/entry[name=eth1]/name = eth1
+/tags = b
+/tags = a
+`,
+		want: &flatRoot{
+			Entry: map[string]*flatRootEntry{
+				"eth0": {Name: ygot.String("eth0"), Desc: ygot.String("uplink")},
+				"eth1": {Name: ygot.String("eth1")},
+			},
+			Tags: []string{"b", "a"},
+		},
+	}, {
+		name: "keyless list entries out of order",
+		in: `/log[index=10]/name = k
+/log[index=2]/name = c
+/log[index=0]/name = a
+/log[index=1]/name = b
+/log[index=3]/name = d
+/log[index=4]/name = e
+/log[index=5]/name = f
+/log[index=6]/name = g
+/log[index=7]/name = h
+/log[index=8]/name = i
+/log[index=9]/name = j
+`,
+		want: &flatRoot{Log: []*flatRootEntry{
+			{Name: ygot.String("a")}, {Name: ygot.String("b")}, {Name: ygot.String("c")},
+			{Name: ygot.String("d")}, {Name: ygot.String("e")}, {Name: ygot.String("f")},
+			{Name: ygot.String("g")}, {Name: ygot.String("h")}, {Name: ygot.String("i")},
+			{Name: ygot.String("j")}, {Name: ygot.String("k")},
+		}},
+	}, {
+		name: "comments, blank lines and quoted values",
+		in: `# interfaces
+
+/entry[name=eth0]/desc = "  spaced\n"
+/name = ""
+`,
+		want: &flatRoot{
+			Entry: map[string]*flatRootEntry{
+				"eth0": {Name: ygot.String("eth0"), Desc: ygot.String("  spaced\n")},
+			},
+			Name: ygot.String(""),
+		},
+	}, {
+		name:   "merge with existing tree",
+		inRoot: &flatRoot{Name: ygot.String("eth0"), Tags: []string{"x"}},
+		in:     "/mtu = 1500\n/tags = y\n",
+		want:   &flatRoot{Name: ygot.String("eth0"), Mtu: ygot.Uint16(1500), Tags: []string{"y"}},
+	}, {
+		name:             "missing separator",
+		inRoot:           &flatRoot{Name: ygot.String("eth0")},
+		in:               "/mtu = 1500\n/name\n",
+		want:             &flatRoot{Name: ygot.String("eth0")},
+		wantErrSubstring: "line 2: no",
+	}, {
+		name:             "invalid integer",
+		inRoot:           &flatRoot{Name: ygot.String("eth0")},
+		in:               "/name = eth1\n/mtu = 70000\n",
+		want:             &flatRoot{Name: ygot.String("eth0")},
+		wantErrSubstring: `line 2: invalid value "70000" for mtu`,
+	}, {
+		name:             "invalid enumeration",
+		in:               "/kind = E_VALUE_FORTY_THREE\n",
+		want:             &flatRoot{},
+		wantErrSubstring: "is not a valid value of enumeration",
+	}, {
+		name:             "invalid binary",
+		in:               "/data = !!\n",
+		want:             &flatRoot{},
+		wantErrSubstring: `invalid value "!!" for data`,
+	}, {
+		name:             "leaf specified twice",
+		in:               "/name = a\n/mtu = 1\n/name = b\n",
+		want:             &flatRoot{},
+		wantErrSubstring: "line 1: leaf name is specified 2 times",
+	}, {
+		name:             "path to non-leaf",
+		in:               "/entry[name=eth0] = a\n",
+		want:             &flatRoot{},
+		wantErrSubstring: "does not refer to a leaf",
+	}, {
+		name:             "unknown path",
+		in:               "/speed = 100\n",
+		want:             &flatRoot{},
+		wantErrSubstring: "line 1: ",
+	}, {
+		name:             "invalid quoted value",
+		in:               "/name = \"abc\n",
+		want:             &flatRoot{},
+		wantErrSubstring: "invalid quoted value",
+	}}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			root := tt.inRoot
+			if root == nil {
+				root = &flatRoot{}
+			}
+			err := UnmarshalFlat(flatSchema(t), root, []byte(tt.in), tt.inOpts...)
+			if diff := errdiff.Substring(err, tt.wantErrSubstring); diff != "" {
+				t.Fatalf("did not get expected error, %s", diff)
+			}
+			if diff := cmp.Diff(tt.want, root); diff != "" {
+				t.Errorf("did not get expected GoStruct, diff(-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestFlatRoundTrip(t *testing.T) {
+	in := &flatRoot{
+		Name:  ygot.String("first = second"),
+		Mtu:   ygot.Uint16(9000),
+		Big:   ygot.Int64(9007199254740993),
+		Ratio: ygot.Float64(-0.5),
+		Kind:  42,
+		Ident: 43,
+		Data:  Binary("binary"),
+		Value: EnumType(42),
+		Tags:  []string{"z", "", "a"},
+		Entry: map[string]*flatRootEntry{
+			"a=b]": {Name: ygot.String("a=b]"), Desc: ygot.String(`"quoted"`)},
+			"c":    {Name: ygot.String("c")},
+		},
+		Log: []*flatRootEntry{{Name: ygot.String("x")}, {Desc: ygot.String("y")}},
+	}
+
+	b, err := ygot.MarshalFlat(in)
+	if err != nil {
+		t.Fatalf("MarshalFlat: unexpected error: %v", err)
+	}
+	want := `/big = 9007199254740993
+/data = YmluYXJ5
+/entry[name=a\=b\]]/desc = "\"quoted\""
+/entry[name=a\=b\]]/name = a=b]
+/entry[name=c]/name = c
+/ident = E_VALUE_FORTY_THREE
+/kind = E_VALUE_FORTY_TWO
+/log[index=0]/name = x
+/log[index=1]/desc = y
+/mtu = 9000
+/name = first = second
+/ratio = -0.5
+/tags = z
+/tags = ""
+/tags = a
+/value = E_VALUE_FORTY_TWO
+`
+	if diff := cmp.Diff(want, string(b)); diff != "" {
+		t.Errorf("MarshalFlat: did not get expected output, diff(-want, +got):\n%s", diff)
+	}
+
+	got := &flatRoot{}
+	if err := UnmarshalFlat(flatSchema(t), got, b); err != nil {
+		t.Fatalf("UnmarshalFlat: unexpected error: %v", err)
+	}
+	if diff := cmp.Diff(in, got); diff != "" {
+		t.Errorf("UnmarshalFlat: did not get expected GoStruct, diff(-want, +got):\n%s", diff)
+	}
+}
@@ -40,16 +40,41 @@ import (
 // entire data tree. The supplied LeafrefOptions specify particular behaviours
 // of the leafref validation such as ignoring missing pointed to elements.
 func ValidateLeafRefData(schema *yang.Entry, value interface{}, opt *LeafrefOptions) util.Errors {
+	return validateLeafRefData(schema, value, opt, nil)
+}
+
+// validateLeafRefData validates the leafrefs within the tree with root value
+// and the given corresponding schema, as per ValidateLeafRefData. If visit is
+// non-nil, it is called for each node of the tree, and only the leafrefs for
+// which it returns true are validated. If it returns util.SkipDescendants, the
+// descendants of the node are not traversed.
+func validateLeafRefData(schema *yang.Entry, value interface{}, opt *LeafrefOptions, visit func(*util.NodeInfo) (bool, error)) util.Errors {
 	// If the IgnoreMissingData flag is set, then we do not need to iterate through nodes,
 	// so immediately return no error.
 	if opt != nil && opt.IgnoreMissingData {
 		return nil
 	}
 
+	// valueIndex stores the values of the nodes that are referred to by
+	// leafrefs, such that the nodes matching each leafref query are
+	// indexed at most once.
+	valueIndex := leafrefValueIndex{}
+
 	// validateLeafRefDataIterFunc is called on every node in the tree through
 	// ForEachField below.
 	validateLeafRefDataIterFunc := func(ni *util.NodeInfo, in, out interface{}) util.Errors {
-		if util.IsValueNil(ni) || util.IsNilOrInvalidValue(ni.FieldValue) {
+		if util.IsValueNil(ni) {
+			return nil
+		}
+		if visit != nil {
+			switch ok, err := visit(ni); {
+			case err != nil:
+				return util.NewErrs(err)
+			case !ok:
+				return nil
+			}
+		}
+		if util.IsNilOrInvalidValue(ni.FieldValue) {
 			return nil
 		}
 		schema := ni.Schema
@@ -68,7 +93,7 @@ func ValidateLeafRefData(schema *yang.Entry, value interface{}, opt *LeafrefOpti
 		if err != nil {
 			return util.NewErrs(err)
 		}
-		matchNodes, err := dataNodesAtPath(ni, gNMIPath, pathQueryNode)
+		matchNodes, q, err := queryDataNodesAtPath(ni, gNMIPath, pathQueryNode)
 		if err != nil {
 			return util.NewErrs(err)
 		}
@@ -76,7 +101,7 @@ func ValidateLeafRefData(schema *yang.Entry, value interface{}, opt *LeafrefOpti
 		pathStr := util.StripModulePrefixesStr(schema.Type.Path)
 		util.DbgPrint("Verifying leafref at %s, matching nodes are: %v", pathStr, util.ValueStrDebug(matchNodes))
 
-		match, err := matchesNodes(ni, matchNodes)
+		match, err := valueIndex.matches(ni, q, matchNodes)
 		if err != nil {
 			return leafrefErrOrLog(util.NewErrs(err), opt)
 		}
@@ -172,9 +197,27 @@ func leafRefToGNMIPath(root *util.NodeInfo, path string, pathQueryNode *util.Pat
 // dataNodesAtPath returns all nodes that match the given path from the given
 // node.
 func dataNodesAtPath(ni *util.NodeInfo, path *gpb.Path, pathQueryNode *util.PathQueryNodeMemo) ([]interface{}, error) {
+	nodes, _, err := queryDataNodesAtPath(ni, path, pathQueryNode)
+	return nodes, err
+}
+
+// leafrefQuery identifies a query for the nodes at a path made by
+// queryDataNodesAtPath. Queries with the same leafrefQuery return the same
+// nodes.
+type leafrefQuery struct {
+	// memo is the memo of the node from which the query was made.
+	memo *util.PathQueryNodeMemo
+	// path is the path queried from the node.
+	path string
+}
+
+// queryDataNodesAtPath returns all nodes that match the given path from the
+// given node, along with a leafrefQuery that identifies the query. The
+// leafrefQuery is the zero value if the query was not memoized.
+func queryDataNodesAtPath(ni *util.NodeInfo, path *gpb.Path, pathQueryNode *util.PathQueryNodeMemo) ([]interface{}, leafrefQuery, error) {
 	util.DbgPrint("DataNodeAtPath got leafref with path %s from node path %s, field name %s", path, ni.Schema.Path(), ni.StructField.Name)
 	if path == nil || len(path.GetElem()) == 0 {
-		return []interface{}{ni}, nil
+		return []interface{}{ni}, leafrefQuery{}, nil
 	}
 	root := getDataTreeRoot(ni)
 	pathQueryRoot := pathQueryNode.GetRoot()
@@ -187,7 +230,7 @@ func dataNodesAtPath(ni *util.NodeInfo, path *gpb.Path, pathQueryNode *util.Path
 		pathQueryRoot = pathQueryNode
 		for len(path.GetElem()) != 0 && path.GetElem()[0].GetName() == ".." {
 			if root.Parent == nil {
-				return nil, fmt.Errorf("no parent for leafref path at %v, with remaining path %s", ni.Schema.Path(), path)
+				return nil, leafrefQuery{}, fmt.Errorf("no parent for leafref path at %v, with remaining path %s", ni.Schema.Path(), path)
 			}
 			if (root.Parent.Schema.IsList() && util.IsValueMap(root.Parent.FieldValue)) || (root.Parent.Schema.IsLeafList() && util.IsValueSlice(root.Parent.FieldValue)) {
 				// YANG lists and YANG leaf-lists are represented as Go maps and slices respectively.
@@ -220,17 +263,18 @@ func dataNodesAtPath(ni *util.NodeInfo, path *gpb.Path, pathQueryNode *util.Path
 	// Get the query path for this node.
 	strPath, err := ygot.PathToString(path)
 	if err != nil {
-		return nil, err
+		return nil, leafrefQuery{}, err
 	}
+	q := leafrefQuery{memo: pathQueryRoot, path: strPath}
 
 	// Now, check for a previous identical query in the memo map.
 	qVal, ok := pathQueryRoot.Memo[strPath]
 	if ok {
-		return qVal.Nodes, qVal.Err
+		return qVal.Nodes, q, qVal.Err
 	}
 	nodes, _, err := util.GetNodes(root.Schema, root.FieldValue.Interface(), path)
 	pathQueryRoot.Memo[strPath] = util.PathQueryResult{Nodes: nodes, Err: err}
-	return nodes, err
+	return nodes, q, err
 }
 
 // removeParentDirPrefix removes the leading .. from path and returns the
@@ -0,0 +1,90 @@
+// Copyright 2021 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ytypes
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/openconfig/ygot/ygot"
+
+	gpb "github.com/openconfig/gnmi/proto/gnmi"
+)
+
+// benchmarkLeafrefRoot returns a validatePathRoot with n interfaces, each of
+// which is referred to by an element of a leaf-list.
+func benchmarkLeafrefRoot(n int) *validatePathRoot {
+	root := &validatePathRoot{
+		Interface: validatePathInterfaces(n),
+		System:    &validatePathSystem{},
+	}
+	for i := 0; i < n; i++ {
+		root.System.OtherIntfs = append(root.System.OtherIntfs, fmt.Sprintf("eth%d", i))
+	}
+	return root
+}
+
+func BenchmarkValidateLeafRefData(b *testing.B) {
+	schema := validatePathSchema()
+	for _, n := range []int{10, 100, 1000} {
+		root := benchmarkLeafrefRoot(n)
+		b.Run(fmt.Sprintf("%d interfaces", n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				if errs := ValidateLeafRefData(schema, root, nil); errs != nil {
+					b.Fatalf("ValidateLeafRefData: got unexpected error: %v", errs)
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkLeafrefIndexReferrers(b *testing.B) {
+	schema := validatePathSchema()
+	for _, n := range []int{10, 100, 1000} {
+		x, err := NewLeafrefIndex(schema, benchmarkLeafrefRoot(n))
+		if err != nil {
+			b.Fatalf("NewLeafrefIndex: got unexpected error: %v", err)
+		}
+		path := &gpb.Path{Elem: []*gpb.PathElem{{Name: "interfaces"}, {Name: "interface", Key: map[string]string{"name": "eth0"}}}}
+		b.Run(fmt.Sprintf("%d interfaces", n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				if _, err := x.Referrers(path); err != nil {
+					b.Fatalf("Referrers: got unexpected error: %v", err)
+				}
+			}
+		})
+	}
+}
+
+// BenchmarkValidatePath validates a subtree that is unrelated to the
+// interfaces of the data tree, such that the cost of each call should not
+// depend on the number of interfaces.
+func BenchmarkValidatePath(b *testing.B) {
+	schema := validatePathSchema()
+	path := &gpb.Path{Elem: []*gpb.PathElem{{Name: "system"}, {Name: "hostname"}}}
+	for _, n := range []int{10, 100, 1000} {
+		root := &validatePathRoot{
+			Interface: validatePathInterfaces(n),
+			System:    &validatePathSystem{Hostname: ygot.String("host")},
+		}
+		b.Run(fmt.Sprintf("%d interfaces", n), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				if errs := ValidatePath(schema, root, path); errs != nil {
+					b.Fatalf("ValidatePath: got unexpected error: %v", errs)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,320 @@
+// Copyright 2021 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ytypes
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/openconfig/goyang/pkg/yang"
+	"github.com/openconfig/ygot/util"
+	"github.com/openconfig/ygot/ygot"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	gpb "github.com/openconfig/gnmi/proto/gnmi"
+)
+
+// minIndexedLeafrefTargets is the minimum number of nodes returned by a
+// leafref query for which the values of the nodes are indexed. Smaller sets of
+// nodes are compared directly, since it is cheaper than building an index.
+const minIndexedLeafrefTargets = 8
+
+// leafrefValueKey returns the key used to index the value v of a leaf, or
+// leaf-list element, that is the source or target of a leafref. Pointers are
+// dereferenced, such that two values have the same key if they are equal
+// according to util.DeepEqualDerefPtrs. It returns false if v cannot be
+// indexed.
+func leafrefValueKey(v interface{}) (interface{}, bool) {
+	rv := reflect.ValueOf(v)
+	if !util.IsValueScalar(rv) {
+		return nil, false
+	}
+	if util.IsValuePtr(rv) {
+		return rv.Elem().Interface(), true
+	}
+	return v, true
+}
+
+// leafrefValueSet returns the set of the keys of the values of the supplied
+// nodes that are the target of a leafref, as returned by leafrefValueKey. The
+// elements of leaf-lists are included individually. Nodes that are nil or
+// have their default value are ignored, as per matchesNodes. It returns nil
+// if any of the nodes cannot be indexed.
+func leafrefValueSet(nodes []interface{}) map[interface{}]bool {
+	set := map[interface{}]bool{}
+	for _, n := range nodes {
+		if util.IsValueNilOrDefault(n) {
+			continue
+		}
+		nv := reflect.ValueOf(n)
+		switch {
+		case util.IsValueScalar(nv):
+			k, ok := leafrefValueKey(n)
+			if !ok {
+				return nil
+			}
+			set[k] = true
+		case util.IsValueSlice(nv):
+			for i := 0; i < nv.Len(); i++ {
+				e := nv.Index(i).Interface()
+				if util.IsValueNil(e) {
+					continue
+				}
+				k, ok := leafrefValueKey(e)
+				if !ok {
+					return nil
+				}
+				set[k] = true
+			}
+		default:
+			return nil
+		}
+	}
+	return set
+}
+
+// leafrefValueIndex stores the sets of values of the nodes returned by each
+// leafref query made whilst validating a data tree, such that each leafref
+// that refers to the same set of nodes can be checked without comparing it to
+// each node.
+type leafrefValueIndex map[leafrefQuery]map[interface{}]bool
+
+// matches reports whether the value of the leafref ni matches any of the
+// nodes that were returned by the query q, as per matchesNodes, using the
+// index where possible.
+func (x leafrefValueIndex) matches(ni *util.NodeInfo, q leafrefQuery, nodes []interface{}) (bool, error) {
+	if q == (leafrefQuery{}) || len(nodes) < minIndexedLeafrefTargets || util.IsNilOrInvalidValue(ni.FieldValue) || util.IsValueNilOrDefault(ni.FieldValue.Interface()) {
+		return matchesNodes(ni, nodes)
+	}
+	k, ok := leafrefValueKey(ni.FieldValue.Interface())
+	if !ok {
+		return matchesNodes(ni, nodes)
+	}
+
+	set, ok := x[q]
+	if !ok {
+		set = leafrefValueSet(nodes)
+		x[q] = set
+	}
+	if set == nil {
+		return matchesNodes(ni, nodes)
+	}
+	return set[k], nil
+}
+
+// LeafrefReferrer describes a leafref within a data tree.
+type LeafrefReferrer struct {
+	// Path is the path of the leafref within the data tree, relative to the
+	// root of the tree.
+	Path *gpb.Path
+	// Schema is the schema of the leafref.
+	Schema *yang.Entry
+	// Value is the value of the leafref. For an element of a leaf-list, it
+	// is the value of the element.
+	Value interface{}
+}
+
+// LeafrefIndex is an index of the leafrefs within a data tree, keyed by the
+// nodes that they refer to. It allows the nodes that refer to a particular
+// subtree - for example, all the nodes that refer to an interface - to be
+// found without walking the data tree for each lookup. The index reflects the
+// data tree at the time that it was built by NewLeafrefIndex, and must be
+// rebuilt if the tree is modified.
+type LeafrefIndex struct {
+	// schema and root are the schema and root of the indexed data tree.
+	schema *yang.Entry
+	root   interface{}
+	// referrers stores the leafrefs within the tree, keyed by the schema
+	// path of the node that they refer to, and then by the key of their
+	// value as returned by leafrefValueKey.
+	referrers map[string]map[interface{}][]*LeafrefReferrer
+}
+
+// NewLeafrefIndex builds a LeafrefIndex for the data tree with root root,
+// whose schema is schema. root should be the root of the entire data tree,
+// such that the leafrefs that refer to any node within it are indexed.
+func NewLeafrefIndex(schema *yang.Entry, root interface{}) (*LeafrefIndex, error) {
+	if schema == nil {
+		return nil, fmt.Errorf("nil schema for type %T, value %v", root, root)
+	}
+	x := &LeafrefIndex{
+		schema:    schema,
+		root:      root,
+		referrers: map[string]map[interface{}][]*LeafrefReferrer{},
+	}
+
+	errs := util.ForEachField(schema, root, nil, nil, func(ni *util.NodeInfo, in, out interface{}) util.Errors {
+		if util.IsValueNil(ni) || util.IsNilOrInvalidValue(ni.FieldValue) || util.IsValueNil(ni.FieldValue.Interface()) {
+			return nil
+		}
+		s := ni.Schema
+		if s == nil || !util.IsLeafRef(s) || s.IsLeafList() {
+			return nil
+		}
+		target, err := util.FindLeafRefSchema(s, s.Type.Path)
+		if err != nil {
+			return util.NewErrs(err)
+		}
+		v := ni.FieldValue.Interface()
+		k, ok := leafrefValueKey(v)
+		if !ok {
+			return util.NewErrs(fmt.Errorf("cannot index value %s of leafref %s", util.ValueStr(v), s.Path()))
+		}
+		p, err := nodeDataPath(ni)
+		if err != nil {
+			return util.NewErrs(err)
+		}
+
+		tp := leafrefSchemaPathKey(target)
+		if x.referrers[tp] == nil {
+			x.referrers[tp] = map[interface{}][]*LeafrefReferrer{}
+		}
+		x.referrers[tp][k] = append(x.referrers[tp][k], &LeafrefReferrer{Path: p, Schema: s, Value: v})
+		return nil
+	})
+	if errs != nil {
+		return nil, errs
+	}
+	return x, nil
+}
+
+// Referrers returns the leafrefs that refer to a node within the subtree at
+// path within the indexed data tree. The path may contain wildcards. Leafrefs
+// are matched to the nodes that they refer to by schema path and value, such
+// that a leafref whose path contains predicates is returned if it has the
+// same value as a node within the subtree, regardless of the predicates. The
+// leafrefs are returned sorted by path, and then by value. If there is no
+// node at path, no leafrefs are returned.
+func (x *LeafrefIndex) Referrers(path *gpb.Path) ([]*LeafrefReferrer, error) {
+	nodes, err := GetNode(x.schema, x.root, path, &GetHandleWildcards{})
+	switch {
+	case status.Code(err) == codes.NotFound:
+		return nil, nil
+	case err != nil:
+		return nil, err
+	}
+
+	seen := map[*LeafrefReferrer]bool{}
+	var refs []*LeafrefReferrer
+	for _, n := range nodes {
+		errs := util.ForEachField(n.Schema, n.Data, nil, nil, func(ni *util.NodeInfo, in, out interface{}) util.Errors {
+			if util.IsValueNil(ni) || util.IsNilOrInvalidValue(ni.FieldValue) || ni.Schema == nil || !ni.Schema.IsLeaf() {
+				return nil
+			}
+			byValue := x.referrers[leafrefSchemaPathKey(ni.Schema)]
+			if byValue == nil {
+				return nil
+			}
+			k, ok := leafrefValueKey(ni.FieldValue.Interface())
+			if !ok {
+				return nil
+			}
+			for _, r := range byValue[k] {
+				if !seen[r] {
+					seen[r] = true
+					refs = append(refs, r)
+				}
+			}
+			return nil
+		})
+		if errs != nil {
+			return nil, errs
+		}
+	}
+
+	// The elements of a leaf-list share the path of the leaf-list, and are
+	// ordered by value.
+	type sortKey struct{ path, value string }
+	keys := map[*LeafrefReferrer]sortKey{}
+	for _, r := range refs {
+		p, err := ygot.PathToString(r.Path)
+		if err != nil {
+			return nil, err
+		}
+		k, _ := leafrefValueKey(r.Value)
+		keys[r] = sortKey{path: p, value: fmt.Sprint(k)}
+	}
+	sort.Slice(refs, func(i, j int) bool {
+		ki, kj := keys[refs[i]], keys[refs[j]]
+		if ki.path != kj.path {
+			return ki.path < kj.path
+		}
+		return ki.value < kj.value
+	})
+	return refs, nil
+}
+
+// referrersWithin returns the leafrefs that refer to a node whose schema path,
+// excluding its module name, has the supplied prefix, regardless of their
+// value.
+func (x *LeafrefIndex) referrersWithin(prefix []string) []*LeafrefReferrer {
+	var refs []*LeafrefReferrer
+	for tp, byValue := range x.referrers {
+		if !pathMatchesPrefix(strings.Split(tp, "/"), prefix) {
+			continue
+		}
+		for _, rs := range byValue {
+			refs = append(refs, rs...)
+		}
+	}
+	return refs
+}
+
+// leafrefSchemaPathKey returns the schema path of the supplied entry, without
+// its module name, for use as the key of a LeafrefIndex.
+func leafrefSchemaPathKey(e *yang.Entry) string {
+	return strings.Join(util.SchemaPathNoChoiceCase(e)[1:], "/")
+}
+
+// nodeDataPath returns the data tree path of the node ni, relative to the
+// node at which the traversal by util.ForEachField started. The keys of YANG
+// list entries are determined using their ΛListKeyMap method.
+func nodeDataPath(ni *util.NodeInfo) (*gpb.Path, error) {
+	var elems []*gpb.PathElem
+	var keys map[string]string
+	for n := ni; n != nil; n = n.Parent {
+		switch {
+		case n.FieldKey.IsValid():
+			// The node is an entry of a keyed list, whose path is
+			// that of the list, with the keys of the entry.
+			k, err := ygot.PathKeyFromStruct(n.FieldValue)
+			if err != nil {
+				return nil, err
+			}
+			keys = k
+			continue
+		case n.Parent != nil && util.IsValueSlice(n.Parent.FieldValue):
+			// The node is an element of a leaf-list or keyless list,
+			// whose path is that of the list.
+			continue
+		}
+		for i := len(n.PathFromParent) - 1; i >= 0; i-- {
+			e := &gpb.PathElem{Name: n.PathFromParent[i]}
+			if keys != nil {
+				e.Key = keys
+				keys = nil
+			}
+			elems = append(elems, e)
+		}
+	}
+
+	for i, j := 0, len(elems)-1; i < j; i, j = i+1, j-1 {
+		elems[i], elems[j] = elems[j], elems[i]
+	}
+	return &gpb.Path{Elem: elems}, nil
+}
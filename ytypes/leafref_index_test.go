@@ -0,0 +1,171 @@
+// Copyright 2021 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ytypes
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/openconfig/gnmi/errdiff"
+	"github.com/openconfig/ygot/ygot"
+)
+
+// validatePathInterfaces returns a map of n interfaces, named eth0 to
+// eth<n-1>, for use in a validatePathRoot.
+func validatePathInterfaces(n int) map[string]*validatePathInterface {
+	intfs := map[string]*validatePathInterface{}
+	for i := 0; i < n; i++ {
+		name := fmt.Sprintf("eth%d", i)
+		intfs[name] = &validatePathInterface{Name: ygot.String(name)}
+	}
+	return intfs
+}
+
+func TestValidateLeafRefDataIndexed(t *testing.T) {
+	schema := validatePathSchema()
+
+	tests := []struct {
+		desc             string
+		inRoot           *validatePathRoot
+		inOpts           *LeafrefOptions
+		wantErrSubstring string
+	}{{
+		desc: "valid leaf",
+		inRoot: &validatePathRoot{
+			Interface: validatePathInterfaces(20),
+			System:    &validatePathSystem{MgmtIntf: ygot.String("eth19")},
+		},
+	}, {
+		desc: "invalid leaf",
+		inRoot: &validatePathRoot{
+			Interface: validatePathInterfaces(20),
+			System:    &validatePathSystem{MgmtIntf: ygot.String("eth20")},
+		},
+		wantErrSubstring: "value eth20 (string ptr) schema path /device/system/mgmt-intf has leafref path /interfaces/interface/name not equal to any target nodes",
+	}, {
+		desc: "valid leaf-list",
+		inRoot: &validatePathRoot{
+			Interface: validatePathInterfaces(20),
+			System:    &validatePathSystem{OtherIntfs: []string{"eth0", "eth5", "eth19"}},
+		},
+	}, {
+		desc: "invalid leaf-list element",
+		inRoot: &validatePathRoot{
+			Interface: validatePathInterfaces(20),
+			System:    &validatePathSystem{OtherIntfs: []string{"eth0", "eth42", "eth19"}},
+		},
+		wantErrSubstring: "value eth42",
+	}, {
+		desc: "invalid leaf, ignoring missing data",
+		inRoot: &validatePathRoot{
+			Interface: validatePathInterfaces(20),
+			System:    &validatePathSystem{MgmtIntf: ygot.String("eth20")},
+		},
+		inOpts: &LeafrefOptions{IgnoreMissingData: true},
+	}}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			var err error
+			if errs := ValidateLeafRefData(schema, tt.inRoot, tt.inOpts); errs != nil {
+				err = errs
+			}
+			if diff := errdiff.Substring(err, tt.wantErrSubstring); diff != "" {
+				t.Errorf("ValidateLeafRefData: %s", diff)
+			}
+		})
+	}
+}
+
+func TestLeafrefIndexReferrers(t *testing.T) {
+	schema := validatePathSchema()
+	root := &validatePathRoot{
+		Interface: validatePathInterfaces(3),
+		System: &validatePathSystem{
+			MgmtIntf:   ygot.String("eth0"),
+			OtherIntfs: []string{"eth0", "eth1"},
+		},
+	}
+
+	x, err := NewLeafrefIndex(schema, root)
+	if err != nil {
+		t.Fatalf("NewLeafrefIndex: got unexpected error: %v", err)
+	}
+
+	tests := []struct {
+		desc             string
+		inPath           string
+		want             []string
+		wantErrSubstring string
+	}{{
+		desc:   "list entry referred to by leaf and leaf-list",
+		inPath: "/interfaces/interface[name=eth0]",
+		want:   []string{"/system/mgmt-intf: eth0", "/system/other-intfs: eth0"},
+	}, {
+		desc:   "leaf referred to by leaf-list",
+		inPath: "/interfaces/interface[name=eth1]/name",
+		want:   []string{"/system/other-intfs: eth1"},
+	}, {
+		desc:   "list entry that is not referred to",
+		inPath: "/interfaces/interface[name=eth2]",
+	}, {
+		desc:   "all list entries",
+		inPath: "/interfaces/interface[name=*]",
+		want:   []string{"/system/mgmt-intf: eth0", "/system/other-intfs: eth0", "/system/other-intfs: eth1"},
+	}, {
+		desc:   "subtree containing leafrefs but not their targets",
+		inPath: "/system",
+	}, {
+		desc:   "missing list entry",
+		inPath: "/interfaces/interface[name=eth3]",
+	}, {
+		desc:             "missing container",
+		inPath:           "/system/unknown",
+		wantErrSubstring: "no match found",
+	}}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			refs, err := x.Referrers(mustPath(tt.inPath))
+			if diff := errdiff.Substring(err, tt.wantErrSubstring); diff != "" {
+				t.Fatalf("Referrers: %s", diff)
+			}
+			var got []string
+			for _, r := range refs {
+				p, err := ygot.PathToString(r.Path)
+				if err != nil {
+					t.Fatalf("cannot convert path %v to string: %v", r.Path, err)
+				}
+				got = append(got, fmt.Sprintf("%s: %v", p, *mustDeref(r.Value)))
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("Referrers: did not get expected leafrefs (-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+// mustDeref returns a pointer to the string v, or the string that v points
+// to.
+func mustDeref(v interface{}) *string {
+	switch s := v.(type) {
+	case string:
+		return &s
+	case *string:
+		return s
+	}
+	panic(fmt.Sprintf("unexpected value %v (%T)", v, v))
+}
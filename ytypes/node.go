@@ -17,6 +17,7 @@ package ytypes
 import (
 	"encoding/json"
 	"reflect"
+	"strconv"
 
 	"github.com/openconfig/goyang/pkg/yang"
 	"github.com/openconfig/ygot/util"
@@ -152,7 +153,10 @@ func retrieveNodeContainer(schema *yang.Entry, root interface{}, path *gpb.Path,
 
 		checkPath := func(p []string, args retrieveNodeArgs, shadowLeaf bool) ([]*TreeNode, error) {
 			to := len(p)
-			if util.IsTypeMap(ft.Type) {
+			// A keyless list is traversed per-entry if the path continues beyond
+			// the list, or the list element is keyed with the index pseudo key.
+			keylessListEntry := isKeylessList(ft.Type) && (len(path.GetElem()) > to || len(path.GetElem()[to-1].GetKey()) != 0)
+			if util.IsTypeMap(ft.Type) || keylessListEntry {
 				to--
 			}
 			np := &gpb.Path{}
@@ -201,6 +205,26 @@ func retrieveNodeContainer(schema *yang.Entry, root interface{}, path *gpb.Path,
 			// the field doesn't have a schema, so it is handled separately.
 			if !util.IsValueNil(args.val) && len(path.Elem) == to {
 				switch {
+				case isKeylessList(ft.Type) && args.val.(*gpb.TypedValue).GetJsonIetfVal() != nil:
+					// A keyless list that is set as a whole replaces any existing
+					// entries within the list.
+					var jsonTree interface{}
+					if err := json.Unmarshal(args.val.(*gpb.TypedValue).GetJsonIetfVal(), &jsonTree); err != nil {
+						return nil, status.Errorf(codes.Unknown, "failed to update struct field %s in %T with value %v; %v", ft.Name, root, args.val, err)
+					}
+					var opts []UnmarshalOpt
+					if args.preferShadowPath {
+						opts = append(opts, &PreferShadowPath{})
+					}
+					fv.Set(reflect.Zero(ft.Type))
+					if err := Unmarshal(cschema, fv.Addr().Interface(), jsonTree, opts...); err != nil {
+						return nil, status.Errorf(codes.Unknown, "failed to update struct field %s in %T with value %v; %v", ft.Name, root, args.val, err)
+					}
+					return []*TreeNode{{
+						Path:   np,
+						Schema: cschema,
+						Data:   fv.Interface(),
+					}}, nil
 				case util.IsYgotAnnotation(ft):
 					if err := util.UpdateField(root, ft.Name, args.val); err != nil {
 						return nil, status.Errorf(codes.Unknown, "failed to update struct field %s in %T with value %v, because of %v", ft.Name, root, args.val, err)
@@ -238,6 +262,10 @@ func retrieveNodeContainer(schema *yang.Entry, root interface{}, path *gpb.Path,
 				// the struct rather than having to use the parent struct.
 			}
 
+			if keylessListEntry {
+				return retrieveNodeKeylessList(cschema, fv, util.TrimGNMIPathPrefix(path, p[0:to]), np, args)
+			}
+
 			return retrieveNode(cschema, fv.Interface(), util.TrimGNMIPathPrefix(path, p[0:to]), np, args)
 		}
 
@@ -431,6 +459,67 @@ func retrieveNodeList(schema *yang.Entry, root interface{}, path, traversedPath
 	return matches, nil
 }
 
+// retrieveNodeKeylessList is an internal function and operates on a slice
+// which represents a YANG list without keys. The supplied list must be
+// addressable such that entries can be added or removed. Entries are
+// identified by the ygot.KeylessListIndexKey pseudo key within the first
+// element of path, whose value is the index of the entry within the list. If
+// the index is not specified, or is a wildcard, all entries of the list are
+// matched when partial key matches or wildcards are handled respectively.
+func retrieveNodeKeylessList(schema *yang.Entry, list reflect.Value, path, traversedPath *gpb.Path, args retrieveNodeArgs) ([]*TreeNode, error) {
+	if len(path.GetElem()) == 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "path length is 0, schema %v, root %v", schema, list.Interface())
+	}
+
+	elem := path.GetElem()[0]
+	idxStr, ok := elem.GetKey()[ygot.KeylessListIndexKey]
+	switch {
+	case len(elem.GetKey()) > 1 || (!ok && len(elem.GetKey()) != 0):
+		return nil, status.Errorf(codes.InvalidArgument, "keyless list can only be indexed by the %s key, path %v", ygot.KeylessListIndexKey, path)
+	case !ok && args.partialKeyMatch, ok && idxStr == "*" && args.handleWildcards:
+		var matches []*TreeNode
+		for i := 0; i < list.Len(); i++ {
+			nodes, err := retrieveNode(schema, list.Index(i).Interface(), util.PopGNMIPath(path), appendElem(traversedPath, &gpb.PathElem{Name: elem.GetName(), Key: map[string]string{ygot.KeylessListIndexKey: strconv.Itoa(i)}}), args)
+			if err != nil {
+				return nil, err
+			}
+			matches = append(matches, nodes...)
+		}
+		return matches, nil
+	case !ok:
+		return nil, status.Errorf(codes.NotFound, "index key %s is not found in gNMI path %v for keyless list", ygot.KeylessListIndexKey, path)
+	}
+
+	idx, err := strconv.Atoi(idxStr)
+	if err != nil || idx < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid index %q for keyless list, path %v", idxStr, path)
+	}
+
+	switch {
+	case idx < list.Len():
+	case idx == list.Len() && args.modifyRoot:
+		list.Set(reflect.Append(list, reflect.New(list.Type().Elem().Elem())))
+	case args.delete:
+		// No-op in case of a delete of an entry that does not exist.
+		return nil, nil
+	default:
+		return nil, status.Errorf(codes.NotFound, "could not find entry at index %d of keyless list with %d entries, path %v", idx, list.Len(), path)
+	}
+
+	remainingPath := util.PopGNMIPath(path)
+	if args.delete && len(remainingPath.GetElem()) == 0 {
+		list.Set(reflect.AppendSlice(list.Slice(0, idx), list.Slice(idx+1, list.Len())))
+		return nil, nil
+	}
+	return retrieveNode(schema, list.Index(idx).Interface(), remainingPath, appendElem(traversedPath, elem), args)
+}
+
+// isKeylessList determines whether the supplied type represents a YANG list
+// without keys, which is a slice of struct pointers in a GoStruct.
+func isKeylessList(t reflect.Type) bool {
+	return util.IsTypeSlice(t) && util.IsTypeStructPtr(t.Elem())
+}
+
 // GetOrCreateNodeOpt defines an interface that can be used to supply arguments to functions using GetOrCreateNode.
 type GetOrCreateNodeOpt interface {
 	// IsGetOrCreateNodeOpt is a marker method that is used to identify an instance of GetOrCreateNodeOpt.
@@ -18,14 +18,18 @@ import (
 	"encoding/json"
 	"fmt"
 	"reflect"
+	"sort"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/openconfig/gnmi/errdiff"
 	"github.com/openconfig/goyang/pkg/yang"
+	"github.com/openconfig/ygot/testutil"
 	"github.com/openconfig/ygot/ygot"
 	"google.golang.org/protobuf/encoding/prototext"
 	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/testing/protocmp"
 
 	gpb "github.com/openconfig/gnmi/proto/gnmi"
 )
@@ -2850,6 +2854,163 @@ func TestDeleteNode(t *testing.T) {
 	}
 }
 
+type keylessListRoot struct {
+	List []*keylessListEntry `path:"list"`
+}
+
+func (*keylessListRoot) IsYANGGoStruct()                          {}
+func (*keylessListRoot) ΛValidate(...ygot.ValidationOption) error { return nil }
+func (*keylessListRoot) ΛEnumTypeMap() map[string][]reflect.Type  { return nil }
+func (*keylessListRoot) ΛBelongingModule() string                 { return "" }
+
+type keylessListEntry struct {
+	Val *string `path:"val"`
+}
+
+func (*keylessListEntry) IsYANGGoStruct()                          {}
+func (*keylessListEntry) ΛValidate(...ygot.ValidationOption) error { return nil }
+func (*keylessListEntry) ΛEnumTypeMap() map[string][]reflect.Type  { return nil }
+func (*keylessListEntry) ΛBelongingModule() string                 { return "" }
+
+func keylessListSchema() *yang.Entry {
+	root := &yang.Entry{
+		Name: "root",
+		Kind: yang.DirectoryEntry,
+		Dir:  map[string]*yang.Entry{},
+	}
+	list := &yang.Entry{
+		Name:     "list",
+		Kind:     yang.DirectoryEntry,
+		ListAttr: yang.NewDefaultListAttr(),
+		Config:   yang.TSFalse,
+		Parent:   root,
+		Dir:      map[string]*yang.Entry{},
+	}
+	list.Dir["val"] = &yang.Entry{
+		Name:   "val",
+		Kind:   yang.LeafEntry,
+		Type:   &yang.YangType{Kind: yang.Ystring},
+		Parent: list,
+	}
+	root.Dir["list"] = list
+	return root
+}
+
+func TestKeylessList(t *testing.T) {
+	newRoot := func() *keylessListRoot {
+		return &keylessListRoot{
+			List: []*keylessListEntry{
+				{Val: ygot.String("zero")},
+				{Val: ygot.String("one")},
+			},
+		}
+	}
+
+	t.Run("get entry by index", func(t *testing.T) {
+		got, err := GetNode(keylessListSchema(), newRoot(), mustPath("/list[index=1]/val"))
+		if err != nil {
+			t.Fatalf("GetNode: unexpected error, %v", err)
+		}
+		if len(got) != 1 {
+			t.Fatalf("GetNode: did not get expected number of nodes, got: %d, want: 1", len(got))
+		}
+		if diff := cmp.Diff(ygot.String("one"), got[0].Data); diff != "" {
+			t.Errorf("GetNode: did not get expected data, diff(-want,+got):\n%s", diff)
+		}
+		if !proto.Equal(got[0].Path, mustPath("/list[index=1]/val")) {
+			t.Errorf("GetNode: did not get expected path, got: %v", got[0].Path)
+		}
+	})
+
+	t.Run("get entries with wildcard index", func(t *testing.T) {
+		got, err := GetNode(keylessListSchema(), newRoot(), mustPath("/list[index=*]/val"), &GetHandleWildcards{})
+		if err != nil {
+			t.Fatalf("GetNode: unexpected error, %v", err)
+		}
+		want := []*TreeNode{{
+			Path: mustPath("/list[index=0]/val"),
+			Data: ygot.String("zero"),
+		}, {
+			Path: mustPath("/list[index=1]/val"),
+			Data: ygot.String("one"),
+		}}
+		if diff := cmp.Diff(want, got, cmpopts.IgnoreFields(TreeNode{}, "Schema"), protocmp.Transform()); diff != "" {
+			t.Errorf("GetNode: did not get expected nodes, diff(-want,+got):\n%s", diff)
+		}
+	})
+
+	t.Run("get entry with out of range index", func(t *testing.T) {
+		_, err := GetNode(keylessListSchema(), newRoot(), mustPath("/list[index=2]/val"))
+		if diff := errdiff.Substring(err, "could not find entry at index 2"); diff != "" {
+			t.Errorf("GetNode: did not get expected error, %s", diff)
+		}
+	})
+
+	t.Run("get entry with invalid key", func(t *testing.T) {
+		_, err := GetNode(keylessListSchema(), newRoot(), mustPath("/list[name=foo]/val"))
+		if diff := errdiff.Substring(err, "can only be indexed by the index key"); diff != "" {
+			t.Errorf("GetNode: did not get expected error, %s", diff)
+		}
+	})
+
+	t.Run("set leaf in new entry", func(t *testing.T) {
+		root := newRoot()
+		if err := SetNode(keylessListSchema(), root, mustPath("/list[index=2]/val"), &gpb.TypedValue{Value: &gpb.TypedValue_StringVal{StringVal: "two"}}, &InitMissingElements{}); err != nil {
+			t.Fatalf("SetNode: unexpected error, %v", err)
+		}
+		want := newRoot()
+		want.List = append(want.List, &keylessListEntry{Val: ygot.String("two")})
+		if diff := cmp.Diff(want, root); diff != "" {
+			t.Errorf("SetNode: did not get expected root, diff(-want,+got):\n%s", diff)
+		}
+	})
+
+	t.Run("set whole list as JSON", func(t *testing.T) {
+		root := newRoot()
+		val := &gpb.TypedValue{Value: &gpb.TypedValue_JsonIetfVal{JsonIetfVal: []byte(`[{"val": "new"}]`)}}
+		if err := SetNode(keylessListSchema(), root, mustPath("/list"), val); err != nil {
+			t.Fatalf("SetNode: unexpected error, %v", err)
+		}
+		want := &keylessListRoot{List: []*keylessListEntry{{Val: ygot.String("new")}}}
+		if diff := cmp.Diff(want, root); diff != "" {
+			t.Errorf("SetNode: did not get expected root, diff(-want,+got):\n%s", diff)
+		}
+	})
+
+	t.Run("delete entry", func(t *testing.T) {
+		root := newRoot()
+		if err := DeleteNode(keylessListSchema(), root, mustPath("/list[index=0]")); err != nil {
+			t.Fatalf("DeleteNode: unexpected error, %v", err)
+		}
+		want := &keylessListRoot{List: []*keylessListEntry{{Val: ygot.String("one")}}}
+		if diff := cmp.Diff(want, root); diff != "" {
+			t.Errorf("DeleteNode: did not get expected root, diff(-want,+got):\n%s", diff)
+		}
+	})
+
+	for _, mode := range []ygot.KeylessListMode{ygot.KeylessListAsJSONIETF, ygot.KeylessListIndexKeys} {
+		t.Run(fmt.Sprintf("notification round trip, mode %d", mode), func(t *testing.T) {
+			notifs, err := ygot.TogNMINotifications(newRoot(), 42, ygot.GNMINotificationsConfig{UsePathElem: true, KeylessListMode: mode})
+			if err != nil {
+				t.Fatalf("TogNMINotifications: unexpected error, %v", err)
+			}
+			got := &keylessListRoot{}
+			// Updates are applied in index order, since an entry can only be
+			// appended to the end of the list.
+			updates := notifs[0].GetUpdate()
+			sort.Slice(updates, func(i, j int) bool { return testutil.PathLess(updates[i].GetPath(), updates[j].GetPath()) })
+			for _, u := range updates {
+				if err := SetNode(keylessListSchema(), got, u.GetPath(), u.GetVal(), &InitMissingElements{}); err != nil {
+					t.Fatalf("SetNode(%v): unexpected error, %v", u.GetPath(), err)
+				}
+			}
+			if diff := cmp.Diff(newRoot(), got); diff != "" {
+				t.Errorf("did not get expected root, diff(-want,+got):\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestRetrieveNodeError(t *testing.T) {
 	tests := []struct {
 		desc             string
@@ -0,0 +1,568 @@
+// Copyright 2021 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ytypes
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/openconfig/goyang/pkg/yang"
+	"github.com/openconfig/ygot/util"
+	"github.com/openconfig/ygot/ygot"
+
+	gpb "github.com/openconfig/gnmi/proto/gnmi"
+)
+
+// ApplyJSONPatch applies the RFC6902 JSON Patch patch to the GoStruct root,
+// whose schema is supplied. The operations of the patch are applied in order
+// to the RFC7951 JSON representation of root, with module names prepended to
+// elements, as produced by ygot.DiffToJSONPatch. The result is then
+// unmarshalled, using the supplied UnmarshalOpts, to replace the contents of
+// root. If an error is returned, root is not modified.
+func ApplyJSONPatch(schema *yang.Entry, root ygot.GoStruct, patch []*ygot.JSONPatchOperation, opts ...UnmarshalOpt) error {
+	doc, err := patchDocument(schema, root)
+	if err != nil {
+		return err
+	}
+
+	for i, op := range patch {
+		if doc, err = applyJSONPatchOperation(doc, op); err != nil {
+			return fmt.Errorf("operation %d (%s %s): %v", i, op.Op, op.Path, err)
+		}
+	}
+	return unmarshalPatchDocument(schema, root, doc, opts)
+}
+
+// ApplyYANGPatch applies the RFC8072 YANG Patch patch to the GoStruct root,
+// whose schema is supplied. The edits of the patch are applied in order to the
+// RFC7951 JSON representation of root, with the target of each edit being a
+// RESTCONF data resource identifier relative to root. The result is then
+// unmarshalled, using the supplied UnmarshalOpts, to replace the contents of
+// root. If an error is returned, root is not modified.
+//
+// The insert and move operations, which apply only to user-ordered lists, are
+// not supported.
+func ApplyYANGPatch(schema *yang.Entry, root ygot.GoStruct, patch *ygot.YANGPatch, opts ...UnmarshalOpt) error {
+	if patch == nil {
+		return fmt.Errorf("nil patch supplied to ApplyYANGPatch")
+	}
+
+	doc, err := patchDocument(schema, root)
+	if err != nil {
+		return err
+	}
+
+	obj, ok := doc.(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("RFC7951 JSON for %T is not an object", root)
+	}
+	for _, e := range patch.Edit {
+		if err := applyYANGPatchEdit(schema, obj, e); err != nil {
+			return fmt.Errorf("edit %s (%s %s): %v", e.EditID, e.Operation, e.Target, err)
+		}
+	}
+	return unmarshalPatchDocument(schema, root, obj, opts)
+}
+
+// patchDocument returns the RFC7951 JSON representation of the GoStruct root
+// that a patch is applied to, as decoded by encoding/json.
+func patchDocument(schema *yang.Entry, root ygot.GoStruct) (interface{}, error) {
+	if schema == nil {
+		return nil, fmt.Errorf("nil schema supplied for %T", root)
+	}
+	if util.IsValueNil(root) {
+		return nil, fmt.Errorf("nil GoStruct supplied")
+	}
+
+	j, err := ygot.ConstructIETFJSON(root, &ygot.RFC7951JSONConfig{AppendModuleName: true})
+	if err != nil {
+		return nil, fmt.Errorf("cannot construct RFC7951 JSON for %T: %v", root, err)
+	}
+	return decodedJSON(j)
+}
+
+// unmarshalPatchDocument unmarshals the patched RFC7951 JSON document doc into
+// a new GoStruct of the same type as root, and replaces the contents of root
+// with it if unmarshalling succeeds.
+func unmarshalPatchDocument(schema *yang.Entry, root ygot.GoStruct, doc interface{}, opts []UnmarshalOpt) error {
+	// Values supplied within the patch are not necessarily of the types
+	// produced by encoding/json.
+	doc, err := decodedJSON(doc)
+	if err != nil {
+		return err
+	}
+
+	nv := reflect.New(reflect.TypeOf(root).Elem())
+	if err := Unmarshal(schema, nv.Interface(), doc, opts...); err != nil {
+		return fmt.Errorf("cannot unmarshal patched JSON: %v", err)
+	}
+	reflect.ValueOf(root).Elem().Set(nv.Elem())
+	return nil
+}
+
+// decodedJSON returns a copy of the JSON tree v whose values are of the types
+// produced by decoding JSON using encoding/json.
+func decodedJSON(v interface{}) (interface{}, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, fmt.Errorf("cannot marshal JSON value %v: %v", v, err)
+	}
+	var out interface{}
+	if err := json.Unmarshal(b, &out); err != nil {
+		return nil, fmt.Errorf("cannot unmarshal JSON value %s: %v", b, err)
+	}
+	return out, nil
+}
+
+// applyJSONPatchOperation applies the JSON Patch operation op to the JSON
+// document doc, returning the patched document.
+func applyJSONPatchOperation(doc interface{}, op *ygot.JSONPatchOperation) (interface{}, error) {
+	path, err := util.ParseJSONPointer(op.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	switch op.Op {
+	case ygot.JSONPatchAdd, ygot.JSONPatchReplace, ygot.JSONPatchTest:
+		v, err := decodedJSON(op.Value)
+		if err != nil {
+			return nil, err
+		}
+		switch op.Op {
+		case ygot.JSONPatchAdd:
+			return addJSONPointer(doc, path, v)
+		case ygot.JSONPatchReplace:
+			return replaceJSONPointer(doc, path, v)
+		}
+		cv, err := getJSONPointer(doc, path)
+		if err != nil {
+			return nil, err
+		}
+		if !reflect.DeepEqual(cv, v) {
+			return nil, fmt.Errorf("value %v is not equal to %v", cv, v)
+		}
+		return doc, nil
+	case ygot.JSONPatchRemove:
+		return removeJSONPointer(doc, path)
+	case ygot.JSONPatchMove, ygot.JSONPatchCopy:
+		from, err := util.ParseJSONPointer(op.From)
+		if err != nil {
+			return nil, err
+		}
+		v, err := getJSONPointer(doc, from)
+		if err != nil {
+			return nil, err
+		}
+		if op.Op == ygot.JSONPatchCopy {
+			if v, err = decodedJSON(v); err != nil {
+				return nil, err
+			}
+			return addJSONPointer(doc, path, v)
+		}
+		if strings.HasPrefix(op.Path, op.From+"/") {
+			return nil, fmt.Errorf("cannot move %s to one of its children", op.From)
+		}
+		if doc, err = removeJSONPointer(doc, from); err != nil {
+			return nil, err
+		}
+		return addJSONPointer(doc, path, v)
+	}
+	return nil, fmt.Errorf("unknown operation %q", op.Op)
+}
+
+// jsonArrayIndex returns the index within a JSON array of length n that is
+// referenced by the token tok. If allowEnd is true, then the index may refer
+// to the end of the array.
+func jsonArrayIndex(tok string, n int, allowEnd bool) (int, error) {
+	if tok == "-" && allowEnd {
+		return n, nil
+	}
+	i, err := strconv.Atoi(tok)
+	if err != nil || i < 0 || i > n || i == n && !allowEnd {
+		return -1, fmt.Errorf("invalid index %s for array of length %d", tok, n)
+	}
+	return i, nil
+}
+
+// getJSONPointer returns the value referenced by the JSON Pointer path within
+// the JSON document doc.
+func getJSONPointer(doc interface{}, path []string) (interface{}, error) {
+	v := doc
+	for _, tok := range path {
+		switch t := v.(type) {
+		case map[string]interface{}:
+			cv, ok := t[tok]
+			if !ok {
+				return nil, fmt.Errorf("member %s does not exist", tok)
+			}
+			v = cv
+		case []interface{}:
+			i, err := jsonArrayIndex(tok, len(t), false)
+			if err != nil {
+				return nil, err
+			}
+			v = t[i]
+		default:
+			return nil, fmt.Errorf("cannot reference %s within value %v", tok, v)
+		}
+	}
+	return v, nil
+}
+
+// updateJSONPointer calls fn with the parent of the value referenced by the
+// JSON Pointer path within the JSON value v, and the final token of path. The
+// value returned by fn replaces the parent, and the updated value of v is
+// returned.
+func updateJSONPointer(v interface{}, path []string, fn func(parent interface{}, tok string) (interface{}, error)) (interface{}, error) {
+	if len(path) == 1 {
+		return fn(v, path[0])
+	}
+
+	switch t := v.(type) {
+	case map[string]interface{}:
+		cv, ok := t[path[0]]
+		if !ok {
+			return nil, fmt.Errorf("member %s does not exist", path[0])
+		}
+		nv, err := updateJSONPointer(cv, path[1:], fn)
+		if err != nil {
+			return nil, err
+		}
+		t[path[0]] = nv
+		return t, nil
+	case []interface{}:
+		i, err := jsonArrayIndex(path[0], len(t), false)
+		if err != nil {
+			return nil, err
+		}
+		nv, err := updateJSONPointer(t[i], path[1:], fn)
+		if err != nil {
+			return nil, err
+		}
+		t[i] = nv
+		return t, nil
+	}
+	return nil, fmt.Errorf("cannot reference %s within value %v", path[0], v)
+}
+
+// addJSONPointer adds the value nv at the JSON Pointer path within the JSON
+// document doc, returning the updated document.
+func addJSONPointer(doc interface{}, path []string, nv interface{}) (interface{}, error) {
+	if len(path) == 0 {
+		return nv, nil
+	}
+	return updateJSONPointer(doc, path, func(parent interface{}, tok string) (interface{}, error) {
+		switch t := parent.(type) {
+		case map[string]interface{}:
+			t[tok] = nv
+			return t, nil
+		case []interface{}:
+			i, err := jsonArrayIndex(tok, len(t), true)
+			if err != nil {
+				return nil, err
+			}
+			t = append(t, nil)
+			copy(t[i+1:], t[i:])
+			t[i] = nv
+			return t, nil
+		}
+		return nil, fmt.Errorf("cannot add %s to value %v", tok, parent)
+	})
+}
+
+// replaceJSONPointer replaces the value at the JSON Pointer path within the
+// JSON document doc with nv, returning the updated document.
+func replaceJSONPointer(doc interface{}, path []string, nv interface{}) (interface{}, error) {
+	if len(path) == 0 {
+		return nv, nil
+	}
+	return updateJSONPointer(doc, path, func(parent interface{}, tok string) (interface{}, error) {
+		switch t := parent.(type) {
+		case map[string]interface{}:
+			if _, ok := t[tok]; !ok {
+				return nil, fmt.Errorf("member %s does not exist", tok)
+			}
+			t[tok] = nv
+			return t, nil
+		case []interface{}:
+			i, err := jsonArrayIndex(tok, len(t), false)
+			if err != nil {
+				return nil, err
+			}
+			t[i] = nv
+			return t, nil
+		}
+		return nil, fmt.Errorf("cannot replace %s within value %v", tok, parent)
+	})
+}
+
+// removeJSONPointer removes the value at the JSON Pointer path within the
+// JSON document doc, returning the updated document.
+func removeJSONPointer(doc interface{}, path []string) (interface{}, error) {
+	if len(path) == 0 {
+		return nil, fmt.Errorf("cannot remove the entire document")
+	}
+	return updateJSONPointer(doc, path, func(parent interface{}, tok string) (interface{}, error) {
+		switch t := parent.(type) {
+		case map[string]interface{}:
+			if _, ok := t[tok]; !ok {
+				return nil, fmt.Errorf("member %s does not exist", tok)
+			}
+			delete(t, tok)
+			return t, nil
+		case []interface{}:
+			i, err := jsonArrayIndex(tok, len(t), false)
+			if err != nil {
+				return nil, err
+			}
+			return append(t[:i:i], t[i+1:]...), nil
+		}
+		return nil, fmt.Errorf("cannot remove %s from value %v", tok, parent)
+	})
+}
+
+// applyYANGPatchEdit applies the YANG Patch edit e to the RFC7951 JSON
+// document doc, whose schema is supplied.
+func applyYANGPatchEdit(schema *yang.Entry, doc map[string]interface{}, e *ygot.YANGPatchEdit) error {
+	p, err := ygot.RESTCONFToPath(e.Target, schema)
+	if err != nil {
+		return err
+	}
+	if len(p.GetElem()) == 0 {
+		return fmt.Errorf("invalid target %q", e.Target)
+	}
+	elems := p.GetElem()
+
+	var create bool
+	switch e.Operation {
+	case ygot.YANGPatchCreate, ygot.YANGPatchMerge, ygot.YANGPatchReplace:
+		create = true
+	case ygot.YANGPatchDelete, ygot.YANGPatchRemove:
+	case ygot.YANGPatchInsert, ygot.YANGPatchMove:
+		return fmt.Errorf("unsupported operation %s", e.Operation)
+	default:
+		return fmt.Errorf("unknown operation %q", e.Operation)
+	}
+
+	// Find the object containing the target node.
+	parent := doc
+	for _, pe := range elems[:len(elems)-1] {
+		schema = util.FirstChild(schema, []string{pe.GetName()})
+		name, v, ok := util.JSONMember(parent, pe.GetName())
+		switch {
+		case !ok && create && pe.GetKey() == nil:
+			// Containers that do not exist are created.
+			name, v = pe.GetName(), map[string]interface{}{}
+			parent[name] = v
+		case !ok && e.Operation == ygot.YANGPatchRemove:
+			return nil
+		case !ok:
+			return fmt.Errorf("%s does not exist", pe.GetName())
+		}
+		if pe.GetKey() != nil {
+			l, _ := v.([]interface{})
+			i, err := util.FindJSONListEntry(l, pe.GetKey())
+			if err != nil {
+				return err
+			}
+			switch {
+			case i == -1 && e.Operation == ygot.YANGPatchRemove:
+				return nil
+			case i == -1:
+				return fmt.Errorf("entry %v of %s does not exist", pe.GetKey(), pe.GetName())
+			}
+			v = l[i]
+		}
+		obj, ok := v.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("%s is not a container or list entry", name)
+		}
+		parent = obj
+	}
+
+	pe := elems[len(elems)-1]
+	schema = util.FirstChild(schema, []string{pe.GetName()})
+	name, v, exists := util.JSONMember(parent, pe.GetName())
+	if !exists {
+		name = pe.GetName()
+	}
+	idx := -1
+	if exists && pe.GetKey() != nil {
+		l, _ := v.([]interface{})
+		if idx, err = util.FindJSONListEntry(l, pe.GetKey()); err != nil {
+			return err
+		}
+		exists = idx != -1
+	}
+
+	switch e.Operation {
+	case ygot.YANGPatchDelete, ygot.YANGPatchRemove:
+		if !exists {
+			if e.Operation == ygot.YANGPatchRemove {
+				return nil
+			}
+			return fmt.Errorf("target does not exist")
+		}
+		if idx == -1 {
+			delete(parent, name)
+			return nil
+		}
+		l := v.([]interface{})
+		if l = append(l[:idx:idx], l[idx+1:]...); len(l) == 0 {
+			delete(parent, name)
+			return nil
+		}
+		parent[name] = l
+		return nil
+	}
+
+	nv, err := yangPatchEditValue(pe, e.Value)
+	if err != nil {
+		return err
+	}
+	switch {
+	case e.Operation == ygot.YANGPatchCreate && exists:
+		return fmt.Errorf("target already exists")
+	case e.Operation == ygot.YANGPatchMerge && exists:
+		var cur interface{} = v
+		if idx != -1 {
+			cur = v.([]interface{})[idx]
+		}
+		nv, err = mergeJSONTree(schema, cur, nv)
+		if err != nil {
+			return err
+		}
+	}
+
+	switch {
+	case pe.GetKey() == nil:
+		parent[name] = nv
+	case idx == -1:
+		l, _ := v.([]interface{})
+		parent[name] = append(l, nv)
+	default:
+		v.([]interface{})[idx] = nv
+	}
+	return nil
+}
+
+// yangPatchEditValue returns the value of the node that is the target of a
+// YANG Patch edit, described by the final element pe of the target, from the
+// edit value v.
+func yangPatchEditValue(pe *gpb.PathElem, v map[string]interface{}) (interface{}, error) {
+	if len(v) != 1 {
+		return nil, fmt.Errorf("edit value must contain a single member, got: %v", v)
+	}
+	_, nv, ok := util.JSONMember(v, pe.GetName())
+	if !ok {
+		return nil, fmt.Errorf("edit value does not contain %s, got: %v", pe.GetName(), v)
+	}
+	nv, err := decodedJSON(nv)
+	if err != nil {
+		return nil, err
+	}
+	if pe.GetKey() == nil {
+		return nv, nil
+	}
+
+	l, ok := nv.([]interface{})
+	if !ok || len(l) != 1 {
+		return nil, fmt.Errorf("edit value for list entry must be an array with a single entry, got: %v", nv)
+	}
+	i, err := util.FindJSONListEntry(l, pe.GetKey())
+	if err != nil {
+		return nil, err
+	}
+	if i == -1 {
+		return nil, fmt.Errorf("edit value does not have keys %v", pe.GetKey())
+	}
+	return l[0], nil
+}
+
+// mergeJSONTree merges the RFC7951 JSON value src, of the data node with the
+// supplied schema, into dst, returning the merged value. Entries of keyed
+// lists are merged according to their keys, all other values in src replace
+// those in dst.
+func mergeJSONTree(schema *yang.Entry, dst, src interface{}) (interface{}, error) {
+	do, dok := dst.(map[string]interface{})
+	so, sok := src.(map[string]interface{})
+	if !dok || !sok || !schema.IsDir() {
+		return src, nil
+	}
+
+	for k, sv := range so {
+		cs := util.FirstChild(schema, []string{util.StripModulePrefix(k)})
+		if cs == nil {
+			return nil, fmt.Errorf("cannot find schema for %s", k)
+		}
+		dk, dv, ok := util.JSONMember(do, k)
+		if !ok {
+			do[k] = sv
+			continue
+		}
+
+		dl, dlok := dv.([]interface{})
+		sl, slok := sv.([]interface{})
+		if !util.IsKeyedList(cs) || !dlok || !slok {
+			nv, err := mergeJSONTree(cs, dv, sv)
+			if err != nil {
+				return nil, err
+			}
+			do[dk] = nv
+			continue
+		}
+
+		for _, se := range sl {
+			keys, err := jsonListEntryKeys(cs, se)
+			if err != nil {
+				return nil, err
+			}
+			i, err := util.FindJSONListEntry(dl, keys)
+			if err != nil {
+				return nil, err
+			}
+			if i == -1 {
+				dl = append(dl, se)
+				continue
+			}
+			if dl[i], err = mergeJSONTree(cs, dl[i], se); err != nil {
+				return nil, err
+			}
+		}
+		do[dk] = dl
+	}
+	return do, nil
+}
+
+// jsonListEntryKeys returns the string representation of the key values of
+// the RFC7951 JSON list entry e, of the list whose schema is supplied.
+func jsonListEntryKeys(schema *yang.Entry, e interface{}) (map[string]string, error) {
+	obj, ok := e.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("list entry is not a JSON object: %v", e)
+	}
+	keys := map[string]string{}
+	for _, k := range strings.Fields(schema.Key) {
+		_, v, ok := util.JSONMember(obj, k)
+		if !ok {
+			return nil, fmt.Errorf("list entry %v does not contain key %s", e, k)
+		}
+		keys[k] = util.JSONKeyString(v)
+	}
+	return keys, nil
+}
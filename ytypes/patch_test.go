@@ -0,0 +1,471 @@
+// Copyright 2021 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ytypes
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/openconfig/gnmi/errdiff"
+	"github.com/openconfig/goyang/pkg/yang"
+	"github.com/openconfig/ygot/ygot"
+)
+
+type patchRoot struct {
+	Name     *string                       `path:"name" module:"pmod"`
+	Child    *patchChild                   `path:"child" module:"pmod"`
+	Multi    map[patchMultiKey]*patchMulti `path:"multi" module:"pmod"`
+	LeafList []string                      `path:"leaf-list" module:"pmod"`
+	Log      []*patchChild                 `path:"log" module:"pmod"`
+}
+
+func (*patchRoot) IsYANGGoStruct()                          {}
+func (*patchRoot) ΛValidate(...ygot.ValidationOption) error { return nil }
+func (*patchRoot) ΛEnumTypeMap() map[string][]reflect.Type  { return nil }
+func (*patchRoot) ΛBelongingModule() string                 { return "" }
+
+type patchChild struct {
+	Val *string `path:"val" module:"pmod"`
+	Aug *string `path:"aug" module:"amod"`
+}
+
+func (*patchChild) IsYANGGoStruct()                          {}
+func (*patchChild) ΛValidate(...ygot.ValidationOption) error { return nil }
+func (*patchChild) ΛEnumTypeMap() map[string][]reflect.Type  { return nil }
+func (*patchChild) ΛBelongingModule() string                 { return "pmod" }
+
+type patchMultiKey struct {
+	B string `path:"b"`
+	A uint32 `path:"a"`
+}
+
+type patchMulti struct {
+	B   *string `path:"b" module:"pmod"`
+	A   *uint32 `path:"a" module:"pmod"`
+	Val *string `path:"val" module:"pmod"`
+}
+
+func (*patchMulti) IsYANGGoStruct()                          {}
+func (*patchMulti) ΛValidate(...ygot.ValidationOption) error { return nil }
+func (*patchMulti) ΛEnumTypeMap() map[string][]reflect.Type  { return nil }
+func (*patchMulti) ΛBelongingModule() string                 { return "pmod" }
+
+func (e *patchMulti) ΛListKeyMap() (map[string]interface{}, error) {
+	return map[string]interface{}{"b": *e.B, "a": *e.A}, nil
+}
+
+func patchSchema() *yang.Entry {
+	leaf := func(name string, k yang.TypeKind) *yang.Entry {
+		return &yang.Entry{Name: name, Kind: yang.LeafEntry, Type: &yang.YangType{Kind: k}}
+	}
+	s := &yang.Entry{
+		Name: "root",
+		Kind: yang.DirectoryEntry,
+		Dir: map[string]*yang.Entry{
+			"name": leaf("name", yang.Ystring),
+			"child": {
+				Name: "child",
+				Kind: yang.DirectoryEntry,
+				Dir: map[string]*yang.Entry{
+					"val": leaf("val", yang.Ystring),
+					"aug": leaf("aug", yang.Ystring),
+				},
+			},
+			"multi": {
+				Name:     "multi",
+				Kind:     yang.DirectoryEntry,
+				ListAttr: yang.NewDefaultListAttr(),
+				Key:      "b a",
+				Dir: map[string]*yang.Entry{
+					"a":   leaf("a", yang.Yuint32),
+					"b":   leaf("b", yang.Ystring),
+					"val": leaf("val", yang.Ystring),
+				},
+			},
+			"leaf-list": {
+				Name:     "leaf-list",
+				Kind:     yang.LeafEntry,
+				ListAttr: yang.NewDefaultListAttr(),
+				Type:     &yang.YangType{Kind: yang.Ystring},
+			},
+			"log": {
+				Name:     "log",
+				Kind:     yang.DirectoryEntry,
+				ListAttr: yang.NewDefaultListAttr(),
+				Dir: map[string]*yang.Entry{
+					"val": leaf("val", yang.Ystring),
+					"aug": leaf("aug", yang.Ystring),
+				},
+			},
+		},
+	}
+	addParents(s)
+	return s
+}
+
+func TestApplyDiffPatches(t *testing.T) {
+	tests := []struct {
+		name   string
+		inOrig *patchRoot
+		inMod  *patchRoot
+	}{{
+		name:   "no changes",
+		inOrig: &patchRoot{Name: ygot.String("foo")},
+		inMod:  &patchRoot{Name: ygot.String("foo")},
+	}, {
+		name:   "leaves and containers changed",
+		inOrig: &patchRoot{Name: ygot.String("foo"), LeafList: []string{"a"}},
+		inMod: &patchRoot{
+			Child:    &patchChild{Val: ygot.String("v"), Aug: ygot.String("a")},
+			LeafList: []string{"b", "a"},
+		},
+	}, {
+		name: "list entries changed",
+		inOrig: &patchRoot{Multi: map[patchMultiKey]*patchMulti{
+			{B: "x/y", A: 1}: {B: ygot.String("x/y"), A: ygot.Uint32(1), Val: ygot.String("one")},
+			{B: "z", A: 2}:   {B: ygot.String("z"), A: ygot.Uint32(2)},
+			{B: "z", A: 3}:   {B: ygot.String("z"), A: ygot.Uint32(3)},
+		}},
+		inMod: &patchRoot{Multi: map[patchMultiKey]*patchMulti{
+			{B: "x/y", A: 1}: {B: ygot.String("x/y"), A: ygot.Uint32(1), Val: ygot.String("two")},
+			{B: "z", A: 3}:   {B: ygot.String("z"), A: ygot.Uint32(3), Val: ygot.String("three")},
+			{B: "a", A: 4}:   {B: ygot.String("a"), A: ygot.Uint32(4)},
+		}},
+	}, {
+		name: "list removed",
+		inOrig: &patchRoot{Multi: map[patchMultiKey]*patchMulti{
+			{B: "x", A: 1}: {B: ygot.String("x"), A: ygot.Uint32(1)},
+		}},
+		inMod: &patchRoot{},
+	}}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			jp, err := ygot.DiffToJSONPatch(tt.inOrig, tt.inMod)
+			if err != nil {
+				t.Fatalf("DiffToJSONPatch: got unexpected error: %v", err)
+			}
+			got, err := ygot.DeepCopy(tt.inOrig)
+			if err != nil {
+				t.Fatalf("DeepCopy: got unexpected error: %v", err)
+			}
+			if err := ApplyJSONPatch(patchSchema(), got, jp); err != nil {
+				t.Fatalf("ApplyJSONPatch: got unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tt.inMod, got); diff != "" {
+				t.Errorf("ApplyJSONPatch: did not get expected struct, diff(-want, +got):\n%s", diff)
+			}
+
+			yp, err := ygot.DiffToYANGPatch(tt.inOrig, tt.inMod, patchSchema(), "p")
+			if err != nil {
+				t.Fatalf("DiffToYANGPatch: got unexpected error: %v", err)
+			}
+			if got, err = ygot.DeepCopy(tt.inOrig); err != nil {
+				t.Fatalf("DeepCopy: got unexpected error: %v", err)
+			}
+			if err := ApplyYANGPatch(patchSchema(), got, yp); err != nil {
+				t.Fatalf("ApplyYANGPatch: got unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tt.inMod, got); diff != "" {
+				t.Errorf("ApplyYANGPatch: did not get expected struct, diff(-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestApplyDiffJSONPatchKeylessList(t *testing.T) {
+	logEntries := func(vals ...string) []*patchChild {
+		var l []*patchChild
+		for _, v := range vals {
+			l = append(l, &patchChild{Val: ygot.String(v)})
+		}
+		return l
+	}
+
+	tests := []struct {
+		name   string
+		inOrig *patchRoot
+		inMod  *patchRoot
+	}{{
+		name:   "multiple trailing entries removed",
+		inOrig: &patchRoot{Log: logEntries("a", "b", "c")},
+		inMod:  &patchRoot{Log: logEntries("a")},
+	}, {
+		name:   "entries removed beyond index ten",
+		inOrig: &patchRoot{Log: logEntries("a", "b", "c", "d", "e", "f", "g", "h", "i", "j", "k", "l")},
+		inMod:  &patchRoot{Log: logEntries("a", "b")},
+	}, {
+		name:   "entries added beyond index ten",
+		inOrig: &patchRoot{Log: logEntries("a")},
+		inMod:  &patchRoot{Log: logEntries("a", "b", "c", "d", "e", "f", "g", "h", "i", "j", "k", "l")},
+	}, {
+		name: "leaf removed and entries removed",
+		inOrig: &patchRoot{Log: []*patchChild{
+			{Val: ygot.String("a"), Aug: ygot.String("x")},
+			{Val: ygot.String("b")},
+			{Val: ygot.String("c")},
+		}},
+		inMod: &patchRoot{Log: logEntries("a")},
+	}}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			jp, err := ygot.DiffToJSONPatch(tt.inOrig, tt.inMod, &ygot.DiffPathOpt{KeylessListMode: ygot.KeylessListIndexKeys})
+			if err != nil {
+				t.Fatalf("DiffToJSONPatch: got unexpected error: %v", err)
+			}
+			got, err := ygot.DeepCopy(tt.inOrig)
+			if err != nil {
+				t.Fatalf("DeepCopy: got unexpected error: %v", err)
+			}
+			if err := ApplyJSONPatch(patchSchema(), got, jp); err != nil {
+				t.Fatalf("ApplyJSONPatch: got unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tt.inMod, got); diff != "" {
+				t.Errorf("ApplyJSONPatch: did not get expected struct, diff(-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestApplyJSONPatch(t *testing.T) {
+	tests := []struct {
+		name             string
+		in               *patchRoot
+		inPatch          []*ygot.JSONPatchOperation
+		want             *patchRoot
+		wantErrSubstring string
+	}{{
+		name: "add to leaf-list",
+		in:   &patchRoot{LeafList: []string{"a", "c"}},
+		inPatch: []*ygot.JSONPatchOperation{
+			{Op: "add", Path: "/pmod:leaf-list/1", Value: "b"},
+			{Op: "add", Path: "/pmod:leaf-list/-", Value: "d"},
+		},
+		want: &patchRoot{LeafList: []string{"a", "b", "c", "d"}},
+	}, {
+		name: "test, copy and move",
+		in:   &patchRoot{Name: ygot.String("foo")},
+		inPatch: []*ygot.JSONPatchOperation{
+			{Op: "test", Path: "/pmod:name", Value: "foo"},
+			{Op: "add", Path: "/pmod:child", Value: map[string]interface{}{}},
+			{Op: "copy", From: "/pmod:name", Path: "/pmod:child/val"},
+			{Op: "move", From: "/pmod:name", Path: "/pmod:child/amod:aug"},
+		},
+		want: &patchRoot{Child: &patchChild{Val: ygot.String("foo"), Aug: ygot.String("foo")}},
+	}, {
+		name: "failed test",
+		in:   &patchRoot{Name: ygot.String("foo")},
+		inPatch: []*ygot.JSONPatchOperation{
+			{Op: "test", Path: "/pmod:name", Value: "bar"},
+		},
+		wantErrSubstring: "value foo is not equal to bar",
+	}, {
+		name: "remove missing member",
+		in:   &patchRoot{},
+		inPatch: []*ygot.JSONPatchOperation{
+			{Op: "remove", Path: "/pmod:name"},
+		},
+		wantErrSubstring: "member pmod:name does not exist",
+	}, {
+		name: "replace missing index",
+		in:   &patchRoot{LeafList: []string{"a"}},
+		inPatch: []*ygot.JSONPatchOperation{
+			{Op: "replace", Path: "/pmod:leaf-list/1", Value: "b"},
+		},
+		wantErrSubstring: "invalid index 1",
+	}, {
+		name: "invalid pointer",
+		in:   &patchRoot{},
+		inPatch: []*ygot.JSONPatchOperation{
+			{Op: "add", Path: "pmod:name", Value: "foo"},
+		},
+		wantErrSubstring: "invalid JSON pointer",
+	}, {
+		name: "unknown operation",
+		in:   &patchRoot{},
+		inPatch: []*ygot.JSONPatchOperation{
+			{Op: "frobnicate", Path: "/pmod:name"},
+		},
+		wantErrSubstring: `unknown operation "frobnicate"`,
+	}, {
+		name: "invalid result",
+		in:   &patchRoot{},
+		inPatch: []*ygot.JSONPatchOperation{
+			{Op: "add", Path: "/pmod:unknown", Value: "foo"},
+		},
+		wantErrSubstring: "cannot unmarshal patched JSON",
+	}}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ApplyJSONPatch(patchSchema(), tt.in, tt.inPatch)
+			if diff := errdiff.Substring(err, tt.wantErrSubstring); diff != "" {
+				t.Fatalf("ApplyJSONPatch: did not get expected error, %s", diff)
+			}
+			if err != nil {
+				return
+			}
+			if diff := cmp.Diff(tt.want, tt.in); diff != "" {
+				t.Errorf("ApplyJSONPatch: did not get expected struct, diff(-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestApplyYANGPatch(t *testing.T) {
+	multi := func(b string, a uint32, val *string) *patchRoot {
+		return &patchRoot{Multi: map[patchMultiKey]*patchMulti{
+			{B: b, A: a}: {B: ygot.String(b), A: ygot.Uint32(a), Val: val},
+		}}
+	}
+
+	tests := []struct {
+		name             string
+		in               *patchRoot
+		inEdits          []*ygot.YANGPatchEdit
+		want             *patchRoot
+		wantErrSubstring string
+	}{{
+		name: "merge into container",
+		in:   &patchRoot{Child: &patchChild{Val: ygot.String("v")}},
+		inEdits: []*ygot.YANGPatchEdit{{
+			EditID:    "1",
+			Operation: "merge",
+			Target:    "/pmod:child",
+			Value:     map[string]interface{}{"pmod:child": map[string]interface{}{"amod:aug": "a"}},
+		}},
+		want: &patchRoot{Child: &patchChild{Val: ygot.String("v"), Aug: ygot.String("a")}},
+	}, {
+		name: "merge list entries",
+		in:   multi("x", 1, ygot.String("one")),
+		inEdits: []*ygot.YANGPatchEdit{{
+			EditID:    "1",
+			Operation: "merge",
+			Target:    "/pmod:multi",
+			Value: map[string]interface{}{"pmod:multi": []interface{}{
+				map[string]interface{}{"a": 1, "b": "x", "val": "two"},
+				map[string]interface{}{"a": 2, "b": "y"},
+			}},
+		}},
+		want: &patchRoot{Multi: map[patchMultiKey]*patchMulti{
+			{B: "x", A: 1}: {B: ygot.String("x"), A: ygot.Uint32(1), Val: ygot.String("two")},
+			{B: "y", A: 2}: {B: ygot.String("y"), A: ygot.Uint32(2)},
+		}},
+	}, {
+		name: "replace list entry",
+		in:   multi("x", 1, ygot.String("one")),
+		inEdits: []*ygot.YANGPatchEdit{{
+			EditID:    "1",
+			Operation: "replace",
+			Target:    "/pmod:multi=x,1",
+			Value: map[string]interface{}{"pmod:multi": []interface{}{
+				map[string]interface{}{"a": 1, "b": "x"},
+			}},
+		}},
+		want: multi("x", 1, nil),
+	}, {
+		name: "create leaf within new container",
+		in:   &patchRoot{},
+		inEdits: []*ygot.YANGPatchEdit{{
+			EditID:    "1",
+			Operation: "create",
+			Target:    "/pmod:child/amod:aug",
+			Value:     map[string]interface{}{"amod:aug": "a"},
+		}},
+		want: &patchRoot{Child: &patchChild{Aug: ygot.String("a")}},
+	}, {
+		name: "remove missing leaf",
+		in:   &patchRoot{Name: ygot.String("foo")},
+		inEdits: []*ygot.YANGPatchEdit{{
+			EditID:    "1",
+			Operation: "remove",
+			Target:    "/pmod:child/val",
+		}},
+		want: &patchRoot{Name: ygot.String("foo")},
+	}, {
+		name: "delete missing list entry",
+		in:   multi("x", 1, nil),
+		inEdits: []*ygot.YANGPatchEdit{{
+			EditID:    "1",
+			Operation: "delete",
+			Target:    "/pmod:multi=x,2",
+		}},
+		wantErrSubstring: "edit 1 (delete /pmod:multi=x,2): target does not exist",
+	}, {
+		name: "create existing leaf",
+		in:   &patchRoot{Name: ygot.String("foo")},
+		inEdits: []*ygot.YANGPatchEdit{{
+			EditID:    "1",
+			Operation: "create",
+			Target:    "/pmod:name",
+			Value:     map[string]interface{}{"pmod:name": "bar"},
+		}},
+		wantErrSubstring: "target already exists",
+	}, {
+		name: "wrong number of keys",
+		in:   multi("x", 1, nil),
+		inEdits: []*ygot.YANGPatchEdit{{
+			EditID:    "1",
+			Operation: "delete",
+			Target:    "/pmod:multi=x",
+		}},
+		wantErrSubstring: "invalid keys [x] for multi",
+	}, {
+		name: "value for a different node",
+		in:   &patchRoot{},
+		inEdits: []*ygot.YANGPatchEdit{{
+			EditID:    "1",
+			Operation: "replace",
+			Target:    "/pmod:name",
+			Value:     map[string]interface{}{"pmod:child": map[string]interface{}{}},
+		}},
+		wantErrSubstring: "edit value does not contain name",
+	}, {
+		name: "unknown node",
+		in:   &patchRoot{},
+		inEdits: []*ygot.YANGPatchEdit{{
+			EditID:    "1",
+			Operation: "remove",
+			Target:    "/pmod:unknown",
+		}},
+		wantErrSubstring: "cannot find schema for pmod:unknown",
+	}, {
+		name: "unsupported operation",
+		in:   &patchRoot{},
+		inEdits: []*ygot.YANGPatchEdit{{
+			EditID:    "1",
+			Operation: "insert",
+			Target:    "/pmod:leaf-list",
+		}},
+		wantErrSubstring: "unsupported operation insert",
+	}}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ApplyYANGPatch(patchSchema(), tt.in, &ygot.YANGPatch{PatchID: "p", Edit: tt.inEdits})
+			if diff := errdiff.Substring(err, tt.wantErrSubstring); diff != "" {
+				t.Fatalf("ApplyYANGPatch: did not get expected error, %s", diff)
+			}
+			if err != nil {
+				return
+			}
+			if diff := cmp.Diff(tt.want, tt.in); diff != "" {
+				t.Errorf("ApplyYANGPatch: did not get expected struct, diff(-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,185 @@
+// Copyright 2021 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ytypes
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/openconfig/goyang/pkg/yang"
+	"github.com/openconfig/ygot/util"
+	"github.com/openconfig/ygot/ygot"
+)
+
+// RFC7951Unmarshaler is an interface implemented by GoStructs that have
+// generated code to unmarshal JSON into their fields. UnmarshalRFC7951 should
+// call the methods of the supplied StructUnmarshaler for each field of the
+// GoStruct, in the order in which the fields are defined.
+type RFC7951Unmarshaler interface {
+	UnmarshalRFC7951(u *StructUnmarshaler) error
+}
+
+// StructUnmarshaler is used by the generated UnmarshalRFC7951 method of a
+// GoStruct to unmarshal a JSON tree into each of its fields.
+type StructUnmarshaler struct {
+	// schema is the schema of the GoStruct being unmarshalled into.
+	schema *yang.Entry
+	// parent is the GoStruct being unmarshalled into.
+	parent interface{}
+	// jsonTree is the JSON being unmarshalled.
+	jsonTree map[string]interface{}
+	// enc and opts are the encoding and options used for unmarshalling.
+	enc  Encoding
+	opts []UnmarshalOpt
+	// allSchemaPaths is the set of data tree paths of the fields that have
+	// been unmarshalled, used to check for unexpected fields in jsonTree.
+	allSchemaPaths [][]string
+}
+
+// structFieldKey is the key used to cache the schema of a GoStruct field.
+type structFieldKey struct {
+	// parent is the schema of the GoStruct that the field belongs to.
+	parent *yang.Entry
+	// field is the field of the GoStruct.
+	field *ygot.StructField
+	// preferShadowPath indicates whether shadow paths are preferred.
+	preferShadowPath bool
+}
+
+// structFieldSchema stores the schema of a GoStruct field, along with its
+// data tree paths.
+type structFieldSchema struct {
+	// schema is the schema of the field, or nil if it was not found.
+	schema *yang.Entry
+	// dataPaths are the data tree paths of the field, including shadow paths.
+	dataPaths [][]string
+	// valuePaths are the paths at which the value of the field is found
+	// within the JSON tree.
+	valuePaths [][]string
+	// err is any error encountered whilst determining the schema.
+	err error
+}
+
+// maxStructFieldSchemas is the maximum number of entries in the
+// structFieldSchemas cache. Since the cache is keyed by schema entry, it is
+// cleared when full such that schema trees that are no longer used (e.g.,
+// those returned by the Schema function of the generated code) are not
+// retained indefinitely.
+const maxStructFieldSchemas = 1 << 16
+
+var (
+	// structFieldSchemasMu protects structFieldSchemas.
+	structFieldSchemasMu sync.RWMutex
+	// structFieldSchemas caches the schemas of the fields of generated
+	// GoStructs, since they are constant for a particular schema tree.
+	structFieldSchemas = map[structFieldKey]*structFieldSchema{}
+)
+
+// fieldSchema returns the schema of the field f of a GoStruct with the schema
+// parent. It is equivalent to the schema lookups made for each field by
+// unmarshalStruct, which are cached.
+func fieldSchema(parent *yang.Entry, f *ygot.StructField, preferShadowPath bool) *structFieldSchema {
+	key := structFieldKey{parent: parent, field: f, preferShadowPath: preferShadowPath}
+	structFieldSchemasMu.RLock()
+	s, ok := structFieldSchemas[key]
+	structFieldSchemasMu.RUnlock()
+	if ok {
+		return s
+	}
+
+	s = &structFieldSchema{}
+	childSchemaFn := util.ChildSchema
+	if preferShadowPath {
+		childSchemaFn = util.ChildSchemaPreferShadow
+	}
+	s.schema, s.err = childSchemaFn(parent, f.StructField)
+	if s.err == nil && s.schema != nil {
+		s.err = func() error {
+			sp, err := dataTreePaths(parent, s.schema, f.StructField)
+			if err != nil {
+				return err
+			}
+			ssp, err := shadowDataTreePaths(parent, s.schema, f.StructField)
+			if err != nil {
+				return err
+			}
+			s.dataPaths = append(append(s.dataPaths, sp...), ssp...)
+
+			// The paths for the value are determined as per
+			// getJSONTreeValForField.
+			s.valuePaths = sp
+			if preferShadowPath && len(ssp) != 0 {
+				s.valuePaths = ssp
+			}
+			return nil
+		}()
+	}
+
+	structFieldSchemasMu.Lock()
+	defer structFieldSchemasMu.Unlock()
+	if len(structFieldSchemas) >= maxStructFieldSchemas {
+		structFieldSchemas = map[structFieldKey]*structFieldSchema{}
+	}
+	structFieldSchemas[key] = s
+	return s
+}
+
+// Field returns the schema of the field f, and the value of the field within
+// the JSON tree being unmarshalled. The value is nil if the field is not
+// present in the JSON tree.
+func (u *StructUnmarshaler) Field(f *ygot.StructField) (*yang.Entry, interface{}, error) {
+	s := fieldSchema(u.schema, f, hasPreferShadowPath(u.opts))
+	if s.err != nil {
+		return nil, nil, s.err
+	}
+	if s.schema == nil {
+		return nil, nil, fmt.Errorf("unmarshalContainer could not find schema for type %T, field name %s", u.parent, f.Name)
+	}
+
+	// Store the data tree paths of the current field, such that it can be
+	// ensured that there are no excess elements in the JSON tree.
+	u.allSchemaPaths = append(u.allSchemaPaths, s.dataPaths...)
+
+	v, err := getJSONTreeValForPaths(u.jsonTree, s.valuePaths)
+	if err != nil {
+		return nil, nil, err
+	}
+	return s.schema, v, nil
+}
+
+// Annotation records the paths of the annotation field f, which is not
+// unmarshalled, such that the JSON fields that correspond to it are not
+// considered to be unexpected.
+func (u *StructUnmarshaler) Annotation(f *ygot.StructField) error {
+	paths, err := pathTagFromField(f.StructField)
+	if err != nil {
+		return fmt.Errorf("cannot find JSON field names for annotation field %s, %v", f.Name, err)
+	}
+
+	for _, s := range strings.Split(paths, "|") {
+		pp := strings.Split(s, "/")
+		u.allSchemaPaths = append(u.allSchemaPaths, []string{pp[len(pp)-1]})
+	}
+	return nil
+}
+
+// Unmarshal unmarshals the JSON value v, returned by Field, into p. schema is
+// the schema of the field returned by Field. p must be the field itself for
+// YANG containers and lists, a pointer to the field for keyless lists, and
+// the GoStruct being unmarshalled into for leaves and leaf-lists.
+func (u *StructUnmarshaler) Unmarshal(schema *yang.Entry, p interface{}, v interface{}) error {
+	return unmarshalGeneric(schema, p, v, u.enc, u.opts...)
+}
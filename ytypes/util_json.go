@@ -42,6 +42,14 @@ func getJSONTreeValForField(parentSchema, schema *yang.Entry, f reflect.StructFi
 			return nil, err
 		}
 	}
+	return getJSONTreeValForPaths(tree, ps)
+}
+
+// getJSONTreeValForPaths returns the JSON subtree of the provided tree that
+// corresponds to any of the paths ps. If no such JSON subtree exists, it
+// returns nil, nil. If more than one path has a JSON subtree, the function
+// returns an error if the two subtrees are unequal.
+func getJSONTreeValForPaths(tree interface{}, ps [][]string) (interface{}, error) {
 	var out interface{}
 	var outPath []string
 	for _, p := range ps {
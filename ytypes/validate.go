@@ -16,10 +16,16 @@ package ytypes
 
 import (
 	"fmt"
+	"reflect"
+	"strings"
 
 	"github.com/openconfig/goyang/pkg/yang"
 	"github.com/openconfig/ygot/util"
 	"github.com/openconfig/ygot/ygot"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	gpb "github.com/openconfig/gnmi/proto/gnmi"
 )
 
 // LeafrefOptions controls the behaviour of validation functions for leaf-ref
@@ -43,6 +49,20 @@ type LeafrefOptions struct {
 // interface.
 func (*LeafrefOptions) IsValidationOption() {}
 
+// LeafrefIndexOptions supplies a LeafrefIndex of the data tree being
+// validated to ValidatePath, which uses it to find the leafrefs that refer to
+// the validated subtree, rather than building an index for each call. Since
+// these leafrefs are outside of the subtree, the index need only reflect the
+// data tree outside of the subtree.
+type LeafrefIndexOptions struct {
+	// Index is the index of the data tree being validated.
+	Index *LeafrefIndex
+}
+
+// IsValidationOption ensures that LeafrefIndexOptions implements the
+// ValidationOption interface.
+func (*LeafrefIndexOptions) IsValidationOption() {}
+
 // CustomValidationOptions controls the custom validate function to be
 // invoked on the root
 type CustomValidationOptions struct {
@@ -116,3 +136,182 @@ func Validate(schema *yang.Entry, value interface{}, opts ...ygot.ValidationOpti
 	}
 	return util.AppendErrs(errs, util.NewErrs(fmt.Errorf("unknown schema type for type %T, value %v", value, value)))
 }
+
+// ValidatePath validates the subtree at path within the data tree with root
+// root, whose schema is schema. Unlike Validate, the nodes outside of the
+// subtree are not validated, other than the leafrefs that may be affected by
+// a change to the subtree - i.e., those that are within the subtree, and those
+// that refer to a node within it. The leafrefs that refer to the subtree are
+// selected by the schema path of their target, such that a leafref that
+// refers to another entry of a list in which the subtree resides is also
+// validated. They are found using the LeafrefIndex supplied by
+// LeafrefIndexOptions, if any. Otherwise, they are found using the schema,
+// and every entry of the lists in which they reside is validated, such that
+// the cost of the call does not depend on the size of the rest of the data
+// tree. Only the nodes on the paths to these leafrefs, and to the subtree, are
+// traversed. The subtree need not exist, such that the leafrefs
+// referring to a deleted subtree can be validated. If path is empty,
+// ValidatePath is equivalent to Validate.
+func ValidatePath(schema *yang.Entry, root ygot.GoStruct, path *gpb.Path, opts ...ygot.ValidationOption) util.Errors {
+	if len(path.GetElem()) == 0 {
+		return Validate(schema, root, opts...)
+	}
+	if schema == nil {
+		return util.NewErrs(fmt.Errorf("nil schema for type %T, value %v", root, root))
+	}
+
+	var errs util.Errors
+	nodes, err := GetNode(schema, root, path, &GetHandleWildcards{})
+	switch {
+	case status.Code(err) == codes.NotFound:
+	case err != nil:
+		return util.NewErrs(err)
+	}
+	for _, n := range nodes {
+		errs = util.AppendErrs(errs, Validate(n.Schema, n.Data, opts...))
+	}
+
+	var leafrefOpt *LeafrefOptions
+	var index *LeafrefIndex
+	for _, o := range opts {
+		switch v := o.(type) {
+		case *LeafrefOptions:
+			leafrefOpt = v
+		case *LeafrefIndexOptions:
+			index = v.Index
+		}
+	}
+	if leafrefOpt != nil && leafrefOpt.IgnoreMissingData {
+		return errs
+	}
+
+	// The schema path of the subtree, which is compared to the schema paths
+	// of the targets of leafrefs, excludes the module name.
+	prefix := util.SchemaPathNoChoiceCase(schema)[1:]
+	if util.IsFakeRoot(schema) {
+		prefix = nil
+	}
+	for _, e := range path.GetElem() {
+		prefix = append(prefix, util.StripModulePrefix(e.GetName()))
+	}
+	var refPaths [][]*gpb.PathElem
+	if index != nil {
+		for _, r := range index.referrersWithin(prefix) {
+			refPaths = append(refPaths, r.Path.GetElem())
+		}
+	} else if refPaths, err = leafrefSchemaReferrers(schema, prefix); err != nil {
+		return util.AppendErr(errs, err)
+	}
+
+	visit := func(ni *util.NodeInfo) (bool, error) {
+		p, err := nodeDataPath(ni)
+		if err != nil {
+			return false, err
+		}
+		if dataPathHasPrefix(p.GetElem(), path.GetElem()) {
+			return true, nil
+		}
+		for _, r := range refPaths {
+			if dataPathHasPrefix(p.GetElem(), r) {
+				return true, nil
+			}
+		}
+		// Nodes that are not within the subtree, or a leafref that refers
+		// to it, are only traversed if they are an ancestor of one.
+		if dataPathHasPrefix(path.GetElem(), p.GetElem()) {
+			return false, nil
+		}
+		for _, r := range refPaths {
+			if dataPathHasPrefix(r, p.GetElem()) {
+				return false, nil
+			}
+		}
+		return false, util.SkipDescendants
+	}
+	return util.AppendErrs(errs, validateLeafRefData(schema, root, leafrefOpt, visit))
+}
+
+// dataPathHasPrefix reports whether the data tree path with elements prefix
+// is a prefix of the path with elements path. Module prefixes of element
+// names are ignored, and keys match if they are equal, or either is a
+// wildcard. Keys that are only specified by one of the paths are ignored, such
+// that the path of a list, without keys, matches the path of any of its
+// entries.
+func dataPathHasPrefix(path, prefix []*gpb.PathElem) bool {
+	if len(path) < len(prefix) {
+		return false
+	}
+	for i, pe := range prefix {
+		e := path[i]
+		if util.StripModulePrefix(e.GetName()) != util.StripModulePrefix(pe.GetName()) {
+			return false
+		}
+		for k, pv := range pe.GetKey() {
+			v, ok := e.GetKey()[k]
+			if ok && v != pv && v != "*" && pv != "*" {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// ValidateField validates the value v against the schema of the field with
+// the name fieldName within the GoStruct parent, whose schema is schema. It
+// allows a value to be validated before the field is set to it, without the
+// remainder of parent being validated. Leafrefs are validated against the
+// type of the node that they refer to, but are not resolved.
+func ValidateField(schema *yang.Entry, parent ygot.GoStruct, fieldName string, v interface{}, opts ...ygot.ValidationOption) util.Errors {
+	t := reflect.TypeOf(parent)
+	if !util.IsTypeStructPtr(t) {
+		return util.NewErrs(fmt.Errorf("type %T is not a GoStruct pointer", parent))
+	}
+	ft, ok := t.Elem().FieldByName(fieldName)
+	if !ok {
+		return util.NewErrs(fmt.Errorf("type %T does not have a field %s", parent, fieldName))
+	}
+	cschema, err := util.ChildSchema(schema, ft)
+	if err != nil {
+		return util.NewErrs(err)
+	}
+	if cschema == nil {
+		return util.NewErrs(fmt.Errorf("could not find schema for type %T, field name %s", parent, fieldName))
+	}
+	return Validate(cschema, v, opts...)
+}
+
+// leafrefSchemaReferrers returns the data paths, relative to schema, of the
+// leafrefs within schema that refer to a node whose schema path, excluding its
+// module name, has the supplied prefix. The paths do not have keys, such that
+// they match every entry of the lists in which the leafrefs reside.
+func leafrefSchemaReferrers(schema *yang.Entry, prefix []string) ([][]*gpb.PathElem, error) {
+	var paths [][]*gpb.PathElem
+	var walk func(e *yang.Entry, elems []*gpb.PathElem) error
+	walk = func(e *yang.Entry, elems []*gpb.PathElem) error {
+		for _, c := range e.Dir {
+			// Choices and cases do not appear in data paths.
+			p := elems
+			if !c.IsChoice() && !c.IsCase() {
+				p = append(append([]*gpb.PathElem{}, elems...), &gpb.PathElem{Name: c.Name})
+			}
+			if !util.IsLeafRef(c) {
+				if err := walk(c, p); err != nil {
+					return err
+				}
+				continue
+			}
+			target, err := util.FindLeafRefSchema(c, c.Type.Path)
+			if err != nil {
+				return err
+			}
+			if pathMatchesPrefix(strings.Split(leafrefSchemaPathKey(target), "/"), prefix) {
+				paths = append(paths, p)
+			}
+		}
+		return nil
+	}
+	if err := walk(schema, nil); err != nil {
+		return nil, err
+	}
+	return paths, nil
+}
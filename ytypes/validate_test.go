@@ -19,8 +19,11 @@ import (
 	"reflect"
 	"testing"
 
+	"github.com/openconfig/gnmi/errdiff"
 	"github.com/openconfig/goyang/pkg/yang"
 	"github.com/openconfig/ygot/ygot"
+
+	gpb "github.com/openconfig/gnmi/proto/gnmi"
 )
 
 type Case1Leaf1ChoiceStruct struct {
@@ -305,3 +308,334 @@ func TestValidate(t *testing.T) {
 	}
 
 }
+
+type validatePathInterface struct {
+	Name   *string `path:"name"`
+	Mtu    *uint16 `path:"mtu"`
+	Parent *string `path:"parent"`
+}
+
+func (*validatePathInterface) IsYANGGoStruct()                          {}
+func (*validatePathInterface) ΛValidate(...ygot.ValidationOption) error { return nil }
+func (*validatePathInterface) ΛEnumTypeMap() map[string][]reflect.Type  { return nil }
+func (*validatePathInterface) ΛBelongingModule() string                 { return "foo" }
+func (t *validatePathInterface) ΛListKeyMap() (map[string]interface{}, error) {
+	return map[string]interface{}{"name": *t.Name}, nil
+}
+
+type validatePathSystem struct {
+	Hostname   *string  `path:"hostname"`
+	MgmtIntf   *string  `path:"mgmt-intf"`
+	OtherIntfs []string `path:"other-intfs"`
+}
+
+func (*validatePathSystem) IsYANGGoStruct()                          {}
+func (*validatePathSystem) ΛValidate(...ygot.ValidationOption) error { return nil }
+func (*validatePathSystem) ΛEnumTypeMap() map[string][]reflect.Type  { return nil }
+func (*validatePathSystem) ΛBelongingModule() string                 { return "foo" }
+
+type validatePathRoot struct {
+	Interface map[string]*validatePathInterface `path:"interfaces/interface"`
+	System    *validatePathSystem               `path:"system"`
+}
+
+func (*validatePathRoot) IsYANGGoStruct()                          {}
+func (*validatePathRoot) ΛValidate(...ygot.ValidationOption) error { return nil }
+func (*validatePathRoot) ΛEnumTypeMap() map[string][]reflect.Type  { return nil }
+func (*validatePathRoot) ΛBelongingModule() string                 { return "" }
+
+// validatePathSchema returns the schema of a validatePathRoot struct.
+func validatePathSchema() *yang.Entry {
+	s := &yang.Entry{
+		Name: "device",
+		Kind: yang.DirectoryEntry,
+		Dir: map[string]*yang.Entry{
+			"interfaces": {
+				Name: "interfaces",
+				Kind: yang.DirectoryEntry,
+				Dir: map[string]*yang.Entry{
+					"interface": {
+						Name:     "interface",
+						Kind:     yang.DirectoryEntry,
+						ListAttr: yang.NewDefaultListAttr(),
+						Key:      "name",
+						Config:   yang.TSTrue,
+						Dir: map[string]*yang.Entry{
+							"name": {
+								Name: "name",
+								Kind: yang.LeafEntry,
+								Type: &yang.YangType{Kind: yang.Ystring},
+							},
+							"mtu": {
+								Name: "mtu",
+								Kind: yang.LeafEntry,
+								Type: &yang.YangType{
+									Kind:  yang.Yuint16,
+									Range: yang.YangRange{{Min: yang.FromInt(64), Max: yang.FromInt(9000)}},
+								},
+							},
+							"parent": {
+								Name: "parent",
+								Kind: yang.LeafEntry,
+								Type: &yang.YangType{
+									Kind: yang.Yleafref,
+									Path: "/interfaces/interface/name",
+								},
+							},
+						},
+					},
+				},
+			},
+			"system": {
+				Name: "system",
+				Kind: yang.DirectoryEntry,
+				Dir: map[string]*yang.Entry{
+					"hostname": {
+						Name: "hostname",
+						Kind: yang.LeafEntry,
+						Type: &yang.YangType{
+							Kind:   yang.Ystring,
+							Length: yang.YangRange{{Min: yang.FromInt(1), Max: yang.FromInt(10)}},
+						},
+					},
+					"mgmt-intf": {
+						Name: "mgmt-intf",
+						Kind: yang.LeafEntry,
+						Type: &yang.YangType{
+							Kind: yang.Yleafref,
+							Path: "/interfaces/interface/name",
+						},
+					},
+					"other-intfs": {
+						Name:     "other-intfs",
+						Kind:     yang.LeafEntry,
+						ListAttr: yang.NewDefaultListAttr(),
+						Type: &yang.YangType{
+							Kind: yang.Yleafref,
+							Path: "/interfaces/interface/name",
+						},
+					},
+				},
+			},
+		},
+		Annotation: map[string]interface{}{"isFakeRoot": true},
+	}
+	addParents(s)
+	return s
+}
+
+func TestValidatePath(t *testing.T) {
+	schema := validatePathSchema()
+
+	tests := []struct {
+		desc             string
+		inRoot           *validatePathRoot
+		inPath           *gpb.Path
+		inOpts           []ygot.ValidationOption
+		inIndex          bool
+		wantErrSubstring string
+	}{{
+		desc: "valid leaf",
+		inRoot: &validatePathRoot{
+			Interface: map[string]*validatePathInterface{
+				"eth0": {Name: ygot.String("eth0"), Mtu: ygot.Uint16(1500)},
+			},
+		},
+		inPath: mustPath("/interfaces/interface[name=eth0]/mtu"),
+	}, {
+		desc: "invalid leaf",
+		inRoot: &validatePathRoot{
+			Interface: map[string]*validatePathInterface{
+				"eth0": {Name: ygot.String("eth0"), Mtu: ygot.Uint16(10)},
+			},
+		},
+		inPath:           mustPath("/interfaces/interface[name=eth0]/mtu"),
+		wantErrSubstring: "outside specified ranges",
+	}, {
+		desc: "invalid leaf within list entry",
+		inRoot: &validatePathRoot{
+			Interface: map[string]*validatePathInterface{
+				"eth0": {Name: ygot.String("eth0"), Mtu: ygot.Uint16(10)},
+			},
+		},
+		inPath:           mustPath("/interfaces/interface[name=eth0]"),
+		wantErrSubstring: "outside specified ranges",
+	}, {
+		desc: "invalid leaf in another list entry",
+		inRoot: &validatePathRoot{
+			Interface: map[string]*validatePathInterface{
+				"eth0": {Name: ygot.String("eth0"), Mtu: ygot.Uint16(1500)},
+				"eth1": {Name: ygot.String("eth1"), Mtu: ygot.Uint16(10)},
+			},
+		},
+		inPath: mustPath("/interfaces/interface[name=eth0]"),
+	}, {
+		desc: "invalid leaf in all list entries using wildcard",
+		inRoot: &validatePathRoot{
+			Interface: map[string]*validatePathInterface{
+				"eth0": {Name: ygot.String("eth0"), Mtu: ygot.Uint16(1500)},
+				"eth1": {Name: ygot.String("eth1"), Mtu: ygot.Uint16(10)},
+			},
+		},
+		inPath:           mustPath("/interfaces/interface[name=*]"),
+		wantErrSubstring: "outside specified ranges",
+	}, {
+		desc: "invalid leaf outside of subtree",
+		inRoot: &validatePathRoot{
+			Interface: map[string]*validatePathInterface{
+				"eth0": {Name: ygot.String("eth0"), Mtu: ygot.Uint16(1500)},
+			},
+			System: &validatePathSystem{Hostname: ygot.String("a-very-long-hostname")},
+		},
+		inPath: mustPath("/interfaces/interface[name=eth0]/mtu"),
+	}, {
+		desc: "invalid leaf outside of subtree with empty path",
+		inRoot: &validatePathRoot{
+			Interface: map[string]*validatePathInterface{
+				"eth0": {Name: ygot.String("eth0"), Mtu: ygot.Uint16(1500)},
+			},
+			System: &validatePathSystem{Hostname: ygot.String("a-very-long-hostname")},
+		},
+		inPath:           &gpb.Path{},
+		wantErrSubstring: "length 20 is outside range 1..10",
+	}, {
+		desc: "valid leafref within subtree",
+		inRoot: &validatePathRoot{
+			Interface: map[string]*validatePathInterface{
+				"eth0": {Name: ygot.String("eth0")},
+			},
+			System: &validatePathSystem{MgmtIntf: ygot.String("eth0")},
+		},
+		inPath: mustPath("/system/mgmt-intf"),
+	}, {
+		desc: "invalid leafref within subtree",
+		inRoot: &validatePathRoot{
+			Interface: map[string]*validatePathInterface{
+				"eth0": {Name: ygot.String("eth0")},
+			},
+			System: &validatePathSystem{MgmtIntf: ygot.String("eth1")},
+		},
+		inPath:           mustPath("/system"),
+		wantErrSubstring: "not equal to any target nodes",
+	}, {
+		desc: "invalid leafref referring to subtree",
+		inRoot: &validatePathRoot{
+			Interface: map[string]*validatePathInterface{
+				"eth0": {Name: ygot.String("eth0")},
+			},
+			System: &validatePathSystem{MgmtIntf: ygot.String("eth1")},
+		},
+		inPath:           mustPath("/interfaces/interface[name=eth0]"),
+		wantErrSubstring: "not equal to any target nodes",
+	}, {
+		desc: "invalid leafref referring to deleted subtree",
+		inRoot: &validatePathRoot{
+			System: &validatePathSystem{MgmtIntf: ygot.String("eth0")},
+		},
+		inPath:           mustPath("/interfaces/interface[name=eth0]"),
+		wantErrSubstring: "is empty set",
+	}, {
+		desc: "invalid leafref referring to deleted subtree, ignoring missing data",
+		inRoot: &validatePathRoot{
+			System: &validatePathSystem{MgmtIntf: ygot.String("eth0")},
+		},
+		inPath: mustPath("/interfaces/interface[name=eth0]"),
+		inOpts: []ygot.ValidationOption{&LeafrefOptions{IgnoreMissingData: true}},
+	}, {
+		desc: "invalid leafref outside of subtree",
+		inRoot: &validatePathRoot{
+			System: &validatePathSystem{Hostname: ygot.String("host"), MgmtIntf: ygot.String("eth0")},
+		},
+		inPath: mustPath("/system/hostname"),
+	}, {
+		desc: "invalid leafref within list entry subtree",
+		inRoot: &validatePathRoot{
+			Interface: map[string]*validatePathInterface{
+				"eth0": {Name: ygot.String("eth0"), Parent: ygot.String("eth1")},
+			},
+		},
+		inPath:           mustPath("/interfaces/interface[name=eth0]/parent"),
+		wantErrSubstring: "not equal to any target nodes",
+	}, {
+		desc: "invalid leafref in another list entry is not evaluated",
+		inRoot: &validatePathRoot{
+			Interface: map[string]*validatePathInterface{
+				"eth0": {Name: ygot.String("eth0"), Parent: ygot.String("eth0")},
+				"eth1": {Name: ygot.String("eth1"), Parent: ygot.String("eth2")},
+			},
+		},
+		inPath: mustPath("/interfaces/interface[name=eth0]/parent"),
+	}, {
+		desc: "invalid leafref referring to subtree, using supplied index",
+		inRoot: &validatePathRoot{
+			Interface: map[string]*validatePathInterface{
+				"eth0": {Name: ygot.String("eth0")},
+			},
+			System: &validatePathSystem{MgmtIntf: ygot.String("eth1")},
+		},
+		inPath:           mustPath("/interfaces/interface[name=eth0]"),
+		inIndex:          true,
+		wantErrSubstring: "not equal to any target nodes",
+	}}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			opts := tt.inOpts
+			if tt.inIndex {
+				x, err := NewLeafrefIndex(schema, tt.inRoot)
+				if err != nil {
+					t.Fatalf("NewLeafrefIndex: unexpected error: %v", err)
+				}
+				opts = append(opts, &LeafrefIndexOptions{Index: x})
+			}
+			var err error
+			if errs := ValidatePath(schema, tt.inRoot, tt.inPath, opts...); errs != nil {
+				err = errs
+			}
+			if diff := errdiff.Substring(err, tt.wantErrSubstring); diff != "" {
+				t.Errorf("ValidatePath: %s", diff)
+			}
+		})
+	}
+}
+
+func TestValidateField(t *testing.T) {
+	schema := validatePathSchema().Dir["system"]
+
+	tests := []struct {
+		desc             string
+		inFieldName      string
+		inValue          interface{}
+		wantErrSubstring string
+	}{{
+		desc:        "valid value",
+		inFieldName: "Hostname",
+		inValue:     ygot.String("host"),
+	}, {
+		desc:             "invalid value",
+		inFieldName:      "Hostname",
+		inValue:          ygot.String("a-very-long-hostname"),
+		wantErrSubstring: "length 20 is outside range 1..10",
+	}, {
+		desc:        "leafref is not resolved",
+		inFieldName: "MgmtIntf",
+		inValue:     ygot.String("eth0"),
+	}, {
+		desc:             "unknown field",
+		inFieldName:      "Unknown",
+		inValue:          ygot.String("host"),
+		wantErrSubstring: "does not have a field Unknown",
+	}}
+
+	for _, tt := range tests {
+		t.Run(tt.desc, func(t *testing.T) {
+			var err error
+			if errs := ValidateField(schema, &validatePathSystem{}, tt.inFieldName, tt.inValue); errs != nil {
+				err = errs
+			}
+			if diff := errdiff.Substring(err, tt.wantErrSubstring); diff != "" {
+				t.Errorf("ValidateField: %s", diff)
+			}
+		})
+	}
+}
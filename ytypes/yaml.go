@@ -0,0 +1,308 @@
+// Copyright 2021 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ytypes
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+
+	"github.com/openconfig/goyang/pkg/yang"
+	"github.com/openconfig/ygot/util"
+	"github.com/openconfig/ygot/ygot"
+	"gopkg.in/yaml.v3"
+)
+
+// yamlTree is a YAML node that has been converted to the equivalent RFC7951
+// JSON value, retaining the node such that its position can be reported.
+type yamlTree struct {
+	// node is the YAML node, whose position is reported in errors.
+	node *yaml.Node
+	// kind is the kind of the node, or of the node that it is an alias of.
+	kind yaml.Kind
+	// value is the JSON value of a scalar node.
+	value interface{}
+	// fields are the members of a mapping node, in document order.
+	fields []*yamlField
+	// items are the items of a sequence node, in document order.
+	items []*yamlTree
+}
+
+// yamlField is a member of a YAML mapping.
+type yamlField struct {
+	// keyNode is the YAML node of the key.
+	keyNode *yaml.Node
+	// name is the key, which is the name of a field in RFC7951 JSON.
+	name string
+	// value is the value of the member.
+	value *yamlTree
+}
+
+// maxYAMLLocateAttempts is the maximum number of times that parts of a YAML
+// document are unmarshalled to locate the node that causes an error.
+const maxYAMLLocateAttempts = 100
+
+// errYAMLLocateLimit is returned by the function that unmarshals part of a
+// YAML document once maxYAMLLocateAttempts is reached.
+var errYAMLLocateLimit = errors.New("YAML locate attempts exhausted")
+
+// yamlError returns an error that reports err at the position of the YAML
+// node n.
+func yamlError(n *yaml.Node, err error) error {
+	return fmt.Errorf("line %d, column %d: %v", n.Line, n.Column, err)
+}
+
+// UnmarshalYAML unmarshals the YAML document in data into the GoStruct root,
+// whose schema is supplied. The document must be the YAML equivalent of the
+// RFC7951 JSON accepted by Unmarshal, as output by ygot.EmitYAML: containers
+// and list entries are mappings, and lists and leaf-lists are sequences. The
+// document is converted to its JSON equivalent, and unmarshalled according
+// to the same rules as RFC7951 JSON, using the supplied UnmarshalOpts. Integer
+// and floating point scalars are JSON numbers, booleans and nulls are their
+// JSON equivalents, and all other scalars are JSON strings. Aliases are
+// resolved, but merge keys are not supported.
+//
+// Errors, including errors returned by Unmarshal, report the line and column
+// of the YAML node that caused them. Since Unmarshal does not report the
+// position of an error, the node is found by unmarshalling parts of the
+// document into empty GoStructs, making at most maxYAMLLocateAttempts
+// attempts. If the limit is reached, the position of the smallest subtree of
+// the document that is known to cause the error is reported. As for
+// Unmarshal, root may be modified if an error is returned.
+func UnmarshalYAML(schema *yang.Entry, root ygot.GoStruct, data []byte, opts ...UnmarshalOpt) error {
+	if schema == nil {
+		return fmt.Errorf("nil schema supplied for %T", root)
+	}
+	if util.IsValueNil(root) {
+		return fmt.Errorf("nil GoStruct supplied")
+	}
+
+	doc := &yaml.Node{}
+	if err := yaml.Unmarshal(data, doc); err != nil {
+		return fmt.Errorf("cannot parse YAML: %v", err)
+	}
+	// An empty document contains no nodes.
+	if len(doc.Content) == 0 {
+		return nil
+	}
+	t, err := newYAMLTree(doc.Content[0])
+	if err != nil {
+		return err
+	}
+	if t.kind != yaml.MappingNode {
+		return yamlError(t.node, fmt.Errorf("YAML document is a %s, expect mapping", yamlKindName(t.kind)))
+	}
+
+	if err := Unmarshal(schema, root, t.json(), opts...); err != nil {
+		// The error does not identify the node that caused it, so the
+		// smallest subtree of the document that causes the same error is
+		// found by unmarshalling parts of it into empty GoStructs.
+		attempts := 0
+		try := func(j interface{}) error {
+			if attempts == maxYAMLLocateAttempts {
+				return errYAMLLocateLimit
+			}
+			attempts++
+			return Unmarshal(schema, reflect.New(reflect.TypeOf(root).Elem()).Interface(), j, opts...)
+		}
+		return yamlError(t.locate(schema, try, func(j interface{}) interface{} { return j }), err)
+	}
+	return nil
+}
+
+// newYAMLTree converts the YAML node n to a yamlTree.
+func newYAMLTree(n *yaml.Node) (*yamlTree, error) {
+	t := &yamlTree{node: n, kind: n.Kind}
+	switch n.Kind {
+	case yaml.AliasNode:
+		at, err := newYAMLTree(n.Alias)
+		if err != nil {
+			return nil, err
+		}
+		// The alias, rather than its anchor, is reported as the position
+		// of errors.
+		at.node = n
+		return at, nil
+	case yaml.MappingNode:
+		seen := map[string]bool{}
+		for i := 0; i+1 < len(n.Content); i += 2 {
+			k, v := n.Content[i], n.Content[i+1]
+			switch {
+			case k.Kind != yaml.ScalarNode:
+				return nil, yamlError(k, fmt.Errorf("mapping key is a %s, expect scalar", yamlKindName(k.Kind)))
+			case k.Tag == "!!merge":
+				return nil, yamlError(k, fmt.Errorf("merge keys are not supported"))
+			case seen[k.Value]:
+				return nil, yamlError(k, fmt.Errorf("duplicate mapping key %s", k.Value))
+			}
+			seen[k.Value] = true
+			vt, err := newYAMLTree(v)
+			if err != nil {
+				return nil, err
+			}
+			t.fields = append(t.fields, &yamlField{keyNode: k, name: k.Value, value: vt})
+		}
+	case yaml.SequenceNode:
+		for _, c := range n.Content {
+			ct, err := newYAMLTree(c)
+			if err != nil {
+				return nil, err
+			}
+			t.items = append(t.items, ct)
+		}
+	case yaml.ScalarNode:
+		v, err := yamlScalarJSON(n)
+		if err != nil {
+			return nil, yamlError(n, err)
+		}
+		t.value = v
+	default:
+		return nil, yamlError(n, fmt.Errorf("unsupported YAML %s", yamlKindName(n.Kind)))
+	}
+	return t, nil
+}
+
+// yamlScalarJSON returns the JSON value, as decoded by encoding/json, of the
+// YAML scalar node n.
+func yamlScalarJSON(n *yaml.Node) (interface{}, error) {
+	switch n.ShortTag() {
+	case "!!null":
+		return nil, nil
+	case "!!bool":
+		var b bool
+		if err := n.Decode(&b); err != nil {
+			return nil, err
+		}
+		return b, nil
+	case "!!int", "!!float":
+		var f float64
+		if err := n.Decode(&f); err != nil {
+			return nil, err
+		}
+		if math.IsInf(f, 0) || math.IsNaN(f) {
+			return nil, fmt.Errorf("%s cannot be represented in JSON", n.Value)
+		}
+		return f, nil
+	}
+	return n.Value, nil
+}
+
+// yamlKindName returns a description of the YAML node kind k.
+func yamlKindName(k yaml.Kind) string {
+	switch k {
+	case yaml.DocumentNode:
+		return "document"
+	case yaml.SequenceNode:
+		return "sequence"
+	case yaml.MappingNode:
+		return "mapping"
+	case yaml.ScalarNode:
+		return "scalar"
+	case yaml.AliasNode:
+		return "alias"
+	}
+	return fmt.Sprintf("node of kind %d", k)
+}
+
+// json returns the JSON value equivalent to t.
+func (t *yamlTree) json() interface{} {
+	switch t.kind {
+	case yaml.MappingNode:
+		return t.object(t.fields)
+	case yaml.SequenceNode:
+		l := []interface{}{}
+		for _, it := range t.items {
+			l = append(l, it.json())
+		}
+		return l
+	}
+	return t.value
+}
+
+// object returns the JSON object containing the supplied fields.
+func (t *yamlTree) object(fields []*yamlField) map[string]interface{} {
+	o := map[string]interface{}{}
+	for _, f := range fields {
+		o[f.name] = f.value.json()
+	}
+	return o
+}
+
+// locate returns the node within t that is responsible for an error that is
+// returned by try. t is the value of a node with schema e, and wrap returns
+// the document in which the JSON value of t is replaced with the supplied
+// value. If the error is returned when only one of the members of a mapping,
+// or one of the items of a sequence, is retained, that member or item is
+// searched in turn, otherwise t itself is returned. t is also returned if try
+// returns errYAMLLocateLimit. The keys of list entries are always retained.
+func (t *yamlTree) locate(e *yang.Entry, try func(interface{}) error, wrap func(interface{}) interface{}) *yaml.Node {
+	switch t.kind {
+	case yaml.MappingNode:
+		if e == nil || (!e.IsContainer() && !e.IsList()) {
+			return t.node
+		}
+		isKey := map[string]bool{}
+		var keys []*yamlField
+		if e.IsList() {
+			for _, k := range keyNames(e) {
+				isKey[k] = true
+			}
+			for _, f := range t.fields {
+				if isKey[util.StripModulePrefix(f.name)] {
+					keys = append(keys, f)
+				}
+			}
+		}
+		for _, f := range t.fields {
+			fields := keys
+			if !isKey[util.StripModulePrefix(f.name)] {
+				fields = append(append([]*yamlField{}, keys...), f)
+			}
+			switch err := try(wrap(t.object(fields))); {
+			case err == errYAMLLocateLimit:
+				return t.node
+			case err == nil:
+				continue
+			}
+			ce := dynamicChildSchema(e, f.name)
+			if ce == nil {
+				return f.keyNode
+			}
+			f := f
+			return f.value.locate(ce, try, func(j interface{}) interface{} {
+				o := t.object(keys)
+				o[f.name] = j
+				return wrap(o)
+			})
+		}
+	case yaml.SequenceNode:
+		if e == nil || (!e.IsList() && !e.IsLeafList()) {
+			return t.node
+		}
+		for _, it := range t.items {
+			switch err := try(wrap([]interface{}{it.json()})); {
+			case err == errYAMLLocateLimit:
+				return t.node
+			case err == nil:
+				continue
+			}
+			return it.locate(e, try, func(j interface{}) interface{} {
+				return wrap([]interface{}{j})
+			})
+		}
+	}
+	return t.node
+}
@@ -0,0 +1,215 @@
+// Copyright 2021 Google Inc.
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//      http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ytypes
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/openconfig/gnmi/errdiff"
+	"github.com/openconfig/ygot/testutil"
+	"github.com/openconfig/ygot/ygot"
+)
+
+func TestUnmarshalYAML(t *testing.T) {
+	tests := []struct {
+		name             string
+		in               string
+		inOpts           []UnmarshalOpt
+		want             *flatRoot
+		wantErrSubstring string
+	}{{
+		name: "empty document",
+		in:   "",
+		want: &flatRoot{},
+	}, {
+		name: "scalar types",
+		in: `big: "-9000000000"
+data: AQID
+ident: fmod:E_VALUE_FORTY_THREE
+kind: E_VALUE_FORTY_TWO
+mtu: 1500
+name: eth0
+ratio: "1.25"
+value: 42
+`,
+		want: &flatRoot{
+			Big:   ygot.Int64(-9000000000),
+			Data:  Binary{1, 2, 3},
+			Ident: 43,
+			Kind:  42,
+			Mtu:   ygot.Uint16(1500),
+			Name:  ygot.String("eth0"),
+			Ratio: ygot.Float64(1.25),
+			Value: testutil.UnionUint32(42),
+		},
+	}, {
+		name: "module-qualified keys, lists and leaf-lists",
+		in: `fmod:entry:
+  - name: eth0
+    desc: uplink
+  - {name: eth1}
+fmod:log:
+  - name: a
+  - desc: b
+fmod:tags: [b, a]
+`,
+		want: &flatRoot{
+			Entry: map[string]*flatRootEntry{
+				"eth0": {Name: ygot.String("eth0"), Desc: ygot.String("uplink")},
+				"eth1": {Name: ygot.String("eth1")},
+			},
+			Log:  []*flatRootEntry{{Name: ygot.String("a")}, {Desc: ygot.String("b")}},
+			Tags: []string{"b", "a"},
+		},
+	}, {
+		name: "aliases",
+		in: `name: &n eth0
+tags: &t [*n]
+entry:
+  - &e {name: eth0}
+log: [*e]
+`,
+		want: &flatRoot{
+			Name:  ygot.String("eth0"),
+			Tags:  []string{"eth0"},
+			Entry: map[string]*flatRootEntry{"eth0": {Name: ygot.String("eth0")}},
+			Log:   []*flatRootEntry{{Name: ygot.String("eth0")}},
+		},
+	}, {
+		name:             "unknown field",
+		in:               "name: eth0\nspeed: 100\n",
+		wantErrSubstring: "line 2, column 1: parent container flat-root (type *ytypes.flatRoot): JSON contains unexpected field speed",
+	}, {
+		name:             "unknown field within list entry",
+		in:               "entry:\n  - name: eth0\n    speed: 100\n",
+		wantErrSubstring: "line 3, column 5: ",
+	}, {
+		name: "invalid value within list entry",
+		in: `entry:
+  - name: eth0
+    desc: uplink
+  - name: eth1
+    desc: [a]
+`,
+		wantErrSubstring: "line 5, column 11: ",
+	}, {
+		name:             "invalid leaf-list item",
+		in:               "tags:\n  - a\n  - {b: c}\n",
+		wantErrSubstring: "line 3, column 5: ",
+	}, {
+		name:             "invalid leaf-list item beyond the locate limit",
+		in:               "tags:\n" + strings.Repeat("  - a\n", maxYAMLLocateAttempts) + "  - {b: c}\n",
+		wantErrSubstring: "line 2, column 3: ",
+	}, {
+		name:             "YAML integer for int64 leaf",
+		in:               "mtu: 1500\nbig: 10\n",
+		wantErrSubstring: "line 2, column 6: ",
+	}, {
+		name:             "invalid YAML",
+		in:               "name: eth0\n  mtu: 1500\n",
+		wantErrSubstring: "line 2",
+	}, {
+		name:             "document is not a mapping",
+		in:               "- a\n",
+		wantErrSubstring: "line 1, column 1: YAML document is a sequence, expect mapping",
+	}, {
+		name:             "non-scalar key",
+		in:               "? [a]\n: b\n",
+		wantErrSubstring: "line 1, column 3: mapping key is a sequence, expect scalar",
+	}, {
+		name:             "duplicate key",
+		in:               "name: a\nname: b\n",
+		wantErrSubstring: "line 2, column 1: duplicate mapping key name",
+	}, {
+		name:             "infinite float",
+		in:               "ratio: .inf\n",
+		wantErrSubstring: "line 1, column 8: .inf cannot be represented in JSON",
+	}}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := &flatRoot{}
+			err := UnmarshalYAML(flatSchema(t), got, []byte(tt.in), tt.inOpts...)
+			if diff := errdiff.Substring(err, tt.wantErrSubstring); diff != "" {
+				t.Fatalf("did not get expected error, %s", diff)
+			}
+			if err != nil {
+				return
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("did not get expected GoStruct, diff(-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestYAMLRoundTrip(t *testing.T) {
+	in := &flatRoot{
+		Name:  ygot.String("yes"),
+		Mtu:   ygot.Uint16(9000),
+		Big:   ygot.Int64(9007199254740993),
+		Ratio: ygot.Float64(-0.5),
+		Kind:  42,
+		Ident: 43,
+		Data:  Binary("binary"),
+		Value: EnumType(42),
+		Tags:  []string{"z", "", "1"},
+		Entry: map[string]*flatRootEntry{
+			"a": {Name: ygot.String("a"), Desc: ygot.String("null")},
+			"c": {Name: ygot.String("c")},
+		},
+		Log: []*flatRootEntry{{Name: ygot.String("x")}, {Desc: ygot.String("y")}},
+	}
+
+	y, err := ygot.EmitYAML(in, &ygot.EmitYAMLConfig{
+		RFC7951Config: &ygot.RFC7951JSONConfig{AppendModuleName: true},
+	})
+	if err != nil {
+		t.Fatalf("EmitYAML: unexpected error: %v", err)
+	}
+	want := `fmod:big: "9007199254740993"
+fmod:data: YmluYXJ5
+fmod:entry:
+  - desc: "null"
+    name: a
+  - name: c
+fmod:ident: E_VALUE_FORTY_THREE
+fmod:kind: E_VALUE_FORTY_TWO
+fmod:log:
+  - name: x
+  - desc: "y"
+fmod:mtu: 9000
+fmod:name: "yes"
+fmod:ratio: "-0.5"
+fmod:tags:
+  - z
+  - ""
+  - "1"
+fmod:value: E_VALUE_FORTY_TWO
+`
+	if diff := cmp.Diff(want, y); diff != "" {
+		t.Errorf("EmitYAML: did not get expected output, diff(-want, +got):\n%s", diff)
+	}
+
+	got := &flatRoot{}
+	if err := UnmarshalYAML(flatSchema(t), got, []byte(y)); err != nil {
+		t.Fatalf("UnmarshalYAML: unexpected error: %v", err)
+	}
+	if diff := cmp.Diff(in, got); diff != "" {
+		t.Errorf("UnmarshalYAML: did not get expected GoStruct, diff(-want, +got):\n%s", diff)
+	}
+}